@@ -5,6 +5,8 @@ package api
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -18,6 +20,138 @@ const (
 	Json   AuthenticateParamsXTokenDelivery = "json"
 )
 
+// Defines values for BatchOperationRequestOp.
+const (
+	Create BatchOperationRequestOp = "create"
+	Delete BatchOperationRequestOp = "delete"
+	Update BatchOperationRequestOp = "update"
+)
+
+// Defines values for BulkMode.
+const (
+	AllOrNothing BulkMode = "allOrNothing"
+	BestEffort   BulkMode = "bestEffort"
+)
+
+// Defines values for LiveClientMessageType.
+const (
+	LiveClientMessageTypeCreate      LiveClientMessageType = "create"
+	LiveClientMessageTypeDelete      LiveClientMessageType = "delete"
+	LiveClientMessageTypeSubscribe   LiveClientMessageType = "subscribe"
+	LiveClientMessageTypeUnsubscribe LiveClientMessageType = "unsubscribe"
+	LiveClientMessageTypeUpdate      LiveClientMessageType = "update"
+)
+
+// Defines values for LiveServerMessageEvent.
+const (
+	LiveServerMessageEventCreated LiveServerMessageEvent = "created"
+	LiveServerMessageEventDeleted LiveServerMessageEvent = "deleted"
+	LiveServerMessageEventReset   LiveServerMessageEvent = "reset"
+	LiveServerMessageEventUpdated LiveServerMessageEvent = "updated"
+)
+
+// Defines values for LiveServerMessageType.
+const (
+	LiveServerMessageTypeError  LiveServerMessageType = "error"
+	LiveServerMessageTypeEvent  LiveServerMessageType = "event"
+	LiveServerMessageTypeResult LiveServerMessageType = "result"
+)
+
+// Defines values for PushChangeRequestOp.
+const (
+	PushChangeRequestOpCreate PushChangeRequestOp = "create"
+	PushChangeRequestOpDelete PushChangeRequestOp = "delete"
+	PushChangeRequestOpUpdate PushChangeRequestOp = "update"
+)
+
+// Defines values for PushChangeResultResponseOp.
+const (
+	PushChangeResultResponseOpCreate PushChangeResultResponseOp = "create"
+	PushChangeResultResponseOpDelete PushChangeResultResponseOp = "delete"
+	PushChangeResultResponseOpUpdate PushChangeResultResponseOp = "update"
+)
+
+// Defines values for PushChangeResultResponseStatus.
+const (
+	Applied  PushChangeResultResponseStatus = "applied"
+	Conflict PushChangeResultResponseStatus = "conflict"
+	Failed   PushChangeResultResponseStatus = "failed"
+	Merged   PushChangeResultResponseStatus = "merged"
+	NotFound PushChangeResultResponseStatus = "not_found"
+)
+
+// Defines values for PushConflictField.
+const (
+	PushConflictFieldIsComplete PushConflictField = "isComplete"
+	PushConflictFieldText       PushConflictField = "text"
+)
+
+// Defines values for SearchSnippetResponseField.
+const (
+	Comment SearchSnippetResponseField = "comment"
+	Text    SearchSnippetResponseField = "text"
+)
+
+// Defines values for TodoField.
+const (
+	TodoFieldIsComplete TodoField = "isComplete"
+	TodoFieldText       TodoField = "text"
+)
+
+// Defines values for TodoListRole.
+const (
+	Editor TodoListRole = "editor"
+	Owner  TodoListRole = "owner"
+	Viewer TodoListRole = "viewer"
+)
+
+// Defines values for TodoRevisionAction.
+const (
+	TodoRevisionActionArchived   TodoRevisionAction = "archived"
+	TodoRevisionActionCreated    TodoRevisionAction = "created"
+	TodoRevisionActionDeleted    TodoRevisionAction = "deleted"
+	TodoRevisionActionRestored   TodoRevisionAction = "restored"
+	TodoRevisionActionReverted   TodoRevisionAction = "reverted"
+	TodoRevisionActionUnarchived TodoRevisionAction = "unarchived"
+	TodoRevisionActionUpdated    TodoRevisionAction = "updated"
+)
+
+// Defines values for WebhookDeliveryResponseStatus.
+const (
+	Dead      WebhookDeliveryResponseStatus = "dead"
+	Pending   WebhookDeliveryResponseStatus = "pending"
+	Succeeded WebhookDeliveryResponseStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeCreated WebhookEventType = "created"
+	WebhookEventTypeDeleted WebhookEventType = "deleted"
+	WebhookEventTypeUpdated WebhookEventType = "updated"
+)
+
+// AddChecklistItemRequest defines model for AddChecklistItemRequest.
+type AddChecklistItemRequest struct {
+	Text string `binding:"required,max=250" json:"text"`
+}
+
+// ArchiveCompletedTodosResponse defines model for ArchiveCompletedTodosResponse.
+type ArchiveCompletedTodosResponse struct {
+	// ArchivedCount Number of todos archived by the request
+	ArchivedCount int32 `json:"archivedCount"`
+}
+
+// AttachmentResponse defines model for AttachmentResponse.
+type AttachmentResponse struct {
+	ContentType string    `json:"contentType"`
+	CreatedAt   time.Time `json:"createdAt"`
+	FileName    string    `json:"fileName"`
+	ID          int32     `json:"id"`
+
+	// Size Size of the content in bytes
+	Size int64 `json:"size"`
+}
+
 // AuthenticateRequest defines model for AuthenticateRequest.
 type AuthenticateRequest struct {
 	LoginID  string `binding:"required,max=100" json:"loginId"`
@@ -30,6 +164,104 @@ type AuthenticateResponse struct {
 	AccessToken *string `json:"accessToken,omitempty"`
 }
 
+// BatchOperationRequest One operation of a batch. A create takes todo, an update takes patch and a delete takes neither
+type BatchOperationRequest struct {
+	// ExpectedVersion Version the todo must have for an update or delete to apply, as in If-Match
+	ExpectedVersion *int32 `binding:"omitempty,gt=0" json:"expectedVersion,omitempty"`
+
+	// ID Todo to update or delete; use tempId instead for a todo created earlier in the batch
+	ID *int32 `binding:"omitempty,gt=0" json:"id,omitempty"`
+
+	// Op Kind of change the operation makes
+	Op    BatchOperationRequestOp `binding:"required,oneof=create update delete" json:"op"`
+	Patch *PatchTodoRequest       `json:"patch,omitempty"`
+
+	// TempID Client-provided name of the todo a create makes, or of the todo created earlier in the batch an update or delete targets
+	TempID *string            `binding:"omitempty,min=1,max=64" json:"tempId,omitempty"`
+	Todo   *CreateTodoRequest `json:"todo,omitempty"`
+}
+
+// BatchOperationRequestOp Kind of change the operation makes
+type BatchOperationRequestOp string
+
+// BatchOperationResultResponse Outcome of one operation. error is set if the operation failed; todo is set for a successful create or update and for a version mismatch
+type BatchOperationResultResponse struct {
+	Error *ErrorResponse `json:"error,omitempty"`
+
+	// Etag Entity tag of the todo in todo, as in the ETag header of the single-todo endpoints
+	Etag *string `json:"etag,omitempty"`
+
+	// ID Todo the operation created or targeted; omitted when it is unknown
+	ID *int32 `json:"id,omitempty"`
+
+	// Status HTTP status code the operation would have had as a single request
+	Status int32                 `json:"status"`
+	TempID *string               `json:"tempId,omitempty"`
+	Todo   *FindTodoResponseTodo `json:"todo,omitempty"`
+}
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	// Atomic Run all operations in one transaction and roll every change back if one of them fails
+	Atomic     *bool                   `json:"atomic,omitempty"`
+	Operations []BatchOperationRequest `binding:"required,min=1,max=100,dive" json:"operations"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	// Committed Whether the changes of the successful operations were kept; false when an atomic batch was rolled back
+	Committed bool `json:"committed"`
+
+	// Results Results in the order of the operations
+	Results []BatchOperationResultResponse `json:"results"`
+}
+
+// BulkMode allOrNothing changes every todo in one transaction or none of them; bestEffort changes each todo on its own and reports per-todo results
+type BulkMode string
+
+// BulkTodoResultResponse Outcome of a bulk operation for one todo. error is set if the todo could not be changed; todo is set for a successful update
+type BulkTodoResultResponse struct {
+	Error *ErrorResponse        `json:"error,omitempty"`
+	ID    int32                 `json:"id"`
+	Todo  *FindTodoResponseTodo `json:"todo,omitempty"`
+}
+
+// BulkTodosResponse defines model for BulkTodosResponse.
+type BulkTodosResponse struct {
+	// Results Results in the order of the requested ids
+	Results []BulkTodoResultResponse `json:"results"`
+}
+
+// ChecklistItemResponse defines model for ChecklistItemResponse.
+type ChecklistItemResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        int32     `json:"id"`
+	IsChecked bool      `json:"isChecked"`
+
+	// Position Zero-based position used for ordering; gaps are allowed
+	Position  int32     `json:"position"`
+	Text      string    `json:"text"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CommentAuthorResponse defines model for CommentAuthorResponse.
+type CommentAuthorResponse struct {
+	LoginID string `json:"loginId"`
+	UserID  int32  `json:"userId"`
+}
+
+// CommentResponse defines model for CommentResponse.
+type CommentResponse struct {
+	Author    CommentAuthorResponse `json:"author"`
+	CreatedAt time.Time             `json:"createdAt"`
+
+	// EditedAt Time of the last edit by the author; omitted if the comment has never been edited
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+	ID        int32      `json:"id"`
+	Text      string     `json:"text"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
 // CreateBulkTodosRequest defines model for CreateBulkTodosRequest.
 type CreateBulkTodosRequest struct {
 	Todos []CreateTodoRequest `json:"todos"`
@@ -40,6 +272,11 @@ type CreateBulkTodosResponse struct {
 	Todos []CreateTodoResponse `json:"todos"`
 }
 
+// CreateCommentRequest defines model for CreateCommentRequest.
+type CreateCommentRequest struct {
+	Text string `binding:"required,max=2000" json:"text"`
+}
+
 // CreateTodoRequest defines model for CreateTodoRequest.
 type CreateTodoRequest struct {
 	Text string `binding:"required,max=250" json:"text"`
@@ -54,30 +291,489 @@ type CreateTodoResponse struct {
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
+// CreateViewRequest defines model for CreateViewRequest.
+type CreateViewRequest struct {
+	// Filter Filter query in the syntax of the filter parameter of GET /todo; empty matches every todo
+	Filter string `binding:"max=500" json:"filter"`
+	Name   string `binding:"required,max=100" json:"name"`
+
+	// Order Sort direction, one of asc, desc; defaults to asc
+	Order *string `json:"order,omitempty"`
+
+	// Sort Sort field, one of id, createdAt, updatedAt, text; defaults to id
+	Sort *string `json:"sort,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	Events []WebhookEventType `binding:"required,min=1,max=3,unique,dive,oneof=created updated deleted" json:"events"`
+
+	// URL Absolute http or https URL to send payloads to
+	URL string `binding:"required,max=2048" json:"url"`
+}
+
+// CreateWebhookResponse defines model for CreateWebhookResponse.
+type CreateWebhookResponse struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Events    []WebhookEventType `json:"events"`
+	ID        int32              `json:"id"`
+
+	// Secret Key the payloads are signed with. It is only returned when the webhook is created
+	Secret    string    `json:"secret"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	URL       string    `json:"url"`
+}
+
+// DeleteBulkTodosRequest defines model for DeleteBulkTodosRequest.
+type DeleteBulkTodosRequest struct {
+	IDs  []int32  `binding:"required,min=1,max=100,unique,dive,gt=0" json:"ids"`
+	Mode BulkMode `binding:"omitempty,oneof=allOrNothing bestEffort" json:"mode,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// FindAttachmentsResponse defines model for FindAttachmentsResponse.
+type FindAttachmentsResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+}
+
+// FindCommentsResponse defines model for FindCommentsResponse.
+type FindCommentsResponse struct {
+	Comments []CommentResponse `json:"comments"`
+}
+
+// FindTodoHistoryResponse defines model for FindTodoHistoryResponse.
+type FindTodoHistoryResponse struct {
+	// Revisions Revisions of the todo, oldest first
+	Revisions []TodoRevisionResponse `json:"revisions"`
+}
+
+// FindSharedTodoListsResponse defines model for FindSharedTodoListsResponse.
+type FindSharedTodoListsResponse struct {
+	Lists []SharedTodoListResponse `json:"lists"`
+}
+
 // FindTodoResponse defines model for FindTodoResponse.
 type FindTodoResponse struct {
-	Todos []FindTodoResponseTodo `json:"todos"`
+	// NextCursor Opaque cursor to pass as the cursor parameter to fetch the next page; omitted on the last page
+	NextCursor *string                `json:"nextCursor,omitempty"`
+	Todos      []FindTodoResponseTodo `json:"todos"`
 }
 
 // FindTodoResponseTodo defines model for FindTodoResponseTodo.
 type FindTodoResponseTodo struct {
+	// ArchivedAt Time the todo was archived; omitted while the todo is not archived
+	ArchivedAt *time.Time              `json:"archivedAt,omitempty"`
+	Checklist  []ChecklistItemResponse `json:"checklist"`
+
+	// CommentCount Number of comments posted on the todo
+	CommentCount int32 `json:"commentCount"`
+
+	// CompletedAt Time the todo was completed; omitted while the todo is not complete
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ID          int32      `json:"id"`
+	IsComplete  bool       `json:"isComplete"`
+	Text        string     `json:"text"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// FindTodoListInvitationsResponse defines model for FindTodoListInvitationsResponse.
+type FindTodoListInvitationsResponse struct {
+	Invitations []TodoListInvitationResponse `json:"invitations"`
+}
+
+// FindTodoListMembersResponse defines model for FindTodoListMembersResponse.
+type FindTodoListMembersResponse struct {
+	Members []TodoListMemberResponse `json:"members"`
+}
+
+// FindTrashResponse defines model for FindTrashResponse.
+type FindTrashResponse struct {
+	Todos []TrashedTodoResponse `json:"todos"`
+}
+
+// FindViewsResponse defines model for FindViewsResponse.
+type FindViewsResponse struct {
+	Views []FindViewsResponseView `json:"views"`
+}
+
+// FindViewsResponseView defines model for FindViewsResponseView.
+type FindViewsResponseView struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Filter    string    `json:"filter"`
+	ID        int32     `json:"id"`
+	Name      string    `json:"name"`
+	Order     string    `json:"order"`
+	Sort      string    `json:"sort"`
+
+	// TodoCount Number of unarchived todos the view matches now
+	TodoCount int32     `json:"todoCount"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// FindWebhookDeliveriesResponse defines model for FindWebhookDeliveriesResponse.
+type FindWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// FindWebhooksResponse defines model for FindWebhooksResponse.
+type FindWebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+// GetMeResponse defines model for GetMeResponse.
+type GetMeResponse struct {
+	LoginID string `json:"loginId"`
+	UserID  int32  `json:"userId"`
+}
+
+// InviteTodoListMemberRequest defines model for InviteTodoListMemberRequest.
+type InviteTodoListMemberRequest struct {
+	// LoginID Login ID of the user to invite
+	LoginID string `binding:"required,max=255" json:"loginId"`
+
+	// Role Permission a user has in a todo list: a viewer reads its todos, an editor also changes them and an owner also manages its members
+	Role TodoListRole `binding:"required,oneof=viewer editor owner" json:"role"`
+}
+
+// LiveClientMessage A message a client sends over the live connection. subscribe and unsubscribe take list, create takes text, update takes id and text or isComplete and delete takes id. version makes an update or delete conditional like If-Match
+type LiveClientMessage struct {
+	// ID Todo to update or delete
+	ID *int32 `binding:"omitempty,gt=0" json:"id,omitempty"`
+
+	// IsComplete New completion state of the todo
+	IsComplete *bool `json:"isComplete,omitempty"`
+
+	// List Todo list to follow: "all" for all unarchived todos, "view:{id}" for the todos a saved view matches or "shared:{ownerId}" for the unarchived todos of a todo list shared with the user
+	List *string `binding:"omitempty,max=32" json:"list,omitempty"`
+
+	// RequestID Client-chosen ID echoed in the reply to the message
+	RequestID *string `binding:"omitempty,max=64" json:"requestId,omitempty"`
+
+	// Text New text of the todo
+	Text *string `json:"text,omitempty"`
+
+	// Type Kind of message
+	Type LiveClientMessageType `binding:"required,oneof=subscribe unsubscribe create update delete" json:"type"`
+
+	// Version Version the todo is expected to be at
+	Version *int32 `binding:"omitempty,gt=0" json:"version,omitempty"`
+}
+
+// LiveClientMessageType Kind of message
+type LiveClientMessageType string
+
+// LiveServerMessage A message the server sends over the live connection. An event carries a todo event and the subscribed lists the todo is now in; a result answers a client message; an error reports a client message that failed and holds the current todo for a version mismatch
+type LiveServerMessage struct {
+	Data  *TodoEventResponse `json:"data,omitempty"`
+	Error *ErrorResponse     `json:"error,omitempty"`
+
+	// Etag Entity tag of the todo in todo
+	Etag *string `json:"etag,omitempty"`
+
+	// Event Kind of change an event reports
+	Event *LiveServerMessageEvent `json:"event,omitempty"`
+
+	// EventID ID of the event
+	EventID *string `json:"eventId,omitempty"`
+
+	// List List a subscribe or unsubscribe result is for
+	List *string `json:"list,omitempty"`
+
+	// Lists Subscribed lists the todo of an event is in after the change
+	Lists *[]string `json:"lists,omitempty"`
+
+	// RequestID requestId of the client message a result or error answers
+	RequestID *string               `json:"requestId,omitempty"`
+	Todo      *FindTodoResponseTodo `json:"todo,omitempty"`
+
+	// Type Kind of message
+	Type LiveServerMessageType `json:"type"`
+}
+
+// LiveServerMessageEvent Kind of change an event reports
+type LiveServerMessageEvent string
+
+// LiveServerMessageType Kind of message
+type LiveServerMessageType string
+
+// PatchBulkTodosRequest defines model for PatchBulkTodosRequest.
+type PatchBulkTodosRequest struct {
+	IDs   []int32          `binding:"required,min=1,max=100,unique,dive,gt=0" json:"ids"`
+	Mode  BulkMode         `binding:"omitempty,oneof=allOrNothing bestEffort" json:"mode,omitempty"`
+	Patch PatchTodoRequest `json:"patch"`
+}
+
+// PatchTodoRequest defines model for PatchTodoRequest.
+type PatchTodoRequest struct {
+	// IsComplete New completion state; left untouched when omitted
+	IsComplete *bool `json:"isComplete,omitempty"`
+
+	// Text New text; left untouched when omitted
+	Text *string `binding:"omitempty,min=1,max=250" json:"text,omitempty"`
+}
+
+// PushChangeRequest A change the client made offline. A create takes local, an update takes id, baseVersion, base and local and a delete takes id and baseVersion
+type PushChangeRequest struct {
+	Base *SyncTodoFields `json:"base,omitempty"`
+
+	// BaseVersion Version of the todo the change was made to, as last received from the server
+	BaseVersion *int32 `binding:"omitempty,gt=0" json:"baseVersion,omitempty"`
+
+	// ID Todo to update or delete
+	ID    *int32          `binding:"omitempty,gt=0" json:"id,omitempty"`
+	Local *SyncTodoFields `json:"local,omitempty"`
+
+	// Op Kind of change the client made
+	Op PushChangeRequestOp `binding:"required,oneof=create update delete" json:"op"`
+
+	// TempID Client-provided name of the todo a create makes, echoed in its result
+	TempID *string `binding:"omitempty,min=1,max=64" json:"tempId,omitempty"`
+}
+
+// PushChangeRequestOp Kind of change the client made
+type PushChangeRequestOp string
+
+// PushChangeResultResponse Outcome of one pushed change. todo is the todo as the server now has it and is omitted for an applied delete; local and conflicts are set for a conflict
+type PushChangeResultResponse struct {
+	// Conflicts Fields both sides changed to different values; the server kept its values. Empty for a delete of a changed todo
+	Conflicts *[]PushConflictField `json:"conflicts,omitempty"`
+	Error     *ErrorResponse       `json:"error,omitempty"`
+
+	// Etag Entity tag of the todo in todo
+	Etag *string `json:"etag,omitempty"`
+
+	// ID Todo the change created or targeted
+	ID    *int32          `json:"id,omitempty"`
+	Local *SyncTodoFields `json:"local,omitempty"`
+
+	// Op Kind of change the client made
+	Op PushChangeResultResponseOp `json:"op"`
+
+	// Status applied: the todo was unchanged since baseVersion and the change was applied as made; merged: the todo was changed on the server too and both changes were merged; conflict: some fields could not be merged, or a delete targeted a changed todo; not_found: the todo no longer exists or is in the trash; failed: the change could not be processed
+	Status PushChangeResultResponseStatus `json:"status"`
+	TempID *string                        `json:"tempId,omitempty"`
+	Todo   *FindTodoResponseTodo          `json:"todo,omitempty"`
+}
+
+// PushChangeResultResponseOp Kind of change the client made
+type PushChangeResultResponseOp string
+
+// PushChangeResultResponseStatus applied: the todo was unchanged since baseVersion and the change was applied as made; merged: the todo was changed on the server too and both changes were merged; conflict: some fields could not be merged, or a delete targeted a changed todo; not_found: the todo no longer exists or is in the trash; failed: the change could not be processed
+type PushChangeResultResponseStatus string
+
+// PushConflictField Field of a todo that is merged on
+type PushConflictField string
+
+// PushRequest defines model for PushRequest.
+type PushRequest struct {
+	// Changes Changes in the order the client made them
+	Changes []PushChangeRequest `binding:"required,min=1,max=100,dive" json:"changes"`
+}
+
+// PushResponse defines model for PushResponse.
+type PushResponse struct {
+	// Results Results in the order of the changes
+	Results []PushChangeResultResponse `json:"results"`
+}
+
+// ReorderChecklistRequest defines model for ReorderChecklistRequest.
+type ReorderChecklistRequest struct {
+	ItemIDs []int32 `binding:"required,max=100" json:"itemIds"`
+}
+
+// ReorderChecklistResponse defines model for ReorderChecklistResponse.
+type ReorderChecklistResponse struct {
+	Items []ChecklistItemResponse `json:"items"`
+}
+
+// SearchSnippetFragmentResponse defines model for SearchSnippetFragmentResponse.
+type SearchSnippetFragmentResponse struct {
+	// Highlighted Whether the fragment is an occurrence of a search term
+	Highlighted bool   `json:"highlighted"`
+	Text        string `json:"text"`
+}
+
+// SearchSnippetResponse defines model for SearchSnippetResponse.
+type SearchSnippetResponse struct {
+	// Field Part of the todo the snippet is taken from
+	Field SearchSnippetResponseField `json:"field"`
+
+	// Fragments Consecutive pieces of the snippet; an ellipsis fragment marks text left out
+	Fragments []SearchSnippetFragmentResponse `json:"fragments"`
+}
+
+// SearchSnippetResponseField Part of the todo the snippet is taken from
+type SearchSnippetResponseField string
+
+// SearchTodoHitResponse defines model for SearchTodoHitResponse.
+type SearchTodoHitResponse struct {
+	// Score Relevance of the hit; only comparable within one search
+	Score    float64                 `json:"score"`
+	Snippets []SearchSnippetResponse `json:"snippets"`
+	Todo     FindTodoResponseTodo    `json:"todo"`
+}
+
+// SearchTodosResponse defines model for SearchTodosResponse.
+type SearchTodosResponse struct {
+	// Hits Matching todos, most relevant first
+	Hits []SearchTodoHitResponse `json:"hits"`
+}
+
+// SharedTodoListResponse A todo list of another user shared with the user
+type SharedTodoListResponse struct {
+	// JoinedAt Time the user accepted the invitation to the list
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// ListOwnerID ID of the user whose todos the list holds
+	ListOwnerID int32 `json:"listOwnerId"`
+
+	// Role Permission a user has in a todo list: a viewer reads its todos, an editor also changes them and an owner also manages its members
+	Role TodoListRole `json:"role"`
+}
+
+// SyncChangedTodoResponse defines model for SyncChangedTodoResponse.
+type SyncChangedTodoResponse struct {
+	// Etag Entity tag of the todo, to be sent in If-Match by later writes
+	Etag string               `json:"etag"`
+	Todo FindTodoResponseTodo `json:"todo"`
+}
+
+// SyncDeletedTodoResponse defines model for SyncDeletedTodoResponse.
+type SyncDeletedTodoResponse struct {
+	// DeletedAt Time the todo was moved to the trash
+	DeletedAt time.Time `json:"deletedAt"`
+	ID        int32     `json:"id"`
+}
+
+// SyncResponse defines model for SyncResponse.
+type SyncResponse struct {
+	// Created Todos created since the sync token
+	Created []SyncChangedTodoResponse `json:"created"`
+
+	// Deleted Todos moved to the trash since the sync token
+	Deleted []SyncDeletedTodoResponse `json:"deleted"`
+
+	// HasMore More changes follow; sync again at once with syncToken
+	HasMore bool `json:"hasMore"`
+
+	// SyncToken Opaque token to pass as the since parameter of the next sync
+	SyncToken string `json:"syncToken"`
+
+	// Updated Other todos changed since the sync token, including archived and restored ones
+	Updated []SyncChangedTodoResponse `json:"updated"`
+}
+
+// SyncTodoFields Values of the fields of a todo a client can edit offline
+type SyncTodoFields struct {
+	IsComplete bool   `json:"isComplete"`
+	Text       string `binding:"required,max=250" json:"text"`
+}
+
+// TodoEventResponse Data of a todo event. todo and etag are set for created and updated events, todoId for created, updated and deleted events
+type TodoEventResponse struct {
+	// Etag Entity tag of the todo in todo
+	Etag *string `json:"etag,omitempty"`
+
+	// OccurredAt Time the change was made
+	OccurredAt time.Time             `json:"occurredAt"`
+	Todo       *FindTodoResponseTodo `json:"todo,omitempty"`
+
+	// TodoID ID of the todo the event is about. Not set for a reset event
+	TodoID *int32 `json:"todoId,omitempty"`
+}
+
+// TodoField Field of a todo whose changes its history records
+type TodoField string
+
+// TodoListInvitationResponse A pending invitation to join a todo list
+type TodoListInvitationResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        int32     `json:"id"`
+
+	// Invitee Login ID the invitation is addressed to
+	Invitee string                `json:"invitee"`
+	Inviter CommentAuthorResponse `json:"inviter"`
+
+	// ListOwnerID ID of the user whose todos the list holds
+	ListOwnerID int32 `json:"listOwnerId"`
+
+	// Role Permission a user has in a todo list: a viewer reads its todos, an editor also changes them and an owner also manages its members
+	Role TodoListRole `json:"role"`
+}
+
+// TodoListMemberResponse defines model for TodoListMemberResponse.
+type TodoListMemberResponse struct {
+	// JoinedAt Time the member accepted the invitation to the list
+	JoinedAt time.Time `json:"joinedAt"`
+	LoginID  string    `json:"loginId"`
+
+	// Role Permission a user has in a todo list: a viewer reads its todos, an editor also changes them and an owner also manages its members
+	Role   TodoListRole `json:"role"`
+	UserID int32        `json:"userId"`
+}
+
+// TodoListRole Permission a user has in a todo list: a viewer reads its todos, an editor also changes them and an owner also manages its members
+type TodoListRole string
+
+// TodoRevisionAction Kind of write a revision records
+type TodoRevisionAction string
+
+// TodoRevisionResponse Text and completion of a todo after a write, and who made the write
+type TodoRevisionResponse struct {
+	// Action Kind of write a revision records
+	Action TodoRevisionAction `json:"action"`
+
+	// ChangedFields Fields the write changed. A creation lists every field, a deletion, restore, archive or unarchive none
+	ChangedFields []TodoField `json:"changedFields"`
+
+	// CreatedAt Time the write was made
 	CreatedAt  time.Time `json:"createdAt"`
+	IsComplete bool      `json:"isComplete"`
+
+	// RevertedFrom Revision whose values a revert restored; omitted for other writes
+	RevertedFrom *int32 `json:"revertedFrom,omitempty"`
+
+	// Revision Number of the revision, counting the writes to the todo from 1
+	Revision int32  `json:"revision"`
+	Text     string `json:"text"`
+
+	// UserID ID of the user who made the write
+	UserID int32 `json:"userId"`
+}
+
+// TrashedTodoResponse defines model for TrashedTodoResponse.
+type TrashedTodoResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeletedAt Time the todo was moved to the trash
+	DeletedAt  time.Time `json:"deletedAt"`
 	ID         int32     `json:"id"`
 	IsComplete bool      `json:"isComplete"`
 	Text       string    `json:"text"`
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
-// GetMeResponse defines model for GetMeResponse.
-type GetMeResponse struct {
-	LoginID string `json:"loginId"`
-	UserID  int32  `json:"userId"`
+// UndoTodosResponse defines model for UndoTodosResponse.
+type UndoTodosResponse struct {
+	// Todos The todos as the undo left them
+	Todos []FindTodoResponseTodo `json:"todos"`
+}
+
+// UpdateChecklistItemRequest defines model for UpdateChecklistItemRequest.
+type UpdateChecklistItemRequest struct {
+	IsChecked bool   `json:"isChecked"`
+	Text      string `binding:"required,max=250" json:"text"`
+}
+
+// UpdateCommentRequest defines model for UpdateCommentRequest.
+type UpdateCommentRequest struct {
+	Text string `binding:"required,max=2000" json:"text"`
 }
 
 // UpdateTodoRequest defines model for UpdateTodoRequest.
@@ -95,6 +791,112 @@ type UpdateTodoResponse struct {
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
+// UpdateViewRequest defines model for UpdateViewRequest.
+type UpdateViewRequest struct {
+	// Filter Filter query in the syntax of the filter parameter of GET /todo; empty matches every todo
+	Filter string `binding:"max=500" json:"filter"`
+	Name   string `binding:"required,max=100" json:"name"`
+
+	// Order Sort direction, one of asc, desc; defaults to asc
+	Order *string `json:"order,omitempty"`
+
+	// Sort Sort field, one of id, createdAt, updatedAt, text; defaults to id
+	Sort *string `json:"sort,omitempty"`
+}
+
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ViewResponse defines model for ViewResponse.
+type ViewResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Filter    string    `json:"filter"`
+	ID        int32     `json:"id"`
+	Name      string    `json:"name"`
+	Order     string    `json:"order"`
+	Sort      string    `json:"sort"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	// Attempts Number of attempts made so far
+	Attempts  int32     `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Event Kind of todo change a webhook is notified of
+	Event WebhookEventType `json:"event"`
+
+	// EventID ID of the payload; the same in every delivery of the event
+	EventID string `json:"eventId"`
+	ID      int32  `json:"id"`
+
+	// LastAttemptAt Time of the latest attempt; omitted before the first attempt
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty"`
+
+	// LastError Why the latest attempt failed; omitted if it succeeded
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode Status code of the response to the latest attempt; omitted if no response was received
+	LastStatusCode *int32 `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt Time of the next attempt of a pending delivery
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+
+	// Status pending until a 2xx response is received; dead once every attempt failed
+	Status WebhookDeliveryResponseStatus `json:"status"`
+}
+
+// WebhookDeliveryResponseStatus pending until a 2xx response is received; dead once every attempt failed
+type WebhookDeliveryResponseStatus string
+
+// WebhookEventType Kind of todo change a webhook is notified of
+type WebhookEventType string
+
+// WebhookPayload Body of the requests sent to webhooks
+type WebhookPayload struct {
+	// ID ID of the event; the same in every delivery of it, so that duplicates can be dropped
+	ID string `json:"id"`
+
+	// OccurredAt Time the change was made
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// Todo The todo after the change; omitted for a deleted event
+	Todo *WebhookPayloadTodo `json:"todo,omitempty"`
+
+	// TodoID ID of the todo the event is about
+	TodoID int32 `json:"todoId"`
+
+	// Type Kind of todo change a webhook is notified of
+	Type WebhookEventType `json:"type"`
+}
+
+// WebhookPayloadTodo The todo after the change; omitted for a deleted event
+type WebhookPayloadTodo struct {
+	// ArchivedAt Time the todo was archived; omitted while the todo is not archived
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+
+	// CompletedAt Time the todo was completed; omitted while the todo is not complete
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ID          int32      `json:"id"`
+	IsComplete  bool       `json:"isComplete"`
+	Text        string     `json:"text"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	Version     int32      `json:"version"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Events    []WebhookEventType `json:"events"`
+	ID        int32              `json:"id"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	URL       string             `json:"url"`
+}
+
 // AuthenticateParams defines parameters for Authenticate.
 type AuthenticateParams struct {
 	// XTokenDelivery Token delivery method (json or cookie)
@@ -107,11 +909,56 @@ type AuthenticateParamsXTokenDelivery string
 // AuthenticateJSONRequestBody defines body for Authenticate for application/json ContentType.
 type AuthenticateJSONRequestBody = AuthenticateRequest
 
+// BatchTodosJSONRequestBody defines body for BatchTodos for application/json ContentType.
+type BatchTodosJSONRequestBody = BatchRequest
+
 // CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
 type CreateTodoJSONRequestBody = CreateTodoRequest
 
 // CreateBulkTodosJSONRequestBody defines body for CreateBulkTodos for application/json ContentType.
 type CreateBulkTodosJSONRequestBody = CreateBulkTodosRequest
 
+// PatchBulkTodosJSONRequestBody defines body for PatchBulkTodos for application/json ContentType.
+type PatchBulkTodosJSONRequestBody = PatchBulkTodosRequest
+
+// DeleteBulkTodosJSONRequestBody defines body for DeleteBulkTodos for application/json ContentType.
+type DeleteBulkTodosJSONRequestBody = DeleteBulkTodosRequest
+
+// PatchTodoApplicationMergePatchPlusJSONRequestBody defines body for PatchTodo for application/merge-patch+json ContentType.
+type PatchTodoApplicationMergePatchPlusJSONRequestBody = PatchTodoRequest
+
 // UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
 type UpdateTodoJSONRequestBody = UpdateTodoRequest
+
+// AddChecklistItemJSONRequestBody defines body for AddChecklistItem for application/json ContentType.
+type AddChecklistItemJSONRequestBody = AddChecklistItemRequest
+
+// ReorderChecklistJSONRequestBody defines body for ReorderChecklist for application/json ContentType.
+type ReorderChecklistJSONRequestBody = ReorderChecklistRequest
+
+// UpdateChecklistItemJSONRequestBody defines body for UpdateChecklistItem for application/json ContentType.
+type UpdateChecklistItemJSONRequestBody = UpdateChecklistItemRequest
+
+// UploadAttachmentMultipartRequestBody defines body for UploadAttachment for multipart/form-data ContentType.
+type UploadAttachmentMultipartRequestBody = UploadAttachmentRequest
+
+// CreateCommentJSONRequestBody defines body for CreateComment for application/json ContentType.
+type CreateCommentJSONRequestBody = CreateCommentRequest
+
+// UpdateCommentJSONRequestBody defines body for UpdateComment for application/json ContentType.
+type UpdateCommentJSONRequestBody = UpdateCommentRequest
+
+// CreateViewJSONRequestBody defines body for CreateView for application/json ContentType.
+type CreateViewJSONRequestBody = CreateViewRequest
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = UpdateViewRequest
+
+// PushTodoChangesJSONRequestBody defines body for PushTodoChanges for application/json ContentType.
+type PushTodoChangesJSONRequestBody = PushRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// InviteTodoListMemberJSONRequestBody defines body for InviteTodoListMember for application/json ContentType.
+type InviteTodoListMemberJSONRequestBody = InviteTodoListMemberRequest
@@ -29,11 +29,44 @@ type AuthConfig struct {
 	Cookie            *controller.CookieConfig `yaml:"cookie" validate:"required"`
 }
 
+// AttachmentConfig holds upload limits and the blob store used for todo attachments.
+// AllowedContentTypes is a comma-separated list of media types.
+type AttachmentConfig struct {
+	MaxSizeBytes        int64                    `yaml:"maxSizeBytes" validate:"gte=1"`
+	AllowedContentTypes string                   `yaml:"allowedContentTypes" validate:"required"`
+	Storage             *gateway.BlobStoreConfig `yaml:"storage" validate:"required"`
+}
+
+// EventConfig holds settings of the real-time todo event stream.
+// ReplayBufferSize is the number of recent events, across all users, kept for clients that reconnect.
+type EventConfig struct {
+	HeartbeatIntervalSec int `yaml:"heartbeatIntervalSec" validate:"gte=1"`
+	ReplayBufferSize     int `yaml:"replayBufferSize" validate:"gte=0"`
+}
+
+// EventPublisherConfig selects where the outbox relay publishes todo events.
+// Types is a comma-separated list of log, which writes the events to the log, webhook, which queues their delivery
+// to the webhooks of their users, and nats, which publishes them to the NATS server configured in NATS.
+type EventPublisherConfig struct {
+	Types string              `yaml:"types" validate:"required"`
+	NATS  *gateway.NATSConfig `yaml:"nats" validate:"required"`
+}
+
 type Config struct {
-	Server *ServerConfig      `yaml:"server" validate:"required"`
-	DB     *gateway.DBConfig  `yaml:"db" validate:"required"`
-	Auth   *AuthConfig        `yaml:"auth" validate:"required"`
-	Log    *gateway.LogConfig `yaml:"log" validate:"required"`
+	Server         *ServerConfig                 `yaml:"server" validate:"required"`
+	DB             *gateway.DBConfig             `yaml:"db" validate:"required"`
+	Auth           *AuthConfig                   `yaml:"auth" validate:"required"`
+	Attachment     *AttachmentConfig             `yaml:"attachment" validate:"required"`
+	Trash          *controller.TrashConfig       `yaml:"trash" validate:"required"`
+	Archive        *controller.ArchiveConfig     `yaml:"archive" validate:"required"`
+	Idempotency    *controller.IdempotencyConfig `yaml:"idempotency" validate:"required"`
+	Undo           *controller.UndoConfig        `yaml:"undo" validate:"required"`
+	Event          *EventConfig                  `yaml:"event" validate:"required"`
+	Live           *handler.LiveConfig           `yaml:"live" validate:"required"`
+	Webhook        *controller.WebhookConfig     `yaml:"webhook" validate:"required"`
+	Outbox         *controller.OutboxConfig      `yaml:"outbox" validate:"required"`
+	EventPublisher *EventPublisherConfig         `yaml:"eventPublisher" validate:"required"`
+	Log            *gateway.LogConfig            `yaml:"log" validate:"required"`
 }
 
 //go:embed config.yml
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteAttachment handles DELETE /todo/:id/attachments/:attachmentId and removes the attachment and its content.
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	attachmentID, ok := getAttachmentIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DeleteAttachment called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Int("attachmentId", attachmentID))
+
+	input, err := domain.NewAttachmentInput(attachmentID, todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid delete attachment input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	if err := h.usecase.DeleteAttachment(ctx, input); err != nil {
+		h.writeAttachmentError(c, err, "failed to delete attachment")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,60 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AttachmentHandler_DeleteAttachment_shouldReturn204_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().DeleteAttachment(mock.Anything, &domain.AttachmentInput{
+		ID:     7,
+		TodoID: 1,
+		UserID: userID,
+	}).Return(nil).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/attachments/7", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+}
+
+func Test_AttachmentHandler_DeleteAttachment_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().DeleteAttachment(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/attachments/7", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DownloadAttachment handles GET /todo/:id/attachments/:attachmentId and streams the attachment content.
+// The response always carries Content-Disposition: attachment and X-Content-Type-Options: nosniff
+// so that uploaded content is never rendered inline by the browser.
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	attachmentID, ok := getAttachmentIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DownloadAttachment called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Int("attachmentId", attachmentID))
+
+	input, err := domain.NewAttachmentInput(attachmentID, todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid download attachment input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	output, err := h.usecase.DownloadAttachment(ctx, input)
+	if err != nil {
+		h.writeAttachmentError(c, err, "failed to download attachment")
+		return
+	}
+	defer output.Content.Close()
+
+	attachment := output.Attachment
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, output.Content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
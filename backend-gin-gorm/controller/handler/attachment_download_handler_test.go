@@ -0,0 +1,100 @@
+package handler_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AttachmentHandler_DownloadAttachment_shouldReturnContentWithHeaders_whenAttachmentExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().DownloadAttachment(mock.Anything, &domain.AttachmentInput{
+		ID:     7,
+		TodoID: 1,
+		UserID: userID,
+	}).Return(&domain.DownloadAttachmentOutput{
+		Attachment: &domain.Attachment{
+			ID:          7,
+			TodoID:      1,
+			FileName:    "領収書 2025.pdf",
+			ContentType: "application/pdf",
+			Size:        8,
+			StorageKey:  "todo/1/key",
+			CreatedAt:   time.Now(),
+		},
+		Content: io.NopCloser(strings.NewReader("%PDF-1.4")),
+	}, nil).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1/attachments/7", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.Equal(t, "%PDF-1.4", string(respBytes))
+	assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	assert.Equal(t, "8", w.Header().Get("Content-Length"))
+	assert.Equal(t, "attachment; filename*=utf-8''%E9%A0%98%E5%8F%8E%E6%9B%B8%202025.pdf", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+}
+
+func Test_AttachmentHandler_DownloadAttachment_shouldReturn404_whenAttachmentNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().DownloadAttachment(mock.Anything, mock.Anything).Return(nil, domain.ErrAttachmentNotFound).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1/attachments/999999", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "attachment_not_found", "Not Found")
+}
+
+func Test_AttachmentHandler_DownloadAttachment_shouldReturn400_whenInvalidAttachmentID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1/attachments/abc", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_attachment_id", "attachment id must be a positive integer")
+}
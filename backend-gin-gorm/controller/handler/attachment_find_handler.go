@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// FindAttachments handles GET /todo/:id/attachments and lists the todo's attachments.
+func (h *AttachmentHandler) FindAttachments(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindAttachments called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	input, err := domain.NewFindAttachmentsInput(todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find attachments input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	attachments, err := h.usecase.FindAttachments(ctx, input)
+	if err != nil {
+		h.writeAttachmentError(c, err, "failed to find attachments")
+		return
+	}
+
+	resp := make([]api.AttachmentResponse, 0, len(attachments))
+	for _, attachment := range attachments {
+		attachmentResp, err := NewAttachmentResponse(&attachment)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+			return
+		}
+		resp = append(resp, *attachmentResp)
+	}
+	c.JSON(http.StatusOK, &api.FindAttachmentsResponse{Attachments: resp})
+}
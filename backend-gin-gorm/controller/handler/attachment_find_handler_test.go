@@ -0,0 +1,51 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AttachmentHandler_FindAttachments_shouldReturn200_whenTodoHasAttachments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().FindAttachments(mock.Anything, &domain.FindAttachmentsInput{
+		TodoID: 1,
+		UserID: userID,
+	}).Return([]domain.Attachment{
+		{ID: 1, TodoID: 1, FileName: "a.png", ContentType: "image/png", Size: 10, StorageKey: "k1", CreatedAt: time.Now()},
+		{ID: 2, TodoID: 1, FileName: "b.pdf", ContentType: "application/pdf", Size: 20, StorageKey: "k2", CreatedAt: time.Now()},
+	}, nil).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1/attachments", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - fileName
+	fileNames := parseExpr(t, "$.attachments[*].fileName").Get(jsonObj)
+	assert.Equal(t, []any{"a.png", "b.pdf"}, fileNames)
+
+	// - storage key is not exposed
+	storageKeys := parseExpr(t, "$.attachments[*].storageKey").Get(jsonObj)
+	assert.Empty(t, storageKeys)
+}
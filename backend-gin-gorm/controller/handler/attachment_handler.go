@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// multipartOverheadBytes is the allowance for multipart boundaries and part headers on top of the file size limit.
+const multipartOverheadBytes = 64 * 1024
+
+// AttachmentUsecase defines the use case operations for managing todo attachments.
+type AttachmentUsecase interface {
+	UploadAttachment(ctx context.Context, input *domain.UploadAttachmentInput) (*domain.UploadAttachmentOutput, error)
+	FindAttachments(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error)
+	DownloadAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.DownloadAttachmentOutput, error)
+	DeleteAttachment(ctx context.Context, input *domain.AttachmentInput) error
+}
+
+// AttachmentHandler handles HTTP requests for files attached to a todo.
+type AttachmentHandler struct {
+	usecase        AttachmentUsecase
+	maxUploadBytes int64
+	logger         *slog.Logger
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler with the given use case.
+// maxUploadBytes bounds the size of a single uploaded file.
+func NewAttachmentHandler(usecase AttachmentUsecase, maxUploadBytes int64) *AttachmentHandler {
+	return &AttachmentHandler{
+		usecase:        usecase,
+		maxUploadBytes: maxUploadBytes,
+		logger:         slog.Default().With(slog.String(domain.LoggerNameKey, "AttachmentHandler")),
+	}
+}
+
+// NewInitAttachmentRouterFunc returns an InitRouterGroupFunc that registers attachment routes under "todo/:id/attachments".
+func NewInitAttachmentRouterFunc(attachmentUsecase AttachmentUsecase, maxUploadBytes int64) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		attachments := parentRouterGroup.Group("todo/:id/attachments", middleware...)
+		attachmentHandler := NewAttachmentHandler(attachmentUsecase, maxUploadBytes)
+
+		attachments.POST("", attachmentHandler.UploadAttachment)
+		attachments.GET("", attachmentHandler.FindAttachments)
+		attachments.GET("/:attachmentId", attachmentHandler.DownloadAttachment)
+		attachments.DELETE("/:attachmentId", attachmentHandler.DeleteAttachment)
+	}
+}
+
+// NewAttachmentResponse converts a domain Attachment to an AttachmentResponse API type.
+func NewAttachmentResponse(attachment *domain.Attachment) (*api.AttachmentResponse, error) {
+	if attachment == nil {
+		return nil, errors.New("attachment is nil")
+	}
+	id, err := safeIntToInt32(attachment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert attachment ID: %w", err)
+	}
+	return &api.AttachmentResponse{
+		ID:          id,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}, nil
+}
+
+func getAttachmentIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "attachmentId", "invalid_attachment_id", "attachment id must be a positive integer")
+}
+
+// writeAttachmentError maps attachment use case errors to HTTP responses.
+func (h *AttachmentHandler) writeAttachmentError(c *gin.Context, err error, message string) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrTodoNotFound):
+		h.logger.WarnContext(ctx, "todo not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrForbidden):
+		h.logger.WarnContext(ctx, "attachment operation forbidden", slog.Any("error", err))
+		c.JSON(http.StatusForbidden, NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden)))
+	case errors.Is(err, domain.ErrAttachmentNotFound):
+		h.logger.WarnContext(ctx, "attachment not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("attachment_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrAttachmentTooLarge):
+		h.writeTooLarge(c)
+	case errors.Is(err, domain.ErrAttachmentTypeNotAllowed):
+		h.logger.WarnContext(ctx, "attachment content type not allowed", slog.Any("error", err))
+		c.JSON(http.StatusUnsupportedMediaType, NewErrorResponse("attachment_type_not_allowed", "file type is not allowed"))
+	default:
+		h.logger.ErrorContext(ctx, message, slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (h *AttachmentHandler) writeTooLarge(c *gin.Context) {
+	h.logger.WarnContext(c.Request.Context(), "attachment too large", slog.Int64("maxUploadBytes", h.maxUploadBytes))
+	c.JSON(http.StatusRequestEntityTooLarge, NewErrorResponse("attachment_too_large", fmt.Sprintf("file must not exceed %d bytes", h.maxUploadBytes)))
+}
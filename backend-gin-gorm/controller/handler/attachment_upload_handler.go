@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// sniffLen is the number of leading bytes http.DetectContentType inspects.
+const sniffLen = 512
+
+// UploadAttachment handles POST /todo/:id/attachments with a multipart "file" field.
+// The content type is detected from the file content rather than trusted from the client.
+func (h *AttachmentHandler) UploadAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "UploadAttachment called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadBytes+multipartOverheadBytes)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.writeTooLarge(c)
+			return
+		}
+		h.logger.WarnContext(ctx, "invalid upload attachment request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+	if fileHeader.Size > h.maxUploadBytes {
+		h.writeTooLarge(c)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to open uploaded file", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	defer file.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		h.logger.ErrorContext(ctx, "failed to read uploaded file", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	contentType := http.DetectContentType(head[:n])
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		h.logger.ErrorContext(ctx, "failed to rewind uploaded file", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	input, err := domain.NewUploadAttachmentInput(todoID, userID, filepath.Base(fileHeader.Filename), contentType, fileHeader.Size, file)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid upload attachment input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.UploadAttachment(ctx, input)
+	if err != nil {
+		h.writeAttachmentError(c, err, "failed to upload attachment")
+		return
+	}
+
+	resp, err := NewAttachmentResponse(output.Attachment)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
@@ -0,0 +1,167 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newMultipartRequest(t *testing.T, ctx context.Context, url string, fieldName string, fileName string, content []byte) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile(fieldName, fileName)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func Test_AttachmentHandler_UploadAttachment_shouldReturn201_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	pngHeader := []byte("\x89PNG\r\n\x1a\n0000")
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().UploadAttachment(mock.Anything, mock.MatchedBy(func(input *domain.UploadAttachmentInput) bool {
+		content, err := io.ReadAll(input.Content)
+		return err == nil &&
+			input.TodoID == 1 &&
+			input.UserID == userID &&
+			input.FileName == "screenshot.png" &&
+			input.ContentType == "image/png" &&
+			input.Size == int64(len(pngHeader)) &&
+			bytes.Equal(content, pngHeader)
+	})).Return(&domain.UploadAttachmentOutput{
+		Attachment: &domain.Attachment{
+			ID:          7,
+			TodoID:      1,
+			FileName:    "screenshot.png",
+			ContentType: "image/png",
+			Size:        int64(len(pngHeader)),
+			StorageKey:  "todo/1/key",
+			CreatedAt:   time.Now(),
+		},
+	}, nil).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req := newMultipartRequest(t, ctx, "/api/v1/todo/1/attachments", "file", "../../screenshot.png", pngHeader)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - id
+	id := parseExpr(t, "$.id").Get(jsonObj)
+	require.Len(t, id, 1, "response should have one id")
+	assert.Equal(t, int64(7), id[0])
+
+	// - contentType
+	contentType := parseExpr(t, "$.contentType").Get(jsonObj)
+	require.Len(t, contentType, 1, "response should have one contentType")
+	assert.Equal(t, "image/png", contentType[0])
+}
+
+func Test_AttachmentHandler_UploadAttachment_shouldReturn400_whenFileFieldIsMissing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req := newMultipartRequest(t, ctx, "/api/v1/todo/1/attachments", "other", "a.txt", []byte("hello"))
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+}
+
+func Test_AttachmentHandler_UploadAttachment_shouldReturn413_whenFileIsTooLarge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req := newMultipartRequest(t, ctx, "/api/v1/todo/1/attachments", "file", "big.txt", bytes.Repeat([]byte("a"), testMaxUploadBytes+1))
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code, "status code should be 413")
+	validateErrorResponse(t, respBytes, "attachment_too_large", "file must not exceed 1024 bytes")
+}
+
+func Test_AttachmentHandler_UploadAttachment_shouldReturn415_whenTypeNotAllowed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().UploadAttachment(mock.Anything, mock.Anything).Return(nil, domain.ErrAttachmentTypeNotAllowed).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req := newMultipartRequest(t, ctx, "/api/v1/todo/1/attachments", "file", "archive.zip", []byte("PK\x03\x04"))
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code, "status code should be 415")
+	validateErrorResponse(t, respBytes, "attachment_type_not_allowed", "file type is not allowed")
+}
+
+func Test_AttachmentHandler_UploadAttachment_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	attachmentUsecase := NewMockAttachmentUsecase(t)
+	attachmentUsecase.EXPECT().UploadAttachment(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initAttachmentRouter(t, ctx, attachmentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req := newMultipartRequest(t, ctx, "/api/v1/todo/999999/attachments", "file", "a.txt", []byte("hello"))
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// AddChecklistItem handles POST /todo/:id/checklist and appends an item to the todo's checklist.
+func (h *ChecklistHandler) AddChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "AddChecklistItem called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	var req api.AddChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid add checklist item request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	input, err := domain.NewAddChecklistItemInput(todoID, userID, req.Text)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid add checklist item input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.AddChecklistItem(ctx, input)
+	if err != nil {
+		h.writeChecklistError(c, err, "failed to add checklist item")
+		return
+	}
+
+	resp, err := NewChecklistItemResponse(output.Item)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
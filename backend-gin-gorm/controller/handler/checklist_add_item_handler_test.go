@@ -0,0 +1,204 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChecklistHandler_AddChecklistItem_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name string
+		body io.Reader
+	}{
+		{
+			name: "empty request",
+			body: bytes.NewBufferString("{}"),
+		},
+		{
+			name: "empty text",
+			body: bytes.NewBufferString(`{"text": ""}`),
+		},
+		{
+			name: "nil request",
+			body: nil,
+		},
+		{
+			name: "256 characters text",
+			body: bytes.NewBufferString(`{"text": "` + strings.Repeat("a", 256) + `"}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			checklistUsecase := NewMockChecklistUsecase(t)
+			r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/checklist", tt.body)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+		})
+	}
+}
+
+func Test_ChecklistHandler_AddChecklistItem_shouldReturn400_whenInvalidPath(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/invalid/checklist", bytes.NewBufferString(`{"text": "step"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_todo_id", "todo id must be a positive integer")
+}
+
+func Test_ChecklistHandler_AddChecklistItem_shouldReturn201_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().AddChecklistItem(mock.Anything, &domain.AddChecklistItemInput{
+		TodoID: 1,
+		UserID: userID,
+		Text:   "step one",
+	}).Return(&domain.AddChecklistItemOutput{
+		Item: &domain.ChecklistItem{
+			ID:        10,
+			TodoID:    1,
+			Text:      "step one",
+			IsChecked: false,
+			Position:  3,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}, nil).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/checklist", bytes.NewBufferString(`{"text": "step one"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - id
+	id := parseExpr(t, "$.id").Get(jsonObj)
+	require.Len(t, id, 1, "response should have one id")
+	assert.Equal(t, int64(10), id[0])
+
+	// - text
+	text := parseExpr(t, "$.text").Get(jsonObj)
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "step one", text[0])
+
+	// - position
+	position := parseExpr(t, "$.position").Get(jsonObj)
+	require.Len(t, position, 1, "response should have one position")
+	assert.Equal(t, int64(3), position[0])
+}
+
+func Test_ChecklistHandler_AddChecklistItem_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().AddChecklistItem(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/999999/checklist", bytes.NewBufferString(`{"text": "step"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
+
+func Test_ChecklistHandler_AddChecklistItem_shouldReturn409_whenChecklistIsFull(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().AddChecklistItem(mock.Anything, mock.Anything).Return(nil, domain.ErrChecklistFull).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/checklist", bytes.NewBufferString(`{"text": "step"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusConflict, w.Code, "status code should be 409")
+	validateErrorResponse(t, respBytes, "checklist_full", "a checklist can hold at most 100 items")
+}
+
+func Test_ChecklistHandler_AddChecklistItem_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().AddChecklistItem(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/checklist", bytes.NewBufferString(`{"text": "step"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteChecklistItem handles DELETE /todo/:id/checklist/:itemId and removes a checklist item.
+func (h *ChecklistHandler) DeleteChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	itemID, ok := getPositiveIntFromPath(c, h.logger, "itemId", "invalid_checklist_item_id", "checklist item id must be a positive integer")
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DeleteChecklistItem called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Int("itemId", itemID))
+
+	input, err := domain.NewDeleteChecklistItemInput(itemID, todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid delete checklist item input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	if err := h.usecase.DeleteChecklistItem(ctx, input); err != nil {
+		h.writeChecklistError(c, err, "failed to delete checklist item")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
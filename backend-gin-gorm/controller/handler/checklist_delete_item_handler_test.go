@@ -0,0 +1,61 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChecklistHandler_DeleteChecklistItem_shouldReturn204_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().DeleteChecklistItem(mock.Anything, &domain.DeleteChecklistItemInput{
+		ID:     5,
+		TodoID: 1,
+		UserID: userID,
+	}).Return(nil).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/checklist/5", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+	assert.Empty(t, w.Body.String(), "response body should be empty")
+}
+
+func Test_ChecklistHandler_DeleteChecklistItem_shouldReturn404_whenItemNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().DeleteChecklistItem(mock.Anything, mock.Anything).Return(domain.ErrChecklistItemNotFound).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/checklist/999999", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "checklist_item_not_found", "Not Found")
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChecklistUsecase defines the use case operations for managing a todo's checklist.
+type ChecklistUsecase interface {
+	AddChecklistItem(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.AddChecklistItemOutput, error)
+	UpdateChecklistItem(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.UpdateChecklistItemOutput, error)
+	ReorderChecklist(ctx context.Context, input *domain.ReorderChecklistInput) (*domain.ReorderChecklistOutput, error)
+	DeleteChecklistItem(ctx context.Context, input *domain.DeleteChecklistItemInput) error
+}
+
+// ChecklistHandler handles HTTP requests for checklist items embedded in a todo.
+type ChecklistHandler struct {
+	usecase ChecklistUsecase
+	logger  *slog.Logger
+}
+
+// NewChecklistHandler creates a new ChecklistHandler with the given use case.
+func NewChecklistHandler(usecase ChecklistUsecase) *ChecklistHandler {
+	return &ChecklistHandler{
+		usecase: usecase,
+		logger:  slog.Default().With(slog.String(domain.LoggerNameKey, "ChecklistHandler")),
+	}
+}
+
+// NewInitChecklistRouterFunc returns an InitRouterGroupFunc that registers checklist routes under "todo/:id/checklist".
+func NewInitChecklistRouterFunc(checklistUsecase ChecklistUsecase) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		checklist := parentRouterGroup.Group("todo/:id/checklist", middleware...)
+		checklistHandler := NewChecklistHandler(checklistUsecase)
+
+		checklist.POST("", checklistHandler.AddChecklistItem)
+		checklist.PUT("/order", checklistHandler.ReorderChecklist)
+		checklist.PUT("/:itemId", checklistHandler.UpdateChecklistItem)
+		checklist.DELETE("/:itemId", checklistHandler.DeleteChecklistItem)
+	}
+}
+
+// NewChecklistItemResponse converts a domain ChecklistItem to a ChecklistItemResponse API type.
+func NewChecklistItemResponse(item *domain.ChecklistItem) (*api.ChecklistItemResponse, error) {
+	if item == nil {
+		return nil, errors.New("checklist item is nil")
+	}
+	id, err := safeIntToInt32(item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert checklist item ID: %w", err)
+	}
+	position, err := safeIntToInt32(item.Position)
+	if err != nil {
+		return nil, fmt.Errorf("convert checklist item position: %w", err)
+	}
+	return &api.ChecklistItemResponse{
+		ID:        id,
+		Text:      item.Text,
+		IsChecked: item.IsChecked,
+		Position:  position,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}, nil
+}
+
+// NewChecklistItemResponses converts a slice of domain ChecklistItems to ChecklistItemResponse API types.
+func NewChecklistItemResponses(items []domain.ChecklistItem) ([]api.ChecklistItemResponse, error) {
+	resp := make([]api.ChecklistItemResponse, 0, len(items))
+	for _, item := range items {
+		itemResp, err := NewChecklistItemResponse(&item)
+		if err != nil {
+			return nil, fmt.Errorf("convert checklist item: %w", err)
+		}
+		resp = append(resp, *itemResp)
+	}
+	return resp, nil
+}
+
+// writeChecklistError maps checklist use case errors to HTTP responses.
+func (h *ChecklistHandler) writeChecklistError(c *gin.Context, err error, message string) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrTodoNotFound):
+		h.logger.WarnContext(ctx, "todo not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrForbidden):
+		h.logger.WarnContext(ctx, "checklist operation forbidden", slog.Any("error", err))
+		c.JSON(http.StatusForbidden, NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden)))
+	case errors.Is(err, domain.ErrChecklistItemNotFound):
+		h.logger.WarnContext(ctx, "checklist item not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("checklist_item_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrChecklistFull):
+		h.logger.WarnContext(ctx, "checklist is full", slog.Any("error", err))
+		c.JSON(http.StatusConflict, NewErrorResponse("checklist_full", fmt.Sprintf("a checklist can hold at most %d items", domain.MaxChecklistItems)))
+	case errors.Is(err, domain.ErrChecklistOrderMismatch):
+		h.logger.WarnContext(ctx, "checklist order mismatch", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_checklist_order", "itemIds must list every checklist item exactly once"))
+	default:
+		h.logger.ErrorContext(ctx, message, slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ReorderChecklist handles PUT /todo/:id/checklist/order and rewrites the order of the todo's checklist.
+func (h *ChecklistHandler) ReorderChecklist(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "ReorderChecklist called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	var req api.ReorderChecklistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid reorder checklist request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	itemIDs := make([]int, len(req.ItemIDs))
+	for i, itemID := range req.ItemIDs {
+		itemIDs[i] = int(itemID)
+	}
+
+	input, err := domain.NewReorderChecklistInput(todoID, userID, itemIDs)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid reorder checklist input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.ReorderChecklist(ctx, input)
+	if err != nil {
+		h.writeChecklistError(c, err, "failed to reorder checklist")
+		return
+	}
+
+	items, err := NewChecklistItemResponses(output.Items)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, &api.ReorderChecklistResponse{Items: items})
+}
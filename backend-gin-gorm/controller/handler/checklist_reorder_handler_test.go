@@ -0,0 +1,118 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChecklistHandler_ReorderChecklist_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name string
+		body io.Reader
+	}{
+		{
+			name: "missing itemIds",
+			body: bytes.NewBufferString("{}"),
+		},
+		{
+			name: "duplicate itemIds",
+			body: bytes.NewBufferString(`{"itemIds": [1, 1]}`),
+		},
+		{
+			name: "non-positive itemIds",
+			body: bytes.NewBufferString(`{"itemIds": [0]}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			checklistUsecase := NewMockChecklistUsecase(t)
+			r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/checklist/order", tt.body)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+		})
+	}
+}
+
+func Test_ChecklistHandler_ReorderChecklist_shouldReturn400_whenOrderMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().ReorderChecklist(mock.Anything, mock.Anything).Return(nil, domain.ErrChecklistOrderMismatch).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/checklist/order", bytes.NewBufferString(`{"itemIds": [2, 1]}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_checklist_order", "itemIds must list every checklist item exactly once")
+}
+
+func Test_ChecklistHandler_ReorderChecklist_shouldReturn200_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().ReorderChecklist(mock.Anything, &domain.ReorderChecklistInput{
+		TodoID:  1,
+		UserID:  userID,
+		ItemIDs: []int{2, 1},
+	}).Return(&domain.ReorderChecklistOutput{
+		Items: []domain.ChecklistItem{
+			{ID: 2, TodoID: 1, Text: "second", Position: 0, CreatedAt: now, UpdatedAt: now},
+			{ID: 1, TodoID: 1, Text: "first", Position: 1, CreatedAt: now, UpdatedAt: now},
+		},
+	}, nil).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/checklist/order", bytes.NewBufferString(`{"itemIds": [2, 1]}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - items
+	ids := parseExpr(t, "$.items[*].id").Get(jsonObj)
+	assert.Equal(t, []any{int64(2), int64(1)}, ids)
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// UpdateChecklistItem handles PUT /todo/:id/checklist/:itemId and edits or toggles a checklist item.
+func (h *ChecklistHandler) UpdateChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	itemID, ok := getPositiveIntFromPath(c, h.logger, "itemId", "invalid_checklist_item_id", "checklist item id must be a positive integer")
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "UpdateChecklistItem called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Int("itemId", itemID))
+
+	var req api.UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid update checklist item request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	input, err := domain.NewUpdateChecklistItemInput(itemID, todoID, userID, req.Text, req.IsChecked)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid update checklist item input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.UpdateChecklistItem(ctx, input)
+	if err != nil {
+		h.writeChecklistError(c, err, "failed to update checklist item")
+		return
+	}
+
+	resp, err := NewChecklistItemResponse(output.Item)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
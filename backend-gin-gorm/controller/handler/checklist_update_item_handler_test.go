@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChecklistHandler_UpdateChecklistItem_shouldReturn400_whenInvalidItemID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/checklist/0", bytes.NewBufferString(`{"text": "step", "isChecked": true}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_checklist_item_id", "checklist item id must be a positive integer")
+}
+
+func Test_ChecklistHandler_UpdateChecklistItem_shouldReturn200_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().UpdateChecklistItem(mock.Anything, &domain.UpdateChecklistItemInput{
+		ID:        5,
+		TodoID:    1,
+		UserID:    userID,
+		Text:      "step",
+		IsChecked: true,
+	}).Return(&domain.UpdateChecklistItemOutput{
+		Item: &domain.ChecklistItem{
+			ID:        5,
+			TodoID:    1,
+			Text:      "step",
+			IsChecked: true,
+			Position:  0,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}, nil).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/checklist/5", bytes.NewBufferString(`{"text": "step", "isChecked": true}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - isChecked
+	isChecked := parseExpr(t, "$.isChecked").Get(jsonObj)
+	require.Len(t, isChecked, 1, "response should have one isChecked")
+	assert.Equal(t, true, isChecked[0])
+}
+
+func Test_ChecklistHandler_UpdateChecklistItem_shouldReturn404_whenItemNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	checklistUsecase := NewMockChecklistUsecase(t)
+	checklistUsecase.EXPECT().UpdateChecklistItem(mock.Anything, mock.Anything).Return(nil, domain.ErrChecklistItemNotFound).Once()
+	r := initChecklistRouter(t, ctx, checklistUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/checklist/999999", bytes.NewBufferString(`{"text": "step", "isChecked": false}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "checklist_item_not_found", "Not Found")
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CreateComment handles POST /todo/:id/comments and posts a comment as the authenticated user.
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	loginID := c.GetString(controller.ContextFieldLoginID{})
+	if userID <= 0 || loginID == "" {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID or login ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "CreateComment called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	var req api.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid create comment request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	input, err := domain.NewCreateCommentInput(todoID, userID, loginID, req.Text)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid create comment input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.CreateComment(ctx, input)
+	if err != nil {
+		h.writeCommentError(c, err, "failed to create comment")
+		return
+	}
+
+	resp, err := NewCommentResponse(output.Comment)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
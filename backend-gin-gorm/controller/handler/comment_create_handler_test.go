@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_CommentHandler_CreateComment_shouldReturn201WithAuthor_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().CreateComment(mock.Anything, &domain.CreateCommentInput{
+		TodoID: 1,
+		Author: domain.CommentAuthor{UserID: userID, LoginID: testLoginID},
+		Text:   "Looks good",
+	}).Return(&domain.CreateCommentOutput{
+		Comment: &domain.Comment{
+			ID:        5,
+			TodoID:    1,
+			Author:    domain.CommentAuthor{UserID: userID, LoginID: testLoginID},
+			Text:      "Looks good",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}, nil).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/comments", bytes.NewBufferString(`{"text":"Looks good"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - author
+	loginID := parseExpr(t, "$.author.loginId").Get(jsonObj)
+	require.Len(t, loginID, 1, "response should have one author loginId")
+	assert.Equal(t, testLoginID, loginID[0])
+
+	// - editedAt is omitted for a new comment
+	editedAt := parseExpr(t, "$.editedAt").Get(jsonObj)
+	assert.Empty(t, editedAt, "response should not have editedAt")
+}
+
+func Test_CommentHandler_CreateComment_shouldReturn400_whenTextIsTooLong(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"text":"` + strings.Repeat("a", 2001) + `"}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/comments", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+}
+
+func Test_CommentHandler_CreateComment_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().CreateComment(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/999999/comments", bytes.NewBufferString(`{"text":"Hello"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteComment handles DELETE /todo/:id/comments/:commentId and removes a comment.
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	commentID, ok := getCommentIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DeleteComment called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Int("commentId", commentID))
+
+	input, err := domain.NewDeleteCommentInput(commentID, todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid delete comment input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	if err := h.usecase.DeleteComment(ctx, input); err != nil {
+		h.writeCommentError(c, err, "failed to delete comment")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_CommentHandler_DeleteComment_shouldReturn204_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().DeleteComment(mock.Anything, &domain.DeleteCommentInput{
+		ID:     5,
+		TodoID: 1,
+		UserID: userID,
+	}).Return(nil).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/comments/5", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+}
+
+func Test_CommentHandler_DeleteComment_shouldReturn403_whenUserIsNeitherAuthorNorOwner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().DeleteComment(mock.Anything, mock.Anything).Return(domain.ErrForbidden).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/comments/5", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusForbidden, w.Code, "status code should be 403")
+	validateErrorResponse(t, respBytes, "forbidden", "Forbidden")
+}
+
+func Test_CommentHandler_DeleteComment_shouldReturn404_whenCommentNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().DeleteComment(mock.Anything, mock.Anything).Return(domain.ErrCommentNotFound).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1/comments/999999", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "comment_not_found", "Not Found")
+}
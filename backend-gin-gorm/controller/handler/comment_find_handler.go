@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// FindComments handles GET /todo/:id/comments and lists the comments of a todo.
+func (h *CommentHandler) FindComments(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindComments called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	input, err := domain.NewFindCommentsInput(todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find comments input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	comments, err := h.usecase.FindComments(ctx, input)
+	if err != nil {
+		h.writeCommentError(c, err, "failed to find comments")
+		return
+	}
+
+	resp := api.FindCommentsResponse{
+		Comments: make([]api.CommentResponse, 0, len(comments)),
+	}
+	for _, comment := range comments {
+		commentResp, err := NewCommentResponse(&comment)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+			return
+		}
+		resp.Comments = append(resp.Comments, *commentResp)
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_CommentHandler_FindComments_shouldReturn200_whenTodoHasComments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().FindComments(mock.Anything, &domain.FindCommentsInput{
+		TodoID: 1,
+		UserID: userID,
+	}).Return([]domain.Comment{
+		{ID: 1, TodoID: 1, Author: domain.CommentAuthor{UserID: userID, LoginID: testLoginID}, Text: "First", CreatedAt: now, UpdatedAt: now},
+		{ID: 2, TodoID: 1, Author: domain.CommentAuthor{UserID: userID, LoginID: testLoginID}, Text: "Second", CreatedAt: now, UpdatedAt: now, EditedAt: &now},
+	}, nil).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1/comments", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - text
+	texts := parseExpr(t, "$.comments[*].text").Get(jsonObj)
+	assert.Equal(t, []any{"First", "Second"}, texts)
+
+	// - editedAt is only present on the edited comment
+	editedAt := parseExpr(t, "$.comments[*].editedAt").Get(jsonObj)
+	assert.Len(t, editedAt, 1, "only one comment should have editedAt")
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CommentUsecase defines the use case operations for the comment thread of a todo.
+type CommentUsecase interface {
+	CreateComment(ctx context.Context, input *domain.CreateCommentInput) (*domain.CreateCommentOutput, error)
+	FindComments(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error)
+	UpdateComment(ctx context.Context, input *domain.UpdateCommentInput) (*domain.UpdateCommentOutput, error)
+	DeleteComment(ctx context.Context, input *domain.DeleteCommentInput) error
+}
+
+// CommentHandler handles HTTP requests for comments posted on a todo.
+type CommentHandler struct {
+	usecase CommentUsecase
+	logger  *slog.Logger
+}
+
+// NewCommentHandler creates a new CommentHandler with the given use case.
+func NewCommentHandler(usecase CommentUsecase) *CommentHandler {
+	return &CommentHandler{
+		usecase: usecase,
+		logger:  slog.Default().With(slog.String(domain.LoggerNameKey, "CommentHandler")),
+	}
+}
+
+// NewInitCommentRouterFunc returns an InitRouterGroupFunc that registers comment routes under "todo/:id/comments".
+func NewInitCommentRouterFunc(commentUsecase CommentUsecase) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		comments := parentRouterGroup.Group("todo/:id/comments", middleware...)
+		commentHandler := NewCommentHandler(commentUsecase)
+
+		comments.GET("", commentHandler.FindComments)
+		comments.POST("", commentHandler.CreateComment)
+		comments.PUT("/:commentId", commentHandler.UpdateComment)
+		comments.DELETE("/:commentId", commentHandler.DeleteComment)
+	}
+}
+
+// NewCommentResponse converts a domain Comment to a CommentResponse API type.
+func NewCommentResponse(comment *domain.Comment) (*api.CommentResponse, error) {
+	if comment == nil {
+		return nil, errors.New("comment is nil")
+	}
+	id, err := safeIntToInt32(comment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert comment ID: %w", err)
+	}
+	authorUserID, err := safeIntToInt32(comment.Author.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("convert comment author user ID: %w", err)
+	}
+	return &api.CommentResponse{
+		ID:   id,
+		Text: comment.Text,
+		Author: api.CommentAuthorResponse{
+			UserID:  authorUserID,
+			LoginID: comment.Author.LoginID,
+		},
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		EditedAt:  comment.EditedAt,
+	}, nil
+}
+
+// getCommentIDFromPath parses the ":commentId" path parameter.
+// On failure it writes a 400 response and returns false.
+func getCommentIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "commentId", "invalid_comment_id", "comment id must be a positive integer")
+}
+
+// writeCommentError maps comment use case errors to HTTP responses.
+func (h *CommentHandler) writeCommentError(c *gin.Context, err error, message string) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrTodoNotFound):
+		h.logger.WarnContext(ctx, "todo not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrCommentNotFound):
+		h.logger.WarnContext(ctx, "comment not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("comment_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrForbidden):
+		h.logger.WarnContext(ctx, "comment operation forbidden", slog.Any("error", err))
+		c.JSON(http.StatusForbidden, NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden)))
+	default:
+		h.logger.ErrorContext(ctx, message, slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+	}
+}
@@ -0,0 +1,30 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller/handler"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+const testLoginID = "user1"
+
+func initCommentRouter(t *testing.T, ctx context.Context, commentUsecase handler.CommentUsecase, userID int) *gin.Engine {
+	t.Helper()
+
+	router, err := handler.InitRootRouterGroup(ctx, config, domain.AppName)
+	require.NoError(t, err)
+	api := router.Group("api")
+	v1 := api.Group("v1")
+
+	v1.Use(fakeAuthMiddleware(userID, testLoginID))
+
+	initCommentRouterFunc := handler.NewInitCommentRouterFunc(commentUsecase)
+	initCommentRouterFunc(v1)
+
+	return router
+}
@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_CommentHandler_UpdateComment_shouldReturn200_whenAuthorEdits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().UpdateComment(mock.Anything, &domain.UpdateCommentInput{
+		ID:     5,
+		TodoID: 1,
+		UserID: userID,
+		Text:   "Edited",
+	}).Return(&domain.UpdateCommentOutput{
+		Comment: &domain.Comment{
+			ID:        5,
+			TodoID:    1,
+			Author:    domain.CommentAuthor{UserID: userID, LoginID: testLoginID},
+			Text:      "Edited",
+			CreatedAt: now,
+			UpdatedAt: now,
+			EditedAt:  &now,
+		},
+	}, nil).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/comments/5", bytes.NewBufferString(`{"text":"Edited"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - editedAt
+	editedAt := parseExpr(t, "$.editedAt").Get(jsonObj)
+	assert.Len(t, editedAt, 1, "response should have editedAt")
+}
+
+func Test_CommentHandler_UpdateComment_shouldReturn403_whenUserIsNotAuthor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	commentUsecase.EXPECT().UpdateComment(mock.Anything, mock.Anything).Return(nil, domain.ErrForbidden).Once()
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/comments/5", bytes.NewBufferString(`{"text":"Edited"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusForbidden, w.Code, "status code should be 403")
+	validateErrorResponse(t, respBytes, "forbidden", "Forbidden")
+}
+
+func Test_CommentHandler_UpdateComment_shouldReturn400_whenInvalidCommentID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	commentUsecase := NewMockCommentUsecase(t)
+	r := initCommentRouter(t, ctx, commentUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1/comments/0", bytes.NewBufferString(`{"text":"Edited"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_comment_id", "comment id must be a positive integer")
+}
@@ -9,11 +9,12 @@ import (
 // ErrCORSCredentialsWithWildcard is returned when AllowCredentials is true but AllowOrigins is wildcard.
 var ErrCORSCredentialsWithWildcard = errors.New("CORS: AllowCredentials=true with wildcard origin is not allowed; set specific AllowOrigins")
 
-// CORSConfig holds allowed origins, methods, headers, and credentials as comma-separated strings.
+// CORSConfig holds allowed origins, methods, headers, exposed response headers, and credentials as comma-separated strings.
 type CORSConfig struct {
 	AllowOrigins     string `yaml:"allowOrigins" validate:"required"`
 	AllowMethods     string `yaml:"allowMethods" validate:"required"`
 	AllowHeaders     string `yaml:"allowHeaders"`
+	ExposeHeaders    string `yaml:"exposeHeaders"`
 	AllowCredentials bool   `yaml:"allowCredentials"`
 }
 
@@ -23,6 +24,7 @@ func InitCORS(cfg *CORSConfig) (cors.Config, error) {
 	allowOrigins := SplitCommaSeparated(cfg.AllowOrigins)
 	allowMethods := SplitCommaSeparated(cfg.AllowMethods)
 	allowHeaders := SplitCommaSeparated(cfg.AllowHeaders)
+	exposeHeaders := SplitCommaSeparated(cfg.ExposeHeaders)
 
 	if len(allowOrigins) == 1 && allowOrigins[0] == "*" {
 		if cfg.AllowCredentials {
@@ -33,6 +35,7 @@ func InitCORS(cfg *CORSConfig) (cors.Config, error) {
 			AllowAllOrigins: true,
 			AllowMethods:    allowMethods,
 			AllowHeaders:    allowHeaders,
+			ExposeHeaders:   exposeHeaders,
 		}, nil
 	}
 
@@ -40,6 +43,7 @@ func InitCORS(cfg *CORSConfig) (cors.Config, error) {
 		AllowOrigins:     allowOrigins,
 		AllowMethods:     allowMethods,
 		AllowHeaders:     allowHeaders,
+		ExposeHeaders:    exposeHeaders,
 		AllowCredentials: cfg.AllowCredentials,
 	}, nil
 }
@@ -13,7 +13,7 @@ func TestMain(m *testing.M) {
 	config = &handler.Config{
 		CORS: &handler.CORSConfig{
 			AllowOrigins: "*",
-			AllowMethods: "GET,POST,PUT,DELETE",
+			AllowMethods: "GET,POST,PUT,PATCH,DELETE",
 		},
 		Log:   &handler.LogConfig{},
 		Debug: &handler.DebugConfig{},
@@ -2,7 +2,9 @@ package handler
 
 import (
 	"fmt"
+	"log/slog"
 	"math"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -32,6 +34,23 @@ func GetIntFromPath(c *gin.Context, param string) (int, error) {
 	return id, nil
 }
 
+// getPositiveIntFromPath reads a positive integer path parameter.
+// On failure it writes a 400 response with the given error code and message and returns false.
+func getPositiveIntFromPath(c *gin.Context, logger *slog.Logger, param string, code string, message string) (int, bool) {
+	v, err := GetIntFromPath(c, param)
+	if err != nil || v <= 0 {
+		logger.WarnContext(c.Request.Context(), "invalid path parameter", slog.String(param, c.Param(param)))
+		c.JSON(http.StatusBadRequest, NewErrorResponse(code, message))
+		return 0, false
+	}
+	return v, true
+}
+
+// getTodoIDFromPath reads the ":id" path parameter of nested todo resources.
+func getTodoIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "id", "invalid_todo_id", "todo id must be a positive integer")
+}
+
 func safeIntToInt32(v int) (int32, error) {
 	if v < math.MinInt32 || v > math.MaxInt32 {
 		return 0, fmt.Errorf("value %d overflows int32", v)
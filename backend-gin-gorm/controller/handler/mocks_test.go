@@ -11,6 +11,294 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockAttachmentUsecase creates a new instance of MockAttachmentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentUsecase {
+	mock := &MockAttachmentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentUsecase is an autogenerated mock type for the AttachmentUsecase type
+type MockAttachmentUsecase struct {
+	mock.Mock
+}
+
+type MockAttachmentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentUsecase) EXPECT() *MockAttachmentUsecase_Expecter {
+	return &MockAttachmentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAttachment provides a mock function for the type MockAttachmentUsecase
+func (_mock *MockAttachmentUsecase) DeleteAttachment(ctx context.Context, input *domain.AttachmentInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentUsecase_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockAttachmentUsecase_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AttachmentInput
+func (_e *MockAttachmentUsecase_Expecter) DeleteAttachment(ctx interface{}, input interface{}) *MockAttachmentUsecase_DeleteAttachment_Call {
+	return &MockAttachmentUsecase_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, input)}
+}
+
+func (_c *MockAttachmentUsecase_DeleteAttachment_Call) Run(run func(ctx context.Context, input *domain.AttachmentInput)) *MockAttachmentUsecase_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AttachmentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AttachmentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_DeleteAttachment_Call) Return(err error) *MockAttachmentUsecase_DeleteAttachment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_DeleteAttachment_Call) RunAndReturn(run func(ctx context.Context, input *domain.AttachmentInput) error) *MockAttachmentUsecase_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadAttachment provides a mock function for the type MockAttachmentUsecase
+func (_mock *MockAttachmentUsecase) DownloadAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.DownloadAttachmentOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadAttachment")
+	}
+
+	var r0 *domain.DownloadAttachmentOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) (*domain.DownloadAttachmentOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) *domain.DownloadAttachmentOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DownloadAttachmentOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AttachmentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentUsecase_DownloadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadAttachment'
+type MockAttachmentUsecase_DownloadAttachment_Call struct {
+	*mock.Call
+}
+
+// DownloadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AttachmentInput
+func (_e *MockAttachmentUsecase_Expecter) DownloadAttachment(ctx interface{}, input interface{}) *MockAttachmentUsecase_DownloadAttachment_Call {
+	return &MockAttachmentUsecase_DownloadAttachment_Call{Call: _e.mock.On("DownloadAttachment", ctx, input)}
+}
+
+func (_c *MockAttachmentUsecase_DownloadAttachment_Call) Run(run func(ctx context.Context, input *domain.AttachmentInput)) *MockAttachmentUsecase_DownloadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AttachmentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AttachmentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_DownloadAttachment_Call) Return(downloadAttachmentOutput *domain.DownloadAttachmentOutput, err error) *MockAttachmentUsecase_DownloadAttachment_Call {
+	_c.Call.Return(downloadAttachmentOutput, err)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_DownloadAttachment_Call) RunAndReturn(run func(ctx context.Context, input *domain.AttachmentInput) (*domain.DownloadAttachmentOutput, error)) *MockAttachmentUsecase_DownloadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAttachments provides a mock function for the type MockAttachmentUsecase
+func (_mock *MockAttachmentUsecase) FindAttachments(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttachments")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindAttachmentsInput) ([]domain.Attachment, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindAttachmentsInput) []domain.Attachment); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindAttachmentsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentUsecase_FindAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachments'
+type MockAttachmentUsecase_FindAttachments_Call struct {
+	*mock.Call
+}
+
+// FindAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindAttachmentsInput
+func (_e *MockAttachmentUsecase_Expecter) FindAttachments(ctx interface{}, input interface{}) *MockAttachmentUsecase_FindAttachments_Call {
+	return &MockAttachmentUsecase_FindAttachments_Call{Call: _e.mock.On("FindAttachments", ctx, input)}
+}
+
+func (_c *MockAttachmentUsecase_FindAttachments_Call) Run(run func(ctx context.Context, input *domain.FindAttachmentsInput)) *MockAttachmentUsecase_FindAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindAttachmentsInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindAttachmentsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_FindAttachments_Call) Return(attachments []domain.Attachment, err error) *MockAttachmentUsecase_FindAttachments_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_FindAttachments_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error)) *MockAttachmentUsecase_FindAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachment provides a mock function for the type MockAttachmentUsecase
+func (_mock *MockAttachmentUsecase) UploadAttachment(ctx context.Context, input *domain.UploadAttachmentInput) (*domain.UploadAttachmentOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 *domain.UploadAttachmentOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UploadAttachmentInput) (*domain.UploadAttachmentOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UploadAttachmentInput) *domain.UploadAttachmentOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UploadAttachmentOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UploadAttachmentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentUsecase_UploadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachment'
+type MockAttachmentUsecase_UploadAttachment_Call struct {
+	*mock.Call
+}
+
+// UploadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.UploadAttachmentInput
+func (_e *MockAttachmentUsecase_Expecter) UploadAttachment(ctx interface{}, input interface{}) *MockAttachmentUsecase_UploadAttachment_Call {
+	return &MockAttachmentUsecase_UploadAttachment_Call{Call: _e.mock.On("UploadAttachment", ctx, input)}
+}
+
+func (_c *MockAttachmentUsecase_UploadAttachment_Call) Run(run func(ctx context.Context, input *domain.UploadAttachmentInput)) *MockAttachmentUsecase_UploadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UploadAttachmentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UploadAttachmentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_UploadAttachment_Call) Return(uploadAttachmentOutput *domain.UploadAttachmentOutput, err error) *MockAttachmentUsecase_UploadAttachment_Call {
+	_c.Call.Return(uploadAttachmentOutput, err)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_UploadAttachment_Call) RunAndReturn(run func(ctx context.Context, input *domain.UploadAttachmentInput) (*domain.UploadAttachmentOutput, error)) *MockAttachmentUsecase_UploadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAuthUsecase creates a new instance of MockAuthUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAuthUsecase(t interface {
@@ -20,134 +308,3165 @@ func NewMockAuthUsecase(t interface {
 	mock := &MockAuthUsecase{}
 	mock.Mock.Test(t)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthUsecase is an autogenerated mock type for the AuthUsecase type
+type MockAuthUsecase struct {
+	mock.Mock
+}
+
+type MockAuthUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthUsecase) EXPECT() *MockAuthUsecase_Expecter {
+	return &MockAuthUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockAuthUsecase
+func (_mock *MockAuthUsecase) Authenticate(input *domain.AuthenticateInput) (*domain.AuthenticateOutput, error) {
+	ret := _mock.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *domain.AuthenticateOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.AuthenticateInput) (*domain.AuthenticateOutput, error)); ok {
+		return returnFunc(input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.AuthenticateInput) *domain.AuthenticateOutput); ok {
+		r0 = returnFunc(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AuthenticateOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.AuthenticateInput) error); ok {
+		r1 = returnFunc(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUsecase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAuthUsecase_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - input *domain.AuthenticateInput
+func (_e *MockAuthUsecase_Expecter) Authenticate(input interface{}) *MockAuthUsecase_Authenticate_Call {
+	return &MockAuthUsecase_Authenticate_Call{Call: _e.mock.On("Authenticate", input)}
+}
+
+func (_c *MockAuthUsecase_Authenticate_Call) Run(run func(input *domain.AuthenticateInput)) *MockAuthUsecase_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.AuthenticateInput
+		if args[0] != nil {
+			arg0 = args[0].(*domain.AuthenticateInput)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUsecase_Authenticate_Call) Return(authenticateOutput *domain.AuthenticateOutput, err error) *MockAuthUsecase_Authenticate_Call {
+	_c.Call.Return(authenticateOutput, err)
+	return _c
+}
+
+func (_c *MockAuthUsecase_Authenticate_Call) RunAndReturn(run func(input *domain.AuthenticateInput) (*domain.AuthenticateOutput, error)) *MockAuthUsecase_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockChecklistUsecase creates a new instance of MockChecklistUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChecklistUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChecklistUsecase {
+	mock := &MockChecklistUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChecklistUsecase is an autogenerated mock type for the ChecklistUsecase type
+type MockChecklistUsecase struct {
+	mock.Mock
+}
+
+type MockChecklistUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChecklistUsecase) EXPECT() *MockChecklistUsecase_Expecter {
+	return &MockChecklistUsecase_Expecter{mock: &_m.Mock}
+}
+
+// AddChecklistItem provides a mock function for the type MockChecklistUsecase
+func (_mock *MockChecklistUsecase) AddChecklistItem(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.AddChecklistItemOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddChecklistItem")
+	}
+
+	var r0 *domain.AddChecklistItemOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AddChecklistItemInput) (*domain.AddChecklistItemOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AddChecklistItemInput) *domain.AddChecklistItemOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AddChecklistItemOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AddChecklistItemInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChecklistUsecase_AddChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddChecklistItem'
+type MockChecklistUsecase_AddChecklistItem_Call struct {
+	*mock.Call
+}
+
+// AddChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AddChecklistItemInput
+func (_e *MockChecklistUsecase_Expecter) AddChecklistItem(ctx interface{}, input interface{}) *MockChecklistUsecase_AddChecklistItem_Call {
+	return &MockChecklistUsecase_AddChecklistItem_Call{Call: _e.mock.On("AddChecklistItem", ctx, input)}
+}
+
+func (_c *MockChecklistUsecase_AddChecklistItem_Call) Run(run func(ctx context.Context, input *domain.AddChecklistItemInput)) *MockChecklistUsecase_AddChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AddChecklistItemInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AddChecklistItemInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChecklistUsecase_AddChecklistItem_Call) Return(addChecklistItemOutput *domain.AddChecklistItemOutput, err error) *MockChecklistUsecase_AddChecklistItem_Call {
+	_c.Call.Return(addChecklistItemOutput, err)
+	return _c
+}
+
+func (_c *MockChecklistUsecase_AddChecklistItem_Call) RunAndReturn(run func(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.AddChecklistItemOutput, error)) *MockChecklistUsecase_AddChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChecklistItem provides a mock function for the type MockChecklistUsecase
+func (_mock *MockChecklistUsecase) DeleteChecklistItem(ctx context.Context, input *domain.DeleteChecklistItemInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChecklistItem")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteChecklistItemInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChecklistUsecase_DeleteChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChecklistItem'
+type MockChecklistUsecase_DeleteChecklistItem_Call struct {
+	*mock.Call
+}
+
+// DeleteChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.DeleteChecklistItemInput
+func (_e *MockChecklistUsecase_Expecter) DeleteChecklistItem(ctx interface{}, input interface{}) *MockChecklistUsecase_DeleteChecklistItem_Call {
+	return &MockChecklistUsecase_DeleteChecklistItem_Call{Call: _e.mock.On("DeleteChecklistItem", ctx, input)}
+}
+
+func (_c *MockChecklistUsecase_DeleteChecklistItem_Call) Run(run func(ctx context.Context, input *domain.DeleteChecklistItemInput)) *MockChecklistUsecase_DeleteChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DeleteChecklistItemInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DeleteChecklistItemInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChecklistUsecase_DeleteChecklistItem_Call) Return(err error) *MockChecklistUsecase_DeleteChecklistItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChecklistUsecase_DeleteChecklistItem_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteChecklistItemInput) error) *MockChecklistUsecase_DeleteChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderChecklist provides a mock function for the type MockChecklistUsecase
+func (_mock *MockChecklistUsecase) ReorderChecklist(ctx context.Context, input *domain.ReorderChecklistInput) (*domain.ReorderChecklistOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderChecklist")
+	}
+
+	var r0 *domain.ReorderChecklistOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReorderChecklistInput) (*domain.ReorderChecklistOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReorderChecklistInput) *domain.ReorderChecklistOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReorderChecklistOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReorderChecklistInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChecklistUsecase_ReorderChecklist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderChecklist'
+type MockChecklistUsecase_ReorderChecklist_Call struct {
+	*mock.Call
+}
+
+// ReorderChecklist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ReorderChecklistInput
+func (_e *MockChecklistUsecase_Expecter) ReorderChecklist(ctx interface{}, input interface{}) *MockChecklistUsecase_ReorderChecklist_Call {
+	return &MockChecklistUsecase_ReorderChecklist_Call{Call: _e.mock.On("ReorderChecklist", ctx, input)}
+}
+
+func (_c *MockChecklistUsecase_ReorderChecklist_Call) Run(run func(ctx context.Context, input *domain.ReorderChecklistInput)) *MockChecklistUsecase_ReorderChecklist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReorderChecklistInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReorderChecklistInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChecklistUsecase_ReorderChecklist_Call) Return(reorderChecklistOutput *domain.ReorderChecklistOutput, err error) *MockChecklistUsecase_ReorderChecklist_Call {
+	_c.Call.Return(reorderChecklistOutput, err)
+	return _c
+}
+
+func (_c *MockChecklistUsecase_ReorderChecklist_Call) RunAndReturn(run func(ctx context.Context, input *domain.ReorderChecklistInput) (*domain.ReorderChecklistOutput, error)) *MockChecklistUsecase_ReorderChecklist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateChecklistItem provides a mock function for the type MockChecklistUsecase
+func (_mock *MockChecklistUsecase) UpdateChecklistItem(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.UpdateChecklistItemOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChecklistItem")
+	}
+
+	var r0 *domain.UpdateChecklistItemOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateChecklistItemInput) (*domain.UpdateChecklistItemOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateChecklistItemInput) *domain.UpdateChecklistItemOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateChecklistItemOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UpdateChecklistItemInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChecklistUsecase_UpdateChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChecklistItem'
+type MockChecklistUsecase_UpdateChecklistItem_Call struct {
+	*mock.Call
+}
+
+// UpdateChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.UpdateChecklistItemInput
+func (_e *MockChecklistUsecase_Expecter) UpdateChecklistItem(ctx interface{}, input interface{}) *MockChecklistUsecase_UpdateChecklistItem_Call {
+	return &MockChecklistUsecase_UpdateChecklistItem_Call{Call: _e.mock.On("UpdateChecklistItem", ctx, input)}
+}
+
+func (_c *MockChecklistUsecase_UpdateChecklistItem_Call) Run(run func(ctx context.Context, input *domain.UpdateChecklistItemInput)) *MockChecklistUsecase_UpdateChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UpdateChecklistItemInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UpdateChecklistItemInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChecklistUsecase_UpdateChecklistItem_Call) Return(updateChecklistItemOutput *domain.UpdateChecklistItemOutput, err error) *MockChecklistUsecase_UpdateChecklistItem_Call {
+	_c.Call.Return(updateChecklistItemOutput, err)
+	return _c
+}
+
+func (_c *MockChecklistUsecase_UpdateChecklistItem_Call) RunAndReturn(run func(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.UpdateChecklistItemOutput, error)) *MockChecklistUsecase_UpdateChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommentUsecase creates a new instance of MockCommentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentUsecase {
+	mock := &MockCommentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCommentUsecase is an autogenerated mock type for the CommentUsecase type
+type MockCommentUsecase struct {
+	mock.Mock
+}
+
+type MockCommentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentUsecase) EXPECT() *MockCommentUsecase_Expecter {
+	return &MockCommentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function for the type MockCommentUsecase
+func (_mock *MockCommentUsecase) CreateComment(ctx context.Context, input *domain.CreateCommentInput) (*domain.CreateCommentOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *domain.CreateCommentOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateCommentInput) (*domain.CreateCommentOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateCommentInput) *domain.CreateCommentOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreateCommentOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateCommentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentUsecase_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockCommentUsecase_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CreateCommentInput
+func (_e *MockCommentUsecase_Expecter) CreateComment(ctx interface{}, input interface{}) *MockCommentUsecase_CreateComment_Call {
+	return &MockCommentUsecase_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, input)}
+}
+
+func (_c *MockCommentUsecase_CreateComment_Call) Run(run func(ctx context.Context, input *domain.CreateCommentInput)) *MockCommentUsecase_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CreateCommentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CreateCommentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_CreateComment_Call) Return(createCommentOutput *domain.CreateCommentOutput, err error) *MockCommentUsecase_CreateComment_Call {
+	_c.Call.Return(createCommentOutput, err)
+	return _c
+}
+
+func (_c *MockCommentUsecase_CreateComment_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateCommentInput) (*domain.CreateCommentOutput, error)) *MockCommentUsecase_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function for the type MockCommentUsecase
+func (_mock *MockCommentUsecase) DeleteComment(ctx context.Context, input *domain.DeleteCommentInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteCommentInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentUsecase_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockCommentUsecase_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.DeleteCommentInput
+func (_e *MockCommentUsecase_Expecter) DeleteComment(ctx interface{}, input interface{}) *MockCommentUsecase_DeleteComment_Call {
+	return &MockCommentUsecase_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, input)}
+}
+
+func (_c *MockCommentUsecase_DeleteComment_Call) Run(run func(ctx context.Context, input *domain.DeleteCommentInput)) *MockCommentUsecase_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DeleteCommentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DeleteCommentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_DeleteComment_Call) Return(err error) *MockCommentUsecase_DeleteComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentUsecase_DeleteComment_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteCommentInput) error) *MockCommentUsecase_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindComments provides a mock function for the type MockCommentUsecase
+func (_mock *MockCommentUsecase) FindComments(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindComments")
+	}
+
+	var r0 []domain.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindCommentsInput) ([]domain.Comment, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindCommentsInput) []domain.Comment); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindCommentsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentUsecase_FindComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindComments'
+type MockCommentUsecase_FindComments_Call struct {
+	*mock.Call
+}
+
+// FindComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindCommentsInput
+func (_e *MockCommentUsecase_Expecter) FindComments(ctx interface{}, input interface{}) *MockCommentUsecase_FindComments_Call {
+	return &MockCommentUsecase_FindComments_Call{Call: _e.mock.On("FindComments", ctx, input)}
+}
+
+func (_c *MockCommentUsecase_FindComments_Call) Run(run func(ctx context.Context, input *domain.FindCommentsInput)) *MockCommentUsecase_FindComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindCommentsInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindCommentsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_FindComments_Call) Return(comments []domain.Comment, err error) *MockCommentUsecase_FindComments_Call {
+	_c.Call.Return(comments, err)
+	return _c
+}
+
+func (_c *MockCommentUsecase_FindComments_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error)) *MockCommentUsecase_FindComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function for the type MockCommentUsecase
+func (_mock *MockCommentUsecase) UpdateComment(ctx context.Context, input *domain.UpdateCommentInput) (*domain.UpdateCommentOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *domain.UpdateCommentOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateCommentInput) (*domain.UpdateCommentOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateCommentInput) *domain.UpdateCommentOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateCommentOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UpdateCommentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentUsecase_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockCommentUsecase_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.UpdateCommentInput
+func (_e *MockCommentUsecase_Expecter) UpdateComment(ctx interface{}, input interface{}) *MockCommentUsecase_UpdateComment_Call {
+	return &MockCommentUsecase_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, input)}
+}
+
+func (_c *MockCommentUsecase_UpdateComment_Call) Run(run func(ctx context.Context, input *domain.UpdateCommentInput)) *MockCommentUsecase_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UpdateCommentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UpdateCommentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_UpdateComment_Call) Return(updateCommentOutput *domain.UpdateCommentOutput, err error) *MockCommentUsecase_UpdateComment_Call {
+	_c.Call.Return(updateCommentOutput, err)
+	return _c
+}
+
+func (_c *MockCommentUsecase_UpdateComment_Call) RunAndReturn(run func(ctx context.Context, input *domain.UpdateCommentInput) (*domain.UpdateCommentOutput, error)) *MockCommentUsecase_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSyncUsecase creates a new instance of MockSyncUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSyncUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSyncUsecase {
+	mock := &MockSyncUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSyncUsecase is an autogenerated mock type for the SyncUsecase type
+type MockSyncUsecase struct {
+	mock.Mock
+}
+
+type MockSyncUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSyncUsecase) EXPECT() *MockSyncUsecase_Expecter {
+	return &MockSyncUsecase_Expecter{mock: &_m.Mock}
+}
+
+// PushTodoChanges provides a mock function for the type MockSyncUsecase
+func (_mock *MockSyncUsecase) PushTodoChanges(ctx context.Context, input *domain.PushTodoChangesInput) (*domain.PushTodoChangesOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushTodoChanges")
+	}
+
+	var r0 *domain.PushTodoChangesOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PushTodoChangesInput) (*domain.PushTodoChangesOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PushTodoChangesInput) *domain.PushTodoChangesOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PushTodoChangesOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PushTodoChangesInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSyncUsecase_PushTodoChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushTodoChanges'
+type MockSyncUsecase_PushTodoChanges_Call struct {
+	*mock.Call
+}
+
+// PushTodoChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.PushTodoChangesInput
+func (_e *MockSyncUsecase_Expecter) PushTodoChanges(ctx interface{}, input interface{}) *MockSyncUsecase_PushTodoChanges_Call {
+	return &MockSyncUsecase_PushTodoChanges_Call{Call: _e.mock.On("PushTodoChanges", ctx, input)}
+}
+
+func (_c *MockSyncUsecase_PushTodoChanges_Call) Run(run func(ctx context.Context, input *domain.PushTodoChangesInput)) *MockSyncUsecase_PushTodoChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PushTodoChangesInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PushTodoChangesInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSyncUsecase_PushTodoChanges_Call) Return(pushTodoChangesOutput *domain.PushTodoChangesOutput, err error) *MockSyncUsecase_PushTodoChanges_Call {
+	_c.Call.Return(pushTodoChangesOutput, err)
+	return _c
+}
+
+func (_c *MockSyncUsecase_PushTodoChanges_Call) RunAndReturn(run func(ctx context.Context, input *domain.PushTodoChangesInput) (*domain.PushTodoChangesOutput, error)) *MockSyncUsecase_PushTodoChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncTodos provides a mock function for the type MockSyncUsecase
+func (_mock *MockSyncUsecase) SyncTodos(ctx context.Context, input *domain.SyncTodosInput) (*domain.SyncTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncTodos")
+	}
+
+	var r0 *domain.SyncTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SyncTodosInput) (*domain.SyncTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SyncTodosInput) *domain.SyncTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SyncTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.SyncTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSyncUsecase_SyncTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncTodos'
+type MockSyncUsecase_SyncTodos_Call struct {
+	*mock.Call
+}
+
+// SyncTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.SyncTodosInput
+func (_e *MockSyncUsecase_Expecter) SyncTodos(ctx interface{}, input interface{}) *MockSyncUsecase_SyncTodos_Call {
+	return &MockSyncUsecase_SyncTodos_Call{Call: _e.mock.On("SyncTodos", ctx, input)}
+}
+
+func (_c *MockSyncUsecase_SyncTodos_Call) Run(run func(ctx context.Context, input *domain.SyncTodosInput)) *MockSyncUsecase_SyncTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SyncTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SyncTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSyncUsecase_SyncTodos_Call) Return(syncTodosOutput *domain.SyncTodosOutput, err error) *MockSyncUsecase_SyncTodos_Call {
+	_c.Call.Return(syncTodosOutput, err)
+	return _c
+}
+
+func (_c *MockSyncUsecase_SyncTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.SyncTodosInput) (*domain.SyncTodosOutput, error)) *MockSyncUsecase_SyncTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoEventUsecase creates a new instance of MockTodoEventUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoEventUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoEventUsecase {
+	mock := &MockTodoEventUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoEventUsecase is an autogenerated mock type for the TodoEventUsecase type
+type MockTodoEventUsecase struct {
+	mock.Mock
+}
+
+type MockTodoEventUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoEventUsecase) EXPECT() *MockTodoEventUsecase_Expecter {
+	return &MockTodoEventUsecase_Expecter{mock: &_m.Mock}
+}
+
+// FindTodoList provides a mock function for the type MockTodoEventUsecase
+func (_mock *MockTodoEventUsecase) FindTodoList(ctx context.Context, input *domain.FindTodoListInput) (*domain.TodoList, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodoList")
+	}
+
+	var r0 *domain.TodoList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoListInput) (*domain.TodoList, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoListInput) *domain.TodoList); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindTodoListInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoEventUsecase_FindTodoList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodoList'
+type MockTodoEventUsecase_FindTodoList_Call struct {
+	*mock.Call
+}
+
+// FindTodoList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindTodoListInput
+func (_e *MockTodoEventUsecase_Expecter) FindTodoList(ctx interface{}, input interface{}) *MockTodoEventUsecase_FindTodoList_Call {
+	return &MockTodoEventUsecase_FindTodoList_Call{Call: _e.mock.On("FindTodoList", ctx, input)}
+}
+
+func (_c *MockTodoEventUsecase_FindTodoList_Call) Run(run func(ctx context.Context, input *domain.FindTodoListInput)) *MockTodoEventUsecase_FindTodoList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindTodoListInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindTodoListInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoEventUsecase_FindTodoList_Call) Return(todoList *domain.TodoList, err error) *MockTodoEventUsecase_FindTodoList_Call {
+	_c.Call.Return(todoList, err)
+	return _c
+}
+
+func (_c *MockTodoEventUsecase_FindTodoList_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindTodoListInput) (*domain.TodoList, error)) *MockTodoEventUsecase_FindTodoList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeTodoEvents provides a mock function for the type MockTodoEventUsecase
+func (_mock *MockTodoEventUsecase) SubscribeTodoEvents(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeTodoEvents")
+	}
+
+	var r0 *domain.TodoEventSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SubscribeTodoEventsInput) *domain.TodoEventSubscription); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoEventSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.SubscribeTodoEventsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoEventUsecase_SubscribeTodoEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeTodoEvents'
+type MockTodoEventUsecase_SubscribeTodoEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeTodoEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.SubscribeTodoEventsInput
+func (_e *MockTodoEventUsecase_Expecter) SubscribeTodoEvents(ctx interface{}, input interface{}) *MockTodoEventUsecase_SubscribeTodoEvents_Call {
+	return &MockTodoEventUsecase_SubscribeTodoEvents_Call{Call: _e.mock.On("SubscribeTodoEvents", ctx, input)}
+}
+
+func (_c *MockTodoEventUsecase_SubscribeTodoEvents_Call) Run(run func(ctx context.Context, input *domain.SubscribeTodoEventsInput)) *MockTodoEventUsecase_SubscribeTodoEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SubscribeTodoEventsInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SubscribeTodoEventsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoEventUsecase_SubscribeTodoEvents_Call) Return(todoEventSubscription *domain.TodoEventSubscription, err error) *MockTodoEventUsecase_SubscribeTodoEvents_Call {
+	_c.Call.Return(todoEventSubscription, err)
+	return _c
+}
+
+func (_c *MockTodoEventUsecase_SubscribeTodoEvents_Call) RunAndReturn(run func(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error)) *MockTodoEventUsecase_SubscribeTodoEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoUsecase creates a new instance of MockTodoUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoUsecase {
+	mock := &MockTodoUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoUsecase is an autogenerated mock type for the TodoUsecase type
+type MockTodoUsecase struct {
+	mock.Mock
+}
+
+type MockTodoUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoUsecase) EXPECT() *MockTodoUsecase_Expecter {
+	return &MockTodoUsecase_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveCompletedTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) ArchiveCompletedTodos(ctx context.Context, input *domain.ArchiveCompletedTodosInput) (*domain.ArchiveTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveCompletedTodos")
+	}
+
+	var r0 *domain.ArchiveTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ArchiveCompletedTodosInput) (*domain.ArchiveTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ArchiveCompletedTodosInput) *domain.ArchiveTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ArchiveTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ArchiveCompletedTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_ArchiveCompletedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveCompletedTodos'
+type MockTodoUsecase_ArchiveCompletedTodos_Call struct {
+	*mock.Call
+}
+
+// ArchiveCompletedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ArchiveCompletedTodosInput
+func (_e *MockTodoUsecase_Expecter) ArchiveCompletedTodos(ctx interface{}, input interface{}) *MockTodoUsecase_ArchiveCompletedTodos_Call {
+	return &MockTodoUsecase_ArchiveCompletedTodos_Call{Call: _e.mock.On("ArchiveCompletedTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_ArchiveCompletedTodos_Call) Run(run func(ctx context.Context, input *domain.ArchiveCompletedTodosInput)) *MockTodoUsecase_ArchiveCompletedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ArchiveCompletedTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ArchiveCompletedTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_ArchiveCompletedTodos_Call) Return(archiveTodosOutput *domain.ArchiveTodosOutput, err error) *MockTodoUsecase_ArchiveCompletedTodos_Call {
+	_c.Call.Return(archiveTodosOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_ArchiveCompletedTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.ArchiveCompletedTodosInput) (*domain.ArchiveTodosOutput, error)) *MockTodoUsecase_ArchiveCompletedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArchiveTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) ArchiveTodo(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.ArchiveTodoOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveTodo")
+	}
+
+	var r0 *domain.ArchiveTodoOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ArchiveTodoInput) (*domain.ArchiveTodoOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ArchiveTodoInput) *domain.ArchiveTodoOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ArchiveTodoOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ArchiveTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_ArchiveTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveTodo'
+type MockTodoUsecase_ArchiveTodo_Call struct {
+	*mock.Call
+}
+
+// ArchiveTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ArchiveTodoInput
+func (_e *MockTodoUsecase_Expecter) ArchiveTodo(ctx interface{}, input interface{}) *MockTodoUsecase_ArchiveTodo_Call {
+	return &MockTodoUsecase_ArchiveTodo_Call{Call: _e.mock.On("ArchiveTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_ArchiveTodo_Call) Run(run func(ctx context.Context, input *domain.ArchiveTodoInput)) *MockTodoUsecase_ArchiveTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ArchiveTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ArchiveTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_ArchiveTodo_Call) Return(archiveTodoOutput *domain.ArchiveTodoOutput, err error) *MockTodoUsecase_ArchiveTodo_Call {
+	_c.Call.Return(archiveTodoOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_ArchiveTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.ArchiveTodoOutput, error)) *MockTodoUsecase_ArchiveTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) BatchTodos(ctx context.Context, input *domain.BatchTodosInput) (*domain.BatchTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchTodos")
+	}
+
+	var r0 *domain.BatchTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BatchTodosInput) (*domain.BatchTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BatchTodosInput) *domain.BatchTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BatchTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.BatchTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_BatchTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchTodos'
+type MockTodoUsecase_BatchTodos_Call struct {
+	*mock.Call
+}
+
+// BatchTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.BatchTodosInput
+func (_e *MockTodoUsecase_Expecter) BatchTodos(ctx interface{}, input interface{}) *MockTodoUsecase_BatchTodos_Call {
+	return &MockTodoUsecase_BatchTodos_Call{Call: _e.mock.On("BatchTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_BatchTodos_Call) Run(run func(ctx context.Context, input *domain.BatchTodosInput)) *MockTodoUsecase_BatchTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.BatchTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.BatchTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_BatchTodos_Call) Return(batchTodosOutput *domain.BatchTodosOutput, err error) *MockTodoUsecase_BatchTodos_Call {
+	_c.Call.Return(batchTodosOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_BatchTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.BatchTodosInput) (*domain.BatchTodosOutput, error)) *MockTodoUsecase_BatchTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBulkTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) CreateBulkTodos(ctx context.Context, input *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBulkTodos")
+	}
+
+	var r0 *domain.CreateBulkTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateBulkTodosInput) *domain.CreateBulkTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreateBulkTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateBulkTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_CreateBulkTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBulkTodos'
+type MockTodoUsecase_CreateBulkTodos_Call struct {
+	*mock.Call
+}
+
+// CreateBulkTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CreateBulkTodosInput
+func (_e *MockTodoUsecase_Expecter) CreateBulkTodos(ctx interface{}, input interface{}) *MockTodoUsecase_CreateBulkTodos_Call {
+	return &MockTodoUsecase_CreateBulkTodos_Call{Call: _e.mock.On("CreateBulkTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_CreateBulkTodos_Call) Run(run func(ctx context.Context, input *domain.CreateBulkTodosInput)) *MockTodoUsecase_CreateBulkTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CreateBulkTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CreateBulkTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_CreateBulkTodos_Call) Return(createBulkTodosOutput *domain.CreateBulkTodosOutput, err error) *MockTodoUsecase_CreateBulkTodos_Call {
+	_c.Call.Return(createBulkTodosOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_CreateBulkTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error)) *MockTodoUsecase_CreateBulkTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) CreateTodo(ctx context.Context, input *domain.CreateTodoInput) (*domain.CreateTodoOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTodo")
+	}
+
+	var r0 *domain.CreateTodoOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateTodoInput) (*domain.CreateTodoOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateTodoInput) *domain.CreateTodoOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreateTodoOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
+type MockTodoUsecase_CreateTodo_Call struct {
+	*mock.Call
+}
+
+// CreateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CreateTodoInput
+func (_e *MockTodoUsecase_Expecter) CreateTodo(ctx interface{}, input interface{}) *MockTodoUsecase_CreateTodo_Call {
+	return &MockTodoUsecase_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_CreateTodo_Call) Run(run func(ctx context.Context, input *domain.CreateTodoInput)) *MockTodoUsecase_CreateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CreateTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CreateTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_CreateTodo_Call) Return(createTodoOutput *domain.CreateTodoOutput, err error) *MockTodoUsecase_CreateTodo_Call {
+	_c.Call.Return(createTodoOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_CreateTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateTodoInput) (*domain.CreateTodoOutput, error)) *MockTodoUsecase_CreateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTodoUndo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) CreateTodoUndo(ctx context.Context, input *domain.CreateTodoUndoInput) (*domain.TodoUndo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTodoUndo")
+	}
+
+	var r0 *domain.TodoUndo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateTodoUndoInput) (*domain.TodoUndo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateTodoUndoInput) *domain.TodoUndo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoUndo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateTodoUndoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_CreateTodoUndo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodoUndo'
+type MockTodoUsecase_CreateTodoUndo_Call struct {
+	*mock.Call
+}
+
+// CreateTodoUndo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CreateTodoUndoInput
+func (_e *MockTodoUsecase_Expecter) CreateTodoUndo(ctx interface{}, input interface{}) *MockTodoUsecase_CreateTodoUndo_Call {
+	return &MockTodoUsecase_CreateTodoUndo_Call{Call: _e.mock.On("CreateTodoUndo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_CreateTodoUndo_Call) Run(run func(ctx context.Context, input *domain.CreateTodoUndoInput)) *MockTodoUsecase_CreateTodoUndo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CreateTodoUndoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CreateTodoUndoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_CreateTodoUndo_Call) Return(todoUndo *domain.TodoUndo, err error) *MockTodoUsecase_CreateTodoUndo_Call {
+	_c.Call.Return(todoUndo, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_CreateTodoUndo_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateTodoUndoInput) (*domain.TodoUndo, error)) *MockTodoUsecase_CreateTodoUndo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBulkTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) DeleteBulkTodos(ctx context.Context, input *domain.DeleteBulkTodosInput) (*domain.BulkTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBulkTodos")
+	}
+
+	var r0 *domain.BulkTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteBulkTodosInput) (*domain.BulkTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteBulkTodosInput) *domain.BulkTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BulkTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.DeleteBulkTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_DeleteBulkTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBulkTodos'
+type MockTodoUsecase_DeleteBulkTodos_Call struct {
+	*mock.Call
+}
+
+// DeleteBulkTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.DeleteBulkTodosInput
+func (_e *MockTodoUsecase_Expecter) DeleteBulkTodos(ctx interface{}, input interface{}) *MockTodoUsecase_DeleteBulkTodos_Call {
+	return &MockTodoUsecase_DeleteBulkTodos_Call{Call: _e.mock.On("DeleteBulkTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_DeleteBulkTodos_Call) Run(run func(ctx context.Context, input *domain.DeleteBulkTodosInput)) *MockTodoUsecase_DeleteBulkTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DeleteBulkTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DeleteBulkTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_DeleteBulkTodos_Call) Return(bulkTodosOutput *domain.BulkTodosOutput, err error) *MockTodoUsecase_DeleteBulkTodos_Call {
+	_c.Call.Return(bulkTodosOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_DeleteBulkTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteBulkTodosInput) (*domain.BulkTodosOutput, error)) *MockTodoUsecase_DeleteBulkTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTodo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteTodoInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTodoUsecase_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
+type MockTodoUsecase_DeleteTodo_Call struct {
+	*mock.Call
+}
+
+// DeleteTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.DeleteTodoInput
+func (_e *MockTodoUsecase_Expecter) DeleteTodo(ctx interface{}, input interface{}) *MockTodoUsecase_DeleteTodo_Call {
+	return &MockTodoUsecase_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_DeleteTodo_Call) Run(run func(ctx context.Context, input *domain.DeleteTodoInput)) *MockTodoUsecase_DeleteTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DeleteTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DeleteTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_DeleteTodo_Call) Return(err error) *MockTodoUsecase_DeleteTodo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_DeleteTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteTodoInput) error) *MockTodoUsecase_DeleteTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindArchivedTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) FindArchivedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArchivedTodos")
+	}
+
+	var r0 []domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Todo, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Todo); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_FindArchivedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArchivedTodos'
+type MockTodoUsecase_FindArchivedTodos_Call struct {
+	*mock.Call
+}
+
+// FindArchivedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockTodoUsecase_Expecter) FindArchivedTodos(ctx interface{}, userID interface{}) *MockTodoUsecase_FindArchivedTodos_Call {
+	return &MockTodoUsecase_FindArchivedTodos_Call{Call: _e.mock.On("FindArchivedTodos", ctx, userID)}
+}
+
+func (_c *MockTodoUsecase_FindArchivedTodos_Call) Run(run func(ctx context.Context, userID int)) *MockTodoUsecase_FindArchivedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindArchivedTodos_Call) Return(todos []domain.Todo, err error) *MockTodoUsecase_FindArchivedTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindArchivedTodos_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Todo, error)) *MockTodoUsecase_FindArchivedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) FindTodo(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodo")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoInput) (*domain.Todo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoInput) *domain.Todo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_FindTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodo'
+type MockTodoUsecase_FindTodo_Call struct {
+	*mock.Call
+}
+
+// FindTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindTodoInput
+func (_e *MockTodoUsecase_Expecter) FindTodo(ctx interface{}, input interface{}) *MockTodoUsecase_FindTodo_Call {
+	return &MockTodoUsecase_FindTodo_Call{Call: _e.mock.On("FindTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_FindTodo_Call) Run(run func(ctx context.Context, input *domain.FindTodoInput)) *MockTodoUsecase_FindTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTodo_Call) Return(todo *domain.Todo, err error) *MockTodoUsecase_FindTodo_Call {
+	_c.Call.Return(todo, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error)) *MockTodoUsecase_FindTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTodoHistory provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) FindTodoHistory(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodoHistory")
+	}
+
+	var r0 []domain.TodoRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoHistoryInput) []domain.TodoRevision); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindTodoHistoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_FindTodoHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodoHistory'
+type MockTodoUsecase_FindTodoHistory_Call struct {
+	*mock.Call
+}
+
+// FindTodoHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindTodoHistoryInput
+func (_e *MockTodoUsecase_Expecter) FindTodoHistory(ctx interface{}, input interface{}) *MockTodoUsecase_FindTodoHistory_Call {
+	return &MockTodoUsecase_FindTodoHistory_Call{Call: _e.mock.On("FindTodoHistory", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_FindTodoHistory_Call) Run(run func(ctx context.Context, input *domain.FindTodoHistoryInput)) *MockTodoUsecase_FindTodoHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindTodoHistoryInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindTodoHistoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTodoHistory_Call) Return(todoRevisions []domain.TodoRevision, err error) *MockTodoUsecase_FindTodoHistory_Call {
+	_c.Call.Return(todoRevisions, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTodoHistory_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error)) *MockTodoUsecase_FindTodoHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) FindTodos(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodos")
+	}
+
+	var r0 *domain.TodoPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodosInput) (*domain.TodoPage, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodosInput) *domain.TodoPage); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_FindTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodos'
+type MockTodoUsecase_FindTodos_Call struct {
+	*mock.Call
+}
+
+// FindTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindTodosInput
+func (_e *MockTodoUsecase_Expecter) FindTodos(ctx interface{}, input interface{}) *MockTodoUsecase_FindTodos_Call {
+	return &MockTodoUsecase_FindTodos_Call{Call: _e.mock.On("FindTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_FindTodos_Call) Run(run func(ctx context.Context, input *domain.FindTodosInput)) *MockTodoUsecase_FindTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTodos_Call) Return(todoPage *domain.TodoPage, err error) *MockTodoUsecase_FindTodos_Call {
+	_c.Call.Return(todoPage, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error)) *MockTodoUsecase_FindTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTrashedTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) FindTrashedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashedTodos")
+	}
+
+	var r0 []domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Todo, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Todo); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_FindTrashedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedTodos'
+type MockTodoUsecase_FindTrashedTodos_Call struct {
+	*mock.Call
+}
+
+// FindTrashedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockTodoUsecase_Expecter) FindTrashedTodos(ctx interface{}, userID interface{}) *MockTodoUsecase_FindTrashedTodos_Call {
+	return &MockTodoUsecase_FindTrashedTodos_Call{Call: _e.mock.On("FindTrashedTodos", ctx, userID)}
+}
+
+func (_c *MockTodoUsecase_FindTrashedTodos_Call) Run(run func(ctx context.Context, userID int)) *MockTodoUsecase_FindTrashedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTrashedTodos_Call) Return(todos []domain.Todo, err error) *MockTodoUsecase_FindTrashedTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_FindTrashedTodos_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Todo, error)) *MockTodoUsecase_FindTrashedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchBulkTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) PatchBulkTodos(ctx context.Context, input *domain.PatchBulkTodosInput) (*domain.BulkTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchBulkTodos")
+	}
+
+	var r0 *domain.BulkTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PatchBulkTodosInput) (*domain.BulkTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PatchBulkTodosInput) *domain.BulkTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BulkTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PatchBulkTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_PatchBulkTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchBulkTodos'
+type MockTodoUsecase_PatchBulkTodos_Call struct {
+	*mock.Call
+}
+
+// PatchBulkTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.PatchBulkTodosInput
+func (_e *MockTodoUsecase_Expecter) PatchBulkTodos(ctx interface{}, input interface{}) *MockTodoUsecase_PatchBulkTodos_Call {
+	return &MockTodoUsecase_PatchBulkTodos_Call{Call: _e.mock.On("PatchBulkTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_PatchBulkTodos_Call) Run(run func(ctx context.Context, input *domain.PatchBulkTodosInput)) *MockTodoUsecase_PatchBulkTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PatchBulkTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PatchBulkTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_PatchBulkTodos_Call) Return(bulkTodosOutput *domain.BulkTodosOutput, err error) *MockTodoUsecase_PatchBulkTodos_Call {
+	_c.Call.Return(bulkTodosOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_PatchBulkTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.PatchBulkTodosInput) (*domain.BulkTodosOutput, error)) *MockTodoUsecase_PatchBulkTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) PatchTodo(ctx context.Context, input *domain.PatchTodoInput) (*domain.UpdateTodoOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTodo")
+	}
+
+	var r0 *domain.UpdateTodoOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PatchTodoInput) (*domain.UpdateTodoOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PatchTodoInput) *domain.UpdateTodoOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateTodoOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PatchTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_PatchTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTodo'
+type MockTodoUsecase_PatchTodo_Call struct {
+	*mock.Call
+}
+
+// PatchTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.PatchTodoInput
+func (_e *MockTodoUsecase_Expecter) PatchTodo(ctx interface{}, input interface{}) *MockTodoUsecase_PatchTodo_Call {
+	return &MockTodoUsecase_PatchTodo_Call{Call: _e.mock.On("PatchTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_PatchTodo_Call) Run(run func(ctx context.Context, input *domain.PatchTodoInput)) *MockTodoUsecase_PatchTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PatchTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PatchTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_PatchTodo_Call) Return(updateTodoOutput *domain.UpdateTodoOutput, err error) *MockTodoUsecase_PatchTodo_Call {
+	_c.Call.Return(updateTodoOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_PatchTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.PatchTodoInput) (*domain.UpdateTodoOutput, error)) *MockTodoUsecase_PatchTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) RestoreTodo(ctx context.Context, input *domain.RestoreTodoInput) (*domain.RestoreTodoOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTodo")
+	}
+
+	var r0 *domain.RestoreTodoOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RestoreTodoInput) (*domain.RestoreTodoOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RestoreTodoInput) *domain.RestoreTodoOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RestoreTodoOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.RestoreTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_RestoreTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTodo'
+type MockTodoUsecase_RestoreTodo_Call struct {
+	*mock.Call
+}
+
+// RestoreTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.RestoreTodoInput
+func (_e *MockTodoUsecase_Expecter) RestoreTodo(ctx interface{}, input interface{}) *MockTodoUsecase_RestoreTodo_Call {
+	return &MockTodoUsecase_RestoreTodo_Call{Call: _e.mock.On("RestoreTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_RestoreTodo_Call) Run(run func(ctx context.Context, input *domain.RestoreTodoInput)) *MockTodoUsecase_RestoreTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.RestoreTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RestoreTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_RestoreTodo_Call) Return(restoreTodoOutput *domain.RestoreTodoOutput, err error) *MockTodoUsecase_RestoreTodo_Call {
+	_c.Call.Return(restoreTodoOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_RestoreTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.RestoreTodoInput) (*domain.RestoreTodoOutput, error)) *MockTodoUsecase_RestoreTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) RevertTodo(ctx context.Context, input *domain.RevertTodoInput) (*domain.RevertTodoOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertTodo")
+	}
+
+	var r0 *domain.RevertTodoOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RevertTodoInput) (*domain.RevertTodoOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RevertTodoInput) *domain.RevertTodoOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RevertTodoOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.RevertTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_RevertTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertTodo'
+type MockTodoUsecase_RevertTodo_Call struct {
+	*mock.Call
+}
+
+// RevertTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.RevertTodoInput
+func (_e *MockTodoUsecase_Expecter) RevertTodo(ctx interface{}, input interface{}) *MockTodoUsecase_RevertTodo_Call {
+	return &MockTodoUsecase_RevertTodo_Call{Call: _e.mock.On("RevertTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_RevertTodo_Call) Run(run func(ctx context.Context, input *domain.RevertTodoInput)) *MockTodoUsecase_RevertTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.RevertTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RevertTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_RevertTodo_Call) Return(revertTodoOutput *domain.RevertTodoOutput, err error) *MockTodoUsecase_RevertTodo_Call {
+	_c.Call.Return(revertTodoOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_RevertTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.RevertTodoInput) (*domain.RevertTodoOutput, error)) *MockTodoUsecase_RevertTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTodos")
+	}
+
+	var r0 []domain.TodoSearchHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SearchTodosInput) ([]domain.TodoSearchHit, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SearchTodosInput) []domain.TodoSearchHit); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoSearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.SearchTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_SearchTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTodos'
+type MockTodoUsecase_SearchTodos_Call struct {
+	*mock.Call
+}
+
+// SearchTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.SearchTodosInput
+func (_e *MockTodoUsecase_Expecter) SearchTodos(ctx interface{}, input interface{}) *MockTodoUsecase_SearchTodos_Call {
+	return &MockTodoUsecase_SearchTodos_Call{Call: _e.mock.On("SearchTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_SearchTodos_Call) Run(run func(ctx context.Context, input *domain.SearchTodosInput)) *MockTodoUsecase_SearchTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SearchTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SearchTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_SearchTodos_Call) Return(todoSearchHits []domain.TodoSearchHit, err error) *MockTodoUsecase_SearchTodos_Call {
+	_c.Call.Return(todoSearchHits, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_SearchTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error)) *MockTodoUsecase_SearchTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndoTodos provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) UndoTodos(ctx context.Context, input *domain.UndoTodosInput) (*domain.UndoTodosOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndoTodos")
+	}
+
+	var r0 *domain.UndoTodosOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UndoTodosInput) (*domain.UndoTodosOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UndoTodosInput) *domain.UndoTodosOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UndoTodosOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UndoTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_UndoTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndoTodos'
+type MockTodoUsecase_UndoTodos_Call struct {
+	*mock.Call
+}
+
+// UndoTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.UndoTodosInput
+func (_e *MockTodoUsecase_Expecter) UndoTodos(ctx interface{}, input interface{}) *MockTodoUsecase_UndoTodos_Call {
+	return &MockTodoUsecase_UndoTodos_Call{Call: _e.mock.On("UndoTodos", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_UndoTodos_Call) Run(run func(ctx context.Context, input *domain.UndoTodosInput)) *MockTodoUsecase_UndoTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UndoTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UndoTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_UndoTodos_Call) Return(undoTodosOutput *domain.UndoTodosOutput, err error) *MockTodoUsecase_UndoTodos_Call {
+	_c.Call.Return(undoTodosOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_UndoTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.UndoTodosInput) (*domain.UndoTodosOutput, error)) *MockTodoUsecase_UndoTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodo provides a mock function for the type MockTodoUsecase
+func (_mock *MockTodoUsecase) UpdateTodo(ctx context.Context, input *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodo")
+	}
+
+	var r0 *domain.UpdateTodoOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateTodoInput) *domain.UpdateTodoOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateTodoOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UpdateTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUsecase_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
+type MockTodoUsecase_UpdateTodo_Call struct {
+	*mock.Call
+}
+
+// UpdateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.UpdateTodoInput
+func (_e *MockTodoUsecase_Expecter) UpdateTodo(ctx interface{}, input interface{}) *MockTodoUsecase_UpdateTodo_Call {
+	return &MockTodoUsecase_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, input)}
+}
+
+func (_c *MockTodoUsecase_UpdateTodo_Call) Run(run func(ctx context.Context, input *domain.UpdateTodoInput)) *MockTodoUsecase_UpdateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UpdateTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UpdateTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUsecase_UpdateTodo_Call) Return(updateTodoOutput *domain.UpdateTodoOutput, err error) *MockTodoUsecase_UpdateTodo_Call {
+	_c.Call.Return(updateTodoOutput, err)
+	return _c
+}
+
+func (_c *MockTodoUsecase_UpdateTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error)) *MockTodoUsecase_UpdateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoListShareUsecase creates a new instance of MockTodoListShareUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoListShareUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoListShareUsecase {
+	mock := &MockTodoListShareUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoListShareUsecase is an autogenerated mock type for the TodoListShareUsecase type
+type MockTodoListShareUsecase struct {
+	mock.Mock
+}
+
+type MockTodoListShareUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoListShareUsecase) EXPECT() *MockTodoListShareUsecase_Expecter {
+	return &MockTodoListShareUsecase_Expecter{mock: &_m.Mock}
+}
+
+// AnswerTodoListInvitation provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) AnswerTodoListInvitation(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnswerTodoListInvitation")
+	}
+
+	var r0 *domain.TodoListMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AnswerTodoListInvitationInput) *domain.TodoListMember); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoListMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AnswerTodoListInvitationInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListShareUsecase_AnswerTodoListInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnswerTodoListInvitation'
+type MockTodoListShareUsecase_AnswerTodoListInvitation_Call struct {
+	*mock.Call
+}
+
+// AnswerTodoListInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AnswerTodoListInvitationInput
+func (_e *MockTodoListShareUsecase_Expecter) AnswerTodoListInvitation(ctx interface{}, input interface{}) *MockTodoListShareUsecase_AnswerTodoListInvitation_Call {
+	return &MockTodoListShareUsecase_AnswerTodoListInvitation_Call{Call: _e.mock.On("AnswerTodoListInvitation", ctx, input)}
+}
+
+func (_c *MockTodoListShareUsecase_AnswerTodoListInvitation_Call) Run(run func(ctx context.Context, input *domain.AnswerTodoListInvitationInput)) *MockTodoListShareUsecase_AnswerTodoListInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AnswerTodoListInvitationInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AnswerTodoListInvitationInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_AnswerTodoListInvitation_Call) Return(todoListMember *domain.TodoListMember, err error) *MockTodoListShareUsecase_AnswerTodoListInvitation_Call {
+	_c.Call.Return(todoListMember, err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_AnswerTodoListInvitation_Call) RunAndReturn(run func(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error)) *MockTodoListShareUsecase_AnswerTodoListInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSharedTodoLists provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) FindSharedTodoLists(ctx context.Context, userID int) ([]domain.TodoListMember, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSharedTodoLists")
+	}
+
+	var r0 []domain.TodoListMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.TodoListMember, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.TodoListMember); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoListMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListShareUsecase_FindSharedTodoLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSharedTodoLists'
+type MockTodoListShareUsecase_FindSharedTodoLists_Call struct {
+	*mock.Call
+}
+
+// FindSharedTodoLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockTodoListShareUsecase_Expecter) FindSharedTodoLists(ctx interface{}, userID interface{}) *MockTodoListShareUsecase_FindSharedTodoLists_Call {
+	return &MockTodoListShareUsecase_FindSharedTodoLists_Call{Call: _e.mock.On("FindSharedTodoLists", ctx, userID)}
+}
+
+func (_c *MockTodoListShareUsecase_FindSharedTodoLists_Call) Run(run func(ctx context.Context, userID int)) *MockTodoListShareUsecase_FindSharedTodoLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindSharedTodoLists_Call) Return(todoListMembers []domain.TodoListMember, err error) *MockTodoListShareUsecase_FindSharedTodoLists_Call {
+	_c.Call.Return(todoListMembers, err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindSharedTodoLists_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.TodoListMember, error)) *MockTodoListShareUsecase_FindSharedTodoLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTodoListInvitations provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) FindTodoListInvitations(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error) {
+	ret := _mock.Called(ctx, loginID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodoListInvitations")
+	}
+
+	var r0 []domain.TodoListInvitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.TodoListInvitation, error)); ok {
+		return returnFunc(ctx, loginID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.TodoListInvitation); ok {
+		r0 = returnFunc(ctx, loginID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoListInvitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, loginID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListShareUsecase_FindTodoListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodoListInvitations'
+type MockTodoListShareUsecase_FindTodoListInvitations_Call struct {
+	*mock.Call
+}
+
+// FindTodoListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loginID string
+func (_e *MockTodoListShareUsecase_Expecter) FindTodoListInvitations(ctx interface{}, loginID interface{}) *MockTodoListShareUsecase_FindTodoListInvitations_Call {
+	return &MockTodoListShareUsecase_FindTodoListInvitations_Call{Call: _e.mock.On("FindTodoListInvitations", ctx, loginID)}
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListInvitations_Call) Run(run func(ctx context.Context, loginID string)) *MockTodoListShareUsecase_FindTodoListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListInvitations_Call) Return(todoListInvitations []domain.TodoListInvitation, err error) *MockTodoListShareUsecase_FindTodoListInvitations_Call {
+	_c.Call.Return(todoListInvitations, err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListInvitations_Call) RunAndReturn(run func(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error)) *MockTodoListShareUsecase_FindTodoListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTodoListMembers provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) FindTodoListMembers(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodoListMembers")
+	}
+
+	var r0 []domain.TodoListMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoListMembersInput) []domain.TodoListMember); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TodoListMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindTodoListMembersInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListShareUsecase_FindTodoListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodoListMembers'
+type MockTodoListShareUsecase_FindTodoListMembers_Call struct {
+	*mock.Call
+}
+
+// FindTodoListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindTodoListMembersInput
+func (_e *MockTodoListShareUsecase_Expecter) FindTodoListMembers(ctx interface{}, input interface{}) *MockTodoListShareUsecase_FindTodoListMembers_Call {
+	return &MockTodoListShareUsecase_FindTodoListMembers_Call{Call: _e.mock.On("FindTodoListMembers", ctx, input)}
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListMembers_Call) Run(run func(ctx context.Context, input *domain.FindTodoListMembersInput)) *MockTodoListShareUsecase_FindTodoListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindTodoListMembersInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindTodoListMembersInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListMembers_Call) Return(todoListMembers []domain.TodoListMember, err error) *MockTodoListShareUsecase_FindTodoListMembers_Call {
+	_c.Call.Return(todoListMembers, err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListMembers_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error)) *MockTodoListShareUsecase_FindTodoListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTodoListTodos provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) FindTodoListTodos(ctx context.Context, input *domain.FindTodoListTodosInput) (*domain.TodoPage, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodoListTodos")
+	}
+
+	var r0 *domain.TodoPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoListTodosInput) (*domain.TodoPage, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindTodoListTodosInput) *domain.TodoPage); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindTodoListTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListShareUsecase_FindTodoListTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodoListTodos'
+type MockTodoListShareUsecase_FindTodoListTodos_Call struct {
+	*mock.Call
+}
+
+// FindTodoListTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindTodoListTodosInput
+func (_e *MockTodoListShareUsecase_Expecter) FindTodoListTodos(ctx interface{}, input interface{}) *MockTodoListShareUsecase_FindTodoListTodos_Call {
+	return &MockTodoListShareUsecase_FindTodoListTodos_Call{Call: _e.mock.On("FindTodoListTodos", ctx, input)}
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListTodos_Call) Run(run func(ctx context.Context, input *domain.FindTodoListTodosInput)) *MockTodoListShareUsecase_FindTodoListTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindTodoListTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindTodoListTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListTodos_Call) Return(todoPage *domain.TodoPage, err error) *MockTodoListShareUsecase_FindTodoListTodos_Call {
+	_c.Call.Return(todoPage, err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_FindTodoListTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindTodoListTodosInput) (*domain.TodoPage, error)) *MockTodoListShareUsecase_FindTodoListTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteTodoListMember provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) InviteTodoListMember(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.InviteTodoListMemberOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteTodoListMember")
+	}
+
+	var r0 *domain.InviteTodoListMemberOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.InviteTodoListMemberInput) (*domain.InviteTodoListMemberOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.InviteTodoListMemberInput) *domain.InviteTodoListMemberOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.InviteTodoListMemberOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.InviteTodoListMemberInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListShareUsecase_InviteTodoListMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteTodoListMember'
+type MockTodoListShareUsecase_InviteTodoListMember_Call struct {
+	*mock.Call
+}
+
+// InviteTodoListMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.InviteTodoListMemberInput
+func (_e *MockTodoListShareUsecase_Expecter) InviteTodoListMember(ctx interface{}, input interface{}) *MockTodoListShareUsecase_InviteTodoListMember_Call {
+	return &MockTodoListShareUsecase_InviteTodoListMember_Call{Call: _e.mock.On("InviteTodoListMember", ctx, input)}
+}
+
+func (_c *MockTodoListShareUsecase_InviteTodoListMember_Call) Run(run func(ctx context.Context, input *domain.InviteTodoListMemberInput)) *MockTodoListShareUsecase_InviteTodoListMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.InviteTodoListMemberInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.InviteTodoListMemberInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_InviteTodoListMember_Call) Return(inviteTodoListMemberOutput *domain.InviteTodoListMemberOutput, err error) *MockTodoListShareUsecase_InviteTodoListMember_Call {
+	_c.Call.Return(inviteTodoListMemberOutput, err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_InviteTodoListMember_Call) RunAndReturn(run func(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.InviteTodoListMemberOutput, error)) *MockTodoListShareUsecase_InviteTodoListMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoListMember provides a mock function for the type MockTodoListShareUsecase
+func (_mock *MockTodoListShareUsecase) RemoveTodoListMember(ctx context.Context, input *domain.RemoveTodoListMemberInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoListMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RemoveTodoListMemberInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTodoListShareUsecase_RemoveTodoListMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoListMember'
+type MockTodoListShareUsecase_RemoveTodoListMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoListMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.RemoveTodoListMemberInput
+func (_e *MockTodoListShareUsecase_Expecter) RemoveTodoListMember(ctx interface{}, input interface{}) *MockTodoListShareUsecase_RemoveTodoListMember_Call {
+	return &MockTodoListShareUsecase_RemoveTodoListMember_Call{Call: _e.mock.On("RemoveTodoListMember", ctx, input)}
+}
+
+func (_c *MockTodoListShareUsecase_RemoveTodoListMember_Call) Run(run func(ctx context.Context, input *domain.RemoveTodoListMemberInput)) *MockTodoListShareUsecase_RemoveTodoListMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.RemoveTodoListMemberInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RemoveTodoListMemberInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_RemoveTodoListMember_Call) Return(err error) *MockTodoListShareUsecase_RemoveTodoListMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTodoListShareUsecase_RemoveTodoListMember_Call) RunAndReturn(run func(ctx context.Context, input *domain.RemoveTodoListMemberInput) error) *MockTodoListShareUsecase_RemoveTodoListMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockViewUsecase creates a new instance of MockViewUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockViewUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockViewUsecase {
+	mock := &MockViewUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockViewUsecase is an autogenerated mock type for the ViewUsecase type
+type MockViewUsecase struct {
+	mock.Mock
+}
+
+type MockViewUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockViewUsecase) EXPECT() *MockViewUsecase_Expecter {
+	return &MockViewUsecase_Expecter{mock: &_m.Mock}
+}
+
+// CreateView provides a mock function for the type MockViewUsecase
+func (_mock *MockViewUsecase) CreateView(ctx context.Context, input *domain.CreateViewInput) (*domain.CreateViewOutput, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateView")
+	}
+
+	var r0 *domain.CreateViewOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateViewInput) (*domain.CreateViewOutput, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateViewInput) *domain.CreateViewOutput); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreateViewOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateViewInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViewUsecase_CreateView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateView'
+type MockViewUsecase_CreateView_Call struct {
+	*mock.Call
+}
+
+// CreateView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CreateViewInput
+func (_e *MockViewUsecase_Expecter) CreateView(ctx interface{}, input interface{}) *MockViewUsecase_CreateView_Call {
+	return &MockViewUsecase_CreateView_Call{Call: _e.mock.On("CreateView", ctx, input)}
+}
+
+func (_c *MockViewUsecase_CreateView_Call) Run(run func(ctx context.Context, input *domain.CreateViewInput)) *MockViewUsecase_CreateView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CreateViewInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CreateViewInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViewUsecase_CreateView_Call) Return(createViewOutput *domain.CreateViewOutput, err error) *MockViewUsecase_CreateView_Call {
+	_c.Call.Return(createViewOutput, err)
+	return _c
+}
+
+func (_c *MockViewUsecase_CreateView_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateViewInput) (*domain.CreateViewOutput, error)) *MockViewUsecase_CreateView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteView provides a mock function for the type MockViewUsecase
+func (_mock *MockViewUsecase) DeleteView(ctx context.Context, input *domain.DeleteViewInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteView")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteViewInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockViewUsecase_DeleteView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteView'
+type MockViewUsecase_DeleteView_Call struct {
+	*mock.Call
+}
 
-	return mock
+// DeleteView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.DeleteViewInput
+func (_e *MockViewUsecase_Expecter) DeleteView(ctx interface{}, input interface{}) *MockViewUsecase_DeleteView_Call {
+	return &MockViewUsecase_DeleteView_Call{Call: _e.mock.On("DeleteView", ctx, input)}
 }
 
-// MockAuthUsecase is an autogenerated mock type for the AuthUsecase type
-type MockAuthUsecase struct {
-	mock.Mock
+func (_c *MockViewUsecase_DeleteView_Call) Run(run func(ctx context.Context, input *domain.DeleteViewInput)) *MockViewUsecase_DeleteView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DeleteViewInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DeleteViewInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-type MockAuthUsecase_Expecter struct {
-	mock *mock.Mock
+func (_c *MockViewUsecase_DeleteView_Call) Return(err error) *MockViewUsecase_DeleteView_Call {
+	_c.Call.Return(err)
+	return _c
 }
 
-func (_m *MockAuthUsecase) EXPECT() *MockAuthUsecase_Expecter {
-	return &MockAuthUsecase_Expecter{mock: &_m.Mock}
+func (_c *MockViewUsecase_DeleteView_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteViewInput) error) *MockViewUsecase_DeleteView_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// Authenticate provides a mock function for the type MockAuthUsecase
-func (_mock *MockAuthUsecase) Authenticate(input *domain.AuthenticateInput) (*domain.AuthenticateOutput, error) {
-	ret := _mock.Called(input)
+// FindViewTodos provides a mock function for the type MockViewUsecase
+func (_mock *MockViewUsecase) FindViewTodos(ctx context.Context, input *domain.FindViewTodosInput) (*domain.TodoPage, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Authenticate")
+		panic("no return value specified for FindViewTodos")
 	}
 
-	var r0 *domain.AuthenticateOutput
+	var r0 *domain.TodoPage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*domain.AuthenticateInput) (*domain.AuthenticateOutput, error)); ok {
-		return returnFunc(input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindViewTodosInput) (*domain.TodoPage, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*domain.AuthenticateInput) *domain.AuthenticateOutput); ok {
-		r0 = returnFunc(input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindViewTodosInput) *domain.TodoPage); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.AuthenticateOutput)
+			r0 = ret.Get(0).(*domain.TodoPage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*domain.AuthenticateInput) error); ok {
-		r1 = returnFunc(input)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindViewTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthUsecase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
-type MockAuthUsecase_Authenticate_Call struct {
+// MockViewUsecase_FindViewTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindViewTodos'
+type MockViewUsecase_FindViewTodos_Call struct {
 	*mock.Call
 }
 
-// Authenticate is a helper method to define mock.On call
-//   - input *domain.AuthenticateInput
-func (_e *MockAuthUsecase_Expecter) Authenticate(input interface{}) *MockAuthUsecase_Authenticate_Call {
-	return &MockAuthUsecase_Authenticate_Call{Call: _e.mock.On("Authenticate", input)}
+// FindViewTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindViewTodosInput
+func (_e *MockViewUsecase_Expecter) FindViewTodos(ctx interface{}, input interface{}) *MockViewUsecase_FindViewTodos_Call {
+	return &MockViewUsecase_FindViewTodos_Call{Call: _e.mock.On("FindViewTodos", ctx, input)}
 }
 
-func (_c *MockAuthUsecase_Authenticate_Call) Run(run func(input *domain.AuthenticateInput)) *MockAuthUsecase_Authenticate_Call {
+func (_c *MockViewUsecase_FindViewTodos_Call) Run(run func(ctx context.Context, input *domain.FindViewTodosInput)) *MockViewUsecase_FindViewTodos_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *domain.AuthenticateInput
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*domain.AuthenticateInput)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindViewTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindViewTodosInput)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthUsecase_Authenticate_Call) Return(authenticateOutput *domain.AuthenticateOutput, err error) *MockAuthUsecase_Authenticate_Call {
-	_c.Call.Return(authenticateOutput, err)
+func (_c *MockViewUsecase_FindViewTodos_Call) Return(todoPage *domain.TodoPage, err error) *MockViewUsecase_FindViewTodos_Call {
+	_c.Call.Return(todoPage, err)
 	return _c
 }
 
-func (_c *MockAuthUsecase_Authenticate_Call) RunAndReturn(run func(input *domain.AuthenticateInput) (*domain.AuthenticateOutput, error)) *MockAuthUsecase_Authenticate_Call {
+func (_c *MockViewUsecase_FindViewTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindViewTodosInput) (*domain.TodoPage, error)) *MockViewUsecase_FindViewTodos_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockTodoUsecase creates a new instance of MockTodoUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockTodoUsecase(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockTodoUsecase {
-	mock := &MockTodoUsecase{}
-	mock.Mock.Test(t)
+// FindViews provides a mock function for the type MockViewUsecase
+func (_mock *MockViewUsecase) FindViews(ctx context.Context, userID int) ([]domain.ViewWithCount, error) {
+	ret := _mock.Called(ctx, userID)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for FindViews")
+	}
 
-	return mock
+	var r0 []domain.ViewWithCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.ViewWithCount, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.ViewWithCount); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ViewWithCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockTodoUsecase is an autogenerated mock type for the TodoUsecase type
-type MockTodoUsecase struct {
-	mock.Mock
+// MockViewUsecase_FindViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindViews'
+type MockViewUsecase_FindViews_Call struct {
+	*mock.Call
 }
 
-type MockTodoUsecase_Expecter struct {
-	mock *mock.Mock
+// FindViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockViewUsecase_Expecter) FindViews(ctx interface{}, userID interface{}) *MockViewUsecase_FindViews_Call {
+	return &MockViewUsecase_FindViews_Call{Call: _e.mock.On("FindViews", ctx, userID)}
 }
 
-func (_m *MockTodoUsecase) EXPECT() *MockTodoUsecase_Expecter {
-	return &MockTodoUsecase_Expecter{mock: &_m.Mock}
+func (_c *MockViewUsecase_FindViews_Call) Run(run func(ctx context.Context, userID int)) *MockViewUsecase_FindViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-// CreateBulkTodos provides a mock function for the type MockTodoUsecase
-func (_mock *MockTodoUsecase) CreateBulkTodos(ctx context.Context, input *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error) {
+func (_c *MockViewUsecase_FindViews_Call) Return(viewWithCounts []domain.ViewWithCount, err error) *MockViewUsecase_FindViews_Call {
+	_c.Call.Return(viewWithCounts, err)
+	return _c
+}
+
+func (_c *MockViewUsecase_FindViews_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.ViewWithCount, error)) *MockViewUsecase_FindViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateView provides a mock function for the type MockViewUsecase
+func (_mock *MockViewUsecase) UpdateView(ctx context.Context, input *domain.UpdateViewInput) (*domain.UpdateViewOutput, error) {
 	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBulkTodos")
+		panic("no return value specified for UpdateView")
 	}
 
-	var r0 *domain.CreateBulkTodosOutput
+	var r0 *domain.UpdateViewOutput
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateViewInput) (*domain.UpdateViewOutput, error)); ok {
 		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateBulkTodosInput) *domain.CreateBulkTodosOutput); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateViewInput) *domain.UpdateViewOutput); ok {
 		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.CreateBulkTodosOutput)
+			r0 = ret.Get(0).(*domain.UpdateViewOutput)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateBulkTodosInput) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UpdateViewInput) error); ok {
 		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
@@ -155,27 +3474,27 @@ func (_mock *MockTodoUsecase) CreateBulkTodos(ctx context.Context, input *domain
 	return r0, r1
 }
 
-// MockTodoUsecase_CreateBulkTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBulkTodos'
-type MockTodoUsecase_CreateBulkTodos_Call struct {
+// MockViewUsecase_UpdateView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateView'
+type MockViewUsecase_UpdateView_Call struct {
 	*mock.Call
 }
 
-// CreateBulkTodos is a helper method to define mock.On call
+// UpdateView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input *domain.CreateBulkTodosInput
-func (_e *MockTodoUsecase_Expecter) CreateBulkTodos(ctx interface{}, input interface{}) *MockTodoUsecase_CreateBulkTodos_Call {
-	return &MockTodoUsecase_CreateBulkTodos_Call{Call: _e.mock.On("CreateBulkTodos", ctx, input)}
+//   - input *domain.UpdateViewInput
+func (_e *MockViewUsecase_Expecter) UpdateView(ctx interface{}, input interface{}) *MockViewUsecase_UpdateView_Call {
+	return &MockViewUsecase_UpdateView_Call{Call: _e.mock.On("UpdateView", ctx, input)}
 }
 
-func (_c *MockTodoUsecase_CreateBulkTodos_Call) Run(run func(ctx context.Context, input *domain.CreateBulkTodosInput)) *MockTodoUsecase_CreateBulkTodos_Call {
+func (_c *MockViewUsecase_UpdateView_Call) Run(run func(ctx context.Context, input *domain.UpdateViewInput)) *MockViewUsecase_UpdateView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.CreateBulkTodosInput
+		var arg1 *domain.UpdateViewInput
 		if args[1] != nil {
-			arg1 = args[1].(*domain.CreateBulkTodosInput)
+			arg1 = args[1].(*domain.UpdateViewInput)
 		}
 		run(
 			arg0,
@@ -185,37 +3504,64 @@ func (_c *MockTodoUsecase_CreateBulkTodos_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockTodoUsecase_CreateBulkTodos_Call) Return(createBulkTodosOutput *domain.CreateBulkTodosOutput, err error) *MockTodoUsecase_CreateBulkTodos_Call {
-	_c.Call.Return(createBulkTodosOutput, err)
+func (_c *MockViewUsecase_UpdateView_Call) Return(updateViewOutput *domain.UpdateViewOutput, err error) *MockViewUsecase_UpdateView_Call {
+	_c.Call.Return(updateViewOutput, err)
 	return _c
 }
 
-func (_c *MockTodoUsecase_CreateBulkTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error)) *MockTodoUsecase_CreateBulkTodos_Call {
+func (_c *MockViewUsecase_UpdateView_Call) RunAndReturn(run func(ctx context.Context, input *domain.UpdateViewInput) (*domain.UpdateViewOutput, error)) *MockViewUsecase_UpdateView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTodo provides a mock function for the type MockTodoUsecase
-func (_mock *MockTodoUsecase) CreateTodo(ctx context.Context, input *domain.CreateTodoInput) (*domain.CreateTodoOutput, error) {
+// NewMockWebhookUsecase creates a new instance of MockWebhookUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookUsecase {
+	mock := &MockWebhookUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookUsecase is an autogenerated mock type for the WebhookUsecase type
+type MockWebhookUsecase struct {
+	mock.Mock
+}
+
+type MockWebhookUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookUsecase) EXPECT() *MockWebhookUsecase_Expecter {
+	return &MockWebhookUsecase_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function for the type MockWebhookUsecase
+func (_mock *MockWebhookUsecase) CreateWebhook(ctx context.Context, input *domain.CreateWebhookInput) (*domain.CreateWebhookOutput, error) {
 	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTodo")
+		panic("no return value specified for CreateWebhook")
 	}
 
-	var r0 *domain.CreateTodoOutput
+	var r0 *domain.CreateWebhookOutput
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateTodoInput) (*domain.CreateTodoOutput, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateWebhookInput) (*domain.CreateWebhookOutput, error)); ok {
 		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateTodoInput) *domain.CreateTodoOutput); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateWebhookInput) *domain.CreateWebhookOutput); ok {
 		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.CreateTodoOutput)
+			r0 = ret.Get(0).(*domain.CreateWebhookOutput)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateTodoInput) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateWebhookInput) error); ok {
 		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
@@ -223,27 +3569,27 @@ func (_mock *MockTodoUsecase) CreateTodo(ctx context.Context, input *domain.Crea
 	return r0, r1
 }
 
-// MockTodoUsecase_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
-type MockTodoUsecase_CreateTodo_Call struct {
+// MockWebhookUsecase_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookUsecase_CreateWebhook_Call struct {
 	*mock.Call
 }
 
-// CreateTodo is a helper method to define mock.On call
+// CreateWebhook is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input *domain.CreateTodoInput
-func (_e *MockTodoUsecase_Expecter) CreateTodo(ctx interface{}, input interface{}) *MockTodoUsecase_CreateTodo_Call {
-	return &MockTodoUsecase_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, input)}
+//   - input *domain.CreateWebhookInput
+func (_e *MockWebhookUsecase_Expecter) CreateWebhook(ctx interface{}, input interface{}) *MockWebhookUsecase_CreateWebhook_Call {
+	return &MockWebhookUsecase_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, input)}
 }
 
-func (_c *MockTodoUsecase_CreateTodo_Call) Run(run func(ctx context.Context, input *domain.CreateTodoInput)) *MockTodoUsecase_CreateTodo_Call {
+func (_c *MockWebhookUsecase_CreateWebhook_Call) Run(run func(ctx context.Context, input *domain.CreateWebhookInput)) *MockWebhookUsecase_CreateWebhook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.CreateTodoInput
+		var arg1 *domain.CreateWebhookInput
 		if args[1] != nil {
-			arg1 = args[1].(*domain.CreateTodoInput)
+			arg1 = args[1].(*domain.CreateWebhookInput)
 		}
 		run(
 			arg0,
@@ -253,26 +3599,26 @@ func (_c *MockTodoUsecase_CreateTodo_Call) Run(run func(ctx context.Context, inp
 	return _c
 }
 
-func (_c *MockTodoUsecase_CreateTodo_Call) Return(createTodoOutput *domain.CreateTodoOutput, err error) *MockTodoUsecase_CreateTodo_Call {
-	_c.Call.Return(createTodoOutput, err)
+func (_c *MockWebhookUsecase_CreateWebhook_Call) Return(createWebhookOutput *domain.CreateWebhookOutput, err error) *MockWebhookUsecase_CreateWebhook_Call {
+	_c.Call.Return(createWebhookOutput, err)
 	return _c
 }
 
-func (_c *MockTodoUsecase_CreateTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateTodoInput) (*domain.CreateTodoOutput, error)) *MockTodoUsecase_CreateTodo_Call {
+func (_c *MockWebhookUsecase_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateWebhookInput) (*domain.CreateWebhookOutput, error)) *MockWebhookUsecase_CreateWebhook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTodo provides a mock function for the type MockTodoUsecase
-func (_mock *MockTodoUsecase) DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) error {
+// DeleteWebhook provides a mock function for the type MockWebhookUsecase
+func (_mock *MockWebhookUsecase) DeleteWebhook(ctx context.Context, input *domain.DeleteWebhookInput) error {
 	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTodo")
+		panic("no return value specified for DeleteWebhook")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteTodoInput) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DeleteWebhookInput) error); ok {
 		r0 = returnFunc(ctx, input)
 	} else {
 		r0 = ret.Error(0)
@@ -280,27 +3626,27 @@ func (_mock *MockTodoUsecase) DeleteTodo(ctx context.Context, input *domain.Dele
 	return r0
 }
 
-// MockTodoUsecase_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
-type MockTodoUsecase_DeleteTodo_Call struct {
+// MockWebhookUsecase_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookUsecase_DeleteWebhook_Call struct {
 	*mock.Call
 }
 
-// DeleteTodo is a helper method to define mock.On call
+// DeleteWebhook is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input *domain.DeleteTodoInput
-func (_e *MockTodoUsecase_Expecter) DeleteTodo(ctx interface{}, input interface{}) *MockTodoUsecase_DeleteTodo_Call {
-	return &MockTodoUsecase_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, input)}
+//   - input *domain.DeleteWebhookInput
+func (_e *MockWebhookUsecase_Expecter) DeleteWebhook(ctx interface{}, input interface{}) *MockWebhookUsecase_DeleteWebhook_Call {
+	return &MockWebhookUsecase_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, input)}
 }
 
-func (_c *MockTodoUsecase_DeleteTodo_Call) Run(run func(ctx context.Context, input *domain.DeleteTodoInput)) *MockTodoUsecase_DeleteTodo_Call {
+func (_c *MockWebhookUsecase_DeleteWebhook_Call) Run(run func(ctx context.Context, input *domain.DeleteWebhookInput)) *MockWebhookUsecase_DeleteWebhook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.DeleteTodoInput
+		var arg1 *domain.DeleteWebhookInput
 		if args[1] != nil {
-			arg1 = args[1].(*domain.DeleteTodoInput)
+			arg1 = args[1].(*domain.DeleteWebhookInput)
 		}
 		run(
 			arg0,
@@ -310,65 +3656,65 @@ func (_c *MockTodoUsecase_DeleteTodo_Call) Run(run func(ctx context.Context, inp
 	return _c
 }
 
-func (_c *MockTodoUsecase_DeleteTodo_Call) Return(err error) *MockTodoUsecase_DeleteTodo_Call {
+func (_c *MockWebhookUsecase_DeleteWebhook_Call) Return(err error) *MockWebhookUsecase_DeleteWebhook_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockTodoUsecase_DeleteTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteTodoInput) error) *MockTodoUsecase_DeleteTodo_Call {
+func (_c *MockWebhookUsecase_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, input *domain.DeleteWebhookInput) error) *MockWebhookUsecase_DeleteWebhook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTodos provides a mock function for the type MockTodoUsecase
-func (_mock *MockTodoUsecase) FindTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
-	ret := _mock.Called(ctx, userID)
+// FindWebhookDeliveries provides a mock function for the type MockWebhookUsecase
+func (_mock *MockWebhookUsecase) FindWebhookDeliveries(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTodos")
+		panic("no return value specified for FindWebhookDeliveries")
 	}
 
-	var r0 []domain.Todo
+	var r0 []domain.WebhookDelivery
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Todo, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Todo); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindWebhookDeliveriesInput) []domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Todo)
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindWebhookDeliveriesInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockTodoUsecase_FindTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodos'
-type MockTodoUsecase_FindTodos_Call struct {
+// MockWebhookUsecase_FindWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhookDeliveries'
+type MockWebhookUsecase_FindWebhookDeliveries_Call struct {
 	*mock.Call
 }
 
-// FindTodos is a helper method to define mock.On call
+// FindWebhookDeliveries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int
-func (_e *MockTodoUsecase_Expecter) FindTodos(ctx interface{}, userID interface{}) *MockTodoUsecase_FindTodos_Call {
-	return &MockTodoUsecase_FindTodos_Call{Call: _e.mock.On("FindTodos", ctx, userID)}
+//   - input *domain.FindWebhookDeliveriesInput
+func (_e *MockWebhookUsecase_Expecter) FindWebhookDeliveries(ctx interface{}, input interface{}) *MockWebhookUsecase_FindWebhookDeliveries_Call {
+	return &MockWebhookUsecase_FindWebhookDeliveries_Call{Call: _e.mock.On("FindWebhookDeliveries", ctx, input)}
 }
 
-func (_c *MockTodoUsecase_FindTodos_Call) Run(run func(ctx context.Context, userID int)) *MockTodoUsecase_FindTodos_Call {
+func (_c *MockWebhookUsecase_FindWebhookDeliveries_Call) Run(run func(ctx context.Context, input *domain.FindWebhookDeliveriesInput)) *MockWebhookUsecase_FindWebhookDeliveries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 *domain.FindWebhookDeliveriesInput
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(*domain.FindWebhookDeliveriesInput)
 		}
 		run(
 			arg0,
@@ -378,65 +3724,65 @@ func (_c *MockTodoUsecase_FindTodos_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockTodoUsecase_FindTodos_Call) Return(todos []domain.Todo, err error) *MockTodoUsecase_FindTodos_Call {
-	_c.Call.Return(todos, err)
+func (_c *MockWebhookUsecase_FindWebhookDeliveries_Call) Return(webhookDeliverys []domain.WebhookDelivery, err error) *MockWebhookUsecase_FindWebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
 	return _c
 }
 
-func (_c *MockTodoUsecase_FindTodos_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Todo, error)) *MockTodoUsecase_FindTodos_Call {
+func (_c *MockWebhookUsecase_FindWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error)) *MockWebhookUsecase_FindWebhookDeliveries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTodo provides a mock function for the type MockTodoUsecase
-func (_mock *MockTodoUsecase) UpdateTodo(ctx context.Context, input *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error) {
-	ret := _mock.Called(ctx, input)
+// FindWebhooks provides a mock function for the type MockWebhookUsecase
+func (_mock *MockWebhookUsecase) FindWebhooks(ctx context.Context, userID int) ([]domain.Webhook, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTodo")
+		panic("no return value specified for FindWebhooks")
 	}
 
-	var r0 *domain.UpdateTodoOutput
+	var r0 []domain.Webhook
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error)); ok {
-		return returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Webhook, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UpdateTodoInput) *domain.UpdateTodoOutput); ok {
-		r0 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Webhook); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UpdateTodoOutput)
+			r0 = ret.Get(0).([]domain.Webhook)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UpdateTodoInput) error); ok {
-		r1 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockTodoUsecase_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
-type MockTodoUsecase_UpdateTodo_Call struct {
+// MockWebhookUsecase_FindWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhooks'
+type MockWebhookUsecase_FindWebhooks_Call struct {
 	*mock.Call
 }
 
-// UpdateTodo is a helper method to define mock.On call
+// FindWebhooks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input *domain.UpdateTodoInput
-func (_e *MockTodoUsecase_Expecter) UpdateTodo(ctx interface{}, input interface{}) *MockTodoUsecase_UpdateTodo_Call {
-	return &MockTodoUsecase_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, input)}
+//   - userID int
+func (_e *MockWebhookUsecase_Expecter) FindWebhooks(ctx interface{}, userID interface{}) *MockWebhookUsecase_FindWebhooks_Call {
+	return &MockWebhookUsecase_FindWebhooks_Call{Call: _e.mock.On("FindWebhooks", ctx, userID)}
 }
 
-func (_c *MockTodoUsecase_UpdateTodo_Call) Run(run func(ctx context.Context, input *domain.UpdateTodoInput)) *MockTodoUsecase_UpdateTodo_Call {
+func (_c *MockWebhookUsecase_FindWebhooks_Call) Run(run func(ctx context.Context, userID int)) *MockWebhookUsecase_FindWebhooks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.UpdateTodoInput
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(*domain.UpdateTodoInput)
+			arg1 = args[1].(int)
 		}
 		run(
 			arg0,
@@ -446,12 +3792,12 @@ func (_c *MockTodoUsecase_UpdateTodo_Call) Run(run func(ctx context.Context, inp
 	return _c
 }
 
-func (_c *MockTodoUsecase_UpdateTodo_Call) Return(updateTodoOutput *domain.UpdateTodoOutput, err error) *MockTodoUsecase_UpdateTodo_Call {
-	_c.Call.Return(updateTodoOutput, err)
+func (_c *MockWebhookUsecase_FindWebhooks_Call) Return(webhooks []domain.Webhook, err error) *MockWebhookUsecase_FindWebhooks_Call {
+	_c.Call.Return(webhooks, err)
 	return _c
 }
 
-func (_c *MockTodoUsecase_UpdateTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error)) *MockTodoUsecase_UpdateTodo_Call {
+func (_c *MockWebhookUsecase_FindWebhooks_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Webhook, error)) *MockWebhookUsecase_FindWebhooks_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// SyncUsecase defines the use case operations for the sync of offline-first clients.
+type SyncUsecase interface {
+	SyncTodos(ctx context.Context, input *domain.SyncTodosInput) (*domain.SyncTodosOutput, error)
+	PushTodoChanges(ctx context.Context, input *domain.PushTodoChangesInput) (*domain.PushTodoChangesOutput, error)
+}
+
+// SyncHandler handles HTTP requests for the sync of todos with offline-first clients.
+type SyncHandler struct {
+	usecase SyncUsecase
+	logger  *slog.Logger
+}
+
+// NewSyncHandler creates a new SyncHandler with the given use case.
+func NewSyncHandler(usecase SyncUsecase) *SyncHandler {
+	return &SyncHandler{
+		usecase: usecase,
+		logger:  slog.Default().With(slog.String(domain.LoggerNameKey, "SyncHandler")),
+	}
+}
+
+// NewInitSyncRouterFunc returns an InitRouterGroupFunc that registers sync routes under a "sync" group.
+// idempotencyMiddleware runs on the push endpoint, after the middleware passed to the returned function.
+func NewInitSyncRouterFunc(syncUsecase SyncUsecase, idempotencyMiddleware gin.HandlerFunc) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		sync := parentRouterGroup.Group("sync", middleware...)
+		syncHandler := NewSyncHandler(syncUsecase)
+
+		sync.GET("", syncHandler.SyncTodos)
+		sync.POST("/push", idempotencyMiddleware, syncHandler.PushTodoChanges)
+	}
+}
+
+// NewSyncChangedTodoResponse converts a changed todo of a sync to a SyncChangedTodoResponse API type.
+func NewSyncChangedTodoResponse(todo *domain.Todo) (*api.SyncChangedTodoResponse, error) {
+	todoResp, err := NewFindTodoResponseTodo(todo)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SyncChangedTodoResponse{
+		Etag: todoETag(todo),
+		Todo: *todoResp,
+	}, nil
+}
+
+// NewSyncResponse converts the changes of a sync to a SyncResponse API type with the encoded token for the next sync.
+func NewSyncResponse(output *domain.SyncTodosOutput) (*api.SyncResponse, error) {
+	syncToken, err := output.Token.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("encode sync token: %w", err)
+	}
+	resp := &api.SyncResponse{
+		Created:   make([]api.SyncChangedTodoResponse, 0, len(output.Created)),
+		Updated:   make([]api.SyncChangedTodoResponse, 0, len(output.Updated)),
+		Deleted:   make([]api.SyncDeletedTodoResponse, 0, len(output.Deleted)),
+		SyncToken: syncToken,
+		HasMore:   output.HasMore,
+	}
+	for _, todo := range output.Created {
+		todoResp, err := NewSyncChangedTodoResponse(&todo)
+		if err != nil {
+			return nil, fmt.Errorf("convert created todo: %w", err)
+		}
+		resp.Created = append(resp.Created, *todoResp)
+	}
+	for _, todo := range output.Updated {
+		todoResp, err := NewSyncChangedTodoResponse(&todo)
+		if err != nil {
+			return nil, fmt.Errorf("convert updated todo: %w", err)
+		}
+		resp.Updated = append(resp.Updated, *todoResp)
+	}
+	for _, tombstone := range output.Deleted {
+		id, err := safeIntToInt32(tombstone.ID)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo ID: %w", err)
+		}
+		resp.Deleted = append(resp.Deleted, api.SyncDeletedTodoResponse{
+			ID:        id,
+			DeletedAt: tombstone.DeletedAt,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller/handler"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func initSyncRouter(t *testing.T, ctx context.Context, syncUsecase handler.SyncUsecase, userID int) *gin.Engine {
+	t.Helper()
+
+	router, err := handler.InitRootRouterGroup(ctx, config, domain.AppName)
+	require.NoError(t, err)
+	api := router.Group("api")
+	v1 := api.Group("v1")
+
+	v1.Use(fakeAuthMiddleware(userID, testLoginID))
+
+	initSyncRouterFunc := handler.NewInitSyncRouterFunc(syncUsecase, func(c *gin.Context) { c.Next() })
+	initSyncRouterFunc(v1)
+
+	return router
+}
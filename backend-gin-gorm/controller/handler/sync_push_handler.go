@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewPushResponse converts the results of a push to a PushResponse API type.
+func NewPushResponse(output *domain.PushTodoChangesOutput) (*api.PushResponse, error) {
+	resp := &api.PushResponse{
+		Results: make([]api.PushChangeResultResponse, 0, len(output.Results)),
+	}
+	for _, result := range output.Results {
+		resultResp, err := newPushChangeResultResponse(&result)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, *resultResp)
+	}
+	return resp, nil
+}
+
+func newPushChangeResultResponse(result *domain.PushChangeResult) (*api.PushChangeResultResponse, error) {
+	resp := &api.PushChangeResultResponse{ //nolint:exhaustruct
+		Op:     api.PushChangeResultResponseOp(result.Type),
+		Status: api.PushChangeResultResponseStatus(result.Status),
+	}
+	if result.ID > 0 {
+		id, err := safeIntToInt32(result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo ID: %w", err)
+		}
+		resp.ID = &id
+	}
+	if result.TempID != "" {
+		resp.TempID = &result.TempID
+	}
+	if result.Todo != nil {
+		todoResp, err := NewFindTodoResponseTodo(result.Todo)
+		if err != nil {
+			return nil, err
+		}
+		etag := todoETag(result.Todo)
+		resp.Todo = todoResp
+		resp.Etag = &etag
+	}
+	if result.Status == domain.PushChangeConflict {
+		conflicts := make([]api.PushConflictField, 0, len(result.Conflicts))
+		for _, field := range result.Conflicts {
+			conflicts = append(conflicts, api.PushConflictField(field))
+		}
+		resp.Conflicts = &conflicts
+		if result.Local != nil {
+			resp.Local = newSyncTodoFields(result.Local)
+		}
+	}
+	if result.Err != nil {
+		resp.Error = NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError))
+	}
+	return resp, nil
+}
+
+func newSyncTodoFields(values *domain.TodoFieldValues) *api.SyncTodoFields {
+	return &api.SyncTodoFields{
+		Text:       values.Text,
+		IsComplete: values.IsComplete,
+	}
+}
+
+func newTodoFieldValues(fields *api.SyncTodoFields) *domain.TodoFieldValues {
+	if fields == nil {
+		return nil
+	}
+	return &domain.TodoFieldValues{
+		Text:       fields.Text,
+		IsComplete: fields.IsComplete,
+	}
+}
+
+// newPushChange converts a change of a push request to a domain change.
+func newPushChange(req *api.PushChangeRequest) *domain.PushChange {
+	change := &domain.PushChange{ //nolint:exhaustruct
+		Type:  domain.PushChangeType(req.Op),
+		Base:  newTodoFieldValues(req.Base),
+		Local: newTodoFieldValues(req.Local),
+	}
+	if req.ID != nil {
+		change.ID = int(*req.ID)
+	}
+	if req.TempID != nil {
+		change.TempID = *req.TempID
+	}
+	if req.BaseVersion != nil {
+		change.BaseVersion = int(*req.BaseVersion)
+	}
+	return change
+}
+
+// PushTodoChanges handles POST /sync/push and applies the todo creates, updates and deletes the authenticated user's
+// client made offline. An update made to an older version of a todo is merged field by field with the server state;
+// fields both sides changed are reported as conflicts with the client values and the server's todo.
+// The response reports a result for each change in request order.
+func (h *SyncHandler) PushTodoChanges(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "PushTodoChanges called", slog.Int("userId", userID))
+
+	var req api.PushRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid push request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	changes := make([]domain.PushChange, len(req.Changes))
+	for i := range req.Changes {
+		changes[i] = *newPushChange(&req.Changes[i])
+	}
+	input, err := domain.NewPushTodoChangesInput(userID, changes)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid push todo changes input", slog.Any("error", err))
+		var changeErr *domain.BatchOperationError
+		if errors.As(err, &changeErr) {
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", fmt.Sprintf("change %d: %v", changeErr.Index, changeErr.Err)))
+			return
+		}
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.PushTodoChanges(ctx, input)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to push todo changes", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	for _, result := range output.Results {
+		if result.Err != nil {
+			h.logger.ErrorContext(ctx, "failed to apply pushed change", slog.String("op", string(result.Type)), slog.Int("todoId", result.ID), slog.Any("error", result.Err))
+		}
+	}
+
+	resp, err := NewPushResponse(output)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_SyncHandler_PushTodoChanges_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name            string
+		body            string
+		expectedMessage string
+	}{
+		{
+			name:            "no changes",
+			body:            `{"changes": []}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "unknown op",
+			body:            `{"changes": [{"op": "move", "id": 1}]}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "empty text",
+			body:            `{"changes": [{"op": "create", "local": {"text": "", "isComplete": false}}]}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "update without base",
+			body:            `{"changes": [{"op": "delete", "id": 2, "baseVersion": 1}, {"op": "update", "id": 1, "baseVersion": 1, "local": {"text": "task", "isComplete": true}}]}`,
+			expectedMessage: "change 1: update requires an id, a base version, base and local",
+		},
+		{
+			name:            "delete without base version",
+			body:            `{"changes": [{"op": "delete", "id": 1}]}`,
+			expectedMessage: "change 0: delete only takes an id and a base version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			syncUsecase := NewMockSyncUsecase(t)
+			r := initSyncRouter(t, ctx, syncUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/sync/push", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", tt.expectedMessage)
+		})
+	}
+}
+
+func Test_SyncHandler_PushTodoChanges_shouldReturnResultPerChange_whenPushed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	syncUsecase := NewMockSyncUsecase(t)
+	syncUsecase.EXPECT().PushTodoChanges(mock.Anything, &domain.PushTodoChangesInput{
+		UserID: userID,
+		Changes: []domain.PushChange{
+			{Type: domain.PushChangeCreate, TempID: "a", Local: &domain.TodoFieldValues{Text: "new", IsComplete: false}},
+			{Type: domain.PushChangeUpdate, ID: 5, BaseVersion: 2, Base: &domain.TodoFieldValues{Text: "old", IsComplete: false}, Local: &domain.TodoFieldValues{Text: "mine", IsComplete: true}},
+			{Type: domain.PushChangeDelete, ID: 6, BaseVersion: 1},
+			{Type: domain.PushChangeDelete, ID: 7, BaseVersion: 1},
+		},
+	}).Return(&domain.PushTodoChangesOutput{
+		Results: []domain.PushChangeResult{
+			{Type: domain.PushChangeCreate, ID: 10, TempID: "a", Status: domain.PushChangeApplied, Todo: &domain.Todo{ID: 10, UserID: userID, Text: "new", Version: 1}},
+			{Type: domain.PushChangeUpdate, ID: 5, Status: domain.PushChangeConflict, Todo: &domain.Todo{ID: 5, UserID: userID, Text: "theirs", IsComplete: true, Version: 4}, Local: &domain.TodoFieldValues{Text: "mine", IsComplete: true}, Conflicts: []domain.TodoField{domain.TodoFieldText}},
+			{Type: domain.PushChangeDelete, ID: 6, Status: domain.PushChangeNotFound},
+			{Type: domain.PushChangeDelete, ID: 7, Status: domain.PushChangeFailed, Err: errors.New("boom")},
+		},
+	}, nil).Once()
+	r := initSyncRouter(t, ctx, syncUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"changes": [
+		{"op": "create", "tempId": "a", "local": {"text": "new", "isComplete": false}},
+		{"op": "update", "id": 5, "baseVersion": 2, "base": {"text": "old", "isComplete": false}, "local": {"text": "mine", "isComplete": true}},
+		{"op": "delete", "id": 6, "baseVersion": 1},
+		{"op": "delete", "id": 7, "baseVersion": 1}
+	]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/sync/push", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []any{"create", "update", "delete", "delete"}, parseExpr(t, "$.results[*].op").Get(jsonObj))
+	assert.Equal(t, []any{"applied", "conflict", "not_found", "failed"}, parseExpr(t, "$.results[*].status").Get(jsonObj))
+	assert.Equal(t, []any{int64(10), int64(5), int64(6), int64(7)}, parseExpr(t, "$.results[*].id").Get(jsonObj))
+	assert.Equal(t, []any{"a"}, parseExpr(t, "$.results[*].tempId").Get(jsonObj))
+	assert.Equal(t, []any{`"1"`, `"4"`}, parseExpr(t, "$.results[*].etag").Get(jsonObj))
+
+	// - conflict
+	assert.Equal(t, []any{"theirs"}, parseExpr(t, "$.results[1].todo.text").Get(jsonObj))
+	assert.Equal(t, []any{"mine"}, parseExpr(t, "$.results[1].local.text").Get(jsonObj))
+	assert.Equal(t, []any{"text"}, parseExpr(t, "$.results[1].conflicts[*]").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[0].conflicts").Get(jsonObj), "an applied change should have no conflicts")
+
+	// - failed
+	assert.Equal(t, []any{"internal_server_error"}, parseExpr(t, "$.results[*].error.code").Get(jsonObj))
+}
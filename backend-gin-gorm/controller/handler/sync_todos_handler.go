@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// SyncTodos handles GET /sync and returns the authenticated user's todos created, updated and moved to the trash
+// since the sync token in the "since" query parameter, with the token for the next sync.
+// Without a token it returns every todo outside the trash. At most "limit" todos are returned per call and
+// hasMore is set when more follow. A token older than the trash retention is answered with 410 Gone,
+// after which the client has to sync from scratch.
+func (h *SyncHandler) SyncTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "SyncTodos called", slog.Int("userId", userID))
+
+	limit := domain.DefaultSyncLimit
+	if limitS, ok := c.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limitS)
+		if err != nil || v < 1 || v > domain.MaxSyncLimit {
+			h.logger.WarnContext(ctx, "invalid limit", slog.String("limit", limitS))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_limit", fmt.Sprintf("limit must be an integer between 1 and %d", domain.MaxSyncLimit)))
+			return
+		}
+		limit = v
+	}
+
+	var since *domain.SyncToken
+	if sinceS, ok := c.GetQuery("since"); ok {
+		v, err := domain.DecodeSyncToken(sinceS)
+		if err != nil {
+			h.logger.WarnContext(ctx, "invalid sync token", slog.Any("error", err))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_sync_token", "sync token is invalid"))
+			return
+		}
+		since = v
+	}
+
+	input, err := domain.NewSyncTodosInput(userID, since, limit)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid sync todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	output, err := h.usecase.SyncTodos(ctx, input)
+	if err != nil {
+		if errors.Is(err, domain.ErrSyncTokenExpired) {
+			h.logger.WarnContext(ctx, "sync token expired", slog.Any("error", err))
+			c.JSON(http.StatusGone, NewErrorResponse("sync_token_expired", "sync token has expired; sync again without a token"))
+			return
+		}
+		h.logger.ErrorContext(ctx, "failed to sync todos", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewSyncResponse(output)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_SyncHandler_SyncTodos_shouldReturn200WithChanges_whenTokenIsGiven(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	since, err := domain.NewSyncToken(3, 7, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+	encodedSince, err := since.Encode()
+	require.NoError(t, err)
+	next, err := domain.NewSyncToken(6, 9, time.Date(2025, 1, 2, 3, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	encodedNext, err := next.Encode()
+	require.NoError(t, err)
+	deletedAt := time.Date(2025, 1, 2, 3, 4, 30, 0, time.UTC)
+
+	syncUsecase := NewMockSyncUsecase(t)
+	syncUsecase.EXPECT().SyncTodos(mock.Anything, &domain.SyncTodosInput{
+		UserID: userID,
+		Since:  since,
+		Limit:  2,
+	}).Return(&domain.SyncTodosOutput{
+		Created: []domain.Todo{{ID: 9, Text: "new", Version: 1}},
+		Updated: []domain.Todo{{ID: 2, Text: "changed", Version: 4}},
+		Deleted: []domain.TodoTombstone{{ID: 5, DeletedAt: deletedAt}},
+		Token:   next,
+		HasMore: true,
+	}, nil).Once()
+	r := initSyncRouter(t, ctx, syncUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/api/v1/sync?since=%s&limit=2", encodedSince), nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - created
+	assert.Equal(t, []any{int64(9)}, parseExpr(t, "$.created[*].todo.id").Get(jsonObj))
+	assert.Equal(t, []any{`"1"`}, parseExpr(t, "$.created[*].etag").Get(jsonObj))
+
+	// - updated
+	assert.Equal(t, []any{int64(2)}, parseExpr(t, "$.updated[*].todo.id").Get(jsonObj))
+	assert.Equal(t, []any{`"4"`}, parseExpr(t, "$.updated[*].etag").Get(jsonObj))
+
+	// - deleted
+	assert.Equal(t, []any{int64(5)}, parseExpr(t, "$.deleted[*].id").Get(jsonObj))
+	assert.Equal(t, []any{"2025-01-02T03:04:30Z"}, parseExpr(t, "$.deleted[*].deletedAt").Get(jsonObj))
+
+	// - syncToken
+	assert.Equal(t, []any{encodedNext}, parseExpr(t, "$.syncToken").Get(jsonObj))
+	assert.Equal(t, []any{true}, parseExpr(t, "$.hasMore").Get(jsonObj))
+}
+
+func Test_SyncHandler_SyncTodos_shouldSyncFromScratch_whenNoTokenIsGiven(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	next, err := domain.NewSyncToken(6, 9, time.Now())
+	require.NoError(t, err)
+
+	syncUsecase := NewMockSyncUsecase(t)
+	syncUsecase.EXPECT().SyncTodos(mock.Anything, &domain.SyncTodosInput{
+		UserID: userID,
+		Since:  nil,
+		Limit:  domain.DefaultSyncLimit,
+	}).Return(&domain.SyncTodosOutput{
+		Created: []domain.Todo{},
+		Updated: []domain.Todo{},
+		Deleted: []domain.TodoTombstone{},
+		Token:   next,
+		HasMore: false,
+	}, nil).Once()
+	r := initSyncRouter(t, ctx, syncUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/sync", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []any{[]any{}}, parseExpr(t, "$.created").Get(jsonObj), "empty lists should not be null")
+	assert.Equal(t, []any{false}, parseExpr(t, "$.hasMore").Get(jsonObj))
+}
+
+func Test_SyncHandler_SyncTodos_shouldReturn410_whenTokenExpired(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	since, err := domain.NewSyncToken(3, 7, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	encodedSince, err := since.Encode()
+	require.NoError(t, err)
+
+	syncUsecase := NewMockSyncUsecase(t)
+	syncUsecase.EXPECT().SyncTodos(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("execute sync todos query: %w", domain.ErrSyncTokenExpired)).Once()
+	r := initSyncRouter(t, ctx, syncUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/sync?since="+encodedSince, nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusGone, w.Code, "status code should be 410")
+	validateErrorResponse(t, respBytes, "sync_token_expired", "sync token has expired; sync again without a token")
+}
+
+func Test_SyncHandler_SyncTodos_shouldReturn400_whenQueryIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		query        string
+		expectedCode string
+	}{
+		{
+			name:         "malformed token",
+			query:        "since=!!!",
+			expectedCode: "invalid_sync_token",
+		},
+		{
+			name:         "limit too large",
+			query:        "limit=501",
+			expectedCode: "invalid_limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			// given
+			syncUsecase := NewMockSyncUsecase(t)
+			r := initSyncRouter(t, ctx, syncUsecase, randomUserID())
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/sync?"+tt.query, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			assert.Contains(t, w.Body.String(), tt.expectedCode)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewArchiveCompletedTodosResponse converts an ArchiveTodosOutput to an ArchiveCompletedTodosResponse API type.
+func NewArchiveCompletedTodosResponse(output *domain.ArchiveTodosOutput) (*api.ArchiveCompletedTodosResponse, error) {
+	archivedCount, err := safeIntToInt32(output.ArchivedCount)
+	if err != nil {
+		return nil, fmt.Errorf("convert archived count: %w", err)
+	}
+	return &api.ArchiveCompletedTodosResponse{
+		ArchivedCount: archivedCount,
+	}, nil
+}
+
+// ArchiveCompletedTodos handles POST /todo/archive/completed and archives every completed todo of the authenticated user.
+func (h *TodoHandler) ArchiveCompletedTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+
+	h.logger.InfoContext(ctx, "ArchiveCompletedTodos called", slog.Int("userId", userID))
+
+	input, err := domain.NewArchiveCompletedTodosInput(userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid archive completed todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	output, err := h.usecase.ArchiveCompletedTodos(ctx, input)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to archive completed todos", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewArchiveCompletedTodosResponse(output)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
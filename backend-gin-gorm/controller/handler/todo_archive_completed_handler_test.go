@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_ArchiveCompletedTodos_shouldReturn200_whenTodosArchived(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().ArchiveCompletedTodos(mock.Anything, &domain.ArchiveCompletedTodosInput{
+		UserID: userID,
+	}).Return(&domain.ArchiveTodosOutput{ArchivedCount: 3}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/archive/completed", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+	archivedCount := parseExpr(t, "$.archivedCount").Get(jsonObj)
+	require.Len(t, archivedCount, 1, "response should have one archivedCount")
+	assert.Equal(t, int64(3), archivedCount[0])
+}
+
+func Test_TodoHandler_ArchiveCompletedTodos_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().ArchiveCompletedTodos(mock.Anything, &domain.ArchiveCompletedTodosInput{
+		UserID: userID,
+	}).Return(nil, assert.AnError).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/archive/completed", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ArchiveTodo handles POST /todo/:id/archive and archives a todo of the authenticated user.
+func (h *TodoHandler) ArchiveTodo(c *gin.Context) {
+	h.setTodoArchived(c, true)
+}
+
+// UnarchiveTodo handles POST /todo/:id/unarchive and moves a todo of the authenticated user out of the archive.
+func (h *TodoHandler) UnarchiveTodo(c *gin.Context) {
+	h.setTodoArchived(c, false)
+}
+
+func (h *TodoHandler) setTodoArchived(c *gin.Context, archived bool) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "ArchiveTodo called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Bool("archived", archived))
+
+	input, err := domain.NewArchiveTodoInput(todoID, userID, archived)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid archive todo input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	output, err := h.usecase.ArchiveTodo(ctx, input)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		h.logger.WarnContext(ctx, "todo not found", slog.Int("todoId", todoID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if writeTodoForbidden(c, h.logger, err) {
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to archive todo", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewFindTodoResponseTodo(output.Todo)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	setTodoETag(c, output.Todo)
+	c.JSON(http.StatusOK, resp)
+}
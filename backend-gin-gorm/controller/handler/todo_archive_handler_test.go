@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_ArchiveTodo_shouldReturn200_whenTodoArchived(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	archivedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().ArchiveTodo(mock.Anything, &domain.ArchiveTodoInput{
+		ID:       1,
+		UserID:   userID,
+		Archived: true,
+	}).Return(&domain.ArchiveTodoOutput{
+		Todo: &domain.Todo{
+			ID:         1,
+			UserID:     userID,
+			Text:       "archived task",
+			ArchivedAt: &archivedAt,
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/archive", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+	archived := parseExpr(t, "$.archivedAt").Get(jsonObj)
+	require.Len(t, archived, 1, "response should have one archivedAt")
+	assert.Equal(t, "2025-01-02T03:04:05Z", archived[0])
+}
+
+func Test_TodoHandler_UnarchiveTodo_shouldReturn200_whenTodoUnarchived(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().ArchiveTodo(mock.Anything, &domain.ArchiveTodoInput{
+		ID:       1,
+		UserID:   userID,
+		Archived: false,
+	}).Return(&domain.ArchiveTodoOutput{
+		Todo: &domain.Todo{
+			ID:     1,
+			UserID: userID,
+			Text:   "active task",
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/unarchive", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+	archived := parseExpr(t, "$.archivedAt").Get(jsonObj)
+	assert.Empty(t, archived, "archivedAt should be omitted")
+}
+
+func Test_TodoHandler_ArchiveTodo_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().ArchiveTodo(mock.Anything, &domain.ArchiveTodoInput{
+		ID:       999999,
+		UserID:   userID,
+		Archived: true,
+	}).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/999999/archive", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
+
+func Test_TodoHandler_ArchiveTodo_shouldReturn400_whenInvalidPath(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/0/archive", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_todo_id", "todo id must be a positive integer")
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// batchSuccessStatus is the status of a successful operation of each type, as returned by the single-todo endpoints.
+var batchSuccessStatus = map[domain.BatchOperationType]int{
+	domain.BatchOperationCreate: http.StatusCreated,
+	domain.BatchOperationUpdate: http.StatusOK,
+	domain.BatchOperationDelete: http.StatusNoContent,
+}
+
+// NewBatchResponse converts the results of a batch to a BatchResponse API type.
+func NewBatchResponse(output *domain.BatchTodosOutput) (*api.BatchResponse, error) {
+	resp := &api.BatchResponse{
+		Committed: output.Committed,
+		Results:   make([]api.BatchOperationResultResponse, 0, len(output.Results)),
+	}
+	for _, result := range output.Results {
+		resultResp, err := newBatchOperationResultResponse(&result)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, *resultResp)
+	}
+	return resp, nil
+}
+
+func newBatchOperationResultResponse(result *domain.BatchOperationResult) (*api.BatchOperationResultResponse, error) {
+	resp := &api.BatchOperationResultResponse{ //nolint:exhaustruct
+		Status: int32(batchSuccessStatus[result.Type]),
+	}
+	if result.ID > 0 {
+		id, err := safeIntToInt32(result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo ID: %w", err)
+		}
+		resp.ID = &id
+	}
+	if result.TempID != "" {
+		resp.TempID = &result.TempID
+	}
+	if result.Err != nil {
+		status, errResp := newBatchOperationErrorResponse(result.Err)
+		resp.Status = int32(status)
+		resp.Error = errResp
+	}
+	if result.Todo != nil {
+		todoResp, err := NewFindTodoResponseTodo(result.Todo)
+		if err != nil {
+			return nil, err
+		}
+		etag := todoETag(result.Todo)
+		resp.Todo = todoResp
+		resp.Etag = &etag
+	}
+	return resp, nil
+}
+
+// newBatchOperationErrorResponse returns the status and error reported for a failed operation of a batch.
+func newBatchOperationErrorResponse(err error) (int, *api.ErrorResponse) {
+	var mismatch *domain.TodoVersionMismatchError
+	switch {
+	case errors.Is(err, domain.ErrTodoNotFound):
+		return http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound))
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden, NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden))
+	case errors.As(err, &mismatch):
+		return http.StatusPreconditionFailed, NewErrorResponse("version_mismatch", "the todo has been changed since the expected version")
+	case errors.Is(err, domain.ErrBatchDependencyFailed):
+		return http.StatusFailedDependency, NewErrorResponse("dependency_failed", "the todo named by tempId was not created")
+	case errors.Is(err, domain.ErrBatchRolledBack):
+		return http.StatusFailedDependency, NewErrorResponse("rolled_back", "the operation was rolled back because another operation failed")
+	case errors.Is(err, domain.ErrBatchNotExecuted):
+		return http.StatusFailedDependency, NewErrorResponse("not_executed", "the operation was not run because an earlier operation failed")
+	default:
+		return http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError))
+	}
+}
+
+// newBatchOperation converts an operation of a batch request to a domain operation.
+// Returns an error if the operation lacks the member its type requires or carries one it does not take.
+func newBatchOperation(req *api.BatchOperationRequest) (*domain.BatchOperation, error) {
+	op := &domain.BatchOperation{ //nolint:exhaustruct
+		Type: domain.BatchOperationType(req.Op),
+	}
+	switch req.Op {
+	case api.Create:
+		if req.Todo == nil || req.Patch != nil {
+			return nil, errors.New("create takes todo and no patch")
+		}
+		op.Text = &req.Todo.Text
+	case api.Update:
+		if req.Patch == nil || req.Todo != nil {
+			return nil, errors.New("update takes patch and no todo")
+		}
+		op.Text = req.Patch.Text
+		op.IsComplete = req.Patch.IsComplete
+	case api.Delete:
+		if req.Patch != nil || req.Todo != nil {
+			return nil, errors.New("delete takes neither todo nor patch")
+		}
+	}
+	if req.ID != nil {
+		op.ID = int(*req.ID)
+	}
+	if req.TempID != nil {
+		op.TempID = *req.TempID
+	}
+	if req.ExpectedVersion != nil {
+		expectedVersion := int(*req.ExpectedVersion)
+		op.ExpectedVersion = &expectedVersion
+	}
+	return op, nil
+}
+
+// BatchTodos handles POST /batch and runs an ordered list of todo creates, updates and deletes for the authenticated user.
+// A create may name its todo with a tempId that later operations use in place of an id.
+// The response reports for each operation the status and body the single-todo endpoint would have returned.
+// With atomic set, a failed operation rolls back the whole batch.
+func (h *TodoHandler) BatchTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "BatchTodos called", slog.Int("userId", userID))
+
+	body, err := c.GetRawData()
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid batch request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+	var members struct {
+		Operations []struct {
+			Patch map[string]json.RawMessage `json:"patch"`
+		} `json:"operations"`
+	}
+	if err := json.Unmarshal(body, &members); err != nil {
+		h.logger.WarnContext(ctx, "invalid batch request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+	for i, op := range members.Operations {
+		if field, ok := findNullPatchTodoMember(op.Patch); ok {
+			h.logger.WarnContext(ctx, "patch removes a required member", slog.Int("index", i), slog.String("field", field))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", fmt.Sprintf("operation %d: %s cannot be null", i, field)))
+			return
+		}
+	}
+
+	var req api.BatchRequest
+	if err := binding.JSON.BindBody(body, &req); err != nil {
+		h.logger.WarnContext(ctx, "invalid batch request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	operations := make([]domain.BatchOperation, len(req.Operations))
+	for i := range req.Operations {
+		op, err := newBatchOperation(&req.Operations[i])
+		if err != nil {
+			h.logger.WarnContext(ctx, "invalid batch operation", slog.Int("index", i), slog.Any("error", err))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", fmt.Sprintf("operation %d: %v", i, err)))
+			return
+		}
+		operations[i] = *op
+	}
+	input, err := domain.NewBatchTodosInput(userID, operations, req.Atomic != nil && *req.Atomic)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid batch todos input", slog.Any("error", err))
+		var opErr *domain.BatchOperationError
+		if errors.As(err, &opErr) {
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", opErr.Error()))
+			return
+		}
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.BatchTodos(ctx, input)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to run batch", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewBatchResponse(output)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
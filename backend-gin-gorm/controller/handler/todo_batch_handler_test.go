@@ -0,0 +1,188 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_BatchTodos_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name            string
+		body            string
+		expectedMessage string
+	}{
+		{
+			name:            "no operations",
+			body:            `{"operations": []}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "unknown op",
+			body:            `{"operations": [{"op": "move", "id": 1}]}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "null member",
+			body:            `{"operations": [{"op": "update", "id": 1, "patch": {"text": null}}]}`,
+			expectedMessage: "operation 0: text cannot be null",
+		},
+		{
+			name:            "create without todo",
+			body:            `{"operations": [{"op": "create", "tempId": "a"}]}`,
+			expectedMessage: "operation 0: create takes todo and no patch",
+		},
+		{
+			name:            "delete with patch",
+			body:            `{"operations": [{"op": "delete", "id": 1, "patch": {"isComplete": true}}]}`,
+			expectedMessage: "operation 0: delete takes neither todo nor patch",
+		},
+		{
+			name:            "update with id and temp id",
+			body:            `{"operations": [{"op": "create", "tempId": "a", "todo": {"text": "task"}}, {"op": "update", "id": 1, "tempId": "a", "patch": {}}]}`,
+			expectedMessage: "operation 1: update requires either an id or a temporary id",
+		},
+		{
+			name:            "undefined temp id",
+			body:            `{"operations": [{"op": "delete", "tempId": "a"}, {"op": "create", "tempId": "a", "todo": {"text": "task"}}]}`,
+			expectedMessage: `operation 0: temporary id "a" is not defined by an earlier create`,
+		},
+		{
+			name:            "duplicate temp id",
+			body:            `{"operations": [{"op": "create", "tempId": "a", "todo": {"text": "task 1"}}, {"op": "create", "tempId": "a", "todo": {"text": "task 2"}}]}`,
+			expectedMessage: `operation 1: temporary id "a" is already defined`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/batch", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", tt.expectedMessage)
+		})
+	}
+}
+
+func Test_TodoHandler_BatchTodos_shouldReturnResultPerOperation_whenCommitted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	text := "task"
+	isComplete := true
+	expectedVersion := 3
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().BatchTodos(mock.Anything, &domain.BatchTodosInput{
+		UserID: userID,
+		Operations: []domain.BatchOperation{
+			{Type: domain.BatchOperationCreate, TempID: "a", Text: &text},
+			{Type: domain.BatchOperationUpdate, TempID: "a", IsComplete: &isComplete},
+			{Type: domain.BatchOperationDelete, ID: 7, ExpectedVersion: &expectedVersion},
+		},
+	}).Return(&domain.BatchTodosOutput{
+		Results: []domain.BatchOperationResult{
+			{Type: domain.BatchOperationCreate, ID: 10, TempID: "a", Todo: &domain.Todo{ID: 10, UserID: userID, Text: "task", Version: 1}},
+			{Type: domain.BatchOperationUpdate, ID: 10, TempID: "a", Todo: &domain.Todo{ID: 10, UserID: userID, Text: "task", IsComplete: true, Version: 2}},
+			{Type: domain.BatchOperationDelete, ID: 7},
+		},
+		Committed: true,
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"operations": [
+		{"op": "create", "tempId": "a", "todo": {"text": "task"}},
+		{"op": "update", "tempId": "a", "patch": {"isComplete": true}},
+		{"op": "delete", "id": 7, "expectedVersion": 3}
+	]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/batch", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []interface{}{true}, parseExpr(t, "$.committed").Get(jsonObj))
+	assert.Equal(t, []interface{}{int64(201), int64(200), int64(204)}, parseExpr(t, "$.results[*].status").Get(jsonObj))
+	assert.Equal(t, []interface{}{int64(10), int64(10), int64(7)}, parseExpr(t, "$.results[*].id").Get(jsonObj))
+	assert.Equal(t, []interface{}{"a", "a"}, parseExpr(t, "$.results[*].tempId").Get(jsonObj))
+	assert.Equal(t, []interface{}{`"1"`, `"2"`}, parseExpr(t, "$.results[*].etag").Get(jsonObj))
+	assert.Equal(t, []interface{}{true}, parseExpr(t, "$.results[1].todo.isComplete").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[2].todo").Get(jsonObj), "a delete should have no todo")
+	assert.Empty(t, parseExpr(t, "$.results[*].error").Get(jsonObj), "no result should have an error")
+}
+
+func Test_TodoHandler_BatchTodos_shouldReportRollback_whenAtomicBatchFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	text := "task"
+	isComplete := true
+	expectedVersion := 1
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().BatchTodos(mock.Anything, &domain.BatchTodosInput{
+		UserID: userID,
+		Operations: []domain.BatchOperation{
+			{Type: domain.BatchOperationCreate, Text: &text},
+			{Type: domain.BatchOperationUpdate, ID: 5, IsComplete: &isComplete, ExpectedVersion: &expectedVersion},
+			{Type: domain.BatchOperationDelete, ID: 6},
+		},
+		Atomic: true,
+	}).Return(&domain.BatchTodosOutput{
+		Results: []domain.BatchOperationResult{
+			{Type: domain.BatchOperationCreate, Err: domain.ErrBatchRolledBack},
+			{Type: domain.BatchOperationUpdate, ID: 5, Todo: &domain.Todo{ID: 5, UserID: userID, Text: "other", Version: 4}, Err: &domain.TodoVersionMismatchError{Current: &domain.Todo{ID: 5, UserID: userID, Text: "other", Version: 4}}},
+			{Type: domain.BatchOperationDelete, ID: 6, Err: domain.ErrBatchNotExecuted},
+		},
+		Committed: false,
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"atomic": true, "operations": [
+		{"op": "create", "todo": {"text": "task"}},
+		{"op": "update", "id": 5, "expectedVersion": 1, "patch": {"isComplete": true}},
+		{"op": "delete", "id": 6}
+	]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/batch", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []interface{}{false}, parseExpr(t, "$.committed").Get(jsonObj))
+	assert.Equal(t, []interface{}{int64(424), int64(412), int64(424)}, parseExpr(t, "$.results[*].status").Get(jsonObj))
+	assert.Equal(t, []interface{}{"rolled_back", "version_mismatch", "not_executed"}, parseExpr(t, "$.results[*].error.code").Get(jsonObj))
+	assert.Equal(t, []interface{}{`"4"`}, parseExpr(t, "$.results[1].etag").Get(jsonObj), "a version mismatch should carry the current todo")
+	assert.Empty(t, parseExpr(t, "$.results[0].id").Get(jsonObj), "a rolled back create should have no id")
+}
@@ -69,5 +69,6 @@ func (h *TodoHandler) CreateTodo(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
 		return
 	}
+	setTodoETag(c, output.Todo)
 	c.JSON(http.StatusCreated, resp)
 }
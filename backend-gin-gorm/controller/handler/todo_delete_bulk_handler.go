@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteBulkTodos handles DELETE /todo/bulk and moves several todos of the authenticated user to the trash.
+// In allOrNothing mode, the default, a todo that cannot be deleted fails the whole request and no todo is changed;
+// in bestEffort mode the response reports the outcome for each todo.
+func (h *TodoHandler) DeleteBulkTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DeleteBulkTodos called", slog.Int("userId", userID))
+
+	var req api.DeleteBulkTodosRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid delete bulk todos request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	ids := make([]int, len(req.IDs))
+	for i, id := range req.IDs {
+		ids[i] = int(id)
+	}
+	input, err := domain.NewDeleteBulkTodosInput(userID, ids, newBulkMode(req.Mode))
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid delete bulk todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.DeleteBulkTodos(ctx, input)
+	if err != nil {
+		writeBulkTodosError(c, h.logger, err)
+		return
+	}
+
+	resp, err := NewBulkTodosResponse(output)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	h.setTodoUndo(c, userID, domain.TodoUndoDelete, newBulkTodoUndoTargets(output))
+	c.JSON(http.StatusOK, resp)
+}
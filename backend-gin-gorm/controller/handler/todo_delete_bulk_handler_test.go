@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_DeleteBulkTodos_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "no ids", body: `{}`},
+		{name: "empty ids", body: `{"ids": []}`},
+		{name: "duplicate ids", body: `{"ids": [3, 3]}`},
+		{name: "unknown mode", body: `{"ids": [1], "mode": "sometimes"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/bulk", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+		})
+	}
+}
+
+func Test_TodoHandler_DeleteBulkTodos_shouldReturn404_whenAllOrNothingFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteBulkTodos(mock.Anything, &domain.DeleteBulkTodosInput{
+		UserID: userID,
+		IDs:    []int{4, 5},
+		Mode:   domain.BulkModeAllOrNothing,
+	}).Return(nil, &domain.BulkTodoError{ID: 5, Err: domain.ErrTodoNotFound}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [4, 5]}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "todo 5 not found; no todo was changed")
+}
+
+func Test_TodoHandler_DeleteBulkTodos_shouldReturn403_whenAllOrNothingIsForbidden(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteBulkTodos(mock.Anything, mock.Anything).Return(nil, &domain.BulkTodoError{ID: 5, Err: domain.ErrForbidden}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [4, 5]}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusForbidden, w.Code, "status code should be 403")
+	validateErrorResponse(t, respBytes, "forbidden", "todo 5 cannot be changed; no todo was changed")
+}
+
+func Test_TodoHandler_DeleteBulkTodos_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteBulkTodos(mock.Anything, &domain.DeleteBulkTodosInput{
+		UserID: userID,
+		IDs:    []int{4},
+		Mode:   domain.BulkModeAllOrNothing,
+	}).Return(nil, assert.AnError).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [4]}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
+
+func Test_TodoHandler_DeleteBulkTodos_shouldReportEachTodo_whenBestEffort(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteBulkTodos(mock.Anything, &domain.DeleteBulkTodosInput{
+		UserID: userID,
+		IDs:    []int{4, 5},
+		Mode:   domain.BulkModeBestEffort,
+	}).Return(&domain.BulkTodosOutput{
+		Results: []domain.BulkTodoResult{
+			{ID: 4},
+			{ID: 5, Err: domain.ErrTodoNotFound},
+		},
+	}, nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [4, 5], "mode": "bestEffort"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []interface{}{int64(4), int64(5)}, parseExpr(t, "$.results[*].id").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[0].error").Get(jsonObj), "the deleted todo should have no error")
+	assert.Equal(t, []interface{}{"todo_not_found"}, parseExpr(t, "$.results[1].error.code").Get(jsonObj))
+}
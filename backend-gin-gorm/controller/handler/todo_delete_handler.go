@@ -12,6 +12,7 @@ import (
 )
 
 // DeleteTodo handles DELETE /todo/:id and removes a todo for the authenticated user.
+// The delete is conditional on the version in the If-Match header, if any, and answered with 412 and the current todo on mismatch.
 func (h *TodoHandler) DeleteTodo(c *gin.Context) {
 	ctx := c.Request.Context()
 	todoID, err := GetIntFromPath(c, "id")
@@ -34,7 +35,7 @@ func (h *TodoHandler) DeleteTodo(c *gin.Context) {
 	}
 	h.logger.InfoContext(ctx, "DeleteTodo called", slog.Int("userId", userID), slog.Int("todoId", todoID))
 
-	input, err := domain.NewDeleteTodoInput(todoID, userID)
+	input, err := domain.NewDeleteTodoInput(todoID, userID, getIfMatchVersion(c))
 	if err != nil {
 		h.logger.WarnContext(ctx, "invalid delete todo input", slog.Any("error", err))
 		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
@@ -47,11 +48,18 @@ func (h *TodoHandler) DeleteTodo(c *gin.Context) {
 		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
 		return
 	}
+	if writeTodoForbidden(c, h.logger, err) {
+		return
+	}
+	if writeTodoVersionMismatch(c, h.logger, err) {
+		return
+	}
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to delete todo", slog.Any("error", err))
 		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
 		return
 	}
 
+	h.setTodoUndo(c, userID, domain.TodoUndoDelete, []domain.TodoUndoTarget{{TodoID: todoID, Version: nil}})
 	c.Status(http.StatusNoContent)
 }
@@ -92,6 +92,7 @@ func Test_TodoHandler_DeleteTodo_shouldReturn204_whenValidRequest(t *testing.T)
 		UserID: userID,
 		ID:     1,
 	}).Return(nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
 	r := initTodoRouter(t, ctx, todoUsecase, userID)
 	w := httptest.NewRecorder()
 
@@ -130,3 +131,41 @@ func Test_TodoHandler_DeleteTodo_shouldReturn500_whenUsecaseReturnsError(t *test
 	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
 	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
 }
+
+func Test_TodoHandler_DeleteTodo_shouldReturn412_whenVersionMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	version := 2
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteTodo(mock.Anything, &domain.DeleteTodoInput{
+		UserID:          userID,
+		ID:              1,
+		ExpectedVersion: &version,
+	}).Return(&domain.TodoVersionMismatchError{
+		Current: &domain.Todo{
+			ID:      1,
+			UserID:  userID,
+			Text:    "task 1",
+			Version: 3,
+		},
+	}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1", nil)
+	require.NoError(t, err)
+	req.Header.Set("If-Match", `"2"`)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "status code should be 412")
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"), "ETag should be the current version")
+	text := parseExpr(t, "$.text").Get(parseJSON(t, respBytes))
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "task 1", text[0], "response should hold the current todo")
+}
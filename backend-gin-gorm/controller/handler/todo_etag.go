@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// todoETag returns the strong entity tag of a todo, which is its quoted version.
+func todoETag(todo *domain.Todo) string {
+	return strconv.Quote(strconv.Itoa(todo.Version))
+}
+
+// setTodoETag sets the ETag response header of a single-todo response.
+func setTodoETag(c *gin.Context, todo *domain.Todo) {
+	c.Header("ETag", todoETag(todo))
+}
+
+// setTodoValidators sets the ETag and Last-Modified response headers of a todo read.
+func setTodoValidators(c *gin.Context, todo *domain.Todo) {
+	setTodoETag(c, todo)
+	c.Header("Last-Modified", todo.UpdatedAt.UTC().Format(http.TimeFormat))
+}
+
+// isTodoNotModified reports whether the conditional headers of a read show that the client's copy of the todo is current.
+// If-None-Match takes precedence over If-Modified-Since and matches "*" or any tag in its list by weak comparison.
+// If-Modified-Since has one second resolution, so the todo counts as unmodified within the second it was last updated.
+func isTodoNotModified(c *gin.Context, todo *domain.Todo) bool {
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		etag := todoETag(todo)
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(c.GetHeader("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !todo.UpdatedAt.Truncate(time.Second).After(ifModifiedSince)
+}
+
+// getIfMatchVersion returns the version the If-Match request header makes a write conditional on,
+// or nil when the header is absent or "*" and the write is unconditional.
+// If-Match must be "*" or a single entity tag issued by todoETag. Anything else, including a list or a weak tag,
+// can never match the current version and yields version 0, which no todo has.
+func getIfMatchVersion(c *gin.Context) *int {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil
+	}
+
+	version := 0
+	if unquoted, err := strconv.Unquote(ifMatch); err == nil && strings.HasPrefix(ifMatch, `"`) {
+		if v, err := strconv.Atoi(unquoted); err == nil && v > 0 {
+			version = v
+		}
+	}
+	return &version
+}
+
+// writeTodoVersionMismatch writes a 412 response holding the current todo if err is a *TodoVersionMismatchError
+// and reports whether it did.
+func writeTodoVersionMismatch(c *gin.Context, logger *slog.Logger, err error) bool {
+	var mismatch *domain.TodoVersionMismatchError
+	if !errors.As(err, &mismatch) {
+		return false
+	}
+
+	ctx := c.Request.Context()
+	logger.WarnContext(ctx, "todo version mismatch", slog.Int("todoId", mismatch.Current.ID), slog.Int("version", mismatch.Current.Version))
+	resp, err := NewFindTodoResponseTodo(mismatch.Current)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return true
+	}
+	setTodoETag(c, mismatch.Current)
+	c.JSON(http.StatusPreconditionFailed, resp)
+	return true
+}
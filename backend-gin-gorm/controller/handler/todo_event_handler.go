@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoEventUsecase defines the use case operations for the real-time delivery of todo events.
+type TodoEventUsecase interface {
+	SubscribeTodoEvents(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error)
+	FindTodoList(ctx context.Context, input *domain.FindTodoListInput) (*domain.TodoList, error)
+}
+
+// TodoEventHandler handles HTTP requests for the stream of todo events.
+type TodoEventHandler struct {
+	usecase           TodoEventUsecase
+	heartbeatInterval time.Duration
+	logger            *slog.Logger
+}
+
+// NewTodoEventHandler creates a new TodoEventHandler with the given use case.
+// A heartbeat comment is sent after each heartbeatInterval without events, so that proxies keep the stream open.
+func NewTodoEventHandler(usecase TodoEventUsecase, heartbeatInterval time.Duration) *TodoEventHandler {
+	return &TodoEventHandler{
+		usecase:           usecase,
+		heartbeatInterval: heartbeatInterval,
+		logger:            slog.Default().With(slog.String(domain.LoggerNameKey, "TodoEventHandler")),
+	}
+}
+
+// NewInitTodoEventRouterFunc returns an InitRouterGroupFunc that registers the todo event stream under the "todo" group.
+func NewInitTodoEventRouterFunc(todoEventUsecase TodoEventUsecase, heartbeatInterval time.Duration) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		todo := parentRouterGroup.Group("todo", middleware...)
+		todoEventHandler := NewTodoEventHandler(todoEventUsecase, heartbeatInterval)
+
+		todo.GET("/events", todoEventHandler.StreamTodoEvents)
+	}
+}
+
+// NewTodoEventResponse converts a todo event to the TodoEventResponse API type sent as the data of the event.
+func NewTodoEventResponse(event *domain.TodoEvent) (*api.TodoEventResponse, error) {
+	resp := &api.TodoEventResponse{ //nolint:exhaustruct
+		OccurredAt: event.OccurredAt,
+	}
+	if event.TodoID > 0 {
+		todoID, err := safeIntToInt32(event.TodoID)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo ID: %w", err)
+		}
+		resp.TodoID = &todoID
+	}
+	if event.Todo != nil {
+		todoResp, err := NewFindTodoResponseTodo(event.Todo)
+		if err != nil {
+			return nil, err
+		}
+		etag := todoETag(event.Todo)
+		resp.Todo = todoResp
+		resp.Etag = &etag
+	}
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// StreamTodoEvents handles GET /todo/events and streams the created, updated and deleted events of the
+// authenticated user's todos as Server-Sent Events. A client that reconnects with the Last-Event-ID header
+// first gets the events it missed, or a reset event if they are no longer known, after which it has to fetch
+// its todos again. The stream ends when the client disconnects, falls too far behind or the server shuts down.
+func (h *TodoEventHandler) StreamTodoEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "StreamTodoEvents called", slog.Int("userId", userID))
+
+	var lastEventID *domain.TodoEventID
+	if lastEventIDS := c.GetHeader("Last-Event-ID"); lastEventIDS != "" {
+		v, err := domain.ParseTodoEventID(lastEventIDS)
+		if err != nil {
+			// An ID that cannot be resumed from is answered with a reset event rather than an error the client cannot act on
+			h.logger.WarnContext(ctx, "invalid last event ID", slog.String("lastEventId", lastEventIDS))
+		}
+		lastEventID = &v
+	}
+
+	input, err := domain.NewSubscribeTodoEventsInput(userID, lastEventID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid subscribe todo events input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	subscription, err := h.usecase.SubscribeTodoEvents(ctx, input)
+	if err != nil {
+		if errors.Is(err, domain.ErrTodoEventStreamClosed) {
+			h.logger.WarnContext(ctx, "todo event stream closed", slog.Any("error", err))
+			c.JSON(http.StatusServiceUnavailable, NewErrorResponse("service_unavailable", "the server is shutting down; reconnect later"))
+			return
+		}
+		h.logger.ErrorContext(ctx, "failed to subscribe todo events", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	defer subscription.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for _, event := range subscription.Replay {
+		if err := writeTodoEvent(c.Writer, &event); err != nil {
+			h.logger.WarnContext(ctx, "failed to write todo event", slog.Any("error", err))
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-subscription.Events:
+			if !ok {
+				h.logger.InfoContext(ctx, "todo event stream ended", slog.Int("userId", userID))
+				return
+			}
+			if err := writeTodoEvent(c.Writer, &event); err != nil {
+				h.logger.WarnContext(ctx, "failed to write todo event", slog.Any("error", err))
+				return
+			}
+			heartbeat.Reset(h.heartbeatInterval)
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				h.logger.WarnContext(ctx, "failed to write heartbeat", slog.Any("error", err))
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// writeTodoEvent writes a todo event in the Server-Sent Events format, named after its type.
+func writeTodoEvent(w io.Writer, event *domain.TodoEvent) error {
+	resp, err := NewTodoEventResponse(event)
+	if err != nil {
+		return fmt.Errorf("convert todo event: %w", err)
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("marshal todo event: %w", err)
+	}
+	if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+		return fmt.Errorf("write todo event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller/handler"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func initTodoEventRouter(t *testing.T, ctx context.Context, todoEventUsecase handler.TodoEventUsecase, userID int) *gin.Engine {
+	t.Helper()
+
+	router, err := handler.InitRootRouterGroup(ctx, config, domain.AppName)
+	require.NoError(t, err)
+	api := router.Group("api")
+	v1 := api.Group("v1")
+
+	v1.Use(fakeAuthMiddleware(userID, testLoginID))
+
+	initTodoEventRouterFunc := handler.NewInitTodoEventRouterFunc(todoEventUsecase, time.Minute)
+	initTodoEventRouterFunc(v1)
+
+	return router
+}
+
+// newEndedTodoEventSubscription returns a subscription whose events are already delivered, so that the stream ends.
+func newEndedTodoEventSubscription(replay []domain.TodoEvent, events ...domain.TodoEvent) *domain.TodoEventSubscription {
+	ch := make(chan domain.TodoEvent, len(events))
+	for _, event := range events {
+		ch <- event
+	}
+	close(ch)
+	return &domain.TodoEventSubscription{
+		Replay: replay,
+		Events: ch,
+		Close:  func() {},
+	}
+}
+
+func Test_TodoEventHandler_StreamTodoEvents_shouldStreamReplayAndEvents_whenLastEventIDIsGiven(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	lastEventID := domain.TodoEventID{Epoch: 1735787045000, Seq: 7}
+	created := domain.NewTodoChangedEvent(domain.TodoEventCreated, &domain.Todo{ID: 3, UserID: userID, Text: "buy milk", Version: 1})
+	created.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 8}
+	deleted := domain.NewTodoDeletedEvent(userID, 5)
+	deleted.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 9}
+
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, &domain.SubscribeTodoEventsInput{
+		UserID:      userID,
+		LastEventID: &lastEventID,
+	}).Return(newEndedTodoEventSubscription([]domain.TodoEvent{created}, deleted), nil).Once()
+	r := initTodoEventRouter(t, ctx, todoEventUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", lastEventID.String())
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	body := string(respBytes)
+	assert.Contains(t, body, "id: 1735787045000-8\nevent: created\ndata: {")
+	assert.Contains(t, body, `"todo":{`)
+	assert.Contains(t, body, `"etag":"\"1\""`)
+	assert.Contains(t, body, "id: 1735787045000-9\nevent: deleted\ndata: {")
+	assert.Contains(t, body, `"todoId":5`)
+	assert.Less(t, strings.Index(body, "event: created"), strings.Index(body, "event: deleted"), "replayed events should come first")
+}
+
+func Test_TodoEventHandler_StreamTodoEvents_shouldSubscribeWithUnknownEventID_whenLastEventIDIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, &domain.SubscribeTodoEventsInput{
+		UserID:      userID,
+		LastEventID: &domain.TodoEventID{},
+	}).Return(newEndedTodoEventSubscription(nil), nil).Once()
+	r := initTodoEventRouter(t, ctx, todoEventUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "not-an-id")
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+}
+
+func Test_TodoEventHandler_StreamTodoEvents_shouldReturn503_whenServerIsShuttingDown(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoEventStreamClosed).Once()
+	r := initTodoEventRouter(t, ctx, todoEventUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/events", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "status code should be 503")
+	validateErrorResponse(t, respBytes, "service_unavailable", "the server is shutting down; reconnect later")
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+)
+
+// FindArchive handles GET /todo/archive and returns the archived todos of the authenticated user.
+func (h *TodoHandler) FindArchive(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+
+	h.logger.InfoContext(ctx, "FindArchive called", slog.Int("userId", userID))
+
+	todos, err := h.usecase.FindArchivedTodos(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to find archived todos", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewFindTodoResponse(todos)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create find archive response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
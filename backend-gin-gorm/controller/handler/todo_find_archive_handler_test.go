@@ -0,0 +1,50 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_FindArchive_shouldReturn200_whenUsecaseReturnsArchivedTodos(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	archivedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindArchivedTodos(mock.Anything, userID).Return([]domain.Todo{
+		{
+			ID:         1,
+			Text:       "archived task",
+			IsComplete: true,
+			ArchivedAt: &archivedAt,
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/archive", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+	todos := parseExpr(t, "$.todos").Get(jsonObj)
+	require.Len(t, todos, 1, "response should have one todo")
+	text := parseExpr(t, "$.todos[0].text").Get(jsonObj)
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "archived task", text[0])
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -22,12 +24,24 @@ func NewFindTodoResponseTodo(todo *domain.Todo) (*api.FindTodoResponseTodo, erro
 	if err != nil {
 		return nil, fmt.Errorf("convert todo ID: %w", err)
 	}
+	checklist, err := NewChecklistItemResponses(todo.Checklist)
+	if err != nil {
+		return nil, fmt.Errorf("convert checklist: %w", err)
+	}
+	commentCount, err := safeIntToInt32(todo.CommentCount)
+	if err != nil {
+		return nil, fmt.Errorf("convert comment count: %w", err)
+	}
 	return &api.FindTodoResponseTodo{
-		ID:         id,
-		Text:       todo.Text,
-		IsComplete: todo.IsComplete,
-		CreatedAt:  todo.CreatedAt,
-		UpdatedAt:  todo.UpdatedAt,
+		ID:           id,
+		Text:         todo.Text,
+		IsComplete:   todo.IsComplete,
+		CreatedAt:    todo.CreatedAt,
+		UpdatedAt:    todo.UpdatedAt,
+		Checklist:    checklist,
+		CommentCount: commentCount,
+		CompletedAt:  todo.CompletedAt,
+		ArchivedAt:   todo.ArchivedAt,
 	}, nil
 }
 
@@ -46,7 +60,24 @@ func NewFindTodoResponse(todos []domain.Todo) (*api.FindTodoResponse, error) {
 	return resp, nil
 }
 
-// FindTodos handles GET /todo and returns all todos for the authenticated user.
+// NewFindTodoPageResponse converts a domain TodoPage to a FindTodoResponse API type with its encoded next cursor.
+func NewFindTodoPageResponse(page *domain.TodoPage) (*api.FindTodoResponse, error) {
+	resp, err := NewFindTodoResponse(page.Todos)
+	if err != nil {
+		return nil, fmt.Errorf("convert todos: %w", err)
+	}
+	if page.NextCursor != nil {
+		nextCursor, err := page.NextCursor.Encode()
+		if err != nil {
+			return nil, fmt.Errorf("encode next cursor: %w", err)
+		}
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// FindTodos handles GET /todo and returns one page of the authenticated user's todos matching the filter query parameters.
+// The page size is taken from the "limit" query parameter and the position from the opaque "cursor" returned as nextCursor.
 func (h *TodoHandler) FindTodos(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID := c.GetInt(controller.ContextFieldUserID{})
@@ -58,14 +89,24 @@ func (h *TodoHandler) FindTodos(c *gin.Context) {
 
 	h.logger.InfoContext(ctx, "FindTodos called", slog.Int("userId", userID))
 
-	todos, err := h.usecase.FindTodos(ctx, userID)
+	input, ok := h.getFindTodosInputFromQuery(c, userID)
+	if !ok {
+		return
+	}
+
+	page, err := h.usecase.FindTodos(ctx, input)
+	if errors.Is(err, domain.ErrInvalidCursor) {
+		h.logger.WarnContext(ctx, "invalid cursor", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_cursor", "cursor is invalid"))
+		return
+	}
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to find todos", slog.Any("error", err))
 		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
 		return
 	}
 
-	resp, err := NewFindTodoResponse(todos)
+	resp, err := NewFindTodoPageResponse(page)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to create find todo response", slog.Any("error", err))
 		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
@@ -74,3 +115,133 @@ func (h *TodoHandler) FindTodos(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// getFindTodosInputFromQuery reads the paging, filter and sort query parameters of GET /todo.
+// On failure it writes a 400 response and returns false.
+func (h *TodoHandler) getFindTodosInputFromQuery(c *gin.Context, userID int) (*domain.FindTodosInput, bool) {
+	ctx := c.Request.Context()
+
+	limit, cursor, ok := getTodoPageFromQuery(c, h.logger)
+	if !ok {
+		return nil, false
+	}
+
+	filter, ok := h.getTodoFilterFromQuery(c)
+	if !ok {
+		return nil, false
+	}
+
+	sort := domain.DefaultTodoSort
+	if sortS, ok := c.GetQuery("sort"); ok {
+		sort.Field = domain.TodoSortField(sortS)
+	}
+	if orderS, ok := c.GetQuery("order"); ok {
+		sort.Direction = domain.SortDirection(orderS)
+	}
+	if _, err := domain.NewTodoSort(sort.Field, sort.Direction); err != nil {
+		h.logger.WarnContext(ctx, "invalid sort", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_sort", "sort must be one of id, createdAt, updatedAt, text and order one of asc, desc"))
+		return nil, false
+	}
+
+	input, err := domain.NewFindTodosInput(userID, *filter, sort, limit, cursor)
+	if errors.Is(err, domain.ErrInvalidCursor) {
+		h.logger.WarnContext(ctx, "cursor does not match sort", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_cursor", "cursor is invalid"))
+		return nil, false
+	}
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return nil, false
+	}
+	return input, true
+}
+
+// getTodoPageFromQuery reads the "limit" and "cursor" query parameters shared by paginated todo lists.
+// On failure it writes a 400 response and returns false.
+func getTodoPageFromQuery(c *gin.Context, logger *slog.Logger) (int, *domain.TodoCursor, bool) {
+	ctx := c.Request.Context()
+
+	limit := domain.DefaultTodoPageLimit
+	if limitS, ok := c.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limitS)
+		if err != nil || v < 1 || v > domain.MaxTodoPageLimit {
+			logger.WarnContext(ctx, "invalid limit", slog.String("limit", limitS))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_limit", fmt.Sprintf("limit must be an integer between 1 and %d", domain.MaxTodoPageLimit)))
+			return 0, nil, false
+		}
+		limit = v
+	}
+
+	var cursor *domain.TodoCursor
+	if cursorS, ok := c.GetQuery("cursor"); ok {
+		v, err := domain.DecodeTodoCursor(cursorS)
+		if err != nil {
+			logger.WarnContext(ctx, "invalid cursor", slog.Any("error", err))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_cursor", "cursor is invalid"))
+			return 0, nil, false
+		}
+		cursor = v
+	}
+
+	return limit, cursor, true
+}
+
+// getTodoFilterFromQuery reads the filter query parameters of GET /todo.
+// The "filter" parameter holds a filter query; the other parameters take precedence over its terms.
+// On failure it writes a 400 response and returns false.
+func (h *TodoHandler) getTodoFilterFromQuery(c *gin.Context) (*domain.TodoFilter, bool) {
+	ctx := c.Request.Context()
+	filter := &domain.TodoFilter{} //nolint:exhaustruct
+	if queryS, ok := c.GetQuery("filter"); ok {
+		v, err := domain.ParseTodoFilterQuery(queryS, time.Now())
+		var queryErr *domain.FilterQueryError
+		if errors.As(err, &queryErr) {
+			h.logger.WarnContext(ctx, "invalid filter query", slog.Any("error", err))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_filter_query", "filter: "+queryErr.Error()))
+			return nil, false
+		}
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to parse filter query", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+			return nil, false
+		}
+		filter = v
+	}
+
+	if text, ok := c.GetQuery("text"); ok {
+		filter.TextContains = text
+	}
+
+	if isCompleteS, ok := c.GetQuery("isComplete"); ok {
+		v, err := strconv.ParseBool(isCompleteS)
+		if err != nil {
+			h.logger.WarnContext(ctx, "invalid isComplete", slog.String("isComplete", isCompleteS))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_filter", "isComplete must be true or false"))
+			return nil, false
+		}
+		filter.IsComplete = &v
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"createdFrom": &filter.CreatedFrom,
+		"createdTo":   &filter.CreatedTo,
+		"updatedFrom": &filter.UpdatedFrom,
+		"updatedTo":   &filter.UpdatedTo,
+	} {
+		valueS, ok := c.GetQuery(param)
+		if !ok {
+			continue
+		}
+		v, err := time.Parse(time.RFC3339, valueS)
+		if err != nil {
+			h.logger.WarnContext(ctx, "invalid date-time filter", slog.String(param, valueS))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_filter", param+" must be an RFC 3339 date-time"))
+			return nil, false
+		}
+		*dst = &v
+	}
+
+	return filter, true
+}
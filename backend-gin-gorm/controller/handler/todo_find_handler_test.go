@@ -5,7 +5,9 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 	"github.com/stretchr/testify/assert"
@@ -20,13 +22,13 @@ func Test_TodoHandler_FindTodos_shouldReturn200_whenUsecaseReturnsTodos(t *testi
 	// given
 	userID := randomUserID()
 	todoUsecase := NewMockTodoUsecase(t)
-	todoUsecase.EXPECT().FindTodos(mock.Anything, userID).Return([]domain.Todo{
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{UserID: userID, Sort: domain.DefaultTodoSort, Limit: domain.DefaultTodoPageLimit}).Return(&domain.TodoPage{Todos: []domain.Todo{
 		{
 			ID:         userID,
 			Text:       "task A",
 			IsComplete: false,
 		},
-	}, nil).Once()
+	}}, nil).Once()
 	r := initTodoRouter(t, ctx, todoUsecase, userID)
 	w := httptest.NewRecorder()
 
@@ -73,7 +75,7 @@ func Test_TodoHandler_FindTodos_shouldReturn500_whenUsecaseReturnsError(t *testi
 	// given
 	userID := randomUserID()
 	todoUsecase := NewMockTodoUsecase(t)
-	todoUsecase.EXPECT().FindTodos(mock.Anything, userID).Return(nil, errors.New("database error")).Once()
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{UserID: userID, Sort: domain.DefaultTodoSort, Limit: domain.DefaultTodoPageLimit}).Return(nil, errors.New("database error")).Once()
 	r := initTodoRouter(t, ctx, todoUsecase, userID)
 	w := httptest.NewRecorder()
 
@@ -87,3 +89,339 @@ func Test_TodoHandler_FindTodos_shouldReturn500_whenUsecaseReturnsError(t *testi
 	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
 	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
 }
+
+func Test_TodoHandler_FindTodos_shouldReturnChecklist_whenTodoHasChecklistItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{UserID: userID, Sort: domain.DefaultTodoSort, Limit: domain.DefaultTodoPageLimit}).Return(&domain.TodoPage{Todos: []domain.Todo{
+		{
+			ID:   1,
+			Text: "task A",
+			Checklist: []domain.ChecklistItem{
+				{ID: 11, TodoID: 1, Text: "step 1", IsChecked: true, Position: 0},
+				{ID: 12, TodoID: 1, Text: "step 2", IsChecked: false, Position: 1},
+			},
+		},
+		{
+			ID:   2,
+			Text: "task B",
+		},
+	}}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - checklist of first todo
+	texts := parseExpr(t, "$.todos[0].checklist[*].text").Get(jsonObj)
+	assert.Equal(t, []any{"step 1", "step 2"}, texts)
+
+	// - checklist of second todo is an empty array, not null
+	checklist := parseExpr(t, "$.todos[1].checklist").Get(jsonObj)
+	require.Len(t, checklist, 1, "response should have one checklist")
+	assert.Equal(t, []any{}, checklist[0])
+}
+
+func Test_TodoHandler_FindTodos_shouldReturnCommentCount_whenTodoHasComments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{UserID: userID, Sort: domain.DefaultTodoSort, Limit: domain.DefaultTodoPageLimit}).Return(&domain.TodoPage{Todos: []domain.Todo{
+		{ID: 1, Text: "task A", CommentCount: 3},
+		{ID: 2, Text: "task B"},
+	}}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - commentCount
+	commentCounts := parseExpr(t, "$.todos[*].commentCount").Get(jsonObj)
+	assert.Equal(t, []any{int64(3), int64(0)}, commentCounts)
+}
+
+func Test_TodoHandler_FindTodos_shouldReturnNextCursor_whenMoreTodosFollow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	cursor, err := domain.NewTodoCursor(domain.DefaultTodoSort, "", 5)
+	require.NoError(t, err)
+	encodedCursor, err := cursor.Encode()
+	require.NoError(t, err)
+	nextCursor, err := domain.NewTodoCursor(domain.DefaultTodoSort, "", 7)
+	require.NoError(t, err)
+	encodedNextCursor, err := nextCursor.Encode()
+	require.NoError(t, err)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{UserID: userID, Sort: domain.DefaultTodoSort, Limit: 2, Cursor: cursor}).Return(&domain.TodoPage{
+		Todos: []domain.Todo{
+			{ID: 6, Text: "task 6"},
+			{ID: 7, Text: "task 7"},
+		},
+		NextCursor: nextCursor,
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo?limit=2&cursor="+encodedCursor, nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - nextCursor
+	next := parseExpr(t, "$.nextCursor").Get(jsonObj)
+	require.Len(t, next, 1, "response should have one nextCursor")
+	assert.Equal(t, encodedNextCursor, next[0])
+}
+
+func Test_TodoHandler_FindTodos_shouldReturn400_whenInvalidQueryParameters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	idDescSort, err := domain.NewTodoSort(domain.TodoSortFieldID, domain.SortDirectionDesc)
+	require.NoError(t, err)
+	cursor, err := domain.NewTodoCursor(*idDescSort, "", 5)
+	require.NoError(t, err)
+	idDescCursor, err := cursor.Encode()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		query           string
+		expectedCode    string
+		expectedMessage string
+	}{
+		{
+			name:            "non-integer limit",
+			query:           "?limit=abc",
+			expectedCode:    "invalid_limit",
+			expectedMessage: "limit must be an integer between 1 and 100",
+		},
+		{
+			name:            "limit is zero",
+			query:           "?limit=0",
+			expectedCode:    "invalid_limit",
+			expectedMessage: "limit must be an integer between 1 and 100",
+		},
+		{
+			name:            "limit exceeds maximum",
+			query:           "?limit=101",
+			expectedCode:    "invalid_limit",
+			expectedMessage: "limit must be an integer between 1 and 100",
+		},
+		{
+			name:            "malformed cursor",
+			query:           "?cursor=!!!",
+			expectedCode:    "invalid_cursor",
+			expectedMessage: "cursor is invalid",
+		},
+		{
+			name:            "cursor issued for another sort",
+			query:           "?sort=text&cursor=" + idDescCursor,
+			expectedCode:    "invalid_cursor",
+			expectedMessage: "cursor is invalid",
+		},
+		{
+			name:            "unknown sort field",
+			query:           "?sort=priority",
+			expectedCode:    "invalid_sort",
+			expectedMessage: "sort must be one of id, createdAt, updatedAt, text and order one of asc, desc",
+		},
+		{
+			name:            "unknown order",
+			query:           "?order=up",
+			expectedCode:    "invalid_sort",
+			expectedMessage: "sort must be one of id, createdAt, updatedAt, text and order one of asc, desc",
+		},
+		{
+			name:            "non-boolean isComplete",
+			query:           "?isComplete=yes",
+			expectedCode:    "invalid_filter",
+			expectedMessage: "isComplete must be true or false",
+		},
+		{
+			name:            "malformed createdFrom",
+			query:           "?createdFrom=2025-01-01",
+			expectedCode:    "invalid_filter",
+			expectedMessage: "createdFrom must be an RFC 3339 date-time",
+		},
+		{
+			name:            "createdFrom after createdTo",
+			query:           "?createdFrom=2025-02-01T00:00:00Z&createdTo=2025-01-01T00:00:00Z",
+			expectedCode:    "invalid_request",
+			expectedMessage: "request is invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			todoUsecase := NewMockTodoUsecase(t)
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo"+tt.query, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, tt.expectedCode, tt.expectedMessage)
+		})
+	}
+}
+
+func Test_TodoHandler_FindTodos_shouldPassFilterAndSort_whenQueryParametersAreGiven(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := false
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedTo := time.Date(2025, 2, 1, 9, 30, 0, 0, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{
+		UserID: userID,
+		Filter: domain.TodoFilter{
+			IsComplete:   &isComplete,
+			CreatedFrom:  &createdFrom,
+			UpdatedTo:    &updatedTo,
+			TextContains: "invoice",
+		},
+		Sort:  domain.TodoSort{Field: domain.TodoSortFieldUpdatedAt, Direction: domain.SortDirectionDesc},
+		Limit: domain.DefaultTodoPageLimit,
+	}).Return(&domain.TodoPage{Todos: []domain.Todo{{ID: 1, Text: "pay invoice"}}}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	query := "?isComplete=false&createdFrom=2025-01-01T00:00:00Z&updatedTo=2025-02-01T09:30:00Z&text=invoice&sort=updatedAt&order=desc"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo"+query, nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+	texts := parseExpr(t, "$.todos[*].text").Get(jsonObj)
+	assert.Equal(t, []any{"pay invoice"}, texts)
+}
+
+func Test_TodoHandler_FindTodos_shouldReturn400_whenUsecaseRejectsCursor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodos(mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidCursor).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_cursor", "cursor is invalid")
+}
+
+func Test_TodoHandler_FindTodos_shouldTranslateFilterQuery_whenFilterParameterIsGiven(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := true
+	createdFrom := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodos(mock.Anything, &domain.FindTodosInput{
+		UserID: userID,
+		Filter: domain.TodoFilter{
+			IsComplete:   &isComplete,
+			CreatedFrom:  &createdFrom,
+			CreatedTo:    &createdTo,
+			TextContains: "receipt",
+		},
+		Sort:  domain.DefaultTodoSort,
+		Limit: domain.DefaultTodoPageLimit,
+	}).Return(&domain.TodoPage{Todos: []domain.Todo{}}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	// the text parameter takes precedence over the text term of the filter
+	query := url.Values{"filter": {`is:done created:2025-01-31 text:"invoice"`}, "text": {"receipt"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo?"+query.Encode(), nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+}
+
+func Test_TodoHandler_FindTodos_shouldReturn400WithPosition_whenFilterQueryIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	query := url.Values{"filter": {`is:open text:"invoice`}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo?"+query.Encode(), nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_filter_query", "filter: column 14: unterminated quoted value")
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewTodoRevisionResponse converts a domain TodoRevision to a TodoRevisionResponse API type.
+func NewTodoRevisionResponse(revision *domain.TodoRevision) (*api.TodoRevisionResponse, error) {
+	if revision == nil {
+		return nil, errors.New("todo revision is nil")
+	}
+	number, err := safeIntToInt32(revision.Revision)
+	if err != nil {
+		return nil, fmt.Errorf("convert revision: %w", err)
+	}
+	userID, err := safeIntToInt32(revision.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("convert user ID: %w", err)
+	}
+	var revertedFrom *int32
+	if revision.RevertedFrom != nil {
+		v, err := safeIntToInt32(*revision.RevertedFrom)
+		if err != nil {
+			return nil, fmt.Errorf("convert reverted from: %w", err)
+		}
+		revertedFrom = &v
+	}
+	changedFields := make([]api.TodoField, len(revision.ChangedFields))
+	for i, field := range revision.ChangedFields {
+		changedFields[i] = api.TodoField(field)
+	}
+	return &api.TodoRevisionResponse{
+		Revision:      number,
+		Action:        api.TodoRevisionAction(revision.Action),
+		UserID:        userID,
+		Text:          revision.Values.Text,
+		IsComplete:    revision.Values.IsComplete,
+		ChangedFields: changedFields,
+		RevertedFrom:  revertedFrom,
+		CreatedAt:     revision.CreatedAt,
+	}, nil
+}
+
+// FindTodoHistory handles GET /todo/:id/history and lists the revisions of a todo of the authenticated user.
+func (h *TodoHandler) FindTodoHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindTodoHistory called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	input, err := domain.NewFindTodoHistoryInput(todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find todo history input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	revisions, err := h.usecase.FindTodoHistory(ctx, input)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		h.logger.WarnContext(ctx, "todo not found", slog.Int("todoId", todoID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to find todo history", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp := api.FindTodoHistoryResponse{
+		Revisions: make([]api.TodoRevisionResponse, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		revisionResp, err := NewTodoRevisionResponse(&revision)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+			return
+		}
+		resp.Revisions = append(resp.Revisions, *revisionResp)
+	}
+	c.JSON(http.StatusOK, resp)
+}
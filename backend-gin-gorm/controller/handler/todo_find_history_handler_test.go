@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_FindTodoHistory_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodoHistory(mock.Anything, &domain.FindTodoHistoryInput{
+		TodoID: 999999,
+		UserID: userID,
+	}).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/999999/history", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
+
+func Test_TodoHandler_FindTodoHistory_shouldReturn200_whenRevisionsExist(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	revertedFrom := 1
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodoHistory(mock.Anything, &domain.FindTodoHistoryInput{
+		TodoID: 1,
+		UserID: userID,
+	}).Return([]domain.TodoRevision{
+		{
+			TodoID:        1,
+			Revision:      1,
+			Action:        domain.TodoRevisionCreated,
+			UserID:        userID,
+			Values:        domain.TodoFieldValues{Text: "task 1", IsComplete: false},
+			ChangedFields: []domain.TodoField{domain.TodoFieldText, domain.TodoFieldIsComplete},
+			CreatedAt:     time.Now(),
+		},
+		{
+			TodoID:        1,
+			Revision:      2,
+			Action:        domain.TodoRevisionReverted,
+			UserID:        userID,
+			Values:        domain.TodoFieldValues{Text: "task 1", IsComplete: false},
+			ChangedFields: []domain.TodoField{},
+			RevertedFrom:  &revertedFrom,
+			CreatedAt:     time.Now(),
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1/history", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+	actions := parseExpr(t, "$.revisions[*].action").Get(jsonObj)
+	assert.Equal(t, []any{"created", "reverted"}, actions)
+	changedFields := parseExpr(t, "$.revisions[0].changedFields[*]").Get(jsonObj)
+	assert.Equal(t, []any{"text", "isComplete"}, changedFields)
+	revertedFromValues := parseExpr(t, "$.revisions[1].revertedFrom").Get(jsonObj)
+	require.Len(t, revertedFromValues, 1, "reverted revision should have revertedFrom")
+	assert.InDelta(t, 1, revertedFromValues[0], 0)
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// FindTodo handles GET /todo/:id and returns a single todo of the authenticated user.
+// The response carries ETag and Last-Modified headers and is answered with 304 Not Modified
+// when If-None-Match or If-Modified-Since shows that the client's copy is current.
+func (h *TodoHandler) FindTodo(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindTodo called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	input, err := domain.NewFindTodoInput(todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find todo input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	todo, err := h.usecase.FindTodo(ctx, input)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		h.logger.WarnContext(ctx, "todo not found", slog.Int("todoId", todoID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to find todo", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	setTodoValidators(c, todo)
+	if isTodoNotModified(c, todo) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	resp, err := NewFindTodoResponseTodo(todo)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
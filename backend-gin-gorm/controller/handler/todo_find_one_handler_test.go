@@ -0,0 +1,191 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_FindTodo_shouldReturn400_whenInvalidPath(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/0", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_todo_id", "todo id must be a positive integer")
+}
+
+func Test_TodoHandler_FindTodo_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodo(mock.Anything, &domain.FindTodoInput{
+		ID:     1,
+		UserID: userID,
+	}).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
+
+func Test_TodoHandler_FindTodo_shouldReturn200WithValidators_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	updatedAt := time.Date(2025, 1, 31, 12, 30, 45, 123456000, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTodo(mock.Anything, &domain.FindTodoInput{
+		ID:     1,
+		UserID: userID,
+	}).Return(&domain.Todo{
+		ID:        1,
+		UserID:    userID,
+		Text:      "task 1",
+		UpdatedAt: updatedAt,
+		Checklist: []domain.ChecklistItem{},
+		Version:   7,
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.Equal(t, `"7"`, w.Header().Get("ETag"), "ETag should be the version")
+	assert.Equal(t, "Fri, 31 Jan 2025 12:30:45 GMT", w.Header().Get("Last-Modified"), "Last-Modified should be UpdatedAt")
+
+	text := parseExpr(t, "$.text").Get(parseJSON(t, respBytes))
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "task 1", text[0])
+}
+
+func Test_TodoHandler_FindTodo_shouldHandleConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	updatedAt := time.Date(2025, 1, 31, 12, 30, 45, 123456000, time.UTC)
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			name:           "matching If-None-Match",
+			headers:        map[string]string{"If-None-Match": `"7"`},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "weak If-None-Match in a list",
+			headers:        map[string]string{"If-None-Match": `"6", W/"7"`},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "any If-None-Match",
+			headers:        map[string]string{"If-None-Match": "*"},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "stale If-None-Match",
+			headers:        map[string]string{"If-None-Match": `"6"`},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "If-Modified-Since the last update",
+			headers:        map[string]string{"If-Modified-Since": "Fri, 31 Jan 2025 12:30:45 GMT"},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "If-Modified-Since before the last update",
+			headers:        map[string]string{"If-Modified-Since": "Fri, 31 Jan 2025 12:30:44 GMT"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "malformed If-Modified-Since",
+			headers:        map[string]string{"If-Modified-Since": "yesterday"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "stale If-None-Match takes precedence over If-Modified-Since",
+			headers: map[string]string{
+				"If-None-Match":     `"6"`,
+				"If-Modified-Since": "Fri, 31 Jan 2025 12:30:45 GMT",
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			todoUsecase.EXPECT().FindTodo(mock.Anything, &domain.FindTodoInput{
+				ID:     1,
+				UserID: userID,
+			}).Return(&domain.Todo{
+				ID:        1,
+				UserID:    userID,
+				Text:      "task 1",
+				UpdatedAt: updatedAt,
+				Checklist: []domain.ChecklistItem{},
+				Version:   7,
+			}, nil).Once()
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/1", nil)
+			require.NoError(t, err)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, `"7"`, w.Header().Get("ETag"), "ETag should be set")
+			if tt.expectedStatus == http.StatusNotModified {
+				assert.Empty(t, respBytes, "304 response should have no body")
+			}
+		})
+	}
+}
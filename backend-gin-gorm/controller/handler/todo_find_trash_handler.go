@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewTrashedTodoResponse converts a trashed domain Todo to a TrashedTodoResponse API type.
+func NewTrashedTodoResponse(todo *domain.Todo) (*api.TrashedTodoResponse, error) {
+	if todo == nil {
+		return nil, errors.New("todo is nil")
+	}
+	if todo.DeletedAt == nil {
+		return nil, errors.New("todo is not in the trash")
+	}
+	id, err := safeIntToInt32(todo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo ID: %w", err)
+	}
+	return &api.TrashedTodoResponse{
+		ID:         id,
+		Text:       todo.Text,
+		IsComplete: todo.IsComplete,
+		CreatedAt:  todo.CreatedAt,
+		UpdatedAt:  todo.UpdatedAt,
+		DeletedAt:  *todo.DeletedAt,
+	}, nil
+}
+
+// NewFindTrashResponse converts a slice of trashed domain Todos to a FindTrashResponse API type.
+func NewFindTrashResponse(todos []domain.Todo) (*api.FindTrashResponse, error) {
+	resp := &api.FindTrashResponse{
+		Todos: make([]api.TrashedTodoResponse, 0, len(todos)),
+	}
+	for _, todo := range todos {
+		todoResp, err := NewTrashedTodoResponse(&todo)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo: %w", err)
+		}
+		resp.Todos = append(resp.Todos, *todoResp)
+	}
+	return resp, nil
+}
+
+// FindTrash handles GET /todo/trash and returns the todos in the authenticated user's trash.
+func (h *TodoHandler) FindTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+
+	h.logger.InfoContext(ctx, "FindTrash called", slog.Int("userId", userID))
+
+	todos, err := h.usecase.FindTrashedTodos(ctx, userID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to find trashed todos", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewFindTrashResponse(todos)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create find trash response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,80 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_FindTrash_shouldReturn200_whenUsecaseReturnsTrashedTodos(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	deletedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTrashedTodos(mock.Anything, userID).Return([]domain.Todo{
+		{
+			ID:        1,
+			Text:      "trashed task",
+			DeletedAt: &deletedAt,
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/trash", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - todos
+	todos := parseExpr(t, "$.todos").Get(jsonObj)
+	require.Len(t, todos, 1, "response should have one todo")
+
+	// - text
+	text := parseExpr(t, "$.todos[0].text").Get(jsonObj)
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "trashed task", text[0])
+
+	// - deletedAt
+	deleted := parseExpr(t, "$.todos[0].deletedAt").Get(jsonObj)
+	require.Len(t, deleted, 1, "response should have one deletedAt")
+	assert.Equal(t, "2025-01-02T03:04:05Z", deleted[0])
+}
+
+func Test_TodoHandler_FindTrash_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().FindTrashedTodos(mock.Anything, userID).Return(nil, assert.AnError).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/trash", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
@@ -2,7 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 
@@ -11,11 +13,26 @@ import (
 
 // TodoUsecase defines the use case operations for managing todos.
 type TodoUsecase interface {
-	FindTodos(ctx context.Context, userID int) ([]domain.Todo, error)
+	FindTodos(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error)
+	FindTodo(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error)
 	CreateTodo(ctx context.Context, input *domain.CreateTodoInput) (*domain.CreateTodoOutput, error)
 	CreateBulkTodos(ctx context.Context, input *domain.CreateBulkTodosInput) (*domain.CreateBulkTodosOutput, error)
+	PatchBulkTodos(ctx context.Context, input *domain.PatchBulkTodosInput) (*domain.BulkTodosOutput, error)
+	DeleteBulkTodos(ctx context.Context, input *domain.DeleteBulkTodosInput) (*domain.BulkTodosOutput, error)
+	BatchTodos(ctx context.Context, input *domain.BatchTodosInput) (*domain.BatchTodosOutput, error)
 	UpdateTodo(ctx context.Context, input *domain.UpdateTodoInput) (*domain.UpdateTodoOutput, error)
+	PatchTodo(ctx context.Context, input *domain.PatchTodoInput) (*domain.UpdateTodoOutput, error)
 	DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) error
+	FindTrashedTodos(ctx context.Context, userID int) ([]domain.Todo, error)
+	RestoreTodo(ctx context.Context, input *domain.RestoreTodoInput) (*domain.RestoreTodoOutput, error)
+	FindTodoHistory(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error)
+	RevertTodo(ctx context.Context, input *domain.RevertTodoInput) (*domain.RevertTodoOutput, error)
+	FindArchivedTodos(ctx context.Context, userID int) ([]domain.Todo, error)
+	ArchiveTodo(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.ArchiveTodoOutput, error)
+	ArchiveCompletedTodos(ctx context.Context, input *domain.ArchiveCompletedTodosInput) (*domain.ArchiveTodosOutput, error)
+	SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error)
+	CreateTodoUndo(ctx context.Context, input *domain.CreateTodoUndoInput) (*domain.TodoUndo, error)
+	UndoTodos(ctx context.Context, input *domain.UndoTodosInput) (*domain.UndoTodosOutput, error)
 }
 
 // TodoHandler handles HTTP requests for todo CRUD operations.
@@ -32,16 +49,48 @@ func NewTodoHandler(usecase TodoUsecase) *TodoHandler {
 	}
 }
 
-// NewInitTodoRouterFunc returns an InitRouterGroupFunc that registers todo routes under a "todo" group.
-func NewInitTodoRouterFunc(todoUsecase TodoUsecase) InitRouterGroupFunc {
+// NewInitTodoRouterFunc returns an InitRouterGroupFunc that registers todo routes under a "todo" group,
+// the batch route under a "batch" group and the undo route under an "undo" group.
+// The idempotency middleware guards the routes that create todos.
+func NewInitTodoRouterFunc(todoUsecase TodoUsecase, idempotencyMiddleware gin.HandlerFunc) InitRouterGroupFunc {
 	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
 		todo := parentRouterGroup.Group("todo", middleware...)
 		todoHandler := NewTodoHandler(todoUsecase)
 
-		todo.POST("", todoHandler.CreateTodo)
-		todo.POST("/bulk", todoHandler.CreateBulkTodos)
+		todo.POST("", idempotencyMiddleware, todoHandler.CreateTodo)
+		todo.POST("/bulk", idempotencyMiddleware, todoHandler.CreateBulkTodos)
+		todo.PATCH("/bulk", todoHandler.PatchBulkTodos)
+		todo.DELETE("/bulk", todoHandler.DeleteBulkTodos)
 		todo.GET("", todoHandler.FindTodos)
+		todo.GET("/search", todoHandler.SearchTodos)
+		todo.GET("/:id", todoHandler.FindTodo)
 		todo.PUT("/:id", todoHandler.UpdateTodo)
+		todo.PATCH("/:id", todoHandler.PatchTodo)
 		todo.DELETE("/:id", todoHandler.DeleteTodo)
+		todo.GET("/trash", todoHandler.FindTrash)
+		todo.POST("/:id/restore", todoHandler.RestoreTodo)
+		todo.GET("/:id/history", todoHandler.FindTodoHistory)
+		todo.POST("/:id/revert/:revision", todoHandler.RevertTodo)
+		todo.GET("/archive", todoHandler.FindArchive)
+		todo.POST("/archive/completed", todoHandler.ArchiveCompletedTodos)
+		todo.POST("/:id/archive", todoHandler.ArchiveTodo)
+		todo.POST("/:id/unarchive", todoHandler.UnarchiveTodo)
+
+		batch := parentRouterGroup.Group("batch", middleware...)
+		batch.POST("", todoHandler.BatchTodos)
+
+		undo := parentRouterGroup.Group("undo", middleware...)
+		undo.POST("/:token", todoHandler.UndoTodos)
+	}
+}
+
+// writeTodoForbidden writes a 403 response if err is ErrForbidden, returned when the todo is in a todo list shared
+// with the user in a role that does not allow the change, and reports whether it did.
+func writeTodoForbidden(c *gin.Context, logger *slog.Logger, err error) bool {
+	if !errors.Is(err, domain.ErrForbidden) {
+		return false
 	}
+	logger.WarnContext(c.Request.Context(), "todo operation forbidden", slog.Any("error", err))
+	c.JSON(http.StatusForbidden, NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden)))
+	return true
 }
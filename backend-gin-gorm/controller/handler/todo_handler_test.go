@@ -29,7 +29,7 @@ func initTodoRouter(t *testing.T, ctx context.Context, todoUsecase handler.TodoU
 
 	v1.Use(mockAuthMiddleware(userID))
 
-	initTodoRouterFunc := handler.NewInitTodoRouterFunc(todoUsecase)
+	initTodoRouterFunc := handler.NewInitTodoRouterFunc(todoUsecase, func(c *gin.Context) { c.Next() })
 	initTodoRouterFunc(v1)
 
 	return router
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// AcceptTodoListInvitation handles POST /lists/invitations/:id/accept. The authenticated user joins the todo list
+// with the role of the invitation, and the list is returned as GET /lists/shared shows it.
+func (h *TodoListShareHandler) AcceptTodoListInvitation(c *gin.Context) {
+	h.answerTodoListInvitation(c, true)
+}
+
+// DeclineTodoListInvitation handles POST /lists/invitations/:id/decline and discards the invitation.
+func (h *TodoListShareHandler) DeclineTodoListInvitation(c *gin.Context) {
+	h.answerTodoListInvitation(c, false)
+}
+
+func (h *TodoListShareHandler) answerTodoListInvitation(c *gin.Context, accept bool) {
+	ctx := c.Request.Context()
+	invitationID, ok := getInvitationIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	loginID := c.GetString(controller.ContextFieldLoginID{})
+	if userID <= 0 || loginID == "" {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID or login ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "AnswerTodoListInvitation called", slog.Int("userId", userID), slog.Int("invitationId", invitationID), slog.Bool("accept", accept))
+
+	input, err := domain.NewAnswerTodoListInvitationInput(invitationID, userID, loginID, accept)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid answer todo list invitation input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	member, err := h.usecase.AnswerTodoListInvitation(ctx, input)
+	if err != nil {
+		h.writeTodoListShareError(c, err, "failed to answer todo list invitation")
+		return
+	}
+	if !accept {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	resp, err := NewSharedTodoListResponse(member)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
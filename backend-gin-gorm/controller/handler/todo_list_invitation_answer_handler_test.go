@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_AcceptTodoListInvitation_shouldReturn200WithList_whenInvitationExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().AnswerTodoListInvitation(mock.Anything, &domain.AnswerTodoListInvitationInput{
+		InvitationID: 4,
+		UserID:       userID,
+		LoginID:      testLoginID,
+		Accept:       true,
+	}).Return(&domain.TodoListMember{
+		ListOwnerID: testListOwnerID,
+		UserID:      userID,
+		LoginID:     testLoginID,
+		Role:        domain.TodoListViewer,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/invitations/4/accept", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - list owner and role
+	listOwnerID := parseExpr(t, "$.listOwnerId").Get(jsonObj)
+	require.Len(t, listOwnerID, 1, "response should have one listOwnerId")
+	assert.Equal(t, int64(testListOwnerID), listOwnerID[0])
+	role := parseExpr(t, "$.role").Get(jsonObj)
+	require.Len(t, role, 1, "response should have one role")
+	assert.Equal(t, "viewer", role[0])
+}
+
+func Test_TodoListShareHandler_DeclineTodoListInvitation_shouldReturn204_whenInvitationExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().AnswerTodoListInvitation(mock.Anything, &domain.AnswerTodoListInvitationInput{
+		InvitationID: 4,
+		UserID:       userID,
+		LoginID:      testLoginID,
+		Accept:       false,
+	}).Return(nil, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/invitations/4/decline", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+}
+
+func Test_TodoListShareHandler_AcceptTodoListInvitation_shouldReturn404_whenInvitationNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().AnswerTodoListInvitation(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoListInvitationNotFound).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/invitations/999/accept", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "invitation_not_found", "Not Found")
+}
+
+func Test_TodoListShareHandler_AcceptTodoListInvitation_shouldReturn409_whenListIsOwnedByTheUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().AnswerTodoListInvitation(mock.Anything, mock.Anything).Return(nil, domain.ErrOwnTodoList).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/invitations/4/accept", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusConflict, w.Code, "status code should be 409")
+	validateErrorResponse(t, respBytes, "own_todo_list", "the todo list is owned by the user")
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewFindTodoListInvitationsResponse converts invitations to a FindTodoListInvitationsResponse API type.
+func NewFindTodoListInvitationsResponse(invitations []domain.TodoListInvitation) (*api.FindTodoListInvitationsResponse, error) {
+	resp := &api.FindTodoListInvitationsResponse{
+		Invitations: make([]api.TodoListInvitationResponse, 0, len(invitations)),
+	}
+	for _, invitation := range invitations {
+		invitationResp, err := NewTodoListInvitationResponse(&invitation)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo list invitation: %w", err)
+		}
+		resp.Invitations = append(resp.Invitations, *invitationResp)
+	}
+	return resp, nil
+}
+
+// FindTodoListInvitations handles GET /lists/invitations and lists the pending invitations addressed to the login ID
+// of the authenticated user.
+func (h *TodoListShareHandler) FindTodoListInvitations(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	loginID := c.GetString(controller.ContextFieldLoginID{})
+	if userID <= 0 || loginID == "" {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID or login ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindTodoListInvitations called", slog.Int("userId", userID))
+
+	invitations, err := h.usecase.FindTodoListInvitations(ctx, loginID)
+	if err != nil {
+		h.writeTodoListShareError(c, err, "failed to find todo list invitations")
+		return
+	}
+
+	resp, err := NewFindTodoListInvitationsResponse(invitations)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_FindTodoListInvitations_shouldReturn200WithInvitations_whenInvitationsExist(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListInvitations(mock.Anything, testLoginID).Return([]domain.TodoListInvitation{
+		{ID: 1, ListOwnerID: testListOwnerID, InviteeLoginID: testLoginID, Role: domain.TodoListViewer, InviterUserID: testListOwnerID, InviterLoginID: "owner1", CreatedAt: now},
+		{ID: 2, ListOwnerID: testListOwnerID + 1, InviteeLoginID: testLoginID, Role: domain.TodoListEditor, InviterUserID: testListOwnerID + 1, InviterLoginID: "owner2", CreatedAt: now},
+	}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/invitations", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - invitations
+	ids := parseExpr(t, "$.invitations[*].id").Get(jsonObj)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, ids)
+	roles := parseExpr(t, "$.invitations[*].role").Get(jsonObj)
+	assert.Equal(t, []interface{}{"viewer", "editor"}, roles)
+	inviters := parseExpr(t, "$.invitations[*].inviter.loginId").Get(jsonObj)
+	assert.Equal(t, []interface{}{"owner1", "owner2"}, inviters)
+}
+
+func Test_TodoListShareHandler_FindTodoListInvitations_shouldReturn200WithEmptyList_whenNoInvitations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListInvitations(mock.Anything, testLoginID).Return([]domain.TodoListInvitation{}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/invitations", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.JSONEq(t, `{"invitations":[]}`, string(respBytes))
+}
+
+func Test_TodoListShareHandler_FindTodoListInvitations_shouldReturn500_whenUsecaseFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListInvitations(mock.Anything, testLoginID).Return(nil, errors.New("database error")).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/invitations", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
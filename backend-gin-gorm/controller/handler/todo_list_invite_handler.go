@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// InviteTodoListMember handles POST /lists/:ownerId/invitations and invites a login ID to a todo list the authenticated
+// user owns. Inviting a login ID again replaces the role of its pending invitation.
+func (h *TodoListShareHandler) InviteTodoListMember(c *gin.Context) {
+	ctx := c.Request.Context()
+	listOwnerID, ok := getListOwnerIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	loginID := c.GetString(controller.ContextFieldLoginID{})
+	if userID <= 0 || loginID == "" {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID or login ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "InviteTodoListMember called", slog.Int("userId", userID), slog.Int("listOwnerId", listOwnerID))
+
+	var req api.InviteTodoListMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid invite todo list member request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	input, err := domain.NewInviteTodoListMemberInput(listOwnerID, userID, loginID, req.LoginID, domain.TodoListRole(req.Role))
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid invite todo list member input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.InviteTodoListMember(ctx, input)
+	if err != nil {
+		h.writeTodoListShareError(c, err, "failed to invite todo list member")
+		return
+	}
+
+	resp, err := NewTodoListInvitationResponse(output.Invitation)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
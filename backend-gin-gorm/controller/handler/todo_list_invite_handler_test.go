@@ -0,0 +1,137 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_InviteTodoListMember_shouldReturn201_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().InviteTodoListMember(mock.Anything, &domain.InviteTodoListMemberInput{
+		ListOwnerID:    userID,
+		UserID:         userID,
+		LoginID:        testLoginID,
+		InviteeLoginID: "user2",
+		Role:           domain.TodoListEditor,
+	}).Return(&domain.InviteTodoListMemberOutput{
+		Invitation: &domain.TodoListInvitation{
+			ID:             4,
+			ListOwnerID:    userID,
+			InviteeLoginID: "user2",
+			Role:           domain.TodoListEditor,
+			InviterUserID:  userID,
+			InviterLoginID: testLoginID,
+			CreatedAt:      time.Now(),
+		},
+	}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/"+strconv.Itoa(userID)+"/invitations", bytes.NewBufferString(`{"loginId":"user2","role":"editor"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - invitee and role
+	invitee := parseExpr(t, "$.invitee").Get(jsonObj)
+	require.Len(t, invitee, 1, "response should have one invitee")
+	assert.Equal(t, "user2", invitee[0])
+	role := parseExpr(t, "$.role").Get(jsonObj)
+	require.Len(t, role, 1, "response should have one role")
+	assert.Equal(t, "editor", role[0])
+
+	// - inviter
+	inviter := parseExpr(t, "$.inviter.loginId").Get(jsonObj)
+	require.Len(t, inviter, 1, "response should have one inviter loginId")
+	assert.Equal(t, testLoginID, inviter[0])
+}
+
+func Test_TodoListShareHandler_InviteTodoListMember_shouldReturn400_whenRoleIsUnknown(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/"+strconv.Itoa(userID)+"/invitations", bytes.NewBufferString(`{"loginId":"user2","role":"admin"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+}
+
+func Test_TodoListShareHandler_InviteTodoListMember_shouldReturn400_whenInvitingThemselves(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/"+strconv.Itoa(userID)+"/invitations", bytes.NewBufferString(`{"loginId":"`+testLoginID+`","role":"viewer"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_request", "request body is invalid")
+}
+
+func Test_TodoListShareHandler_InviteTodoListMember_shouldReturn403_whenUserIsNotAnOwner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().InviteTodoListMember(mock.Anything, mock.Anything).Return(nil, domain.ErrForbidden).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/lists/2000000/invitations", bytes.NewBufferString(`{"loginId":"user2","role":"viewer"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusForbidden, w.Code, "status code should be 403")
+	validateErrorResponse(t, respBytes, "forbidden", "Forbidden")
+}
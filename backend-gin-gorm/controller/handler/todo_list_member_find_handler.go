@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewFindTodoListMembersResponse converts members to a FindTodoListMembersResponse API type.
+func NewFindTodoListMembersResponse(members []domain.TodoListMember) (*api.FindTodoListMembersResponse, error) {
+	resp := &api.FindTodoListMembersResponse{
+		Members: make([]api.TodoListMemberResponse, 0, len(members)),
+	}
+	for _, member := range members {
+		memberResp, err := NewTodoListMemberResponse(&member)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo list member: %w", err)
+		}
+		resp.Members = append(resp.Members, *memberResp)
+	}
+	return resp, nil
+}
+
+// FindTodoListMembers handles GET /lists/:ownerId/members and lists the members of a todo list shared with
+// the authenticated user. The owner of the list is not a member and is not listed.
+func (h *TodoListShareHandler) FindTodoListMembers(c *gin.Context) {
+	ctx := c.Request.Context()
+	listOwnerID, ok := getListOwnerIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindTodoListMembers called", slog.Int("userId", userID), slog.Int("listOwnerId", listOwnerID))
+
+	input, err := domain.NewFindTodoListMembersInput(listOwnerID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find todo list members input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	members, err := h.usecase.FindTodoListMembers(ctx, input)
+	if err != nil {
+		h.writeTodoListShareError(c, err, "failed to find todo list members")
+		return
+	}
+
+	resp, err := NewFindTodoListMembersResponse(members)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
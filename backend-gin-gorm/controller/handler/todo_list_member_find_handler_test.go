@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_FindTodoListMembers_shouldReturn200WithMembers_whenListIsShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListMembers(mock.Anything, &domain.FindTodoListMembersInput{
+		ListOwnerID: testListOwnerID,
+		UserID:      userID,
+	}).Return([]domain.TodoListMember{
+		{ListOwnerID: testListOwnerID, UserID: userID, LoginID: testLoginID, Role: domain.TodoListViewer, CreatedAt: now, UpdatedAt: now},
+		{ListOwnerID: testListOwnerID, UserID: testListOwnerID + 1, LoginID: "user2", Role: domain.TodoListEditor, CreatedAt: now, UpdatedAt: now},
+	}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/2000000/members", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - members
+	loginIDs := parseExpr(t, "$.members[*].loginId").Get(jsonObj)
+	assert.Equal(t, []interface{}{testLoginID, "user2"}, loginIDs)
+	roles := parseExpr(t, "$.members[*].role").Get(jsonObj)
+	assert.Equal(t, []interface{}{"viewer", "editor"}, roles)
+}
+
+func Test_TodoListShareHandler_FindTodoListMembers_shouldReturn404_whenListIsNotShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListMembers(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoListNotFound).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/2000000/members", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_list_not_found", "Not Found")
+}
+
+func Test_TodoListShareHandler_FindTodoListMembers_shouldReturn400_whenOwnerIDIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	// FindTodoListMembers が呼ばれた場合は mock が失敗させる
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/abc/members", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_list_owner_id", "list owner id must be a positive integer")
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// RemoveTodoListMember handles DELETE /lists/:ownerId/members/:userId and removes a member from a todo list.
+// Owners may remove any member; other members may only remove themselves to leave the list.
+func (h *TodoListShareHandler) RemoveTodoListMember(c *gin.Context) {
+	ctx := c.Request.Context()
+	listOwnerID, ok := getListOwnerIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	memberUserID, ok := getPositiveIntFromPath(c, h.logger, "userId", "invalid_user_id", "user id must be a positive integer")
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "RemoveTodoListMember called", slog.Int("userId", userID), slog.Int("listOwnerId", listOwnerID), slog.Int("memberUserId", memberUserID))
+
+	input, err := domain.NewRemoveTodoListMemberInput(listOwnerID, userID, memberUserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid remove todo list member input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	if err := h.usecase.RemoveTodoListMember(ctx, input); err != nil {
+		h.writeTodoListShareError(c, err, "failed to remove todo list member")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_RemoveTodoListMember_shouldReturn204_whenMemberLeaves(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().RemoveTodoListMember(mock.Anything, &domain.RemoveTodoListMemberInput{
+		ListOwnerID:  testListOwnerID,
+		UserID:       userID,
+		MemberUserID: userID,
+	}).Return(nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/lists/2000000/members/"+strconv.Itoa(userID), nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+}
+
+func Test_TodoListShareHandler_RemoveTodoListMember_shouldReturn403_whenUserIsNotAnOwner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().RemoveTodoListMember(mock.Anything, mock.Anything).Return(domain.ErrForbidden).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/lists/2000000/members/2000001", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusForbidden, w.Code, "status code should be 403")
+	validateErrorResponse(t, respBytes, "forbidden", "Forbidden")
+}
+
+func Test_TodoListShareHandler_RemoveTodoListMember_shouldReturn404_whenMemberNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().RemoveTodoListMember(mock.Anything, mock.Anything).Return(domain.ErrTodoListMemberNotFound).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/lists/"+strconv.Itoa(userID)+"/members/2000001", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_list_member_not_found", "Not Found")
+}
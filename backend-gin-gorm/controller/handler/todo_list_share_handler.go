@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListShareUsecase defines the use case operations for sharing todo lists with other users.
+type TodoListShareUsecase interface {
+	InviteTodoListMember(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.InviteTodoListMemberOutput, error)
+	FindTodoListInvitations(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error)
+	AnswerTodoListInvitation(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error)
+	FindTodoListMembers(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error)
+	RemoveTodoListMember(ctx context.Context, input *domain.RemoveTodoListMemberInput) error
+	FindSharedTodoLists(ctx context.Context, userID int) ([]domain.TodoListMember, error)
+	FindTodoListTodos(ctx context.Context, input *domain.FindTodoListTodosInput) (*domain.TodoPage, error)
+}
+
+// TodoListShareHandler handles HTTP requests for todo lists shared between users, their members and invitations.
+type TodoListShareHandler struct {
+	usecase TodoListShareUsecase
+	logger  *slog.Logger
+}
+
+// NewTodoListShareHandler creates a new TodoListShareHandler with the given use case.
+func NewTodoListShareHandler(usecase TodoListShareUsecase) *TodoListShareHandler {
+	return &TodoListShareHandler{
+		usecase: usecase,
+		logger:  slog.Default().With(slog.String(domain.LoggerNameKey, "TodoListShareHandler")),
+	}
+}
+
+// NewInitTodoListShareRouterFunc returns an InitRouterGroupFunc that registers todo list sharing routes under a "lists" group.
+// A todo list is identified by the ID of the user whose todos it holds.
+func NewInitTodoListShareRouterFunc(todoListShareUsecase TodoListShareUsecase) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		lists := parentRouterGroup.Group("lists", middleware...)
+		todoListShareHandler := NewTodoListShareHandler(todoListShareUsecase)
+
+		lists.GET("/shared", todoListShareHandler.FindSharedTodoLists)
+		lists.GET("/invitations", todoListShareHandler.FindTodoListInvitations)
+		lists.POST("/invitations/:id/accept", todoListShareHandler.AcceptTodoListInvitation)
+		lists.POST("/invitations/:id/decline", todoListShareHandler.DeclineTodoListInvitation)
+		lists.GET("/:ownerId/todos", todoListShareHandler.FindTodoListTodos)
+		lists.GET("/:ownerId/members", todoListShareHandler.FindTodoListMembers)
+		lists.DELETE("/:ownerId/members/:userId", todoListShareHandler.RemoveTodoListMember)
+		lists.POST("/:ownerId/invitations", todoListShareHandler.InviteTodoListMember)
+	}
+}
+
+// NewTodoListInvitationResponse converts a domain TodoListInvitation to a TodoListInvitationResponse API type.
+func NewTodoListInvitationResponse(invitation *domain.TodoListInvitation) (*api.TodoListInvitationResponse, error) {
+	if invitation == nil {
+		return nil, errors.New("todo list invitation is nil")
+	}
+	id, err := safeIntToInt32(invitation.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo list invitation ID: %w", err)
+	}
+	listOwnerID, err := safeIntToInt32(invitation.ListOwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo list owner ID: %w", err)
+	}
+	inviterUserID, err := safeIntToInt32(invitation.InviterUserID)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo list inviter user ID: %w", err)
+	}
+	return &api.TodoListInvitationResponse{
+		ID:          id,
+		ListOwnerID: listOwnerID,
+		Invitee:     invitation.InviteeLoginID,
+		Role:        api.TodoListRole(invitation.Role),
+		Inviter: api.CommentAuthorResponse{
+			UserID:  inviterUserID,
+			LoginID: invitation.InviterLoginID,
+		},
+		CreatedAt: invitation.CreatedAt,
+	}, nil
+}
+
+// NewTodoListMemberResponse converts a domain TodoListMember to a TodoListMemberResponse API type.
+func NewTodoListMemberResponse(member *domain.TodoListMember) (*api.TodoListMemberResponse, error) {
+	if member == nil {
+		return nil, errors.New("todo list member is nil")
+	}
+	userID, err := safeIntToInt32(member.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo list member user ID: %w", err)
+	}
+	return &api.TodoListMemberResponse{
+		UserID:   userID,
+		LoginID:  member.LoginID,
+		Role:     api.TodoListRole(member.Role),
+		JoinedAt: member.CreatedAt,
+	}, nil
+}
+
+// NewSharedTodoListResponse converts the membership of a user to a SharedTodoListResponse API type.
+func NewSharedTodoListResponse(member *domain.TodoListMember) (*api.SharedTodoListResponse, error) {
+	if member == nil {
+		return nil, errors.New("todo list member is nil")
+	}
+	listOwnerID, err := safeIntToInt32(member.ListOwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo list owner ID: %w", err)
+	}
+	return &api.SharedTodoListResponse{
+		ListOwnerID: listOwnerID,
+		Role:        api.TodoListRole(member.Role),
+		JoinedAt:    member.CreatedAt,
+	}, nil
+}
+
+// getListOwnerIDFromPath parses the ":ownerId" path parameter of todo list routes.
+// On failure it writes a 400 response and returns false.
+func getListOwnerIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "ownerId", "invalid_list_owner_id", "list owner id must be a positive integer")
+}
+
+// getInvitationIDFromPath parses the ":id" path parameter of invitation routes.
+// On failure it writes a 400 response and returns false.
+func getInvitationIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "id", "invalid_invitation_id", "invitation id must be a positive integer")
+}
+
+// writeTodoListShareError maps todo list sharing use case errors to HTTP responses.
+func (h *TodoListShareHandler) writeTodoListShareError(c *gin.Context, err error, message string) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrTodoListNotFound):
+		h.logger.WarnContext(ctx, "todo list not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_list_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrTodoListMemberNotFound):
+		h.logger.WarnContext(ctx, "todo list member not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_list_member_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrTodoListInvitationNotFound):
+		h.logger.WarnContext(ctx, "todo list invitation not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("invitation_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrForbidden):
+		h.logger.WarnContext(ctx, "todo list operation forbidden", slog.Any("error", err))
+		c.JSON(http.StatusForbidden, NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden)))
+	case errors.Is(err, domain.ErrOwnTodoList):
+		h.logger.WarnContext(ctx, "own todo list", slog.Any("error", err))
+		c.JSON(http.StatusConflict, NewErrorResponse("own_todo_list", "the todo list is owned by the user"))
+	case errors.Is(err, domain.ErrInvalidCursor):
+		h.logger.WarnContext(ctx, "invalid cursor", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_cursor", "cursor is invalid"))
+	default:
+		h.logger.ErrorContext(ctx, message, slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+	}
+}
@@ -0,0 +1,31 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller/handler"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// testListOwnerID is outside the range of randomUserID, so that the list is never the authenticated user's own.
+const testListOwnerID = 2000000
+
+func initTodoListShareRouter(t *testing.T, ctx context.Context, todoListShareUsecase handler.TodoListShareUsecase, userID int) *gin.Engine {
+	t.Helper()
+
+	router, err := handler.InitRootRouterGroup(ctx, config, domain.AppName)
+	require.NoError(t, err)
+	api := router.Group("api")
+	v1 := api.Group("v1")
+
+	v1.Use(fakeAuthMiddleware(userID, testLoginID))
+
+	initTodoListShareRouterFunc := handler.NewInitTodoListShareRouterFunc(todoListShareUsecase)
+	initTodoListShareRouterFunc(v1)
+
+	return router
+}
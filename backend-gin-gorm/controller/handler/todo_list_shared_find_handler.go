@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewFindSharedTodoListsResponse converts the memberships of a user to a FindSharedTodoListsResponse API type.
+func NewFindSharedTodoListsResponse(members []domain.TodoListMember) (*api.FindSharedTodoListsResponse, error) {
+	resp := &api.FindSharedTodoListsResponse{
+		Lists: make([]api.SharedTodoListResponse, 0, len(members)),
+	}
+	for _, member := range members {
+		listResp, err := NewSharedTodoListResponse(&member)
+		if err != nil {
+			return nil, fmt.Errorf("convert shared todo list: %w", err)
+		}
+		resp.Lists = append(resp.Lists, *listResp)
+	}
+	return resp, nil
+}
+
+// FindSharedTodoLists handles GET /lists/shared and lists the todo lists of other users shared with the authenticated user,
+// with the role the user has in each.
+func (h *TodoListShareHandler) FindSharedTodoLists(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindSharedTodoLists called", slog.Int("userId", userID))
+
+	members, err := h.usecase.FindSharedTodoLists(ctx, userID)
+	if err != nil {
+		h.writeTodoListShareError(c, err, "failed to find shared todo lists")
+		return
+	}
+
+	resp, err := NewFindSharedTodoListsResponse(members)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
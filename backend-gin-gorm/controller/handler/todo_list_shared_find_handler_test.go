@@ -0,0 +1,46 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_FindSharedTodoLists_shouldReturn200WithLists_whenListsAreShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindSharedTodoLists(mock.Anything, userID).Return([]domain.TodoListMember{
+		{ListOwnerID: testListOwnerID, UserID: userID, LoginID: testLoginID, Role: domain.TodoListEditor, CreatedAt: now, UpdatedAt: now},
+		{ListOwnerID: testListOwnerID + 1, UserID: userID, LoginID: testLoginID, Role: domain.TodoListOwner, CreatedAt: now, UpdatedAt: now},
+	}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/shared", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - lists
+	roles := parseExpr(t, "$.lists[*].role").Get(jsonObj)
+	assert.Equal(t, []interface{}{"editor", "owner"}, roles)
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// FindTodoListTodos handles GET /lists/:ownerId/todos and returns one page of the unarchived todos of a todo list
+// shared with the authenticated user, in the default order. Paging works as for GET /todo, with the "limit" and "cursor" query parameters.
+func (h *TodoListShareHandler) FindTodoListTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	listOwnerID, ok := getListOwnerIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindTodoListTodos called", slog.Int("userId", userID), slog.Int("listOwnerId", listOwnerID))
+
+	limit, cursor, ok := getTodoPageFromQuery(c, h.logger)
+	if !ok {
+		return
+	}
+
+	input, err := domain.NewFindTodoListTodosInput(listOwnerID, userID, limit, cursor)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find todo list todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	page, err := h.usecase.FindTodoListTodos(ctx, input)
+	if err != nil {
+		h.writeTodoListShareError(c, err, "failed to find todo list todos")
+		return
+	}
+
+	resp, err := NewFindTodoPageResponse(page)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
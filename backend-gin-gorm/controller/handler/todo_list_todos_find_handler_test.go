@@ -0,0 +1,94 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoListShareHandler_FindTodoListTodos_shouldReturn200_whenListIsShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListTodos(mock.Anything, &domain.FindTodoListTodosInput{
+		ListOwnerID: testListOwnerID,
+		UserID:      userID,
+		Limit:       2,
+		Cursor:      nil,
+	}).Return(&domain.TodoPage{
+		Todos: []domain.Todo{
+			{ID: 1, UserID: testListOwnerID, Text: "task 1"},
+			{ID: 2, UserID: testListOwnerID, Text: "task 2"},
+		},
+	}, nil).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/2000000/todos?limit=2", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - todos
+	texts := parseExpr(t, "$.todos[*].text").Get(jsonObj)
+	assert.Equal(t, []interface{}{"task 1", "task 2"}, texts)
+}
+
+func Test_TodoListShareHandler_FindTodoListTodos_shouldReturn404_whenListIsNotShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	todoListShareUsecase.EXPECT().FindTodoListTodos(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoListNotFound).Once()
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/2000000/todos", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_list_not_found", "Not Found")
+}
+
+func Test_TodoListShareHandler_FindTodoListTodos_shouldReturn400_whenOwnerIDIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoListShareUsecase := NewMockTodoListShareUsecase(t)
+	r := initTodoListShareRouter(t, ctx, todoListShareUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/lists/abc/todos", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_list_owner_id", "list owner id must be a positive integer")
+}
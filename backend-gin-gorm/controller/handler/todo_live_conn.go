@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// todoLiveCloseError ends a live connection with a close status the client can act on.
+type todoLiveCloseError struct {
+	status websocket.StatusCode
+	reason string
+}
+
+func (e *todoLiveCloseError) Error() string {
+	return fmt.Sprintf("close live connection with status %d: %s", e.status, e.reason)
+}
+
+var (
+	errTodoLiveClientBehind = &todoLiveCloseError{status: websocket.StatusTryAgainLater, reason: "client fell behind; reconnect"}
+	errTodoLiveStreamEnded  = &todoLiveCloseError{status: websocket.StatusGoingAway, reason: "event stream ended; reconnect"}
+	errTodoLiveNotText      = &todoLiveCloseError{status: websocket.StatusUnsupportedData, reason: "messages must be JSON text"}
+)
+
+// todoLiveConn is one live connection of a user.
+// Messages of the client are handled one at a time in the order they arrive. Replies and events are queued
+// for a single writer; a client that lets the queue fill up is disconnected rather than buffered without bound.
+type todoLiveConn struct {
+	handler      *TodoLiveHandler
+	conn         *websocket.Conn
+	userID       int
+	subscription *domain.TodoEventSubscription
+	send         chan *api.LiveServerMessage
+	cancel       context.CancelCauseFunc
+
+	mu    sync.Mutex
+	lists map[string]*domain.TodoList
+
+	logger *slog.Logger
+}
+
+func newTodoLiveConn(h *TodoLiveHandler, conn *websocket.Conn, userID int, subscription *domain.TodoEventSubscription) *todoLiveConn {
+	return &todoLiveConn{
+		handler:      h,
+		conn:         conn,
+		userID:       userID,
+		subscription: subscription,
+		send:         make(chan *api.LiveServerMessage, h.config.SendBufferSize),
+		cancel:       nil,
+		mu:           sync.Mutex{},
+		lists:        make(map[string]*domain.TodoList),
+		logger:       h.logger.With(slog.Int("userId", userID)),
+	}
+}
+
+// run serves the connection until the client leaves, stops answering pings or falls behind, or the event stream ends,
+// and then closes it.
+func (lc *todoLiveConn) run(ctx context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	lc.cancel = cancel
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		lc.readMessages(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		lc.forwardEvents(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		lc.keepAlive(ctx)
+	}()
+	lc.writeMessages(ctx)
+
+	var closeErr *todoLiveCloseError
+	cause := context.Cause(ctx)
+	switch {
+	case errors.As(cause, &closeErr):
+		lc.logger.InfoContext(ctx, "closing live connection", slog.Any("reason", closeErr))
+		if err := lc.conn.Close(closeErr.status, closeErr.reason); err != nil {
+			lc.logger.WarnContext(ctx, "failed to close live connection", slog.Any("error", err))
+		}
+	case websocket.CloseStatus(cause) != -1:
+		// The client closed the connection
+		_ = lc.conn.CloseNow()
+	default:
+		lc.logger.WarnContext(ctx, "live connection failed", slog.Any("error", cause))
+		_ = lc.conn.CloseNow()
+	}
+	wg.Wait()
+}
+
+// enqueue queues a message for the writer. If the queue is full the connection is ended and false is returned.
+func (lc *todoLiveConn) enqueue(msg *api.LiveServerMessage) bool {
+	select {
+	case lc.send <- msg:
+		return true
+	default:
+		lc.cancel(errTodoLiveClientBehind)
+		return false
+	}
+}
+
+// readMessages handles the messages of the client until the connection fails or is closed.
+func (lc *todoLiveConn) readMessages(ctx context.Context) {
+	// Reading is ended by closing the connection; a cancelled context would make the library close it without a status
+	readCtx := context.WithoutCancel(ctx)
+	for {
+		msgType, data, err := lc.conn.Read(readCtx)
+		if err != nil {
+			lc.cancel(fmt.Errorf("read message: %w", err))
+			return
+		}
+		if msgType != websocket.MessageText {
+			lc.cancel(errTodoLiveNotText)
+			return
+		}
+		if !lc.enqueue(lc.handleMessage(ctx, data)) {
+			return
+		}
+	}
+}
+
+// forwardEvents queues the todo events of the user and of the lists shared with them for the lists the client follows.
+func (lc *todoLiveConn) forwardEvents(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-lc.subscription.Events:
+			if !ok {
+				lc.cancel(errTodoLiveStreamEnded)
+				return
+			}
+			msg, err := lc.newEventMessage(ctx, &event)
+			if err != nil {
+				lc.logger.ErrorContext(ctx, "failed to create event message", slog.Any("error", err))
+				continue
+			}
+			if msg != nil && !lc.enqueue(msg) {
+				return
+			}
+		}
+	}
+}
+
+// newEventMessage returns the message that reports the event to the client, or nil if it concerns none of the
+// lists the client follows. Events are only reported if the client follows a list of the owner of the todo.
+// Created todos are only reported if they are in a followed list; updates are always reported so that the client
+// can drop a todo from a list it has left.
+func (lc *todoLiveConn) newEventMessage(ctx context.Context, event *domain.TodoEvent) (*api.LiveServerMessage, error) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	followsOwner := false
+	lists := make([]string, 0, len(lc.lists))
+	now := time.Now()
+	for _, list := range lc.lists {
+		if list.OwnerID != event.UserID {
+			continue
+		}
+		followsOwner = true
+		if event.Todo == nil {
+			lists = append(lists, list.ID)
+			continue
+		}
+		contains, err := list.Contains(event.Todo, now)
+		if err != nil {
+			lc.logger.WarnContext(ctx, "failed to evaluate list", slog.String("list", list.ID), slog.Any("error", err))
+			continue
+		}
+		if contains {
+			lists = append(lists, list.ID)
+		}
+	}
+	if !followsOwner || event.Type == domain.TodoEventCreated && len(lists) == 0 {
+		return nil, nil
+	}
+	slices.Sort(lists)
+
+	data, err := NewTodoEventResponse(event)
+	if err != nil {
+		return nil, fmt.Errorf("convert todo event: %w", err)
+	}
+	eventID := event.ID.String()
+	eventType := api.LiveServerMessageEvent(event.Type)
+	return &api.LiveServerMessage{ //nolint:exhaustruct
+		Type:    api.LiveServerMessageTypeEvent,
+		EventID: &eventID,
+		Event:   &eventType,
+		Lists:   &lists,
+		Data:    data,
+	}, nil
+}
+
+// keepAlive pings the client and ends the connection when a pong does not arrive in time.
+func (lc *todoLiveConn) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(lc.handler.pingInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lc.handler.pongTimeout())
+			err := lc.conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				lc.cancel(fmt.Errorf("ping: %w", err))
+				return
+			}
+		}
+	}
+}
+
+// writeMessages writes the queued messages until the connection is ended.
+func (lc *todoLiveConn) writeMessages(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-lc.send:
+			writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lc.handler.pongTimeout())
+			err := wsjson.Write(writeCtx, lc.conn, msg)
+			cancel()
+			if err != nil {
+				lc.cancel(fmt.Errorf("write message: %w", err))
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bufio"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// LiveConfig holds the settings of live WebSocket connections.
+type LiveConfig struct {
+	// PingIntervalSec is the time between pings. A client that does not answer a ping within PongTimeoutSec is disconnected.
+	PingIntervalSec int `yaml:"pingIntervalSec" validate:"gte=1"`
+	PongTimeoutSec  int `yaml:"pongTimeoutSec" validate:"gte=1"`
+	// SendBufferSize is the number of messages a client may fall behind before it is disconnected.
+	SendBufferSize  int   `yaml:"sendBufferSize" validate:"gte=1"`
+	MaxMessageBytes int64 `yaml:"maxMessageBytes" validate:"gte=1"`
+}
+
+// TodoLiveHandler handles live WebSocket connections, over which clients follow todo lists and change todos.
+type TodoLiveHandler struct {
+	todoUsecase      TodoUsecase
+	todoEventUsecase TodoEventUsecase
+	config           *LiveConfig
+	originPatterns   []string
+	logger           *slog.Logger
+}
+
+// NewTodoLiveHandler creates a new TodoLiveHandler with the given use cases.
+// originPatterns lists the origins other than the server itself that may connect, as accepted by websocket.AcceptOptions.
+func NewTodoLiveHandler(todoUsecase TodoUsecase, todoEventUsecase TodoEventUsecase, config *LiveConfig, originPatterns []string) *TodoLiveHandler {
+	return &TodoLiveHandler{
+		todoUsecase:      todoUsecase,
+		todoEventUsecase: todoEventUsecase,
+		config:           config,
+		originPatterns:   originPatterns,
+		logger:           slog.Default().With(slog.String(domain.LoggerNameKey, "TodoLiveHandler")),
+	}
+}
+
+// NewInitTodoLiveRouterFunc returns an InitRouterGroupFunc that registers the live endpoint under the "todo" group.
+func NewInitTodoLiveRouterFunc(todoUsecase TodoUsecase, todoEventUsecase TodoEventUsecase, config *LiveConfig, originPatterns []string) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		todo := parentRouterGroup.Group("todo", middleware...)
+		todoLiveHandler := NewTodoLiveHandler(todoUsecase, todoEventUsecase, config, originPatterns)
+
+		todo.GET("/live", todoLiveHandler.ServeTodoLive)
+	}
+}
+
+// ServeTodoLive handles GET /todo/live and upgrades the request of the authenticated user to a WebSocket connection.
+// Over the connection the client subscribes to todo lists and receives their change events, and creates, updates
+// and deletes todos as over HTTP. See LiveClientMessage and LiveServerMessage for the messages.
+func (h *TodoLiveHandler) ServeTodoLive(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "ServeTodoLive called", slog.Int("userId", userID))
+
+	input, err := domain.NewSubscribeTodoEventsInput(userID, nil)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid subscribe todo events input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	// Subscribe before the upgrade so that a server that is shutting down can still answer with a status
+	subscription, err := h.todoEventUsecase.SubscribeTodoEvents(ctx, input)
+	if err != nil {
+		if errors.Is(err, domain.ErrTodoEventStreamClosed) {
+			h.logger.WarnContext(ctx, "todo event stream closed", slog.Any("error", err))
+			c.JSON(http.StatusServiceUnavailable, NewErrorResponse("service_unavailable", "the server is shutting down; reconnect later"))
+			return
+		}
+		h.logger.ErrorContext(ctx, "failed to subscribe todo events", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	defer subscription.Close()
+
+	conn, err := websocket.Accept(newUpgradeResponseWriter(c.Writer), c.Request, &websocket.AcceptOptions{ //nolint:exhaustruct
+		OriginPatterns: h.originPatterns,
+	})
+	if err != nil {
+		// Accept has written the response
+		h.logger.WarnContext(ctx, "failed to accept websocket connection", slog.Any("error", err))
+		return
+	}
+	conn.SetReadLimit(h.config.MaxMessageBytes)
+
+	lc := newTodoLiveConn(h, conn, userID, subscription)
+	lc.run(ctx)
+	h.logger.InfoContext(ctx, "todo live connection ended", slog.Int("userId", userID))
+}
+
+// upgradeResponseWriter writes the 101 response of a WebSocket upgrade to the underlying writer and hijacks the
+// connection through gin, which refuses to hand over a connection once it has written a status itself.
+type upgradeResponseWriter struct {
+	http.ResponseWriter
+	gin gin.ResponseWriter
+}
+
+func newUpgradeResponseWriter(w gin.ResponseWriter) http.ResponseWriter {
+	unwrapper, ok := w.(interface{ Unwrap() http.ResponseWriter })
+	if !ok {
+		return w
+	}
+	return &upgradeResponseWriter{ResponseWriter: unwrapper.Unwrap(), gin: w}
+}
+
+func (w *upgradeResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.gin.Hijack() //nolint:wrapcheck
+}
+
+func (h *TodoLiveHandler) pingInterval() time.Duration {
+	return time.Duration(h.config.PingIntervalSec) * time.Second
+}
+
+func (h *TodoLiveHandler) pongTimeout() time.Duration {
+	return time.Duration(h.config.PongTimeoutSec) * time.Second
+}
@@ -0,0 +1,264 @@
+package handler_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller/handler"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func initTodoLiveRouter(t *testing.T, ctx context.Context, todoUsecase handler.TodoUsecase, todoEventUsecase handler.TodoEventUsecase, userID int) *gin.Engine {
+	t.Helper()
+
+	router, err := handler.InitRootRouterGroup(ctx, config, domain.AppName)
+	require.NoError(t, err)
+	api := router.Group("api")
+	v1 := api.Group("v1")
+
+	v1.Use(fakeAuthMiddleware(userID, testLoginID))
+
+	liveConfig := &handler.LiveConfig{
+		PingIntervalSec: 60,
+		PongTimeoutSec:  10,
+		SendBufferSize:  16,
+		MaxMessageBytes: 4096,
+	}
+	initTodoLiveRouterFunc := handler.NewInitTodoLiveRouterFunc(todoUsecase, todoEventUsecase, liveConfig, nil)
+	initTodoLiveRouterFunc(v1)
+
+	return router
+}
+
+// dialTodoLive starts a server for the router and opens a live connection to it.
+func dialTodoLive(t *testing.T, ctx context.Context, router *gin.Engine) *websocket.Conn {
+	t.Helper()
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(server.URL, "http")+"/api/v1/todo/live", nil) //nolint:bodyclose
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.CloseNow() })
+	return conn
+}
+
+func sendLiveMessage(t *testing.T, ctx context.Context, conn *websocket.Conn, msg string) {
+	t.Helper()
+	require.NoError(t, conn.Write(ctx, websocket.MessageText, []byte(msg)))
+}
+
+func readLiveMessage(t *testing.T, ctx context.Context, conn *websocket.Conn) *api.LiveServerMessage {
+	t.Helper()
+	var msg api.LiveServerMessage
+	require.NoError(t, wsjson.Read(ctx, conn, &msg))
+	return &msg
+}
+
+func Test_TodoLiveHandler_ServeTodoLive_shouldForwardEventsOfSubscribedLists(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	view, err := domain.NewView(3, userID, "Milk", "text:milk", domain.DefaultTodoSort, now, now)
+	require.NoError(t, err)
+	events := make(chan domain.TodoEvent, 2)
+
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, mock.Anything).Return(&domain.TodoEventSubscription{
+		Replay: nil,
+		Events: events,
+		Close:  func() {},
+	}, nil).Once()
+	todoEventUsecase.EXPECT().FindTodoList(mock.Anything, &domain.FindTodoListInput{UserID: userID, ListOwnerID: userID, ViewID: 3}).Return(&domain.TodoList{ID: "view:3", OwnerID: userID, View: view}, nil).Once()
+	todoEventUsecase.EXPECT().FindTodoList(mock.Anything, &domain.FindTodoListInput{UserID: userID, ListOwnerID: userID, ViewID: 0}).Return(&domain.TodoList{ID: domain.TodoListAll, OwnerID: userID, View: nil}, nil).Once()
+	conn := dialTodoLive(t, ctx, initTodoLiveRouter(t, ctx, NewMockTodoUsecase(t), todoEventUsecase, userID))
+
+	// when
+	sendLiveMessage(t, ctx, conn, `{"type":"subscribe","requestId":"1","list":"view:3"}`)
+	subscribed := readLiveMessage(t, ctx, conn)
+	sendLiveMessage(t, ctx, conn, `{"type":"subscribe","requestId":"2","list":"all"}`)
+	readLiveMessage(t, ctx, conn)
+
+	milk := domain.NewTodoChangedEvent(domain.TodoEventCreated, &domain.Todo{ID: 7, UserID: userID, Text: "buy milk", Version: 1})
+	milk.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 1}
+	bread := domain.NewTodoChangedEvent(domain.TodoEventUpdated, &domain.Todo{ID: 8, UserID: userID, Text: "buy bread", Version: 2})
+	bread.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 2}
+	events <- milk
+	events <- bread
+	milkEvent := readLiveMessage(t, ctx, conn)
+	breadEvent := readLiveMessage(t, ctx, conn)
+
+	// then
+	assert.Equal(t, api.LiveServerMessageTypeResult, subscribed.Type)
+	require.NotNil(t, subscribed.RequestID)
+	assert.Equal(t, "1", *subscribed.RequestID)
+	require.NotNil(t, subscribed.List)
+	assert.Equal(t, "view:3", *subscribed.List)
+
+	assert.Equal(t, api.LiveServerMessageTypeEvent, milkEvent.Type)
+	require.NotNil(t, milkEvent.Event)
+	assert.Equal(t, api.LiveServerMessageEventCreated, *milkEvent.Event)
+	require.NotNil(t, milkEvent.EventID)
+	assert.Equal(t, "1735787045000-1", *milkEvent.EventID)
+	assert.Equal(t, &[]string{"all", "view:3"}, milkEvent.Lists)
+	require.NotNil(t, milkEvent.Data)
+	assert.Equal(t, int32(7), milkEvent.Data.Todo.ID)
+
+	require.NotNil(t, breadEvent.Event)
+	assert.Equal(t, api.LiveServerMessageEventUpdated, *breadEvent.Event)
+	assert.Equal(t, &[]string{"all"}, breadEvent.Lists, "the todo is no longer in the view")
+}
+
+func Test_TodoLiveHandler_ServeTodoLive_shouldForwardEventsOfSharedList_whenSubscribedToIt(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// given
+	userID := randomUserID()
+	ownerID := userID + 1
+	strangerID := userID + 2
+	events := make(chan domain.TodoEvent, 3)
+
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, mock.Anything).Return(&domain.TodoEventSubscription{
+		Replay: nil,
+		Events: events,
+		Close:  func() {},
+	}, nil).Once()
+	todoEventUsecase.EXPECT().FindTodoList(mock.Anything, &domain.FindTodoListInput{UserID: userID, ListOwnerID: ownerID, ViewID: 0}).Return(&domain.TodoList{ID: domain.TodoListIDOfSharedList(ownerID), OwnerID: ownerID, View: nil}, nil).Once()
+	todoEventUsecase.EXPECT().FindTodoList(mock.Anything, &domain.FindTodoListInput{UserID: userID, ListOwnerID: strangerID, ViewID: 0}).Return(nil, domain.ErrTodoListNotFound).Once()
+	conn := dialTodoLive(t, ctx, initTodoLiveRouter(t, ctx, NewMockTodoUsecase(t), todoEventUsecase, userID))
+	sharedListID := domain.TodoListIDOfSharedList(ownerID)
+
+	// when
+	sendLiveMessage(t, ctx, conn, `{"type":"subscribe","requestId":"1","list":"`+sharedListID+`"}`)
+	subscribed := readLiveMessage(t, ctx, conn)
+	sendLiveMessage(t, ctx, conn, `{"type":"subscribe","requestId":"2","list":"`+domain.TodoListIDOfSharedList(strangerID)+`"}`)
+	notShared := readLiveMessage(t, ctx, conn)
+
+	own := domain.NewTodoChangedEvent(domain.TodoEventUpdated, &domain.Todo{ID: 6, UserID: userID, Text: "my todo", Version: 2})
+	own.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 1}
+	shared := domain.NewTodoChangedEvent(domain.TodoEventCreated, &domain.Todo{ID: 7, UserID: ownerID, Text: "shared todo", Version: 1})
+	shared.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 2}
+	deleted := domain.NewTodoDeletedEvent(ownerID, 8)
+	deleted.ID = domain.TodoEventID{Epoch: 1735787045000, Seq: 3}
+	events <- own
+	events <- shared
+	events <- deleted
+	sharedEvent := readLiveMessage(t, ctx, conn)
+	deletedEvent := readLiveMessage(t, ctx, conn)
+
+	// then
+	require.NotNil(t, subscribed.List)
+	assert.Equal(t, sharedListID, *subscribed.List)
+	require.NotNil(t, notShared.Error)
+	assert.Equal(t, "todo_list_not_found", notShared.Error.Code)
+
+	// - the event of the user's own todo is not reported, as the client follows none of the user's lists
+	require.NotNil(t, sharedEvent.EventID)
+	assert.Equal(t, "1735787045000-2", *sharedEvent.EventID)
+	assert.Equal(t, &[]string{sharedListID}, sharedEvent.Lists)
+	require.NotNil(t, deletedEvent.Event)
+	assert.Equal(t, api.LiveServerMessageEventDeleted, *deletedEvent.Event)
+	assert.Equal(t, &[]string{sharedListID}, deletedEvent.Lists)
+}
+
+func Test_TodoLiveHandler_ServeTodoLive_shouldChangeTodosThroughTodoUsecase(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// given
+	userID := randomUserID()
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, mock.Anything).Return(&domain.TodoEventSubscription{
+		Replay: nil,
+		Events: make(chan domain.TodoEvent),
+		Close:  func() {},
+	}, nil).Once()
+
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().CreateTodo(mock.Anything, &domain.CreateTodoInput{UserID: userID, Text: "buy milk"}).Return(&domain.CreateTodoOutput{
+		Todo: &domain.Todo{ID: 7, UserID: userID, Text: "buy milk", Version: 1},
+	}, nil).Once()
+	expectedVersion := 1
+	isComplete := true
+	todoUsecase.EXPECT().PatchTodo(mock.Anything, &domain.PatchTodoInput{ID: 7, UserID: userID, IsComplete: &isComplete, ExpectedVersion: &expectedVersion}).Return(nil, &domain.TodoVersionMismatchError{
+		Current: &domain.Todo{ID: 7, UserID: userID, Text: "buy oat milk", Version: 2},
+	}).Once()
+	todoUsecase.EXPECT().DeleteTodo(mock.Anything, &domain.DeleteTodoInput{ID: 8, UserID: userID}).Return(domain.ErrTodoNotFound).Once()
+	conn := dialTodoLive(t, ctx, initTodoLiveRouter(t, ctx, todoUsecase, todoEventUsecase, userID))
+
+	// when
+	sendLiveMessage(t, ctx, conn, `{"type":"create","requestId":"1","text":"buy milk"}`)
+	created := readLiveMessage(t, ctx, conn)
+	sendLiveMessage(t, ctx, conn, `{"type":"update","requestId":"2","id":7,"isComplete":true,"version":1}`)
+	mismatch := readLiveMessage(t, ctx, conn)
+	sendLiveMessage(t, ctx, conn, `{"type":"delete","requestId":"3","id":8}`)
+	notFound := readLiveMessage(t, ctx, conn)
+	sendLiveMessage(t, ctx, conn, `{"type":"create","requestId":"4"}`)
+	invalid := readLiveMessage(t, ctx, conn)
+
+	// then
+	// - create
+	assert.Equal(t, api.LiveServerMessageTypeResult, created.Type)
+	require.NotNil(t, created.Todo)
+	assert.Equal(t, int32(7), created.Todo.ID)
+	require.NotNil(t, created.Etag)
+	assert.Equal(t, `"1"`, *created.Etag)
+
+	// - update at an old version
+	assert.Equal(t, api.LiveServerMessageTypeError, mismatch.Type)
+	require.NotNil(t, mismatch.Error)
+	assert.Equal(t, "version_mismatch", mismatch.Error.Code)
+	require.NotNil(t, mismatch.Todo)
+	assert.Equal(t, "buy oat milk", mismatch.Todo.Text)
+
+	// - delete of a missing todo
+	require.NotNil(t, notFound.Error)
+	assert.Equal(t, "todo_not_found", notFound.Error.Code)
+	assert.Equal(t, "3", *notFound.RequestID)
+
+	// - create without text
+	require.NotNil(t, invalid.Error)
+	assert.Equal(t, "invalid_request", invalid.Error.Code)
+}
+
+func Test_TodoLiveHandler_ServeTodoLive_shouldCloseWithGoingAway_whenEventStreamEnds(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// given
+	userID := randomUserID()
+	events := make(chan domain.TodoEvent)
+	todoEventUsecase := NewMockTodoEventUsecase(t)
+	todoEventUsecase.EXPECT().SubscribeTodoEvents(mock.Anything, mock.Anything).Return(&domain.TodoEventSubscription{
+		Replay: nil,
+		Events: events,
+		Close:  func() {},
+	}, nil).Once()
+	conn := dialTodoLive(t, ctx, initTodoLiveRouter(t, ctx, NewMockTodoUsecase(t), todoEventUsecase, userID))
+
+	// when
+	close(events)
+	_, _, err := conn.Read(ctx)
+
+	// then
+	assert.Equal(t, websocket.StatusGoingAway, websocket.CloseStatus(err))
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// handleMessage handles one message of the client and returns the reply to it.
+func (lc *todoLiveConn) handleMessage(ctx context.Context, data []byte) *api.LiveServerMessage {
+	var msg api.LiveClientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		lc.logger.WarnContext(ctx, "invalid live message", slog.Any("error", err))
+		return newLiveErrorMessage(nil, "invalid_request", "message must be a JSON object")
+	}
+	if err := binding.Validator.ValidateStruct(&msg); err != nil {
+		lc.logger.WarnContext(ctx, "invalid live message", slog.Any("error", err))
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "message is invalid")
+	}
+
+	switch msg.Type {
+	case api.LiveClientMessageTypeSubscribe:
+		return lc.subscribe(ctx, &msg)
+	case api.LiveClientMessageTypeUnsubscribe:
+		return lc.unsubscribe(ctx, &msg)
+	case api.LiveClientMessageTypeCreate:
+		return lc.createTodo(ctx, &msg)
+	case api.LiveClientMessageTypeUpdate:
+		return lc.updateTodo(ctx, &msg)
+	case api.LiveClientMessageTypeDelete:
+		return lc.deleteTodo(ctx, &msg)
+	default:
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "message is invalid")
+	}
+}
+
+// subscribe starts following a todo list of the user or one shared with them. Subscribing to a list again picks up changes to its view.
+func (lc *todoLiveConn) subscribe(ctx context.Context, msg *api.LiveClientMessage) *api.LiveServerMessage {
+	input, reply := lc.newFindTodoListInput(ctx, msg)
+	if reply != nil {
+		return reply
+	}
+
+	list, err := lc.handler.todoEventUsecase.FindTodoList(ctx, input)
+	switch {
+	case errors.Is(err, domain.ErrViewNotFound):
+		lc.logger.WarnContext(ctx, "view not found", slog.Int("viewId", input.ViewID))
+		return newLiveErrorMessage(msg.RequestID, "view_not_found", http.StatusText(http.StatusNotFound))
+	case errors.Is(err, domain.ErrTodoListNotFound):
+		lc.logger.WarnContext(ctx, "todo list not found", slog.Int("listOwnerId", input.ListOwnerID))
+		return newLiveErrorMessage(msg.RequestID, "todo_list_not_found", http.StatusText(http.StatusNotFound))
+	case err != nil:
+		lc.logger.ErrorContext(ctx, "failed to find todo list", slog.Any("error", err))
+		return newLiveErrorMessage(msg.RequestID, "internal_server_error", http.StatusText(http.StatusInternalServerError))
+	}
+
+	lc.mu.Lock()
+	lc.lists[list.ID] = list
+	lc.mu.Unlock()
+
+	return &api.LiveServerMessage{ //nolint:exhaustruct
+		Type:      api.LiveServerMessageTypeResult,
+		RequestID: msg.RequestID,
+		List:      &list.ID,
+	}
+}
+
+// unsubscribe stops following a todo list. Unsubscribing from a list that is not followed is not an error.
+func (lc *todoLiveConn) unsubscribe(ctx context.Context, msg *api.LiveClientMessage) *api.LiveServerMessage {
+	input, reply := lc.newFindTodoListInput(ctx, msg)
+	if reply != nil {
+		return reply
+	}
+
+	listID := input.ListID()
+
+	lc.mu.Lock()
+	delete(lc.lists, listID)
+	lc.mu.Unlock()
+
+	return &api.LiveServerMessage{ //nolint:exhaustruct
+		Type:      api.LiveServerMessageTypeResult,
+		RequestID: msg.RequestID,
+		List:      &listID,
+	}
+}
+
+func (lc *todoLiveConn) newFindTodoListInput(ctx context.Context, msg *api.LiveClientMessage) (*domain.FindTodoListInput, *api.LiveServerMessage) {
+	if msg.List == nil {
+		return nil, newLiveErrorMessage(msg.RequestID, "invalid_request", string(msg.Type)+" takes list")
+	}
+	input, err := domain.NewFindTodoListInput(*msg.List, lc.userID)
+	if err != nil {
+		lc.logger.WarnContext(ctx, "invalid todo list", slog.Any("error", err))
+		return nil, newLiveErrorMessage(msg.RequestID, "invalid_request", `list must be "all", "view:{id}" or "shared:{ownerId}"`)
+	}
+	return input, nil
+}
+
+// createTodo creates a todo like POST /todo.
+func (lc *todoLiveConn) createTodo(ctx context.Context, msg *api.LiveClientMessage) *api.LiveServerMessage {
+	if msg.Text == nil || msg.ID != nil || msg.IsComplete != nil || msg.Version != nil {
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "create only takes text")
+	}
+	input, err := domain.NewCreateTodoInput(lc.userID, *msg.Text)
+	if err != nil {
+		lc.logger.WarnContext(ctx, "invalid create todo input", slog.Any("error", err))
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "message is invalid")
+	}
+
+	output, err := lc.handler.todoUsecase.CreateTodo(ctx, input)
+	if err != nil {
+		return lc.newTodoErrorMessage(ctx, msg.RequestID, err)
+	}
+	return lc.newTodoResultMessage(ctx, msg.RequestID, output.Todo)
+}
+
+// updateTodo changes the fields of a todo that are set, like PATCH /todo/{id}.
+func (lc *todoLiveConn) updateTodo(ctx context.Context, msg *api.LiveClientMessage) *api.LiveServerMessage {
+	if msg.ID == nil || msg.List != nil {
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "update requires an id and takes no list")
+	}
+	input, err := domain.NewPatchTodoInput(int(*msg.ID), lc.userID, msg.Text, msg.IsComplete, newLiveExpectedVersion(msg))
+	if err != nil {
+		lc.logger.WarnContext(ctx, "invalid patch todo input", slog.Any("error", err))
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "message is invalid")
+	}
+
+	output, err := lc.handler.todoUsecase.PatchTodo(ctx, input)
+	if err != nil {
+		return lc.newTodoErrorMessage(ctx, msg.RequestID, err)
+	}
+	return lc.newTodoResultMessage(ctx, msg.RequestID, output.Todo)
+}
+
+// deleteTodo moves a todo to the trash like DELETE /todo/{id}.
+func (lc *todoLiveConn) deleteTodo(ctx context.Context, msg *api.LiveClientMessage) *api.LiveServerMessage {
+	if msg.ID == nil || msg.List != nil || msg.Text != nil || msg.IsComplete != nil {
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "delete only takes an id and a version")
+	}
+	input, err := domain.NewDeleteTodoInput(int(*msg.ID), lc.userID, newLiveExpectedVersion(msg))
+	if err != nil {
+		lc.logger.WarnContext(ctx, "invalid delete todo input", slog.Any("error", err))
+		return newLiveErrorMessage(msg.RequestID, "invalid_request", "message is invalid")
+	}
+
+	if err := lc.handler.todoUsecase.DeleteTodo(ctx, input); err != nil {
+		return lc.newTodoErrorMessage(ctx, msg.RequestID, err)
+	}
+	return &api.LiveServerMessage{ //nolint:exhaustruct
+		Type:      api.LiveServerMessageTypeResult,
+		RequestID: msg.RequestID,
+	}
+}
+
+func newLiveExpectedVersion(msg *api.LiveClientMessage) *int {
+	if msg.Version == nil {
+		return nil
+	}
+	version := int(*msg.Version)
+	return &version
+}
+
+func (lc *todoLiveConn) newTodoResultMessage(ctx context.Context, requestID *string, todo *domain.Todo) *api.LiveServerMessage {
+	todoResp, err := NewFindTodoResponseTodo(todo)
+	if err != nil {
+		lc.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		return newLiveErrorMessage(requestID, "internal_server_error", http.StatusText(http.StatusInternalServerError))
+	}
+	etag := todoETag(todo)
+	return &api.LiveServerMessage{ //nolint:exhaustruct
+		Type:      api.LiveServerMessageTypeResult,
+		RequestID: requestID,
+		Todo:      todoResp,
+		Etag:      &etag,
+	}
+}
+
+// newTodoErrorMessage reports a failed change to a todo with the codes HTTP uses. A version mismatch carries the current todo.
+func (lc *todoLiveConn) newTodoErrorMessage(ctx context.Context, requestID *string, err error) *api.LiveServerMessage {
+	var mismatch *domain.TodoVersionMismatchError
+	switch {
+	case errors.Is(err, domain.ErrTodoNotFound):
+		lc.logger.WarnContext(ctx, "todo not found", slog.Any("error", err))
+		return newLiveErrorMessage(requestID, "todo_not_found", http.StatusText(http.StatusNotFound))
+	case errors.Is(err, domain.ErrForbidden):
+		lc.logger.WarnContext(ctx, "todo operation forbidden", slog.Any("error", err))
+		return newLiveErrorMessage(requestID, "forbidden", http.StatusText(http.StatusForbidden))
+	case errors.As(err, &mismatch):
+		lc.logger.WarnContext(ctx, "todo version mismatch", slog.Int("todoId", mismatch.Current.ID), slog.Int("version", mismatch.Current.Version))
+		msg := lc.newTodoResultMessage(ctx, requestID, mismatch.Current)
+		if msg.Type == api.LiveServerMessageTypeError {
+			return msg
+		}
+		msg.Type = api.LiveServerMessageTypeError
+		msg.Error = NewErrorResponse("version_mismatch", "the todo has been changed since the expected version")
+		return msg
+	default:
+		lc.logger.ErrorContext(ctx, "failed to change todo", slog.Any("error", err))
+		return newLiveErrorMessage(requestID, "internal_server_error", http.StatusText(http.StatusInternalServerError))
+	}
+}
+
+func newLiveErrorMessage(requestID *string, code string, message string) *api.LiveServerMessage {
+	return &api.LiveServerMessage{ //nolint:exhaustruct
+		Type:      api.LiveServerMessageTypeError,
+		RequestID: requestID,
+		Error:     NewErrorResponse(code, message),
+	}
+}
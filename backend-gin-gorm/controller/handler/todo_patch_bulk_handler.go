@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewBulkTodosResponse converts the results of a bulk operation to a BulkTodosResponse API type.
+func NewBulkTodosResponse(output *domain.BulkTodosOutput) (*api.BulkTodosResponse, error) {
+	resp := &api.BulkTodosResponse{
+		Results: make([]api.BulkTodoResultResponse, 0, len(output.Results)),
+	}
+	for _, result := range output.Results {
+		id, err := safeIntToInt32(result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo ID: %w", err)
+		}
+		resultResp := api.BulkTodoResultResponse{ //nolint:exhaustruct
+			ID: id,
+		}
+		switch {
+		case result.Err != nil:
+			resultResp.Error = newBulkTodoErrorResponse(result.Err)
+		case result.Todo != nil:
+			todoResp, err := NewFindTodoResponseTodo(result.Todo)
+			if err != nil {
+				return nil, err
+			}
+			resultResp.Todo = todoResp
+		}
+		resp.Results = append(resp.Results, resultResp)
+	}
+	return resp, nil
+}
+
+// newBulkTodoErrorResponse returns the error code and message reported for a todo a bulk operation could not change.
+func newBulkTodoErrorResponse(err error) *api.ErrorResponse {
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		return NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound))
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return NewErrorResponse("forbidden", http.StatusText(http.StatusForbidden))
+	}
+	return NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError))
+}
+
+// newBulkMode returns the domain mode of a bulk request, which defaults to all-or-nothing.
+func newBulkMode(mode api.BulkMode) domain.BulkMode {
+	if mode == "" {
+		return domain.BulkModeAllOrNothing
+	}
+	return domain.BulkMode(mode)
+}
+
+// writeBulkTodosError writes the response of a bulk operation that failed as a whole.
+// An all-or-nothing operation that failed on one todo is reported with the error of that todo.
+func writeBulkTodosError(c *gin.Context, logger *slog.Logger, err error) {
+	ctx := c.Request.Context()
+	var bulkErr *domain.BulkTodoError
+	if errors.As(err, &bulkErr) && errors.Is(bulkErr.Err, domain.ErrTodoNotFound) {
+		logger.WarnContext(ctx, "todo not found", slog.Int("todoId", bulkErr.ID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", fmt.Sprintf("todo %d not found; no todo was changed", bulkErr.ID)))
+		return
+	}
+	if errors.As(err, &bulkErr) && errors.Is(bulkErr.Err, domain.ErrForbidden) {
+		logger.WarnContext(ctx, "todo operation forbidden", slog.Int("todoId", bulkErr.ID))
+		c.JSON(http.StatusForbidden, NewErrorResponse("forbidden", fmt.Sprintf("todo %d cannot be changed; no todo was changed", bulkErr.ID)))
+		return
+	}
+	logger.ErrorContext(ctx, "failed to execute bulk operation", slog.Any("error", err))
+	c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+}
+
+// PatchBulkTodos handles PATCH /todo/bulk and applies the same JSON Merge Patch to several todos of the authenticated user.
+// In allOrNothing mode, the default, a todo that cannot be patched fails the whole request and no todo is changed;
+// in bestEffort mode the response reports the outcome for each todo.
+func (h *TodoHandler) PatchBulkTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "PatchBulkTodos called", slog.Int("userId", userID))
+
+	body, err := c.GetRawData()
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid patch bulk todos request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+	var members struct {
+		Patch map[string]json.RawMessage `json:"patch"`
+	}
+	if err := json.Unmarshal(body, &members); err != nil || members.Patch == nil {
+		h.logger.WarnContext(ctx, "invalid patch bulk todos request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "patch must be a JSON object"))
+		return
+	}
+	if field, ok := findNullPatchTodoMember(members.Patch); ok {
+		h.logger.WarnContext(ctx, "patch removes a required member", slog.String("field", field))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", field+" cannot be null"))
+		return
+	}
+
+	var req api.PatchBulkTodosRequest
+	if err := binding.JSON.BindBody(body, &req); err != nil {
+		h.logger.WarnContext(ctx, "invalid patch bulk todos request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	ids := make([]int, len(req.IDs))
+	for i, id := range req.IDs {
+		ids[i] = int(id)
+	}
+	input, err := domain.NewPatchBulkTodosInput(userID, ids, req.Patch.Text, req.Patch.IsComplete, newBulkMode(req.Mode))
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid patch bulk todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.PatchBulkTodos(ctx, input)
+	if err != nil {
+		writeBulkTodosError(c, h.logger, err)
+		return
+	}
+
+	resp, err := NewBulkTodosResponse(output)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	if input.Text != nil || input.IsComplete != nil {
+		h.setTodoUndo(c, userID, domain.TodoUndoUpdate, newBulkTodoUndoTargets(output))
+	}
+	c.JSON(http.StatusOK, resp)
+}
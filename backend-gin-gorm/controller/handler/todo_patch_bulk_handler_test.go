@@ -0,0 +1,186 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_PatchBulkTodos_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name            string
+		body            string
+		expectedMessage string
+	}{
+		{
+			name:            "no patch",
+			body:            `{"ids": [1, 2]}`,
+			expectedMessage: "patch must be a JSON object",
+		},
+		{
+			name:            "null member",
+			body:            `{"ids": [1, 2], "patch": {"isComplete": null}}`,
+			expectedMessage: "isComplete cannot be null",
+		},
+		{
+			name:            "no ids",
+			body:            `{"ids": [], "patch": {"isComplete": true}}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "duplicate ids",
+			body:            `{"ids": [1, 1], "patch": {"isComplete": true}}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "non-positive id",
+			body:            `{"ids": [0], "patch": {"isComplete": true}}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "unknown mode",
+			body:            `{"ids": [1], "mode": "sometimes", "patch": {"isComplete": true}}`,
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "empty text",
+			body:            `{"ids": [1], "patch": {"text": ""}}`,
+			expectedMessage: "request body is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/bulk", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", tt.expectedMessage)
+		})
+	}
+}
+
+func Test_TodoHandler_PatchBulkTodos_shouldReturn200_whenAllTodosPatched(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := true
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchBulkTodos(mock.Anything, &domain.PatchBulkTodosInput{
+		UserID:     userID,
+		IDs:        []int{1, 2},
+		IsComplete: &isComplete,
+		Mode:       domain.BulkModeAllOrNothing,
+	}).Return(&domain.BulkTodosOutput{
+		Results: []domain.BulkTodoResult{
+			{ID: 1, Todo: &domain.Todo{ID: 1, UserID: userID, Text: "task 1", IsComplete: true}},
+			{ID: 2, Todo: &domain.Todo{ID: 2, UserID: userID, Text: "task 2", IsComplete: true}},
+		},
+	}, nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [1, 2], "patch": {"isComplete": true}}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, parseExpr(t, "$.results[*].id").Get(jsonObj))
+	assert.Equal(t, []interface{}{true, true}, parseExpr(t, "$.results[*].todo.isComplete").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[*].error").Get(jsonObj), "no result should have an error")
+}
+
+func Test_TodoHandler_PatchBulkTodos_shouldReturn404_whenAllOrNothingFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := true
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchBulkTodos(mock.Anything, &domain.PatchBulkTodosInput{
+		UserID:     userID,
+		IDs:        []int{1, 2},
+		IsComplete: &isComplete,
+		Mode:       domain.BulkModeAllOrNothing,
+	}).Return(nil, &domain.BulkTodoError{ID: 2, Err: domain.ErrTodoNotFound}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [1, 2], "mode": "allOrNothing", "patch": {"isComplete": true}}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "todo 2 not found; no todo was changed")
+}
+
+func Test_TodoHandler_PatchBulkTodos_shouldReportEachTodo_whenBestEffort(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	text := "renamed"
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchBulkTodos(mock.Anything, &domain.PatchBulkTodosInput{
+		UserID: userID,
+		IDs:    []int{1, 2, 3},
+		Text:   &text,
+		Mode:   domain.BulkModeBestEffort,
+	}).Return(&domain.BulkTodosOutput{
+		Results: []domain.BulkTodoResult{
+			{ID: 1, Todo: &domain.Todo{ID: 1, UserID: userID, Text: "renamed"}},
+			{ID: 2, Err: domain.ErrTodoNotFound},
+			{ID: 3, Err: assert.AnError},
+		},
+	}, nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [1, 2, 3], "mode": "bestEffort", "patch": {"text": "renamed"}}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []interface{}{"renamed"}, parseExpr(t, "$.results[0].todo.text").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[0].error").Get(jsonObj), "the patched todo should have no error")
+	assert.Equal(t, []interface{}{"todo_not_found"}, parseExpr(t, "$.results[1].error.code").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[1].todo").Get(jsonObj), "a failed todo should have no todo")
+	assert.Equal(t, []interface{}{"internal_server_error"}, parseExpr(t, "$.results[2].error.code").Get(jsonObj))
+}
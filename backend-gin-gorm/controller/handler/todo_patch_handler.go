@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// patchTodoFields are the members of a todo a merge patch can change.
+var patchTodoFields = []string{"text", "isComplete"}
+
+// PatchTodo handles PATCH /todo/:id and applies a JSON Merge Patch (RFC 7396) to a todo of the authenticated user.
+// Members absent from the patch are left untouched. Neither member can be removed, so null values are rejected.
+// The patch is conditional on the version in the If-Match header, if any, and answered with 412 and the current todo on mismatch.
+func (h *TodoHandler) PatchTodo(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "PatchTodo called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	// A merge patch must be an object; a patch of any other type would replace the whole todo
+	body, err := c.GetRawData()
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid patch todo request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body must be a JSON object"))
+		return
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		h.logger.WarnContext(ctx, "invalid patch todo request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body must be a JSON object"))
+		return
+	}
+	if field, ok := findNullPatchTodoMember(members); ok {
+		h.logger.WarnContext(ctx, "patch removes a required member", slog.String("field", field))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", field+" cannot be null"))
+		return
+	}
+
+	var req api.PatchTodoRequest
+	if err := binding.JSON.BindBody(body, &req); err != nil {
+		h.logger.WarnContext(ctx, "invalid patch todo request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	input, err := domain.NewPatchTodoInput(todoID, userID, req.Text, req.IsComplete, getIfMatchVersion(c))
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid patch todo input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.PatchTodo(ctx, input)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		h.logger.WarnContext(ctx, "todo not found", slog.Int("todoId", todoID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if writeTodoForbidden(c, h.logger, err) {
+		return
+	}
+	if writeTodoVersionMismatch(c, h.logger, err) {
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to patch todo", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewUpdateTodoResponse(output.Todo)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	setTodoETag(c, output.Todo)
+	// A patch without fields changes nothing that could be undone
+	if input.Text != nil || input.IsComplete != nil {
+		h.setTodoUndo(c, userID, domain.TodoUndoUpdate, []domain.TodoUndoTarget{newTodoUndoTarget(output.Todo)})
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// findNullPatchTodoMember returns the first member of a todo merge patch that is null, which would remove it.
+func findNullPatchTodoMember(members map[string]json.RawMessage) (string, bool) {
+	for _, field := range patchTodoFields {
+		if value, ok := members[field]; ok && string(value) == "null" {
+			return field, true
+		}
+	}
+	return "", false
+}
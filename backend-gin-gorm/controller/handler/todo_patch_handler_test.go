@@ -0,0 +1,302 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_PatchTodo_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	tests := []struct {
+		name            string
+		body            io.Reader
+		expectedMessage string
+	}{
+		{
+			name:            "nil request",
+			body:            nil,
+			expectedMessage: "request body must be a JSON object",
+		},
+		{
+			name:            "array patch",
+			body:            bytes.NewBufferString(`[{"text": "task 1"}]`),
+			expectedMessage: "request body must be a JSON object",
+		},
+		{
+			name:            "null patch",
+			body:            bytes.NewBufferString(`null`),
+			expectedMessage: "request body must be a JSON object",
+		},
+		{
+			name:            "null text",
+			body:            bytes.NewBufferString(`{"text": null}`),
+			expectedMessage: "text cannot be null",
+		},
+		{
+			name:            "null isComplete",
+			body:            bytes.NewBufferString(`{"text": "task 1", "isComplete": null}`),
+			expectedMessage: "isComplete cannot be null",
+		},
+		{
+			name:            "empty text",
+			body:            bytes.NewBufferString(`{"text": ""}`),
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "251 characters text",
+			body:            bytes.NewBufferString(`{"text": "` + strings.Repeat("a", 251) + `"}`),
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "string isComplete",
+			body:            bytes.NewBufferString(`{"isComplete": "true"}`),
+			expectedMessage: "request body is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/1", tt.body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_request", tt.expectedMessage)
+		})
+	}
+}
+
+func Test_TodoHandler_PatchTodo_shouldReturn400_whenInvalidPath(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/invalid", bytes.NewBufferString(`{"isComplete": true}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_todo_id", "todo id must be a positive integer")
+}
+
+func Test_TodoHandler_PatchTodo_shouldReturn404_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := true
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchTodo(mock.Anything, &domain.PatchTodoInput{
+		ID:         1,
+		UserID:     userID,
+		Text:       nil,
+		IsComplete: &isComplete,
+	}).Return(nil, domain.ErrTodoNotFound).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/1", bytes.NewBufferString(`{"isComplete": true}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
+}
+
+func Test_TodoHandler_PatchTodo_shouldLeaveAbsentFieldsUntouched(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	text := "task 1"
+	isComplete := true
+	tests := []struct {
+		name               string
+		body               io.Reader
+		expectedText       *string
+		expectedIsComplete *bool
+	}{
+		{
+			name:               "isComplete only",
+			body:               bytes.NewBufferString(`{"isComplete": true}`),
+			expectedText:       nil,
+			expectedIsComplete: &isComplete,
+		},
+		{
+			name:               "text only",
+			body:               bytes.NewBufferString(`{"text": "task 1"}`),
+			expectedText:       &text,
+			expectedIsComplete: nil,
+		},
+		{
+			name:               "both fields",
+			body:               bytes.NewBufferString(`{"text": "task 1", "isComplete": true}`),
+			expectedText:       &text,
+			expectedIsComplete: &isComplete,
+		},
+		{
+			name:               "empty patch",
+			body:               bytes.NewBufferString(`{}`),
+			expectedText:       nil,
+			expectedIsComplete: nil,
+		},
+		{
+			name:               "unknown member",
+			body:               bytes.NewBufferString(`{"priority": null}`),
+			expectedText:       nil,
+			expectedIsComplete: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			todoUsecase.EXPECT().PatchTodo(mock.Anything, &domain.PatchTodoInput{
+				ID:         1,
+				UserID:     userID,
+				Text:       tt.expectedText,
+				IsComplete: tt.expectedIsComplete,
+			}).Return(&domain.UpdateTodoOutput{
+				Todo: &domain.Todo{
+					ID:         1,
+					Text:       "task 1",
+					IsComplete: true,
+				},
+			}, nil).Once()
+			// Only a patch with fields is undoable
+			if tt.expectedText != nil || tt.expectedIsComplete != nil {
+				todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			}
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/1", tt.body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+			jsonObj := parseJSON(t, respBytes)
+
+			// - text
+			textExpr := parseExpr(t, "$.text")
+			respText := textExpr.Get(jsonObj)
+			require.Len(t, respText, 1, "response should have one text")
+			assert.Equal(t, "task 1", respText[0])
+
+			// - isComplete
+			isCompleteExpr := parseExpr(t, "$.isComplete")
+			respIsComplete := isCompleteExpr.Get(jsonObj)
+			require.Len(t, respIsComplete, 1, "response should have one isComplete")
+			assert.Equal(t, true, respIsComplete[0])
+		})
+	}
+}
+
+func Test_TodoHandler_PatchTodo_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := true
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchTodo(mock.Anything, &domain.PatchTodoInput{
+		ID:         1,
+		UserID:     userID,
+		Text:       nil,
+		IsComplete: &isComplete,
+	}).Return(nil, assert.AnError).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/1", bytes.NewBufferString(`{"isComplete": true}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
+
+func Test_TodoHandler_PatchTodo_shouldReturn412_whenVersionMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	version := 1
+	isComplete := true
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchTodo(mock.Anything, &domain.PatchTodoInput{
+		ID:              1,
+		UserID:          userID,
+		Text:            nil,
+		IsComplete:      &isComplete,
+		ExpectedVersion: &version,
+	}).Return(nil, &domain.TodoVersionMismatchError{
+		Current: &domain.Todo{
+			ID:      1,
+			UserID:  userID,
+			Text:    "task 1",
+			Version: 2,
+		},
+	}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/1", bytes.NewBufferString(`{"isComplete": true}`))
+	require.NoError(t, err)
+	req.Header.Set("If-Match", `"1"`)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "status code should be 412")
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"), "ETag should be the current version")
+	isCompleteValues := parseExpr(t, "$.isComplete").Get(parseJSON(t, respBytes))
+	require.Len(t, isCompleteValues, 1, "response should have one isComplete")
+	assert.Equal(t, false, isCompleteValues[0], "response should hold the current todo")
+}
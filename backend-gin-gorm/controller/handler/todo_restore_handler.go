@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// RestoreTodo handles POST /todo/:id/restore and moves a todo out of the authenticated user's trash.
+func (h *TodoHandler) RestoreTodo(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "RestoreTodo called", slog.Int("userId", userID), slog.Int("todoId", todoID))
+
+	input, err := domain.NewRestoreTodoInput(todoID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid restore todo input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	output, err := h.usecase.RestoreTodo(ctx, input)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		h.logger.WarnContext(ctx, "todo not found in trash", slog.Int("todoId", todoID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if writeTodoForbidden(c, h.logger, err) {
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to restore todo", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewFindTodoResponseTodo(output.Todo)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	setTodoETag(c, output.Todo)
+	c.JSON(http.StatusOK, resp)
+}
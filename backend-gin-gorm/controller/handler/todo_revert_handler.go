@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// RevertTodo handles POST /todo/:id/revert/:revision and sets the text and completion of a todo of the authenticated user
+// back to their values at the revision.
+// The revert is conditional on the version in the If-Match header, if any, and answered with 412 and the current todo on mismatch.
+func (h *TodoHandler) RevertTodo(c *gin.Context) {
+	ctx := c.Request.Context()
+	todoID, ok := getTodoIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+	revision, ok := getPositiveIntFromPath(c, h.logger, "revision", "invalid_revision", "revision must be a positive integer")
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "RevertTodo called", slog.Int("userId", userID), slog.Int("todoId", todoID), slog.Int("revision", revision))
+
+	input, err := domain.NewRevertTodoInput(todoID, userID, revision, getIfMatchVersion(c))
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid revert todo input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	output, err := h.usecase.RevertTodo(ctx, input)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		h.logger.WarnContext(ctx, "todo not found", slog.Int("todoId", todoID))
+		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if writeTodoForbidden(c, h.logger, err) {
+		return
+	}
+	if errors.Is(err, domain.ErrTodoRevisionNotFound) {
+		h.logger.WarnContext(ctx, "todo revision not found", slog.Int("todoId", todoID), slog.Int("revision", revision))
+		c.JSON(http.StatusNotFound, NewErrorResponse("revision_not_found", http.StatusText(http.StatusNotFound)))
+		return
+	}
+	if writeTodoVersionMismatch(c, h.logger, err) {
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to revert todo", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewFindTodoResponseTodo(output.Todo)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	setTodoETag(c, output.Todo)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TodoHandler_RevertTodo_shouldReturn400_whenInvalidRevision(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/revert/0", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_revision", "revision must be a positive integer")
+}
+
+func Test_TodoHandler_RevertTodo_shouldReturn404_whenRevisionNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().RevertTodo(mock.Anything, &domain.RevertTodoInput{
+		TodoID:   1,
+		UserID:   userID,
+		Revision: 9,
+	}).Return(nil, domain.ErrTodoRevisionNotFound).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/revert/9", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "revision_not_found", "Not Found")
+}
+
+func Test_TodoHandler_RevertTodo_shouldReturn412_whenVersionMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	version := 3
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().RevertTodo(mock.Anything, &domain.RevertTodoInput{
+		TodoID:          1,
+		UserID:          userID,
+		Revision:        1,
+		ExpectedVersion: &version,
+	}).Return(nil, &domain.TodoVersionMismatchError{
+		Current: &domain.Todo{
+			ID:      1,
+			UserID:  userID,
+			Text:    "edited elsewhere",
+			Version: 5,
+		},
+	}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/revert/1", nil)
+	require.NoError(t, err)
+	req.Header.Set("If-Match", `"3"`)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "status code should be 412")
+	assert.Equal(t, `"5"`, w.Header().Get("ETag"), "ETag should be the current version")
+}
+
+func Test_TodoHandler_RevertTodo_shouldReturn200_whenTodoReverted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().RevertTodo(mock.Anything, &domain.RevertTodoInput{
+		TodoID:   1,
+		UserID:   userID,
+		Revision: 1,
+	}).Return(&domain.RevertTodoOutput{
+		Todo: &domain.Todo{
+			ID:      1,
+			UserID:  userID,
+			Text:    "original task",
+			Version: 4,
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/todo/1/revert/1", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"), "ETag should be the new version")
+
+	jsonObj := parseJSON(t, respBytes)
+	text := parseExpr(t, "$.text").Get(jsonObj)
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "original task", text[0])
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewSearchTodosResponse converts domain search hits to a SearchTodosResponse API type.
+func NewSearchTodosResponse(hits []domain.TodoSearchHit) (*api.SearchTodosResponse, error) {
+	resp := &api.SearchTodosResponse{
+		Hits: make([]api.SearchTodoHitResponse, 0, len(hits)),
+	}
+	for _, hit := range hits {
+		todoResp, err := NewFindTodoResponseTodo(&hit.Todo)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo: %w", err)
+		}
+		snippets := make([]api.SearchSnippetResponse, 0, len(hit.Snippets))
+		for _, snippet := range hit.Snippets {
+			fragments := make([]api.SearchSnippetFragmentResponse, 0, len(snippet.Fragments))
+			for _, fragment := range snippet.Fragments {
+				fragments = append(fragments, api.SearchSnippetFragmentResponse{
+					Text:        fragment.Text,
+					Highlighted: fragment.Highlighted,
+				})
+			}
+			snippets = append(snippets, api.SearchSnippetResponse{
+				Field:     api.SearchSnippetResponseField(snippet.Field),
+				Fragments: fragments,
+			})
+		}
+		resp.Hits = append(resp.Hits, api.SearchTodoHitResponse{
+			Todo:     *todoResp,
+			Score:    hit.Score,
+			Snippets: snippets,
+		})
+	}
+	return resp, nil
+}
+
+// SearchTodos handles GET /todo/search and returns the authenticated user's todos matching the "q" query parameter,
+// most relevant first, with snippets highlighting the matched terms.
+func (h *TodoHandler) SearchTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+
+	h.logger.InfoContext(ctx, "SearchTodos called", slog.Int("userId", userID))
+
+	limit := domain.DefaultTodoSearchLimit
+	if limitS, ok := c.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limitS)
+		if err != nil || v < 1 || v > domain.MaxTodoPageLimit {
+			h.logger.WarnContext(ctx, "invalid limit", slog.String("limit", limitS))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_limit", fmt.Sprintf("limit must be an integer between 1 and %d", domain.MaxTodoPageLimit)))
+			return
+		}
+		limit = v
+	}
+
+	input, err := domain.NewSearchTodosInput(userID, c.Query("q"), limit)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid search query", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_query", fmt.Sprintf("q must contain between 1 and %d terms and at most 255 characters", domain.MaxTodoSearchTerms)))
+		return
+	}
+
+	hits, err := h.usecase.SearchTodos(ctx, input)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to search todos", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp, err := NewSearchTodosResponse(hits)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create search todos response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
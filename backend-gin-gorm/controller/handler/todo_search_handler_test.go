@@ -0,0 +1,173 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_TodoHandler_SearchTodos_shouldReturn200WithHighlightedSnippets_whenTodosMatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().SearchTodos(mock.Anything, &domain.SearchTodosInput{
+		UserID: userID,
+		Query:  "請求書 send",
+		Terms:  []string{"請求書", "send"},
+		Limit:  domain.DefaultTodoSearchLimit,
+	}).Return([]domain.TodoSearchHit{
+		{
+			Todo:  domain.Todo{ID: 1, Text: "請求書を send する"},
+			Score: 1.5,
+			Snippets: []domain.TodoSearchSnippet{
+				{
+					Field: domain.TodoSearchFieldText,
+					Fragments: []domain.SnippetFragment{
+						{Text: "請求書", Highlighted: true},
+						{Text: "を ", Highlighted: false},
+						{Text: "send", Highlighted: true},
+						{Text: " する", Highlighted: false},
+					},
+				},
+			},
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/search?q="+url.QueryEscape("請求書 send"), nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - todo
+	ids := parseExpr(t, "$.hits[*].todo.id").Get(jsonObj)
+	assert.Equal(t, []any{int64(1)}, ids)
+
+	// - score
+	scores := parseExpr(t, "$.hits[*].score").Get(jsonObj)
+	assert.Equal(t, []any{1.5}, scores)
+
+	// - snippet
+	fields := parseExpr(t, "$.hits[0].snippets[*].field").Get(jsonObj)
+	assert.Equal(t, []any{"text"}, fields)
+	highlighted := parseExpr(t, "$.hits[0].snippets[0].fragments[?(@.highlighted==true)].text").Get(jsonObj)
+	assert.Equal(t, []any{"請求書", "send"}, highlighted)
+}
+
+func Test_TodoHandler_SearchTodos_shouldReturnEmptyHits_whenNothingMatches(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().SearchTodos(mock.Anything, mock.Anything).Return([]domain.TodoSearchHit{}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/search?q=invoice&limit=5", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	hits := parseExpr(t, "$.hits").Get(parseJSON(t, respBytes))
+	require.Len(t, hits, 1, "response should have hits")
+	assert.Equal(t, []any{}, hits[0])
+}
+
+func Test_TodoHandler_SearchTodos_shouldReturn400_whenInvalidQueryParameters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+
+	tests := []struct {
+		name            string
+		query           string
+		expectedCode    string
+		expectedMessage string
+	}{
+		{
+			name:            "missing q",
+			query:           "",
+			expectedCode:    "invalid_query",
+			expectedMessage: "q must contain between 1 and 10 terms and at most 255 characters",
+		},
+		{
+			name:            "blank q",
+			query:           "?q=%20%20",
+			expectedCode:    "invalid_query",
+			expectedMessage: "q must contain between 1 and 10 terms and at most 255 characters",
+		},
+		{
+			name:            "limit exceeds maximum",
+			query:           "?q=invoice&limit=101",
+			expectedCode:    "invalid_limit",
+			expectedMessage: "limit must be an integer between 1 and 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			todoUsecase := NewMockTodoUsecase(t)
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/search"+tt.query, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, tt.expectedCode, tt.expectedMessage)
+		})
+	}
+}
+
+func Test_TodoHandler_SearchTodos_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().SearchTodos(mock.Anything, mock.Anything).Return(nil, errors.New("database error")).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/todo/search?q=invoice", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// setTodoUndo issues a token for undoing a successful write by the user to the targets and sets the Undo-Token
+// and Undo-Expires response headers to it. The write has already been made, so a token that cannot be issued
+// is logged and leaves the headers unset, as does a write that left nothing to undo.
+func (h *TodoHandler) setTodoUndo(c *gin.Context, userID int, action domain.TodoUndoAction, targets []domain.TodoUndoTarget) {
+	ctx := c.Request.Context()
+	if len(targets) == 0 {
+		return
+	}
+	input, err := domain.NewCreateTodoUndoInput(userID, action, targets)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "invalid create todo undo input", slog.Any("error", err))
+		return
+	}
+
+	undo, err := h.usecase.CreateTodoUndo(ctx, input)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create todo undo", slog.Any("error", err))
+		return
+	}
+	if undo == nil {
+		return
+	}
+	c.Header("Undo-Token", undo.Token)
+	c.Header("Undo-Expires", undo.ExpiresAt.UTC().Format(http.TimeFormat))
+}
+
+// newTodoUndoTarget returns the undo target of a todo as an update left it.
+func newTodoUndoTarget(todo *domain.Todo) domain.TodoUndoTarget {
+	version := todo.Version
+	return domain.TodoUndoTarget{TodoID: todo.ID, Version: &version}
+}
+
+// newBulkTodoUndoTargets returns the undo targets of the todos a bulk operation changed.
+func newBulkTodoUndoTargets(output *domain.BulkTodosOutput) []domain.TodoUndoTarget {
+	targets := make([]domain.TodoUndoTarget, 0, len(output.Results))
+	for _, result := range output.Results {
+		if result.Err != nil {
+			continue
+		}
+		if result.Todo != nil {
+			targets = append(targets, newTodoUndoTarget(result.Todo))
+		} else {
+			targets = append(targets, domain.TodoUndoTarget{TodoID: result.ID, Version: nil})
+		}
+	}
+	return targets
+}
+
+// UndoTodos handles POST /undo/:token and reverses the update or delete an undo token of the authenticated user was issued for.
+// The undo is refused with 409 and nothing changed if a todo changed since the write.
+func (h *TodoHandler) UndoTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "UndoTodos called", slog.Int("userId", userID))
+
+	input, err := domain.NewUndoTodosInput(userID, c.Param("token"))
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid undo todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_undo_token", "undo token is malformed"))
+		return
+	}
+
+	output, err := h.usecase.UndoTodos(ctx, input)
+	if errors.Is(err, domain.ErrTodoUndoNotFound) {
+		h.logger.WarnContext(ctx, "todo undo not found")
+		c.JSON(http.StatusNotFound, NewErrorResponse("undo_not_found", "undo token is unknown, expired or already used"))
+		return
+	}
+	if errors.Is(err, domain.ErrTodoUndoConflict) {
+		h.logger.WarnContext(ctx, "todo changed since the write to undo", slog.Any("error", err))
+		c.JSON(http.StatusConflict, NewErrorResponse("todo_changed", "a todo changed after the write the token undoes"))
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to undo todos", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	resp := api.UndoTodosResponse{
+		Todos: make([]api.FindTodoResponseTodo, 0, len(output.Todos)),
+	}
+	for _, todo := range output.Todos {
+		todoResp, err := NewFindTodoResponseTodo(&todo)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+			return
+		}
+		resp.Todos = append(resp.Todos, *todoResp)
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,215 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testUndoToken = "0123456789abcdef0123456789abcdef"
+
+func Test_TodoHandler_DeleteTodo_shouldSetUndoHeaders_whenUndoIssued(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	expiresAt := time.Date(2025, 1, 1, 12, 0, 30, 0, time.UTC)
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteTodo(mock.Anything, &domain.DeleteTodoInput{
+		UserID: userID,
+		ID:     1,
+	}).Return(nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, &domain.CreateTodoUndoInput{
+		UserID:  userID,
+		Action:  domain.TodoUndoDelete,
+		Targets: []domain.TodoUndoTarget{{TodoID: 1}},
+	}).Return(&domain.TodoUndo{Token: testUndoToken, ExpiresAt: expiresAt}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+	assert.Equal(t, testUndoToken, w.Header().Get("Undo-Token"))
+	assert.Equal(t, "Wed, 01 Jan 2025 12:00:30 GMT", w.Header().Get("Undo-Expires"))
+}
+
+func Test_TodoHandler_DeleteTodo_shouldSucceedWithoutUndoHeaders_whenUndoFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().DeleteTodo(mock.Anything, &domain.DeleteTodoInput{
+		UserID: userID,
+		ID:     1,
+	}).Return(nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, errors.New("connection reset")).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/todo/1", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+	assert.Empty(t, w.Header().Get("Undo-Token"))
+}
+
+func Test_TodoHandler_PatchBulkTodos_shouldIssueUndoForPatchedTodosOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	isComplete := true
+	version := 2
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().PatchBulkTodos(mock.Anything, &domain.PatchBulkTodosInput{
+		UserID:     userID,
+		IDs:        []int{4, 5},
+		IsComplete: &isComplete,
+		Mode:       domain.BulkModeBestEffort,
+	}).Return(&domain.BulkTodosOutput{
+		Results: []domain.BulkTodoResult{
+			{ID: 4, Todo: &domain.Todo{ID: 4, UserID: userID, Text: "task 4", IsComplete: true, Version: version}},
+			{ID: 5, Err: domain.ErrTodoNotFound},
+		},
+	}, nil).Once()
+	todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, &domain.CreateTodoUndoInput{
+		UserID:  userID,
+		Action:  domain.TodoUndoUpdate,
+		Targets: []domain.TodoUndoTarget{{TodoID: 4, Version: &version}},
+	}).Return(&domain.TodoUndo{Token: testUndoToken, ExpiresAt: time.Now().Add(30 * time.Second)}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, "/api/v1/todo/bulk", bytes.NewBufferString(`{"ids": [4, 5], "patch": {"isComplete": true}, "mode": "bestEffort"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.Equal(t, testUndoToken, w.Header().Get("Undo-Token"))
+}
+
+func Test_TodoHandler_UndoTodos_shouldReturn400_whenTokenMalformed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/undo/not-a-token", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_undo_token", "undo token is malformed")
+}
+
+func Test_TodoHandler_UndoTodos_shouldReturn404_whenTokenNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().UndoTodos(mock.Anything, &domain.UndoTodosInput{
+		UserID: userID,
+		Token:  testUndoToken,
+	}).Return(nil, domain.ErrTodoUndoNotFound).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/undo/"+testUndoToken, nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "undo_not_found", "undo token is unknown, expired or already used")
+}
+
+func Test_TodoHandler_UndoTodos_shouldReturn409_whenTodoChanged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().UndoTodos(mock.Anything, &domain.UndoTodosInput{
+		UserID: userID,
+		Token:  testUndoToken,
+	}).Return(nil, fmt.Errorf("todo 1: %w", domain.ErrTodoUndoConflict)).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/undo/"+testUndoToken, nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusConflict, w.Code, "status code should be 409")
+	validateErrorResponse(t, respBytes, "todo_changed", "a todo changed after the write the token undoes")
+}
+
+func Test_TodoHandler_UndoTodos_shouldReturn200_whenUndone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().UndoTodos(mock.Anything, &domain.UndoTodosInput{
+		UserID: userID,
+		Token:  testUndoToken,
+	}).Return(&domain.UndoTodosOutput{
+		Todos: []domain.Todo{
+			{ID: 1, UserID: userID, Text: "task 1", Version: 3},
+			{ID: 2, UserID: userID, Text: "task 2", Version: 5},
+		},
+	}, nil).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/undo/"+testUndoToken, nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	jsonObj := parseJSON(t, respBytes)
+	assert.Equal(t, []interface{}{"task 1", "task 2"}, parseExpr(t, "$.todos[*].text").Get(jsonObj))
+}
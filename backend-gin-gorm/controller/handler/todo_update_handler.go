@@ -32,6 +32,7 @@ func NewUpdateTodoResponse(todo *domain.Todo) (*api.UpdateTodoResponse, error) {
 }
 
 // UpdateTodo handles PUT /todo/:id and updates an existing todo for the authenticated user.
+// The update is conditional on the version in the If-Match header, if any, and answered with 412 and the current todo on mismatch.
 func (h *TodoHandler) UpdateTodo(c *gin.Context) {
 	ctx := c.Request.Context()
 	todoID, err := GetIntFromPath(c, "id")
@@ -61,7 +62,7 @@ func (h *TodoHandler) UpdateTodo(c *gin.Context) {
 		return
 	}
 
-	input, err := domain.NewUpdateTodoInput(todoID, userID, req.Text, req.IsComplete)
+	input, err := domain.NewUpdateTodoInput(todoID, userID, req.Text, req.IsComplete, getIfMatchVersion(c))
 	if err != nil {
 		h.logger.WarnContext(ctx, "invalid update todo input", slog.Any("error", err))
 		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
@@ -74,6 +75,12 @@ func (h *TodoHandler) UpdateTodo(c *gin.Context) {
 		c.JSON(http.StatusNotFound, NewErrorResponse("todo_not_found", http.StatusText(http.StatusNotFound)))
 		return
 	}
+	if writeTodoForbidden(c, h.logger, err) {
+		return
+	}
+	if writeTodoVersionMismatch(c, h.logger, err) {
+		return
+	}
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to update todo", slog.Any("error", err))
 		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
@@ -86,5 +93,7 @@ func (h *TodoHandler) UpdateTodo(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
 		return
 	}
+	setTodoETag(c, output.Todo)
+	h.setTodoUndo(c, userID, domain.TodoUndoUpdate, []domain.TodoUndoTarget{newTodoUndoTarget(output.Todo)})
 	c.JSON(http.StatusOK, resp)
 }
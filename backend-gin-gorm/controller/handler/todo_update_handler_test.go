@@ -119,6 +119,28 @@ func Test_TodoHandler_UpdateTodo_shouldReturn404_whenTodoNotFound(t *testing.T)
 	validateErrorResponse(t, respBytes, "todo_not_found", "Not Found")
 }
 
+func Test_TodoHandler_UpdateTodo_shouldReturn403_whenTodoIsSharedWithViewer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().UpdateTodo(mock.Anything, mock.Anything).Return(nil, domain.ErrForbidden).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1", bytes.NewBufferString(`{"text": "task 1"}`))
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusForbidden, w.Code, "status code should be 403")
+	validateErrorResponse(t, respBytes, "forbidden", "Forbidden")
+}
+
 func Test_TodoHandler_UpdateTodo_shouldReturn200_whenValidRequest(t *testing.T) {
 	t.Parallel()
 
@@ -191,6 +213,7 @@ func Test_TodoHandler_UpdateTodo_shouldReturn200_whenValidRequest(t *testing.T)
 					IsComplete: tt.expectedIsComplete,
 				},
 			}, nil).Once()
+			todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
 			r := initTodoRouter(t, ctx, todoUsecase, userID)
 			w := httptest.NewRecorder()
 
@@ -279,3 +302,124 @@ func Test_TodoHandler_UpdateTodo_shouldReturn500_whenUsecaseReturnsInvalidTodo(t
 	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
 	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
 }
+
+func Test_TodoHandler_UpdateTodo_shouldPassIfMatchVersion(t *testing.T) {
+	t.Parallel()
+
+	// given
+	userID := randomUserID()
+	version := 3
+	neverMatches := 0
+	tests := []struct {
+		name            string
+		ifMatch         string
+		expectedVersion *int
+	}{
+		{
+			name:            "no If-Match",
+			ifMatch:         "",
+			expectedVersion: nil,
+		},
+		{
+			name:            "any version",
+			ifMatch:         "*",
+			expectedVersion: nil,
+		},
+		{
+			name:            "entity tag",
+			ifMatch:         `"3"`,
+			expectedVersion: &version,
+		},
+		{
+			name:            "weak entity tag",
+			ifMatch:         `W/"3"`,
+			expectedVersion: &neverMatches,
+		},
+		{
+			name:            "unknown entity tag",
+			ifMatch:         `"abc"`,
+			expectedVersion: &neverMatches,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			todoUsecase := NewMockTodoUsecase(t)
+			todoUsecase.EXPECT().UpdateTodo(mock.Anything, &domain.UpdateTodoInput{
+				ID:              1,
+				UserID:          userID,
+				Text:            "task 1",
+				IsComplete:      false,
+				ExpectedVersion: tt.expectedVersion,
+			}).Return(&domain.UpdateTodoOutput{
+				Todo: &domain.Todo{
+					ID:      1,
+					Text:    "task 1",
+					Version: 4,
+				},
+			}, nil).Once()
+			todoUsecase.EXPECT().CreateTodoUndo(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			r := initTodoRouter(t, ctx, todoUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1", bytes.NewBufferString(`{"text": "task 1"}`))
+			require.NoError(t, err)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+			assert.Equal(t, `"4"`, w.Header().Get("ETag"), "ETag should be the new version")
+		})
+	}
+}
+
+func Test_TodoHandler_UpdateTodo_shouldReturn412_whenVersionMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	version := 3
+	todoUsecase := NewMockTodoUsecase(t)
+	todoUsecase.EXPECT().UpdateTodo(mock.Anything, &domain.UpdateTodoInput{
+		ID:              1,
+		UserID:          userID,
+		Text:            "task 1",
+		IsComplete:      false,
+		ExpectedVersion: &version,
+	}).Return(nil, &domain.TodoVersionMismatchError{
+		Current: &domain.Todo{
+			ID:         1,
+			UserID:     userID,
+			Text:       "edited elsewhere",
+			IsComplete: true,
+			Version:    5,
+		},
+	}).Once()
+	r := initTodoRouter(t, ctx, todoUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/todo/1", bytes.NewBufferString(`{"text": "task 1"}`))
+	require.NoError(t, err)
+	req.Header.Set("If-Match", `"3"`)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "status code should be 412")
+	assert.Equal(t, `"5"`, w.Header().Get("ETag"), "ETag should be the current version")
+
+	jsonObj := parseJSON(t, respBytes)
+	text := parseExpr(t, "$.text").Get(jsonObj)
+	require.Len(t, text, 1, "response should have one text")
+	assert.Equal(t, "edited elsewhere", text[0], "response should hold the current todo")
+	isComplete := parseExpr(t, "$.isComplete").Get(jsonObj)
+	require.Len(t, isComplete, 1, "response should have one isComplete")
+	assert.Equal(t, true, isComplete[0], "response should hold the current todo")
+}
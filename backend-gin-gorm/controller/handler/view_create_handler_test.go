@@ -0,0 +1,205 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_ViewHandler_CreateView_shouldReturn201_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	sort := domain.TodoSort{Field: domain.TodoSortFieldUpdatedAt, Direction: domain.SortDirectionDesc}
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().CreateView(mock.Anything, &domain.CreateViewInput{
+		UserID: userID,
+		Name:   "This week",
+		Filter: "is:open created<7d",
+		Sort:   sort,
+	}).Return(&domain.CreateViewOutput{
+		View: &domain.View{
+			ID:        3,
+			UserID:    userID,
+			Name:      "This week",
+			Filter:    "is:open created<7d",
+			Sort:      sort,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}, nil).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"name":"This week","filter":"is:open created<7d","sort":"updatedAt","order":"desc"}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/views", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - id
+	id := parseExpr(t, "$.id").Get(jsonObj)
+	assert.Equal(t, []any{int64(3)}, id)
+
+	// - sort and order
+	sortField := parseExpr(t, "$.sort").Get(jsonObj)
+	assert.Equal(t, []any{"updatedAt"}, sortField)
+	order := parseExpr(t, "$.order").Get(jsonObj)
+	assert.Equal(t, []any{"desc"}, order)
+}
+
+func Test_ViewHandler_CreateView_shouldUseDefaultSort_whenSortIsOmitted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().CreateView(mock.Anything, &domain.CreateViewInput{
+		UserID: userID,
+		Name:   "Everything",
+		Filter: "",
+		Sort:   domain.DefaultTodoSort,
+	}).Return(&domain.CreateViewOutput{
+		View: &domain.View{ID: 4, UserID: userID, Name: "Everything", Sort: domain.DefaultTodoSort},
+	}, nil).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/views", bytes.NewBufferString(`{"name":"Everything"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+}
+
+func Test_ViewHandler_CreateView_shouldReturn400_whenInvalidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+
+	tests := []struct {
+		name            string
+		body            string
+		expectedCode    string
+		expectedMessage string
+	}{
+		{
+			name:            "missing name",
+			body:            `{"filter":"is:open"}`,
+			expectedCode:    "invalid_request",
+			expectedMessage: "request body is invalid",
+		},
+		{
+			name:            "unknown sort field",
+			body:            `{"name":"a","sort":"priority"}`,
+			expectedCode:    "invalid_sort",
+			expectedMessage: "sort must be one of id, createdAt, updatedAt, text and order one of asc, desc",
+		},
+		{
+			name:            "malformed filter",
+			body:            `{"name":"a","filter":"is:open text:\"unterminated"}`,
+			expectedCode:    "invalid_filter_query",
+			expectedMessage: "filter: column 14: unterminated quoted value",
+		},
+		{
+			name:            "unsupported filter field",
+			body:            `{"name":"a","filter":"tag:work"}`,
+			expectedCode:    "invalid_filter_query",
+			expectedMessage: `filter: column 1: unknown field "tag"; supported fields are is, text, created and updated`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			viewUsecase := NewMockViewUsecase(t)
+			r := initViewRouter(t, ctx, viewUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/views", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, tt.expectedCode, tt.expectedMessage)
+		})
+	}
+}
+
+func Test_ViewHandler_CreateView_shouldReturn409_whenViewCannotBeAdded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    string
+		expectedMessage string
+	}{
+		{
+			name:            "duplicate name",
+			err:             domain.ErrDuplicateViewName,
+			expectedCode:    "duplicate_view_name",
+			expectedMessage: "a view with the same name already exists",
+		},
+		{
+			name:            "limit exceeded",
+			err:             domain.ErrViewLimitExceeded,
+			expectedCode:    "view_limit_exceeded",
+			expectedMessage: "a user can have at most 50 views",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			viewUsecase := NewMockViewUsecase(t)
+			viewUsecase.EXPECT().CreateView(mock.Anything, mock.Anything).Return(nil, tt.err).Once()
+			r := initViewRouter(t, ctx, viewUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/views", bytes.NewBufferString(`{"name":"Work"}`))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusConflict, w.Code, "status code should be 409")
+			validateErrorResponse(t, respBytes, tt.expectedCode, tt.expectedMessage)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteView handles DELETE /views/:id and removes a view. The todos it matched are not affected.
+func (h *ViewHandler) DeleteView(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID, ok := getViewIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DeleteView called", slog.Int("userId", userID), slog.Int("viewId", viewID))
+
+	input, err := domain.NewDeleteViewInput(viewID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid delete view input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	if err := h.usecase.DeleteView(ctx, input); err != nil {
+		h.writeViewError(c, err, "failed to delete view")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_ViewHandler_DeleteView_shouldReturn204_whenViewExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().DeleteView(mock.Anything, &domain.DeleteViewInput{ID: 3, UserID: userID}).Return(nil).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/views/3", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+}
+
+func Test_ViewHandler_DeleteView_shouldReturn404_whenViewNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().DeleteView(mock.Anything, mock.Anything).Return(domain.ErrViewNotFound).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/views/999", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "view_not_found", "Not Found")
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewFindViewsResponse converts views with their todo counts to a FindViewsResponse API type.
+func NewFindViewsResponse(views []domain.ViewWithCount) (*api.FindViewsResponse, error) {
+	resp := &api.FindViewsResponse{
+		Views: make([]api.FindViewsResponseView, 0, len(views)),
+	}
+	for _, view := range views {
+		viewResp, err := NewViewResponse(&view.View)
+		if err != nil {
+			return nil, fmt.Errorf("convert view: %w", err)
+		}
+		todoCount, err := safeIntToInt32(view.TodoCount)
+		if err != nil {
+			return nil, fmt.Errorf("convert todo count: %w", err)
+		}
+		resp.Views = append(resp.Views, api.FindViewsResponseView{
+			ID:        viewResp.ID,
+			Name:      viewResp.Name,
+			Filter:    viewResp.Filter,
+			Sort:      viewResp.Sort,
+			Order:     viewResp.Order,
+			TodoCount: todoCount,
+			CreatedAt: viewResp.CreatedAt,
+			UpdatedAt: viewResp.UpdatedAt,
+		})
+	}
+	return resp, nil
+}
+
+// FindViews handles GET /views and lists the authenticated user's views with the number of todos each matches,
+// for the badges of the sidebar.
+func (h *ViewHandler) FindViews(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindViews called", slog.Int("userId", userID))
+
+	views, err := h.usecase.FindViews(ctx, userID)
+	if err != nil {
+		h.writeViewError(c, err, "failed to find views")
+		return
+	}
+
+	resp, err := NewFindViewsResponse(views)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
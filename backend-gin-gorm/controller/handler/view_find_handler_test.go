@@ -0,0 +1,71 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_ViewHandler_FindViews_shouldReturn200WithTodoCounts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().FindViews(mock.Anything, userID).Return([]domain.ViewWithCount{
+		{View: domain.View{ID: 1, UserID: userID, Name: "Open", Filter: "is:open", Sort: domain.DefaultTodoSort}, TodoCount: 12},
+		{View: domain.View{ID: 2, UserID: userID, Name: "Done", Filter: "is:done", Sort: domain.DefaultTodoSort}, TodoCount: 0},
+	}, nil).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/views", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - names
+	names := parseExpr(t, "$.views[*].name").Get(jsonObj)
+	assert.Equal(t, []any{"Open", "Done"}, names)
+
+	// - counts
+	counts := parseExpr(t, "$.views[*].todoCount").Get(jsonObj)
+	assert.Equal(t, []any{int64(12), int64(0)}, counts)
+}
+
+func Test_ViewHandler_FindViews_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().FindViews(mock.Anything, userID).Return(nil, errors.New("database error")).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/views", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
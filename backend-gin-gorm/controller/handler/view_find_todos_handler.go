@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// FindViewTodos handles GET /views/:id/todos and returns one page of the todos the view matches, in the order of the view.
+// Paging works as for GET /todo, with the "limit" and "cursor" query parameters.
+func (h *ViewHandler) FindViewTodos(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID, ok := getViewIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindViewTodos called", slog.Int("userId", userID), slog.Int("viewId", viewID))
+
+	limit, cursor, ok := getTodoPageFromQuery(c, h.logger)
+	if !ok {
+		return
+	}
+
+	input, err := domain.NewFindViewTodosInput(viewID, userID, limit, cursor)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find view todos input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	page, err := h.usecase.FindViewTodos(ctx, input)
+	if err != nil {
+		h.writeViewError(c, err, "failed to find view todos")
+		return
+	}
+
+	resp, err := NewFindTodoPageResponse(page)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
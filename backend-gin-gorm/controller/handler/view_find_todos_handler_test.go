@@ -0,0 +1,131 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_ViewHandler_FindViewTodos_shouldReturn200WithNextCursor_whenMoreTodosFollow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	cursor, err := domain.NewTodoCursor(domain.DefaultTodoSort, "", 5)
+	require.NoError(t, err)
+	encodedCursor, err := cursor.Encode()
+	require.NoError(t, err)
+	nextCursor, err := domain.NewTodoCursor(domain.DefaultTodoSort, "", 7)
+	require.NoError(t, err)
+	encodedNextCursor, err := nextCursor.Encode()
+	require.NoError(t, err)
+
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().FindViewTodos(mock.Anything, &domain.FindViewTodosInput{
+		ID:     3,
+		UserID: userID,
+		Limit:  2,
+		Cursor: cursor,
+	}).Return(&domain.TodoPage{
+		Todos: []domain.Todo{
+			{ID: 6, Text: "task 6"},
+			{ID: 7, Text: "task 7"},
+		},
+		NextCursor: nextCursor,
+	}, nil).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/api/v1/views/3/todos?limit=2&cursor=%s", encodedCursor), nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - todos
+	ids := parseExpr(t, "$.todos[*].id").Get(jsonObj)
+	assert.Equal(t, []any{int64(6), int64(7)}, ids)
+
+	// - nextCursor
+	next := parseExpr(t, "$.nextCursor").Get(jsonObj)
+	assert.Equal(t, []any{encodedNextCursor}, next)
+}
+
+func Test_ViewHandler_FindViewTodos_shouldReturn400_whenCursorWasIssuedForAnotherSort(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().FindViewTodos(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("new find todos input: %w", domain.ErrInvalidCursor)).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/views/3/todos", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_cursor", "cursor is invalid")
+}
+
+func Test_ViewHandler_FindViewTodos_shouldReturn404_whenViewNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().FindViewTodos(mock.Anything, mock.Anything).Return(nil, domain.ErrViewNotFound).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/views/999/todos", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "view_not_found", "Not Found")
+}
+
+func Test_ViewHandler_FindViewTodos_shouldReturn400_whenLimitIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/views/3/todos?limit=0", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_limit", "limit must be an integer between 1 and 100")
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewUsecase defines the use case operations for saved views.
+type ViewUsecase interface {
+	CreateView(ctx context.Context, input *domain.CreateViewInput) (*domain.CreateViewOutput, error)
+	FindViews(ctx context.Context, userID int) ([]domain.ViewWithCount, error)
+	UpdateView(ctx context.Context, input *domain.UpdateViewInput) (*domain.UpdateViewOutput, error)
+	DeleteView(ctx context.Context, input *domain.DeleteViewInput) error
+	FindViewTodos(ctx context.Context, input *domain.FindViewTodosInput) (*domain.TodoPage, error)
+}
+
+// ViewHandler handles HTTP requests for saved views, the smart lists of the sidebar.
+type ViewHandler struct {
+	usecase ViewUsecase
+	logger  *slog.Logger
+}
+
+// NewViewHandler creates a new ViewHandler with the given use case.
+func NewViewHandler(usecase ViewUsecase) *ViewHandler {
+	return &ViewHandler{
+		usecase: usecase,
+		logger:  slog.Default().With(slog.String(domain.LoggerNameKey, "ViewHandler")),
+	}
+}
+
+// NewInitViewRouterFunc returns an InitRouterGroupFunc that registers view routes under a "views" group.
+func NewInitViewRouterFunc(viewUsecase ViewUsecase) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		views := parentRouterGroup.Group("views", middleware...)
+		viewHandler := NewViewHandler(viewUsecase)
+
+		views.GET("", viewHandler.FindViews)
+		views.POST("", viewHandler.CreateView)
+		views.PUT("/:id", viewHandler.UpdateView)
+		views.DELETE("/:id", viewHandler.DeleteView)
+		views.GET("/:id/todos", viewHandler.FindViewTodos)
+	}
+}
+
+// NewViewResponse converts a domain View to a ViewResponse API type.
+func NewViewResponse(view *domain.View) (*api.ViewResponse, error) {
+	if view == nil {
+		return nil, errors.New("view is nil")
+	}
+	id, err := safeIntToInt32(view.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert view ID: %w", err)
+	}
+	return &api.ViewResponse{
+		ID:        id,
+		Name:      view.Name,
+		Filter:    view.Filter,
+		Sort:      string(view.Sort.Field),
+		Order:     string(view.Sort.Direction),
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+	}, nil
+}
+
+// newViewSort builds the sort of a view from the optional sort and order of a request, defaulting to DefaultTodoSort.
+func newViewSort(sortField *string, order *string) (*domain.TodoSort, error) {
+	sort := domain.DefaultTodoSort
+	if sortField != nil {
+		sort.Field = domain.TodoSortField(*sortField)
+	}
+	if order != nil {
+		sort.Direction = domain.SortDirection(*order)
+	}
+	s, err := domain.NewTodoSort(sort.Field, sort.Direction)
+	if err != nil {
+		return nil, fmt.Errorf("new todo sort: %w", err)
+	}
+	return s, nil
+}
+
+// getViewIDFromPath parses the ":id" path parameter of view routes.
+// On failure it writes a 400 response and returns false.
+func getViewIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "id", "invalid_view_id", "view id must be a positive integer")
+}
+
+// writeViewInputError maps an invalid create or update view input to a 400 response.
+// Filter query errors report the offending column.
+func (h *ViewHandler) writeViewInputError(c *gin.Context, err error) {
+	ctx := c.Request.Context()
+	var queryErr *domain.FilterQueryError
+	if errors.As(err, &queryErr) {
+		h.logger.WarnContext(ctx, "invalid view filter query", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_filter_query", "filter: "+queryErr.Error()))
+		return
+	}
+	h.logger.WarnContext(ctx, "invalid view input", slog.Any("error", err))
+	c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+}
+
+// writeViewError maps view use case errors to HTTP responses.
+func (h *ViewHandler) writeViewError(c *gin.Context, err error, message string) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrViewNotFound):
+		h.logger.WarnContext(ctx, "view not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("view_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrDuplicateViewName):
+		h.logger.WarnContext(ctx, "duplicate view name", slog.Any("error", err))
+		c.JSON(http.StatusConflict, NewErrorResponse("duplicate_view_name", "a view with the same name already exists"))
+	case errors.Is(err, domain.ErrViewLimitExceeded):
+		h.logger.WarnContext(ctx, "view limit exceeded", slog.Any("error", err))
+		c.JSON(http.StatusConflict, NewErrorResponse("view_limit_exceeded", fmt.Sprintf("a user can have at most %d views", domain.MaxViewsPerUser)))
+	case errors.Is(err, domain.ErrInvalidCursor):
+		h.logger.WarnContext(ctx, "invalid cursor", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_cursor", "cursor is invalid"))
+	default:
+		h.logger.ErrorContext(ctx, message, slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// UpdateView handles PUT /views/:id and replaces the name, filter and sort of a view.
+func (h *ViewHandler) UpdateView(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID, ok := getViewIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "UpdateView called", slog.Int("userId", userID), slog.Int("viewId", viewID))
+
+	var req api.UpdateViewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid update view request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	sort, err := newViewSort(req.Sort, req.Order)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid sort", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_sort", "sort must be one of id, createdAt, updatedAt, text and order one of asc, desc"))
+		return
+	}
+
+	input, err := domain.NewUpdateViewInput(viewID, userID, req.Name, req.Filter, *sort)
+	if err != nil {
+		h.writeViewInputError(c, err)
+		return
+	}
+
+	output, err := h.usecase.UpdateView(ctx, input)
+	if err != nil {
+		h.writeViewError(c, err, "failed to update view")
+		return
+	}
+
+	resp, err := NewViewResponse(output.View)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
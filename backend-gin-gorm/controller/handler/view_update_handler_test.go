@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_ViewHandler_UpdateView_shouldReturn200_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	sort := domain.TodoSort{Field: domain.TodoSortFieldText, Direction: domain.SortDirectionAsc}
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().UpdateView(mock.Anything, &domain.UpdateViewInput{
+		ID:     3,
+		UserID: userID,
+		Name:   "Done",
+		Filter: "is:done",
+		Sort:   sort,
+	}).Return(&domain.UpdateViewOutput{
+		View: &domain.View{ID: 3, UserID: userID, Name: "Done", Filter: "is:done", Sort: sort},
+	}, nil).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/views/3", bytes.NewBufferString(`{"name":"Done","filter":"is:done","sort":"text"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	name := parseExpr(t, "$.name").Get(parseJSON(t, respBytes))
+	assert.Equal(t, []any{"Done"}, name)
+}
+
+func Test_ViewHandler_UpdateView_shouldReturn404_whenViewNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	viewUsecase.EXPECT().UpdateView(mock.Anything, mock.Anything).Return(nil, domain.ErrViewNotFound).Once()
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/views/999", bytes.NewBufferString(`{"name":"Done"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "view_not_found", "Not Found")
+}
+
+func Test_ViewHandler_UpdateView_shouldReturn400_whenViewIDIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	viewUsecase := NewMockViewUsecase(t)
+	r := initViewRouter(t, ctx, viewUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "/api/v1/views/abc", bytes.NewBufferString(`{"name":"Done"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_view_id", "view id must be a positive integer")
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CreateWebhook handles POST /webhooks and registers a webhook for the authenticated user.
+// The response carries the signing secret of the webhook, which is not returned again.
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "CreateWebhook called", slog.Int("userId", userID))
+
+	var req api.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WarnContext(ctx, "invalid create webhook request", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	events := make([]domain.TodoEventType, len(req.Events))
+	for i, event := range req.Events {
+		events[i] = domain.TodoEventType(event)
+	}
+	input, err := domain.NewCreateWebhookInput(userID, req.URL, events)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidWebhookURL) {
+			h.logger.WarnContext(ctx, "invalid webhook URL", slog.Any("error", err))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_webhook_url", "url must be an absolute http or https URL of a public host"))
+			return
+		}
+		h.logger.WarnContext(ctx, "invalid create webhook input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request body is invalid"))
+		return
+	}
+
+	output, err := h.usecase.CreateWebhook(ctx, input)
+	if err != nil {
+		h.writeWebhookError(c, err, "failed to create webhook")
+		return
+	}
+
+	webhookResp, err := NewWebhookResponse(output.Webhook)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusCreated, &api.CreateWebhookResponse{
+		ID:        webhookResp.ID,
+		URL:       webhookResp.URL,
+		Events:    webhookResp.Events,
+		Secret:    output.Webhook.Secret,
+		CreatedAt: webhookResp.CreatedAt,
+		UpdatedAt: webhookResp.UpdatedAt,
+	})
+}
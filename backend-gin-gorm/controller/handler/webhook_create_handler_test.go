@@ -0,0 +1,159 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_WebhookHandler_CreateWebhook_shouldReturn201WithSecret_whenValidRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	events := []domain.TodoEventType{domain.TodoEventCreated, domain.TodoEventDeleted}
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().CreateWebhook(mock.Anything, &domain.CreateWebhookInput{
+		UserID: userID,
+		URL:    "https://example.com/hooks",
+		Events: events,
+	}).Return(&domain.CreateWebhookOutput{
+		Webhook: &domain.Webhook{
+			ID:        3,
+			UserID:    userID,
+			URL:       "https://example.com/hooks",
+			Events:    events,
+			Secret:    "whsec_test",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}, nil).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"url":"https://example.com/hooks","events":["created","deleted"]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/webhooks", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code, "status code should be 201")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - id
+	id := parseExpr(t, "$.id").Get(jsonObj)
+	assert.Equal(t, []any{int64(3)}, id)
+
+	// - events
+	eventsResp := parseExpr(t, "$.events[*]").Get(jsonObj)
+	assert.Equal(t, []any{"created", "deleted"}, eventsResp)
+
+	// - secret
+	secret := parseExpr(t, "$.secret").Get(jsonObj)
+	assert.Equal(t, []any{"whsec_test"}, secret)
+}
+
+func Test_WebhookHandler_CreateWebhook_shouldReturn409_whenLimitIsReached(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().CreateWebhook(mock.Anything, mock.Anything).Return(nil, domain.ErrWebhookLimitExceeded).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"url":"https://example.com/hooks","events":["created"]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/webhooks", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusConflict, w.Code, "status code should be 409")
+	validateErrorResponse(t, respBytes, "webhook_limit_exceeded", "a user can have at most 10 webhooks")
+}
+
+func Test_WebhookHandler_CreateWebhook_shouldReturn400_whenHostResolvesToInternalAddress(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().CreateWebhook(mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidWebhookURL).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	body := `{"url":"https://internal.example.com/hooks","events":["created"]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/webhooks", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_webhook_url", "url must be an absolute http or https URL of a public host")
+}
+
+func Test_WebhookHandler_CreateWebhook_shouldReturn400_whenRequestIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		code    string
+		message string
+	}{
+		{name: "missing url", body: `{"events":["created"]}`, code: "invalid_request", message: "request body is invalid"},
+		{name: "missing events", body: `{"url":"https://example.com/hooks"}`, code: "invalid_request", message: "request body is invalid"},
+		{name: "unknown event", body: `{"url":"https://example.com/hooks","events":["archived"]}`, code: "invalid_request", message: "request body is invalid"},
+		{name: "duplicate event", body: `{"url":"https://example.com/hooks","events":["created","created"]}`, code: "invalid_request", message: "request body is invalid"},
+		{name: "unsupported scheme", body: `{"url":"ftp://example.com/hooks","events":["created"]}`, code: "invalid_webhook_url", message: "url must be an absolute http or https URL of a public host"},
+		{name: "relative url", body: `{"url":"/hooks","events":["created"]}`, code: "invalid_webhook_url", message: "url must be an absolute http or https URL of a public host"},
+		{name: "private address", body: `{"url":"http://192.168.0.10/hooks","events":["created"]}`, code: "invalid_webhook_url", message: "url must be an absolute http or https URL of a public host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			// given
+			userID := randomUserID()
+			webhookUsecase := NewMockWebhookUsecase(t)
+			r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/webhooks", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, tt.code, tt.message)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteWebhook handles DELETE /webhooks/:id and removes a webhook along with its delivery log.
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+	webhookID, ok := getWebhookIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "DeleteWebhook called", slog.Int("userId", userID), slog.Int("webhookId", webhookID))
+
+	input, err := domain.NewDeleteWebhookInput(webhookID, userID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid delete webhook input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	if err := h.usecase.DeleteWebhook(ctx, input); err != nil {
+		h.writeWebhookError(c, err, "failed to delete webhook")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
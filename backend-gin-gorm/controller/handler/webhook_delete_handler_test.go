@@ -0,0 +1,77 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_WebhookHandler_DeleteWebhook_shouldReturn204_whenWebhookExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().DeleteWebhook(mock.Anything, &domain.DeleteWebhookInput{ID: 3, UserID: userID}).Return(nil).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/webhooks/3", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, w.Code, "status code should be 204")
+}
+
+func Test_WebhookHandler_DeleteWebhook_shouldReturn404_whenWebhookNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().DeleteWebhook(mock.Anything, mock.Anything).Return(domain.ErrWebhookNotFound).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/webhooks/999", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "webhook_not_found", "Not Found")
+}
+
+func Test_WebhookHandler_DeleteWebhook_shouldReturn400_whenIDIsInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/api/v1/webhooks/abc", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+	validateErrorResponse(t, respBytes, "invalid_webhook_id", "webhook id must be a positive integer")
+}
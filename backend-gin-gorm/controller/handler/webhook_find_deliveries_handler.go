@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewWebhookDeliveryResponse converts a domain WebhookDelivery to a WebhookDeliveryResponse API type.
+func NewWebhookDeliveryResponse(delivery *domain.WebhookDelivery) (*api.WebhookDeliveryResponse, error) {
+	id, err := safeIntToInt32(delivery.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert delivery ID: %w", err)
+	}
+	attempts, err := safeIntToInt32(delivery.Attempts)
+	if err != nil {
+		return nil, fmt.Errorf("convert attempts: %w", err)
+	}
+	resp := &api.WebhookDeliveryResponse{ //nolint:exhaustruct
+		ID:            id,
+		EventID:       delivery.EventID,
+		Event:         api.WebhookEventType(delivery.EventType),
+		Status:        api.WebhookDeliveryResponseStatus(delivery.Status),
+		Attempts:      attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastAttemptAt: delivery.LastAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+	if delivery.LastStatusCode != nil {
+		statusCode, err := safeIntToInt32(*delivery.LastStatusCode)
+		if err != nil {
+			return nil, fmt.Errorf("convert last status code: %w", err)
+		}
+		resp.LastStatusCode = &statusCode
+	}
+	if delivery.LastError != "" {
+		resp.LastError = &delivery.LastError
+	}
+	return resp, nil
+}
+
+// FindWebhookDeliveries handles GET /webhooks/:id/deliveries and lists the most recent deliveries of a webhook.
+// The number of deliveries is taken from the "limit" query parameter.
+func (h *WebhookHandler) FindWebhookDeliveries(c *gin.Context) {
+	ctx := c.Request.Context()
+	webhookID, ok := getWebhookIDFromPath(c, h.logger)
+	if !ok {
+		return
+	}
+
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindWebhookDeliveries called", slog.Int("userId", userID), slog.Int("webhookId", webhookID))
+
+	limit := domain.DefaultWebhookDeliveryLimit
+	if limitS, ok := c.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limitS)
+		if err != nil || v < 1 || v > domain.MaxWebhookDeliveryLimit {
+			h.logger.WarnContext(ctx, "invalid limit", slog.String("limit", limitS))
+			c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_limit", fmt.Sprintf("limit must be an integer between 1 and %d", domain.MaxWebhookDeliveryLimit)))
+			return
+		}
+		limit = v
+	}
+
+	input, err := domain.NewFindWebhookDeliveriesInput(webhookID, userID, limit)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid find webhook deliveries input", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_request", "request is invalid"))
+		return
+	}
+
+	deliveries, err := h.usecase.FindWebhookDeliveries(ctx, input)
+	if err != nil {
+		h.writeWebhookError(c, err, "failed to find webhook deliveries")
+		return
+	}
+
+	resp := &api.FindWebhookDeliveriesResponse{
+		Deliveries: make([]api.WebhookDeliveryResponse, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		deliveryResp, err := NewWebhookDeliveryResponse(&delivery)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+			return
+		}
+		resp.Deliveries = append(resp.Deliveries, *deliveryResp)
+	}
+	c.JSON(http.StatusOK, resp)
+}
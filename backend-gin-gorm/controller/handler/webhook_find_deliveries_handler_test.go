@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_WebhookHandler_FindWebhookDeliveries_shouldReturn200_whenWebhookExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	now := time.Now()
+	statusCode := http.StatusBadGateway
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().FindWebhookDeliveries(mock.Anything, &domain.FindWebhookDeliveriesInput{WebhookID: 3, UserID: userID, Limit: 20}).Return([]domain.WebhookDelivery{
+		{ID: 12, WebhookID: 3, EventID: "0123456789abcdef0123456789abcdef", EventType: domain.TodoEventUpdated, Status: domain.WebhookDeliveryPending, Attempts: 2, NextAttemptAt: now, LastAttemptAt: &now, LastStatusCode: &statusCode, LastError: "unexpected status code 502", CreatedAt: now, UpdatedAt: now},
+		{ID: 11, WebhookID: 3, EventID: "fedcba9876543210fedcba9876543210", EventType: domain.TodoEventCreated, Status: domain.WebhookDeliverySucceeded, Attempts: 1, NextAttemptAt: now, CreatedAt: now, UpdatedAt: now},
+	}, nil).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/webhooks/3/deliveries?limit=20", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - statuses
+	statuses := parseExpr(t, "$.deliveries[*].status").Get(jsonObj)
+	assert.Equal(t, []any{"pending", "succeeded"}, statuses)
+
+	// - last error is only present on the failed delivery
+	lastErrors := parseExpr(t, "$.deliveries[*].lastError").Get(jsonObj)
+	assert.Equal(t, []any{"unexpected status code 502"}, lastErrors)
+
+	// - last status code
+	lastStatusCodes := parseExpr(t, "$.deliveries[*].lastStatusCode").Get(jsonObj)
+	assert.Equal(t, []any{int64(502)}, lastStatusCodes)
+}
+
+func Test_WebhookHandler_FindWebhookDeliveries_shouldUseDefaultLimit_whenLimitIsOmitted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().FindWebhookDeliveries(mock.Anything, &domain.FindWebhookDeliveriesInput{WebhookID: 3, UserID: userID, Limit: domain.DefaultWebhookDeliveryLimit}).Return([]domain.WebhookDelivery{}, nil).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/webhooks/3/deliveries", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+	assert.JSONEq(t, `{"deliveries":[]}`, string(respBytes))
+}
+
+func Test_WebhookHandler_FindWebhookDeliveries_shouldReturn404_whenWebhookNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().FindWebhookDeliveries(mock.Anything, mock.Anything).Return(nil, domain.ErrWebhookNotFound).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/webhooks/999/deliveries", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, w.Code, "status code should be 404")
+	validateErrorResponse(t, respBytes, "webhook_not_found", "Not Found")
+}
+
+func Test_WebhookHandler_FindWebhookDeliveries_shouldReturn400_whenLimitIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, limit := range []string{"0", "101", "abc"} {
+		t.Run(limit, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			// given
+			userID := randomUserID()
+			webhookUsecase := NewMockWebhookUsecase(t)
+			r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+			w := httptest.NewRecorder()
+
+			// when
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/webhooks/3/deliveries?limit="+limit, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+			respBytes := readBytes(t, w.Body)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, w.Code, "status code should be 400")
+			validateErrorResponse(t, respBytes, "invalid_limit", "limit must be an integer between 1 and 100")
+		})
+	}
+}
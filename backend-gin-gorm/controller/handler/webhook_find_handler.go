@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewFindWebhooksResponse converts webhooks to a FindWebhooksResponse API type.
+func NewFindWebhooksResponse(webhooks []domain.Webhook) (*api.FindWebhooksResponse, error) {
+	resp := &api.FindWebhooksResponse{
+		Webhooks: make([]api.WebhookResponse, 0, len(webhooks)),
+	}
+	for _, webhook := range webhooks {
+		webhookResp, err := NewWebhookResponse(&webhook)
+		if err != nil {
+			return nil, fmt.Errorf("convert webhook: %w", err)
+		}
+		resp.Webhooks = append(resp.Webhooks, *webhookResp)
+	}
+	return resp, nil
+}
+
+// FindWebhooks handles GET /webhooks and lists the authenticated user's webhooks.
+func (h *WebhookHandler) FindWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.GetInt(controller.ContextFieldUserID{})
+	if userID <= 0 {
+		h.logger.WarnContext(ctx, "unauthorized: missing or invalid user ID")
+		c.JSON(http.StatusUnauthorized, NewErrorResponse("unauthorized", http.StatusText(http.StatusUnauthorized)))
+		return
+	}
+	h.logger.InfoContext(ctx, "FindWebhooks called", slog.Int("userId", userID))
+
+	webhooks, err := h.usecase.FindWebhooks(ctx, userID)
+	if err != nil {
+		h.writeWebhookError(c, err, "failed to find webhooks")
+		return
+	}
+
+	resp, err := NewFindWebhooksResponse(webhooks)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to create response", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
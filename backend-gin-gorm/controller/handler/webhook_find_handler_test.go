@@ -0,0 +1,71 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func Test_WebhookHandler_FindWebhooks_shouldReturn200WithoutSecrets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().FindWebhooks(mock.Anything, userID).Return([]domain.Webhook{
+		{ID: 1, UserID: userID, URL: "https://example.com/a", Events: []domain.TodoEventType{domain.TodoEventCreated}, Secret: "whsec_a"},
+		{ID: 2, UserID: userID, URL: "https://example.com/b", Events: []domain.TodoEventType{domain.TodoEventUpdated}, Secret: "whsec_b"},
+	}, nil).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/webhooks", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code, "status code should be 200")
+
+	jsonObj := parseJSON(t, respBytes)
+
+	// - urls
+	urls := parseExpr(t, "$.webhooks[*].url").Get(jsonObj)
+	assert.Equal(t, []any{"https://example.com/a", "https://example.com/b"}, urls)
+
+	// - secrets are not returned
+	secrets := parseExpr(t, "$.webhooks[*].secret").Get(jsonObj)
+	assert.Empty(t, secrets)
+}
+
+func Test_WebhookHandler_FindWebhooks_shouldReturn500_whenUsecaseReturnsError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	userID := randomUserID()
+	webhookUsecase := NewMockWebhookUsecase(t)
+	webhookUsecase.EXPECT().FindWebhooks(mock.Anything, userID).Return(nil, errors.New("database error")).Once()
+	r := initWebhookRouter(t, ctx, webhookUsecase, userID)
+	w := httptest.NewRecorder()
+
+	// when
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/webhooks", nil)
+	require.NoError(t, err)
+	r.ServeHTTP(w, req)
+	respBytes := readBytes(t, w.Body)
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "status code should be 500")
+	validateErrorResponse(t, respBytes, "internal_server_error", "Internal Server Error")
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookUsecase defines the use case operations for webhooks.
+type WebhookUsecase interface {
+	CreateWebhook(ctx context.Context, input *domain.CreateWebhookInput) (*domain.CreateWebhookOutput, error)
+	FindWebhooks(ctx context.Context, userID int) ([]domain.Webhook, error)
+	DeleteWebhook(ctx context.Context, input *domain.DeleteWebhookInput) error
+	FindWebhookDeliveries(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error)
+}
+
+// WebhookHandler handles HTTP requests for the webhooks todo changes are sent to.
+type WebhookHandler struct {
+	usecase WebhookUsecase
+	logger  *slog.Logger
+}
+
+// NewWebhookHandler creates a new WebhookHandler with the given use case.
+func NewWebhookHandler(usecase WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{
+		usecase: usecase,
+		logger:  slog.Default().With(slog.String(domain.LoggerNameKey, "WebhookHandler")),
+	}
+}
+
+// NewInitWebhookRouterFunc returns an InitRouterGroupFunc that registers webhook routes under a "webhooks" group.
+func NewInitWebhookRouterFunc(webhookUsecase WebhookUsecase) InitRouterGroupFunc {
+	return func(parentRouterGroup gin.IRouter, middleware ...gin.HandlerFunc) {
+		webhooks := parentRouterGroup.Group("webhooks", middleware...)
+		webhookHandler := NewWebhookHandler(webhookUsecase)
+
+		webhooks.GET("", webhookHandler.FindWebhooks)
+		webhooks.POST("", webhookHandler.CreateWebhook)
+		webhooks.DELETE("/:id", webhookHandler.DeleteWebhook)
+		webhooks.GET("/:id/deliveries", webhookHandler.FindWebhookDeliveries)
+	}
+}
+
+// NewWebhookResponse converts a domain Webhook to a WebhookResponse API type. The secret is left out.
+func NewWebhookResponse(webhook *domain.Webhook) (*api.WebhookResponse, error) {
+	if webhook == nil {
+		return nil, errors.New("webhook is nil")
+	}
+	id, err := safeIntToInt32(webhook.ID)
+	if err != nil {
+		return nil, fmt.Errorf("convert webhook ID: %w", err)
+	}
+	events := make([]api.WebhookEventType, len(webhook.Events))
+	for i, event := range webhook.Events {
+		events[i] = api.WebhookEventType(event)
+	}
+	return &api.WebhookResponse{
+		ID:        id,
+		URL:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}, nil
+}
+
+// getWebhookIDFromPath parses the ":id" path parameter of webhook routes.
+// On failure it writes a 400 response and returns false.
+func getWebhookIDFromPath(c *gin.Context, logger *slog.Logger) (int, bool) {
+	return getPositiveIntFromPath(c, logger, "id", "invalid_webhook_id", "webhook id must be a positive integer")
+}
+
+// writeWebhookError maps webhook use case errors to HTTP responses.
+func (h *WebhookHandler) writeWebhookError(c *gin.Context, err error, message string) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		h.logger.WarnContext(ctx, "webhook not found", slog.Any("error", err))
+		c.JSON(http.StatusNotFound, NewErrorResponse("webhook_not_found", http.StatusText(http.StatusNotFound)))
+	case errors.Is(err, domain.ErrInvalidWebhookURL):
+		h.logger.WarnContext(ctx, "invalid webhook URL", slog.Any("error", err))
+		c.JSON(http.StatusBadRequest, NewErrorResponse("invalid_webhook_url", "url must be an absolute http or https URL of a public host"))
+	case errors.Is(err, domain.ErrWebhookLimitExceeded):
+		h.logger.WarnContext(ctx, "webhook limit exceeded", slog.Any("error", err))
+		c.JSON(http.StatusConflict, NewErrorResponse("webhook_limit_exceeded", fmt.Sprintf("a user can have at most %d webhooks", domain.MaxWebhooksPerUser)))
+	default:
+		h.logger.ErrorContext(ctx, message, slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, NewErrorResponse("internal_server_error", http.StatusText(http.StatusInternalServerError)))
+	}
+}
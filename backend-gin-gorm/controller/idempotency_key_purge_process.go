@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/process"
+)
+
+// IdempotencyConfig holds how long idempotency keys are kept and how often expired keys are purged.
+type IdempotencyConfig struct {
+	KeyTTLHours      int `yaml:"keyTtlHours" validate:"gte=1"`
+	PurgeIntervalMin int `yaml:"purgeIntervalMin" validate:"gte=1"`
+	PurgeBatchSize   int `yaml:"purgeBatchSize" validate:"gte=1,lte=1000"`
+}
+
+// IdempotencyKeyPurger defines the use case operation invoked by the idempotency key purge process.
+type IdempotencyKeyPurger interface {
+	PurgeIdempotencyKeys(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (*domain.PurgeIdempotencyKeysOutput, error)
+}
+
+// WithIdempotencyKeyPurgeProcess returns a RunProcessFunc that periodically purges expired idempotency keys.
+func WithIdempotencyKeyPurgeProcess(purger IdempotencyKeyPurger, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return IdempotencyKeyPurgeProcess(ctx, purger, interval, batchSize)
+		}
+	}
+}
+
+// IdempotencyKeyPurgeProcess purges expired idempotency keys once at startup and then every interval until the context is canceled.
+// A failed purge is logged and retried on the next tick; it does not stop the process.
+func IdempotencyKeyPurgeProcess(ctx context.Context, purger IdempotencyKeyPurger, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "IdempotencyKeyPurge"))
+	logger.InfoContext(ctx, "idempotency key purge process started", slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		purgeExpiredIdempotencyKeys(ctx, logger, purger, batchSize)
+	})
+	return nil
+}
+
+func purgeExpiredIdempotencyKeys(ctx context.Context, logger *slog.Logger, purger IdempotencyKeyPurger, batchSize int) {
+	input, err := domain.NewPurgeIdempotencyKeysInput(time.Now(), batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid purge idempotency keys input", slog.Any("error", err))
+		return
+	}
+
+	output, err := purger.PurgeIdempotencyKeys(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to purge idempotency keys", slog.Any("error", err))
+		}
+		return
+	}
+	if output.PurgedCount > 0 {
+		logger.InfoContext(ctx, "purged idempotency keys", slog.Int("count", output.PurgedCount))
+	}
+}
@@ -0,0 +1,54 @@
+package controller_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+type fakeIdempotencyKeyPurger struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.PurgeIdempotencyKeysInput]
+}
+
+func (f *fakeIdempotencyKeyPurger) PurgeIdempotencyKeys(_ context.Context, input *domain.PurgeIdempotencyKeysInput) (*domain.PurgeIdempotencyKeysOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.PurgeIdempotencyKeysOutput{PurgedCount: 0}, nil
+}
+
+func Test_IdempotencyKeyPurgeProcess_shouldPurgeAtStartupAndStop_whenContextCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	purger := &fakeIdempotencyKeyPurger{}
+	started := time.Now()
+	done := make(chan error, 1)
+
+	// when
+	go func() {
+		done <- controller.IdempotencyKeyPurgeProcess(ctx, purger, time.Hour, 100)
+	}()
+	require.Eventually(t, func() bool { return purger.calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("IdempotencyKeyPurgeProcess did not stop after the context was canceled")
+	}
+	input := purger.input.Load()
+	assert.Equal(t, 100, input.BatchSize)
+	assert.WithinDuration(t, started, input.ExpiredBefore, time.Second)
+}
@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/api"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// replayedResponseHeaders are the response headers stored with an idempotent response and replayed with it.
+var replayedResponseHeaders = []string{"Content-Type", "ETag", "Last-Modified", "Location"}
+
+// IdempotencyUsecase defines the use case for claiming idempotency keys and storing the responses of their requests.
+type IdempotencyUsecase interface {
+	BeginIdempotentRequest(ctx context.Context, input *domain.BeginIdempotentRequestInput) (*domain.IdempotentResponse, error)
+	CompleteIdempotentRequest(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error
+	ReleaseIdempotentRequest(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error
+}
+
+// NewIdempotencyMiddleware returns a Gin middleware that honors the Idempotency-Key request header.
+// It must run after the auth middleware because keys are scoped to the user.
+// The first request with a key runs and its response is stored; a retry with the same method, path and body
+// replays the stored response with an Idempotent-Replayed header. Reusing the key with a different request
+// is rejected with 422, and a retry that arrives while the first request is still running gets 409.
+// A request that fails with a 5xx status frees its key so that it can be retried.
+func NewIdempotencyMiddleware(idempotencyUsecase IdempotencyUsecase) gin.HandlerFunc {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-IdempotencyMiddleware"))
+
+	return func(c *gin.Context) {
+		key := c.GetHeader("Idempotency-Key")
+		userID := c.GetInt(controller.ContextFieldUserID{})
+		if key == "" || userID <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, span := tracer.Start(c.Request.Context(), "IdempotencyMiddleware")
+		defer span.End()
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			logger.WarnContext(ctx, "read request body", slog.Any("error", err))
+			c.AbortWithStatusJSON(http.StatusBadRequest, &api.ErrorResponse{Code: "invalid_request", Message: "request body is invalid"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Request = c.Request.WithContext(ctx)
+
+		input, err := domain.NewBeginIdempotentRequestInput(userID, key, requestFingerprint(c.Request, body))
+		if err != nil {
+			logger.WarnContext(ctx, "invalid idempotency key", slog.Any("error", err))
+			c.AbortWithStatusJSON(http.StatusBadRequest, &api.ErrorResponse{Code: "invalid_request", Message: "Idempotency-Key must be at most 255 printable ASCII characters"})
+			return
+		}
+
+		stored, err := idempotencyUsecase.BeginIdempotentRequest(ctx, input)
+		if err != nil {
+			abortIdempotentRequest(c, logger, err)
+			return
+		}
+		if stored != nil {
+			logger.InfoContext(ctx, "replay idempotent response", slog.Int("statusCode", stored.StatusCode))
+			for name, value := range stored.Header {
+				c.Header(name, value)
+			}
+			c.Header("Idempotent-Replayed", "true")
+			c.Status(stored.StatusCode)
+			_, _ = c.Writer.Write(stored.Body)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer, body: bytes.Buffer{}}
+		c.Writer = recorder
+		finishCtx := context.WithoutCancel(ctx)
+		completed := false
+		defer func() {
+			// Also runs while a panic unwinds, so that a crashed request does not hold its key until it expires
+			if !completed {
+				releaseIdempotentRequest(finishCtx, logger, idempotencyUsecase, userID, key)
+			}
+		}()
+
+		c.Next()
+
+		if recorder.Status() >= http.StatusInternalServerError {
+			return
+		}
+		completed = true
+		completeIdempotentRequest(finishCtx, logger, idempotencyUsecase, userID, key, recorder)
+	}
+}
+
+// requestFingerprint returns the digest that tells a retry of a request from a different request using the same key.
+func requestFingerprint(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func abortIdempotentRequest(c *gin.Context, logger *slog.Logger, err error) {
+	ctx := c.Request.Context()
+	switch {
+	case errors.Is(err, domain.ErrIdempotencyKeyReused):
+		logger.WarnContext(ctx, "idempotency key reused", slog.Any("error", err))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, &api.ErrorResponse{Code: "idempotency_key_reused", Message: "Idempotency-Key was already used with a different request"})
+	case errors.Is(err, domain.ErrIdempotencyKeyInFlight):
+		logger.WarnContext(ctx, "idempotency key in flight", slog.Any("error", err))
+		c.Header("Retry-After", "1")
+		c.AbortWithStatusJSON(http.StatusConflict, &api.ErrorResponse{Code: "idempotency_key_in_flight", Message: "a request with this Idempotency-Key is still being processed"})
+	default:
+		logger.ErrorContext(ctx, "failed to begin idempotent request", slog.Any("error", err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, &api.ErrorResponse{Code: "internal_server_error", Message: http.StatusText(http.StatusInternalServerError)})
+	}
+}
+
+// completeIdempotentRequest stores the recorded response for the key.
+// A response that cannot be stored leaves the key in flight until it expires rather than risk running the request twice.
+func completeIdempotentRequest(ctx context.Context, logger *slog.Logger, idempotencyUsecase IdempotencyUsecase, userID int, key string, recorder *responseRecorder) {
+	header := make(map[string]string)
+	for _, name := range replayedResponseHeaders {
+		if value := recorder.Header().Get(name); value != "" {
+			header[name] = value
+		}
+	}
+	response := domain.IdempotentResponse{
+		StatusCode: recorder.Status(),
+		Header:     header,
+		Body:       recorder.body.Bytes(),
+	}
+
+	input, err := domain.NewCompleteIdempotentRequestInput(userID, key, response)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid complete idempotent request input", slog.Any("error", err))
+		return
+	}
+	if err := idempotencyUsecase.CompleteIdempotentRequest(ctx, input); err != nil {
+		logger.ErrorContext(ctx, "failed to store idempotent response", slog.Any("error", err))
+	}
+}
+
+func releaseIdempotentRequest(ctx context.Context, logger *slog.Logger, idempotencyUsecase IdempotencyUsecase, userID int, key string) {
+	input, err := domain.NewReleaseIdempotentRequestInput(userID, key)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid release idempotent request input", slog.Any("error", err))
+		return
+	}
+	if err := idempotencyUsecase.ReleaseIdempotentRequest(ctx, input); err != nil {
+		logger.ErrorContext(ctx, "failed to release idempotency key", slog.Any("error", err))
+	}
+}
+
+// responseRecorder is a gin.ResponseWriter that keeps a copy of the response body.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b) //nolint:wrapcheck
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s) //nolint:wrapcheck
+}
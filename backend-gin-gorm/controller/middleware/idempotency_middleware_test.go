@@ -0,0 +1,196 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller/middleware"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+const idempotencyTestUserID = 42
+
+func setupIdempotencyRouter(t *testing.T, idempotencyUsecase middleware.IdempotencyUsecase, handler gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(controller.ContextFieldUserID{}, idempotencyTestUserID)
+		c.Next()
+	})
+	r.POST("/todo", middleware.NewIdempotencyMiddleware(idempotencyUsecase), handler)
+	return r
+}
+
+func newIdempotentRequest(t *testing.T, ctx context.Context, key string, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/todo", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	return req
+}
+
+func Test_IdempotencyMiddleware_shouldStoreResponse_whenFirstRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	mockUsecase := NewMockIdempotencyUsecase(t)
+	mockUsecase.EXPECT().BeginIdempotentRequest(mock.Anything, mock.MatchedBy(func(input *domain.BeginIdempotentRequestInput) bool {
+		return input.UserID == idempotencyTestUserID && input.Key == "key-1" && len(input.Fingerprint) == 64
+	})).Return(nil, nil).Once()
+	mockUsecase.EXPECT().CompleteIdempotentRequest(mock.Anything, &domain.CompleteIdempotentRequestInput{
+		UserID: idempotencyTestUserID,
+		Key:    "key-1",
+		Response: domain.IdempotentResponse{
+			StatusCode: http.StatusCreated,
+			Header:     map[string]string{"Content-Type": "application/json; charset=utf-8", "ETag": `"1"`},
+			Body:       []byte(`{"text":"task"}`),
+		},
+	}).Return(nil).Once()
+	var handlerBody string
+	r := setupIdempotencyRouter(t, mockUsecase, func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		handlerBody = string(body)
+		c.Header("ETag", `"1"`)
+		c.JSON(http.StatusCreated, gin.H{"text": "task"})
+	})
+	w := httptest.NewRecorder()
+
+	// when
+	r.ServeHTTP(w, newIdempotentRequest(t, ctx, "key-1", `{"text":"task"}`))
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"text":"task"}`, w.Body.String())
+	assert.Equal(t, `{"text":"task"}`, handlerBody, "the handler should still be able to read the body")
+	assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+}
+
+func Test_IdempotencyMiddleware_shouldReplayStoredResponse_whenRetried(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	mockUsecase := NewMockIdempotencyUsecase(t)
+	mockUsecase.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(&domain.IdempotentResponse{
+		StatusCode: http.StatusCreated,
+		Header:     map[string]string{"Content-Type": "application/json; charset=utf-8", "ETag": `"1"`},
+		Body:       []byte(`{"id":7}`),
+	}, nil).Once()
+	r := setupIdempotencyRouter(t, mockUsecase, func(c *gin.Context) {
+		t.Error("the handler should not run for a replayed request")
+	})
+	w := httptest.NewRecorder()
+
+	// when
+	r.ServeHTTP(w, newIdempotentRequest(t, ctx, "key-1", `{"text":"task"}`))
+
+	// then
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"id":7}`, w.Body.String())
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+	assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+}
+
+func Test_IdempotencyMiddleware_shouldRejectRequest_whenKeyCannotBeClaimed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "reused with a different request",
+			err:          domain.ErrIdempotencyKeyReused,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: "idempotency_key_reused",
+		},
+		{
+			name:         "first request in flight",
+			err:          domain.ErrIdempotencyKeyInFlight,
+			expectedCode: http.StatusConflict,
+			expectedBody: "idempotency_key_in_flight",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			// given
+			mockUsecase := NewMockIdempotencyUsecase(t)
+			mockUsecase.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, tt.err).Once()
+			r := setupIdempotencyRouter(t, mockUsecase, func(c *gin.Context) {
+				t.Error("the handler should not run")
+			})
+			w := httptest.NewRecorder()
+
+			// when
+			r.ServeHTTP(w, newIdempotentRequest(t, ctx, "key-1", `{"text":"task"}`))
+
+			// then
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func Test_IdempotencyMiddleware_shouldReleaseKey_whenHandlerFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	mockUsecase := NewMockIdempotencyUsecase(t)
+	mockUsecase.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	mockUsecase.EXPECT().ReleaseIdempotentRequest(mock.Anything, &domain.ReleaseIdempotentRequestInput{
+		UserID: idempotencyTestUserID,
+		Key:    "key-1",
+	}).Return(nil).Once()
+	r := setupIdempotencyRouter(t, mockUsecase, func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, gin.H{"code": "internal_server_error"})
+	})
+	w := httptest.NewRecorder()
+
+	// when
+	r.ServeHTTP(w, newIdempotentRequest(t, ctx, "key-1", `{"text":"task"}`))
+
+	// then
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func Test_IdempotencyMiddleware_shouldNotClaimKey_whenHeaderIsAbsentOrInvalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	mockUsecase := NewMockIdempotencyUsecase(t)
+	r := setupIdempotencyRouter(t, mockUsecase, func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	// when
+	withoutKey := httptest.NewRecorder()
+	r.ServeHTTP(withoutKey, newIdempotentRequest(t, ctx, "", `{"text":"task"}`))
+	tooLong := httptest.NewRecorder()
+	r.ServeHTTP(tooLong, newIdempotentRequest(t, ctx, strings.Repeat("k", 256), `{"text":"task"}`))
+
+	// then
+	assert.Equal(t, http.StatusCreated, withoutKey.Code, "a request without a key should run as usual")
+	assert.Equal(t, http.StatusBadRequest, tooLong.Code)
+}
@@ -5,6 +5,8 @@
 package middleware_test
 
 import (
+	"context"
+
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -159,3 +161,212 @@ func (_c *MockAuthUsecase_RefreshToken_Call) RunAndReturn(run func(input *domain
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockIdempotencyUsecase creates a new instance of MockIdempotencyUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyUsecase {
+	mock := &MockIdempotencyUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyUsecase is an autogenerated mock type for the IdempotencyUsecase type
+type MockIdempotencyUsecase struct {
+	mock.Mock
+}
+
+type MockIdempotencyUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyUsecase) EXPECT() *MockIdempotencyUsecase_Expecter {
+	return &MockIdempotencyUsecase_Expecter{mock: &_m.Mock}
+}
+
+// BeginIdempotentRequest provides a mock function for the type MockIdempotencyUsecase
+func (_mock *MockIdempotencyUsecase) BeginIdempotentRequest(ctx context.Context, input *domain.BeginIdempotentRequestInput) (*domain.IdempotentResponse, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginIdempotentRequest")
+	}
+
+	var r0 *domain.IdempotentResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BeginIdempotentRequestInput) (*domain.IdempotentResponse, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.BeginIdempotentRequestInput) *domain.IdempotentResponse); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IdempotentResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.BeginIdempotentRequestInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyUsecase_BeginIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginIdempotentRequest'
+type MockIdempotencyUsecase_BeginIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// BeginIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.BeginIdempotentRequestInput
+func (_e *MockIdempotencyUsecase_Expecter) BeginIdempotentRequest(ctx interface{}, input interface{}) *MockIdempotencyUsecase_BeginIdempotentRequest_Call {
+	return &MockIdempotencyUsecase_BeginIdempotentRequest_Call{Call: _e.mock.On("BeginIdempotentRequest", ctx, input)}
+}
+
+func (_c *MockIdempotencyUsecase_BeginIdempotentRequest_Call) Run(run func(ctx context.Context, input *domain.BeginIdempotentRequestInput)) *MockIdempotencyUsecase_BeginIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.BeginIdempotentRequestInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.BeginIdempotentRequestInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyUsecase_BeginIdempotentRequest_Call) Return(idempotentResponse *domain.IdempotentResponse, err error) *MockIdempotencyUsecase_BeginIdempotentRequest_Call {
+	_c.Call.Return(idempotentResponse, err)
+	return _c
+}
+
+func (_c *MockIdempotencyUsecase_BeginIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, input *domain.BeginIdempotentRequestInput) (*domain.IdempotentResponse, error)) *MockIdempotencyUsecase_BeginIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteIdempotentRequest provides a mock function for the type MockIdempotencyUsecase
+func (_mock *MockIdempotencyUsecase) CompleteIdempotentRequest(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CompleteIdempotentRequestInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyUsecase_CompleteIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteIdempotentRequest'
+type MockIdempotencyUsecase_CompleteIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// CompleteIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CompleteIdempotentRequestInput
+func (_e *MockIdempotencyUsecase_Expecter) CompleteIdempotentRequest(ctx interface{}, input interface{}) *MockIdempotencyUsecase_CompleteIdempotentRequest_Call {
+	return &MockIdempotencyUsecase_CompleteIdempotentRequest_Call{Call: _e.mock.On("CompleteIdempotentRequest", ctx, input)}
+}
+
+func (_c *MockIdempotencyUsecase_CompleteIdempotentRequest_Call) Run(run func(ctx context.Context, input *domain.CompleteIdempotentRequestInput)) *MockIdempotencyUsecase_CompleteIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CompleteIdempotentRequestInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CompleteIdempotentRequestInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyUsecase_CompleteIdempotentRequest_Call) Return(err error) *MockIdempotencyUsecase_CompleteIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyUsecase_CompleteIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error) *MockIdempotencyUsecase_CompleteIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseIdempotentRequest provides a mock function for the type MockIdempotencyUsecase
+func (_mock *MockIdempotencyUsecase) ReleaseIdempotentRequest(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReleaseIdempotentRequestInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyUsecase_ReleaseIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseIdempotentRequest'
+type MockIdempotencyUsecase_ReleaseIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// ReleaseIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ReleaseIdempotentRequestInput
+func (_e *MockIdempotencyUsecase_Expecter) ReleaseIdempotentRequest(ctx interface{}, input interface{}) *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call {
+	return &MockIdempotencyUsecase_ReleaseIdempotentRequest_Call{Call: _e.mock.On("ReleaseIdempotentRequest", ctx, input)}
+}
+
+func (_c *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call) Run(run func(ctx context.Context, input *domain.ReleaseIdempotentRequestInput)) *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReleaseIdempotentRequestInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReleaseIdempotentRequestInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call) Return(err error) *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error) *MockIdempotencyUsecase_ReleaseIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
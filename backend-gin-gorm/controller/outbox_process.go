@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/process"
+)
+
+// OutboxConfig holds how often the outbox is relayed to the event publisher and how long dispatched events are kept.
+// A claimed event is leased for LeaseSec; an event that could not be published is published again once its lease ends.
+type OutboxConfig struct {
+	RelayIntervalSec int `yaml:"relayIntervalSec" validate:"gte=1"`
+	RelayBatchSize   int `yaml:"relayBatchSize" validate:"gte=1,lte=1000"`
+	LeaseSec         int `yaml:"leaseSec" validate:"gte=1"`
+	RetentionHours   int `yaml:"retentionHours" validate:"gte=1"`
+	PurgeIntervalMin int `yaml:"purgeIntervalMin" validate:"gte=1"`
+	PurgeBatchSize   int `yaml:"purgeBatchSize" validate:"gte=1,lte=1000"`
+}
+
+// OutboxRelayer defines the use case operation invoked by the outbox relay process.
+type OutboxRelayer interface {
+	RelayOutboxEvents(ctx context.Context, input *domain.RelayOutboxEventsInput) (*domain.RelayOutboxEventsOutput, error)
+}
+
+// OutboxPurger defines the use case operation invoked by the outbox purge process.
+type OutboxPurger interface {
+	PurgeOutboxEvents(ctx context.Context, input *domain.PurgeOutboxEventsInput) (*domain.PurgeOutboxEventsOutput, error)
+}
+
+// WithOutboxRelayProcess returns a RunProcessFunc that periodically publishes the undispatched outbox events.
+func WithOutboxRelayProcess(relayer OutboxRelayer, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return OutboxRelayProcess(ctx, relayer, interval, batchSize)
+		}
+	}
+}
+
+// OutboxRelayProcess publishes the undispatched outbox events once at startup and then every interval until the context is canceled.
+// A failed run is logged and retried on the next tick; it does not stop the process.
+func OutboxRelayProcess(ctx context.Context, relayer OutboxRelayer, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "OutboxRelay"))
+	logger.InfoContext(ctx, "outbox relay process started", slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		relayOutboxEvents(ctx, logger, relayer, batchSize)
+	})
+	return nil
+}
+
+func relayOutboxEvents(ctx context.Context, logger *slog.Logger, relayer OutboxRelayer, batchSize int) {
+	input, err := domain.NewRelayOutboxEventsInput(batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid relay outbox events input", slog.Any("error", err))
+		return
+	}
+
+	output, err := relayer.RelayOutboxEvents(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to relay outbox events", slog.Any("error", err))
+		}
+		return
+	}
+	if output.DispatchedCount > 0 {
+		logger.InfoContext(ctx, "relayed outbox events", slog.Int("count", output.DispatchedCount))
+	}
+}
+
+// WithOutboxPurgeProcess returns a RunProcessFunc that periodically purges outbox events dispatched more than retention ago.
+func WithOutboxPurgeProcess(purger OutboxPurger, retention time.Duration, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return OutboxPurgeProcess(ctx, purger, retention, interval, batchSize)
+		}
+	}
+}
+
+// OutboxPurgeProcess purges outbox events dispatched more than retention ago once at startup and then every interval
+// until the context is canceled. A failed purge is logged and retried on the next tick; it does not stop the process.
+func OutboxPurgeProcess(ctx context.Context, purger OutboxPurger, retention time.Duration, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "OutboxPurge"))
+	logger.InfoContext(ctx, "outbox purge process started", slog.Duration("retention", retention), slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		purgeDispatchedOutboxEvents(ctx, logger, purger, retention, batchSize)
+	})
+	return nil
+}
+
+func purgeDispatchedOutboxEvents(ctx context.Context, logger *slog.Logger, purger OutboxPurger, retention time.Duration, batchSize int) {
+	input, err := domain.NewPurgeOutboxEventsInput(time.Now().Add(-retention), batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid purge outbox events input", slog.Any("error", err))
+		return
+	}
+
+	output, err := purger.PurgeOutboxEvents(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to purge outbox events", slog.Any("error", err))
+		}
+		return
+	}
+	if output.PurgedCount > 0 {
+		logger.InfoContext(ctx, "purged outbox events", slog.Int("count", output.PurgedCount))
+	}
+}
@@ -0,0 +1,92 @@
+package controller_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+type fakeOutboxRelayer struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.RelayOutboxEventsInput]
+}
+
+func (f *fakeOutboxRelayer) RelayOutboxEvents(_ context.Context, input *domain.RelayOutboxEventsInput) (*domain.RelayOutboxEventsOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.RelayOutboxEventsOutput{DispatchedCount: 0}, nil
+}
+
+type fakeOutboxPurger struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.PurgeOutboxEventsInput]
+}
+
+func (f *fakeOutboxPurger) PurgeOutboxEvents(_ context.Context, input *domain.PurgeOutboxEventsInput) (*domain.PurgeOutboxEventsOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.PurgeOutboxEventsOutput{PurgedCount: 0}, nil
+}
+
+func Test_OutboxRelayProcess_shouldRelayPeriodicallyAndStop_whenContextCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	relayer := &fakeOutboxRelayer{}
+	done := make(chan error, 1)
+
+	// when
+	go func() {
+		done <- controller.OutboxRelayProcess(ctx, relayer, 10*time.Millisecond, 100)
+	}()
+	require.Eventually(t, func() bool { return relayer.calls.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("OutboxRelayProcess did not stop after the context was canceled")
+	}
+	assert.Equal(t, 100, relayer.input.Load().BatchSize)
+}
+
+func Test_OutboxPurgeProcess_shouldPurgeEventsDispatchedBeforeRetention(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	purger := &fakeOutboxPurger{}
+	retention := 24 * time.Hour
+	done := make(chan error, 1)
+	started := time.Now()
+
+	// when
+	go func() {
+		done <- controller.OutboxPurgeProcess(ctx, purger, retention, time.Hour, 500)
+	}()
+	require.Eventually(t, func() bool { return purger.calls.Load() >= 1 }, time.Second, 5*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("OutboxPurgeProcess did not stop after the context was canceled")
+	}
+	input := purger.input.Load()
+	assert.Equal(t, 500, input.BatchSize)
+	assert.WithinDuration(t, started.Add(-retention), input.DispatchedBefore, time.Second)
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically calls fn once immediately and then every interval until the context is canceled.
+func runPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/process"
+)
+
+// ArchiveConfig holds the settings of the auto-archive process, which archives todos that have been complete for AutoArchiveAfterDays.
+// The process only runs when AutoArchiveEnabled is true.
+type ArchiveConfig struct {
+	AutoArchiveEnabled     bool `yaml:"autoArchiveEnabled"`
+	AutoArchiveAfterDays   int  `yaml:"autoArchiveAfterDays" validate:"gte=1"`
+	AutoArchiveIntervalMin int  `yaml:"autoArchiveIntervalMin" validate:"gte=1"`
+	AutoArchiveBatchSize   int  `yaml:"autoArchiveBatchSize" validate:"gte=1,lte=1000"`
+}
+
+// TodoAutoArchiver defines the use case operation invoked by the auto-archive process.
+type TodoAutoArchiver interface {
+	AutoArchiveTodos(ctx context.Context, input *domain.AutoArchiveTodosInput) (*domain.AutoArchiveTodosOutput, error)
+}
+
+// WithTodoAutoArchiveProcess returns a RunProcessFunc that periodically archives todos completed more than archiveAfter ago.
+func WithTodoAutoArchiveProcess(archiver TodoAutoArchiver, archiveAfter, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return TodoAutoArchiveProcess(ctx, archiver, archiveAfter, interval, batchSize)
+		}
+	}
+}
+
+// TodoAutoArchiveProcess archives long-completed todos once at startup and then every interval until the context is canceled.
+// A failed run is logged and retried on the next tick; it does not stop the process.
+func TodoAutoArchiveProcess(ctx context.Context, archiver TodoAutoArchiver, archiveAfter, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "TodoAutoArchive"))
+	logger.InfoContext(ctx, "todo auto archive process started", slog.Duration("archiveAfter", archiveAfter), slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		autoArchiveTodos(ctx, logger, archiver, archiveAfter, batchSize)
+	})
+	return nil
+}
+
+func autoArchiveTodos(ctx context.Context, logger *slog.Logger, archiver TodoAutoArchiver, archiveAfter time.Duration, batchSize int) {
+	input, err := domain.NewAutoArchiveTodosInput(time.Now().Add(-archiveAfter), batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid auto archive todos input", slog.Any("error", err))
+		return
+	}
+
+	output, err := archiver.AutoArchiveTodos(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to auto archive todos", slog.Any("error", err))
+		}
+		return
+	}
+	if output.ArchivedCount > 0 {
+		logger.InfoContext(ctx, "auto archived todos", slog.Int("count", output.ArchivedCount))
+	}
+}
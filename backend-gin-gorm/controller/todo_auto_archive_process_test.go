@@ -0,0 +1,55 @@
+package controller_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+type fakeTodoAutoArchiver struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.AutoArchiveTodosInput]
+}
+
+func (f *fakeTodoAutoArchiver) AutoArchiveTodos(_ context.Context, input *domain.AutoArchiveTodosInput) (*domain.AutoArchiveTodosOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.AutoArchiveTodosOutput{ArchivedCount: 0, UserIDs: []int{}}, nil
+}
+
+func Test_TodoAutoArchiveProcess_shouldArchiveAtStartupAndStop_whenContextCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	archiver := &fakeTodoAutoArchiver{}
+	archiveAfter := 24 * time.Hour
+	started := time.Now()
+	done := make(chan error, 1)
+
+	// when
+	go func() {
+		done <- controller.TodoAutoArchiveProcess(ctx, archiver, archiveAfter, time.Hour, 50)
+	}()
+	require.Eventually(t, func() bool { return archiver.calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("TodoAutoArchiveProcess did not stop after the context was canceled")
+	}
+	input := archiver.input.Load()
+	assert.Equal(t, 50, input.BatchSize)
+	assert.WithinDuration(t, started.Add(-archiveAfter), input.CompletedBefore, time.Second)
+}
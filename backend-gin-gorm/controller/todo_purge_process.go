@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/process"
+)
+
+// TrashConfig holds how long trashed todos are kept and how often the purge process runs.
+type TrashConfig struct {
+	RetentionDays    int `yaml:"retentionDays" validate:"gte=1"`
+	PurgeIntervalMin int `yaml:"purgeIntervalMin" validate:"gte=1"`
+	PurgeBatchSize   int `yaml:"purgeBatchSize" validate:"gte=1,lte=1000"`
+}
+
+// TodoPurger defines the use case operation invoked by the purge process.
+type TodoPurger interface {
+	PurgeTodos(ctx context.Context, input *domain.PurgeTodosInput) (*domain.PurgeTodosOutput, error)
+}
+
+// WithTodoPurgeProcess returns a RunProcessFunc that periodically purges todos whose trash retention has expired.
+func WithTodoPurgeProcess(purger TodoPurger, retention, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return TodoPurgeProcess(ctx, purger, retention, interval, batchSize)
+		}
+	}
+}
+
+// TodoPurgeProcess purges expired trash once at startup and then every interval until the context is canceled.
+// A failed purge is logged and retried on the next tick; it does not stop the process.
+func TodoPurgeProcess(ctx context.Context, purger TodoPurger, retention, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "TodoPurge"))
+	logger.InfoContext(ctx, "todo purge process started", slog.Duration("retention", retention), slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		purgeExpiredTodos(ctx, logger, purger, retention, batchSize)
+	})
+	return nil
+}
+
+func purgeExpiredTodos(ctx context.Context, logger *slog.Logger, purger TodoPurger, retention time.Duration, batchSize int) {
+	input, err := domain.NewPurgeTodosInput(time.Now().Add(-retention), batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid purge todos input", slog.Any("error", err))
+		return
+	}
+
+	output, err := purger.PurgeTodos(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to purge todos", slog.Any("error", err))
+		}
+		return
+	}
+	if output.PurgedCount > 0 {
+		logger.InfoContext(ctx, "purged todos", slog.Int("count", output.PurgedCount))
+	}
+}
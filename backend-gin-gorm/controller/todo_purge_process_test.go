@@ -0,0 +1,55 @@
+package controller_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+type fakeTodoPurger struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.PurgeTodosInput]
+}
+
+func (f *fakeTodoPurger) PurgeTodos(_ context.Context, input *domain.PurgeTodosInput) (*domain.PurgeTodosOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.PurgeTodosOutput{PurgedCount: 0}, nil
+}
+
+func Test_TodoPurgeProcess_shouldPurgeAtStartupAndStop_whenContextCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	purger := &fakeTodoPurger{}
+	retention := 24 * time.Hour
+	started := time.Now()
+	done := make(chan error, 1)
+
+	// when
+	go func() {
+		done <- controller.TodoPurgeProcess(ctx, purger, retention, time.Hour, 50)
+	}()
+	require.Eventually(t, func() bool { return purger.calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("TodoPurgeProcess did not stop after the context was canceled")
+	}
+	input := purger.input.Load()
+	assert.Equal(t, 50, input.BatchSize)
+	assert.WithinDuration(t, started.Add(-retention), input.DeletedBefore, time.Second)
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/process"
+)
+
+// UndoConfig holds how long undo tokens can be used and how often expired tokens are purged.
+type UndoConfig struct {
+	TokenTTLSec      int `yaml:"tokenTtlSec" validate:"gte=1"`
+	PurgeIntervalMin int `yaml:"purgeIntervalMin" validate:"gte=1"`
+	PurgeBatchSize   int `yaml:"purgeBatchSize" validate:"gte=1,lte=1000"`
+}
+
+// TodoUndoPurger defines the use case operation invoked by the undo token purge process.
+type TodoUndoPurger interface {
+	PurgeTodoUndos(ctx context.Context, input *domain.PurgeTodoUndosInput) (*domain.PurgeTodoUndosOutput, error)
+}
+
+// WithTodoUndoPurgeProcess returns a RunProcessFunc that periodically purges expired undo tokens.
+func WithTodoUndoPurgeProcess(purger TodoUndoPurger, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return TodoUndoPurgeProcess(ctx, purger, interval, batchSize)
+		}
+	}
+}
+
+// TodoUndoPurgeProcess purges expired undo tokens once at startup and then every interval until the context is canceled.
+// A failed purge is logged and retried on the next tick; it does not stop the process.
+func TodoUndoPurgeProcess(ctx context.Context, purger TodoUndoPurger, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "TodoUndoPurge"))
+	logger.InfoContext(ctx, "todo undo purge process started", slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		purgeExpiredTodoUndos(ctx, logger, purger, batchSize)
+	})
+	return nil
+}
+
+func purgeExpiredTodoUndos(ctx context.Context, logger *slog.Logger, purger TodoUndoPurger, batchSize int) {
+	input, err := domain.NewPurgeTodoUndosInput(time.Now(), batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid purge todo undos input", slog.Any("error", err))
+		return
+	}
+
+	output, err := purger.PurgeTodoUndos(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to purge todo undos", slog.Any("error", err))
+		}
+		return
+	}
+	if output.PurgedCount > 0 {
+		logger.InfoContext(ctx, "purged todo undos", slog.Int("count", output.PurgedCount))
+	}
+}
@@ -0,0 +1,54 @@
+package controller_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+type fakeTodoUndoPurger struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.PurgeTodoUndosInput]
+}
+
+func (f *fakeTodoUndoPurger) PurgeTodoUndos(_ context.Context, input *domain.PurgeTodoUndosInput) (*domain.PurgeTodoUndosOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.PurgeTodoUndosOutput{PurgedCount: 0}, nil
+}
+
+func Test_TodoUndoPurgeProcess_shouldPurgeAtStartupAndStop_whenContextCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	purger := &fakeTodoUndoPurger{}
+	started := time.Now()
+	done := make(chan error, 1)
+
+	// when
+	go func() {
+		done <- controller.TodoUndoPurgeProcess(ctx, purger, time.Hour, 100)
+	}()
+	require.Eventually(t, func() bool { return purger.calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("TodoUndoPurgeProcess did not stop after the context was canceled")
+	}
+	input := purger.input.Load()
+	assert.Equal(t, 100, input.BatchSize)
+	assert.WithinDuration(t, started, input.ExpiredBefore, time.Second)
+}
@@ -20,16 +20,18 @@ type ShutdownConfig struct {
 }
 
 // WithWebServerProcess returns a RunProcessFunc that starts the main HTTP server.
-func WithWebServerProcess(router http.Handler, port int, readHeaderTimeout, shutdownTime time.Duration) process.RunProcessFunc {
+func WithWebServerProcess(router http.Handler, port int, readHeaderTimeout, shutdownTime time.Duration, onShutdown ...func()) process.RunProcessFunc {
 	return func(ctx context.Context) process.RunProcess {
 		return func() error {
-			return WebServerProcess(ctx, router, port, readHeaderTimeout, shutdownTime)
+			return WebServerProcess(ctx, router, port, readHeaderTimeout, shutdownTime, onShutdown...)
 		}
 	}
 }
 
 // WebServerProcess runs the HTTP server and shuts down gracefully when the context is canceled.
-func WebServerProcess(ctx context.Context, router http.Handler, port int, readHeaderTimeout, shutdownTime time.Duration) error {
+// The onShutdown functions are called when the shutdown begins, to end long-lived responses such as event streams
+// that would otherwise keep the shutdown waiting until it times out.
+func WebServerProcess(ctx context.Context, router http.Handler, port int, readHeaderTimeout, shutdownTime time.Duration, onShutdown ...func()) error {
 	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "WebServer"))
 
 	httpServer := http.Server{ //nolint:exhaustruct
@@ -37,6 +39,9 @@ func WebServerProcess(ctx context.Context, router http.Handler, port int, readHe
 		Handler:           router,
 		ReadHeaderTimeout: readHeaderTimeout,
 	}
+	for _, f := range onShutdown {
+		httpServer.RegisterOnShutdown(f)
+	}
 
 	logger.InfoContext(ctx, fmt.Sprintf("http server listening at %v", httpServer.Addr))
 
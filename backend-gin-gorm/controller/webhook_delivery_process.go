@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/process"
+)
+
+// WebhookConfig holds how webhook requests are sent and retried and how often due deliveries are attempted.
+// The delay before the n-th retry is RetryBaseDelaySec doubled n-1 times, capped at RetryMaxDelaySec;
+// a delivery that failed MaxAttempts times is dead.
+type WebhookConfig struct {
+	TimeoutSec          int `yaml:"timeoutSec" validate:"gte=1"`
+	MaxAttempts         int `yaml:"maxAttempts" validate:"gte=1"`
+	RetryBaseDelaySec   int `yaml:"retryBaseDelaySec" validate:"gte=1"`
+	RetryMaxDelaySec    int `yaml:"retryMaxDelaySec" validate:"gtefield=RetryBaseDelaySec"`
+	DeliveryIntervalSec int `yaml:"deliveryIntervalSec" validate:"gte=1"`
+	DeliveryBatchSize   int `yaml:"deliveryBatchSize" validate:"gte=1,lte=1000"`
+}
+
+// WebhookDeliverer defines the use case operation invoked by the webhook delivery process.
+type WebhookDeliverer interface {
+	DeliverWebhooks(ctx context.Context, input *domain.DeliverWebhooksInput) (*domain.DeliverWebhooksOutput, error)
+}
+
+// WithWebhookDeliveryProcess returns a RunProcessFunc that periodically attempts due webhook deliveries.
+func WithWebhookDeliveryProcess(deliverer WebhookDeliverer, interval time.Duration, batchSize int) process.RunProcessFunc {
+	return func(ctx context.Context) process.RunProcess {
+		return func() error {
+			return WebhookDeliveryProcess(ctx, deliverer, interval, batchSize)
+		}
+	}
+}
+
+// WebhookDeliveryProcess attempts due webhook deliveries once at startup and then every interval until the context is canceled.
+// A failed run is logged and retried on the next tick; it does not stop the process.
+func WebhookDeliveryProcess(ctx context.Context, deliverer WebhookDeliverer, interval time.Duration, batchSize int) error {
+	logger := slog.Default().With(slog.String(domain.LoggerNameKey, "WebhookDelivery"))
+	logger.InfoContext(ctx, "webhook delivery process started", slog.Duration("interval", interval))
+
+	runPeriodically(ctx, interval, func(ctx context.Context) {
+		deliverDueWebhooks(ctx, logger, deliverer, batchSize)
+	})
+	return nil
+}
+
+func deliverDueWebhooks(ctx context.Context, logger *slog.Logger, deliverer WebhookDeliverer, batchSize int) {
+	input, err := domain.NewDeliverWebhooksInput(batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "invalid deliver webhooks input", slog.Any("error", err))
+		return
+	}
+
+	output, err := deliverer.DeliverWebhooks(ctx, input)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.ErrorContext(ctx, "failed to deliver webhooks", slog.Any("error", err))
+		}
+		return
+	}
+	if output.SucceededCount > 0 || output.RetryingCount > 0 || output.DeadCount > 0 {
+		logger.InfoContext(ctx, "delivered webhooks",
+			slog.Int("succeeded", output.SucceededCount), slog.Int("retrying", output.RetryingCount), slog.Int("dead", output.DeadCount))
+	}
+}
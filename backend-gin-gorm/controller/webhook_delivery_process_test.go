@@ -0,0 +1,51 @@
+package controller_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/controller"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+type fakeWebhookDeliverer struct {
+	calls atomic.Int32
+	input atomic.Pointer[domain.DeliverWebhooksInput]
+}
+
+func (f *fakeWebhookDeliverer) DeliverWebhooks(_ context.Context, input *domain.DeliverWebhooksInput) (*domain.DeliverWebhooksOutput, error) {
+	f.calls.Add(1)
+	f.input.Store(input)
+	return &domain.DeliverWebhooksOutput{SucceededCount: 0, RetryingCount: 0, DeadCount: 0}, nil
+}
+
+func Test_WebhookDeliveryProcess_shouldDeliverPeriodicallyAndStop_whenContextCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// given
+	deliverer := &fakeWebhookDeliverer{}
+	done := make(chan error, 1)
+
+	// when
+	go func() {
+		done <- controller.WebhookDeliveryProcess(ctx, deliverer, 10*time.Millisecond, 50)
+	}()
+	require.Eventually(t, func() bool { return deliverer.calls.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("WebhookDeliveryProcess did not stop after the context was canceled")
+	}
+	assert.Equal(t, 50, deliverer.input.Load().BatchSize)
+}
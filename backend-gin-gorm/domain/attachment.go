@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrAttachmentNotFound is returned when a requested attachment does not exist.
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	// ErrAttachmentTooLarge is returned when an uploaded file exceeds the configured size limit.
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+	// ErrAttachmentTypeNotAllowed is returned when an uploaded file's content type is not in the allow list.
+	ErrAttachmentTypeNotAllowed = errors.New("attachment content type is not allowed")
+	// ErrBlobNotFound is returned by a blob store when the requested object does not exist.
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+// Attachment holds the metadata of a file attached to a todo. The content itself lives in a blob store under StorageKey.
+type Attachment struct {
+	ID          int    `validate:"required,gt=0"`
+	TodoID      int    `validate:"required,gt=0"`
+	FileName    string `validate:"required,max=255"`
+	ContentType string `validate:"required,max=255"`
+	Size        int64  `validate:"gte=0"`
+	StorageKey  string `validate:"required,max=255"`
+	CreatedAt   time.Time
+}
+
+// NewAttachment creates a validated Attachment. Returns an error if validation fails.
+func NewAttachment(id int, todoID int, fileName string, contentType string, size int64, storageKey string, createdAt time.Time) (*Attachment, error) {
+	m := &Attachment{
+		ID:          id,
+		TodoID:      todoID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  storageKey,
+		CreatedAt:   createdAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate attachment model: %w", err)
+	}
+	return m, nil
+}
+
+// AttachmentPolicy holds the upload limits applied to every attachment.
+type AttachmentPolicy struct {
+	MaxSizeBytes        int64    `validate:"gte=1"`
+	AllowedContentTypes []string `validate:"required,min=1,dive,required"`
+}
+
+// NewAttachmentPolicy creates a validated AttachmentPolicy from a comma-separated list of media types.
+func NewAttachmentPolicy(maxSizeBytes int64, allowedContentTypes string) (*AttachmentPolicy, error) {
+	types := make([]string, 0)
+	for t := range strings.SplitSeq(allowedContentTypes, ",") {
+		if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+			types = append(types, t)
+		}
+	}
+	m := &AttachmentPolicy{
+		MaxSizeBytes:        maxSizeBytes,
+		AllowedContentTypes: types,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate attachment policy: %w", err)
+	}
+	return m, nil
+}
+
+// Check returns ErrAttachmentTooLarge or ErrAttachmentTypeNotAllowed if a file of the given type and size may not be stored.
+// Media type parameters such as charset are ignored when matching the allow list.
+func (p *AttachmentPolicy) Check(contentType string, size int64) error {
+	if size > p.MaxSizeBytes {
+		return ErrAttachmentTooLarge
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ErrAttachmentTypeNotAllowed
+	}
+	if !slices.Contains(p.AllowedContentTypes, mediaType) {
+		return ErrAttachmentTypeNotAllowed
+	}
+	return nil
+}
+
+// UploadAttachmentInput holds the parameters required to attach a file to a todo.
+// Content is read exactly once and must yield Size bytes.
+type UploadAttachmentInput struct {
+	TodoID      int       `validate:"required,gt=0"`
+	UserID      int       `validate:"required,gt=0"`
+	FileName    string    `validate:"required,max=255"`
+	ContentType string    `validate:"required,max=255"`
+	Size        int64     `validate:"gte=0"`
+	Content     io.Reader `validate:"required"`
+}
+
+// NewUploadAttachmentInput creates a validated UploadAttachmentInput. Returns an error if validation fails.
+func NewUploadAttachmentInput(todoID int, userID int, fileName string, contentType string, size int64, content io.Reader) (*UploadAttachmentInput, error) {
+	m := &UploadAttachmentInput{
+		TodoID:      todoID,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		Content:     content,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate upload attachment input: %w", err)
+	}
+	return m, nil
+}
+
+// CreateAttachmentInput holds the metadata persisted after an upload's content has been stored.
+type CreateAttachmentInput struct {
+	TodoID      int    `validate:"required,gt=0"`
+	UserID      int    `validate:"required,gt=0"`
+	FileName    string `validate:"required,max=255"`
+	ContentType string `validate:"required,max=255"`
+	Size        int64  `validate:"gte=0"`
+	StorageKey  string `validate:"required,max=255"`
+}
+
+// NewCreateAttachmentInput creates a validated CreateAttachmentInput. Returns an error if validation fails.
+func NewCreateAttachmentInput(todoID int, userID int, fileName string, contentType string, size int64, storageKey string) (*CreateAttachmentInput, error) {
+	m := &CreateAttachmentInput{
+		TodoID:      todoID,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  storageKey,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create attachment input: %w", err)
+	}
+	return m, nil
+}
+
+// UploadAttachmentOutput holds the result of an upload.
+type UploadAttachmentOutput struct {
+	Attachment *Attachment `validate:"required"`
+}
+
+// NewUploadAttachmentOutput creates a validated UploadAttachmentOutput. Returns an error if validation fails.
+func NewUploadAttachmentOutput(attachment *Attachment) (*UploadAttachmentOutput, error) {
+	m := &UploadAttachmentOutput{
+		Attachment: attachment,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate upload attachment output: %w", err)
+	}
+	return m, nil
+}
+
+// FindAttachmentsInput identifies the todo whose attachments are listed.
+type FindAttachmentsInput struct {
+	TodoID int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewFindAttachmentsInput creates a validated FindAttachmentsInput. Returns an error if validation fails.
+func NewFindAttachmentsInput(todoID int, userID int) (*FindAttachmentsInput, error) {
+	m := &FindAttachmentsInput{
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find attachments input: %w", err)
+	}
+	return m, nil
+}
+
+// AttachmentInput identifies a single attachment of a todo owned by the user.
+type AttachmentInput struct {
+	ID     int `validate:"required,gt=0"`
+	TodoID int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewAttachmentInput creates a validated AttachmentInput. Returns an error if validation fails.
+func NewAttachmentInput(id int, todoID int, userID int) (*AttachmentInput, error) {
+	m := &AttachmentInput{
+		ID:     id,
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate attachment input: %w", err)
+	}
+	return m, nil
+}
+
+// DownloadAttachmentOutput holds an attachment's metadata and an open reader over its content.
+// The caller must close Content.
+type DownloadAttachmentOutput struct {
+	Attachment *Attachment   `validate:"required"`
+	Content    io.ReadCloser `validate:"required"`
+}
+
+// NewDownloadAttachmentOutput creates a validated DownloadAttachmentOutput. Returns an error if validation fails.
+func NewDownloadAttachmentOutput(attachment *Attachment, content io.ReadCloser) (*DownloadAttachmentOutput, error) {
+	m := &DownloadAttachmentOutput{
+		Attachment: attachment,
+		Content:    content,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate download attachment output: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,118 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewAttachmentPolicy tests
+func TestNewAttachmentPolicy_shouldNormalizeContentTypes_whenListHasSpacesAndCase(t *testing.T) {
+	t.Parallel()
+
+	// when
+	policy, err := domain.NewAttachmentPolicy(1024, " image/PNG, application/pdf ,,")
+
+	// then
+	require.NoError(t, err, "expected no error for valid AttachmentPolicy")
+	assert.Equal(t, []string{"image/png", "application/pdf"}, policy.AllowedContentTypes)
+}
+
+func TestNewAttachmentPolicy_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		maxSizeBytes        int64
+		allowedContentTypes string
+	}{
+		{
+			name:                "max size is zero",
+			maxSizeBytes:        0,
+			allowedContentTypes: "image/png",
+		},
+		{
+			name:                "no content types",
+			maxSizeBytes:        1024,
+			allowedContentTypes: " , ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			policy, err := domain.NewAttachmentPolicy(tt.maxSizeBytes, tt.allowedContentTypes)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, policy, "expected nil AttachmentPolicy")
+			assert.Contains(t, err.Error(), "validate attachment policy", "error should mention validation")
+		})
+	}
+}
+
+// AttachmentPolicy.Check tests
+func TestAttachmentPolicy_Check(t *testing.T) {
+	t.Parallel()
+
+	// given
+	policy, err := domain.NewAttachmentPolicy(100, "image/png,text/plain")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		expected    error
+	}{
+		{
+			name:        "allowed type within limit",
+			contentType: "image/png",
+			size:        100,
+			expected:    nil,
+		},
+		{
+			name:        "parameters are ignored",
+			contentType: "text/plain; charset=utf-8",
+			size:        10,
+			expected:    nil,
+		},
+		{
+			name:        "too large",
+			contentType: "image/png",
+			size:        101,
+			expected:    domain.ErrAttachmentTooLarge,
+		},
+		{
+			name:        "type not allowed",
+			contentType: "application/zip",
+			size:        10,
+			expected:    domain.ErrAttachmentTypeNotAllowed,
+		},
+		{
+			name:        "malformed type",
+			contentType: "not a media type",
+			size:        10,
+			expected:    domain.ErrAttachmentTypeNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			err := policy.Check(tt.contentType, tt.size)
+
+			// then
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxChecklistItems is the maximum number of checklist items a single todo can hold.
+const MaxChecklistItems = 100
+
+var (
+	// ErrChecklistItemNotFound is returned when a requested checklist item does not exist.
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+	// ErrChecklistFull is returned when a todo already holds MaxChecklistItems items.
+	ErrChecklistFull = errors.New("checklist is full")
+	// ErrChecklistOrderMismatch is returned when a reorder request does not list exactly the todo's current items.
+	ErrChecklistOrderMismatch = errors.New("checklist order does not match current items")
+)
+
+// ChecklistItem represents a single ordered checklist entry embedded in a todo.
+type ChecklistItem struct {
+	ID        int    `validate:"required,gt=0"`
+	TodoID    int    `validate:"required,gt=0"`
+	Text      string `validate:"required,max=255"`
+	IsChecked bool
+	Position  int `validate:"gte=0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewChecklistItem creates a validated ChecklistItem. Returns an error if validation fails.
+func NewChecklistItem(id int, todoID int, text string, isChecked bool, position int, createdAt, updatedAt time.Time) (*ChecklistItem, error) {
+	m := &ChecklistItem{
+		ID:        id,
+		TodoID:    todoID,
+		Text:      text,
+		IsChecked: isChecked,
+		Position:  position,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate checklist item model: %w", err)
+	}
+	return m, nil
+}
+
+// AddChecklistItemInput holds the parameters required to append an item to a todo's checklist.
+type AddChecklistItemInput struct {
+	TodoID int    `validate:"required,gt=0"`
+	UserID int    `validate:"required,gt=0"`
+	Text   string `validate:"required,max=255"`
+}
+
+// NewAddChecklistItemInput creates a validated AddChecklistItemInput. Returns an error if validation fails.
+func NewAddChecklistItemInput(todoID int, userID int, text string) (*AddChecklistItemInput, error) {
+	m := &AddChecklistItemInput{
+		TodoID: todoID,
+		UserID: userID,
+		Text:   text,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate add checklist item input: %w", err)
+	}
+	return m, nil
+}
+
+// AddChecklistItemOutput holds the result of adding a checklist item and the todo it belongs to.
+type AddChecklistItemOutput struct {
+	Item *ChecklistItem `validate:"required"`
+	Todo *Todo          `validate:"required"`
+}
+
+// NewAddChecklistItemOutput creates a validated AddChecklistItemOutput. Returns an error if validation fails.
+func NewAddChecklistItemOutput(item *ChecklistItem, todo *Todo) (*AddChecklistItemOutput, error) {
+	m := &AddChecklistItemOutput{
+		Item: item,
+		Todo: todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate add checklist item output: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateChecklistItemInput holds the parameters required to edit or toggle a checklist item.
+type UpdateChecklistItemInput struct {
+	ID        int    `validate:"required,gt=0"`
+	TodoID    int    `validate:"required,gt=0"`
+	UserID    int    `validate:"required,gt=0"`
+	Text      string `validate:"required,max=255"`
+	IsChecked bool
+}
+
+// NewUpdateChecklistItemInput creates a validated UpdateChecklistItemInput. Returns an error if validation fails.
+func NewUpdateChecklistItemInput(id int, todoID int, userID int, text string, isChecked bool) (*UpdateChecklistItemInput, error) {
+	m := &UpdateChecklistItemInput{
+		ID:        id,
+		TodoID:    todoID,
+		UserID:    userID,
+		Text:      text,
+		IsChecked: isChecked,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate update checklist item input: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateChecklistItemOutput holds the result of a checklist item update and the todo it belongs to.
+type UpdateChecklistItemOutput struct {
+	Item *ChecklistItem `validate:"required"`
+	Todo *Todo          `validate:"required"`
+}
+
+// NewUpdateChecklistItemOutput creates a validated UpdateChecklistItemOutput. Returns an error if validation fails.
+func NewUpdateChecklistItemOutput(item *ChecklistItem, todo *Todo) (*UpdateChecklistItemOutput, error) {
+	m := &UpdateChecklistItemOutput{
+		Item: item,
+		Todo: todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate update checklist item output: %w", err)
+	}
+	return m, nil
+}
+
+// ReorderChecklistInput holds the desired order of a todo's checklist.
+// ItemIDs must list every current item of the todo exactly once.
+type ReorderChecklistInput struct {
+	TodoID  int   `validate:"required,gt=0"`
+	UserID  int   `validate:"required,gt=0"`
+	ItemIDs []int `validate:"max=100,unique,dive,gt=0"`
+}
+
+// NewReorderChecklistInput creates a validated ReorderChecklistInput. Returns an error if validation fails.
+func NewReorderChecklistInput(todoID int, userID int, itemIDs []int) (*ReorderChecklistInput, error) {
+	m := &ReorderChecklistInput{
+		TodoID:  todoID,
+		UserID:  userID,
+		ItemIDs: itemIDs,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate reorder checklist input: %w", err)
+	}
+	return m, nil
+}
+
+// ReorderChecklistOutput holds the checklist after reordering and the todo it belongs to.
+type ReorderChecklistOutput struct {
+	Items []ChecklistItem `validate:"dive"`
+	Todo  *Todo           `validate:"required"`
+}
+
+// NewReorderChecklistOutput creates a validated ReorderChecklistOutput. Returns an error if validation fails.
+func NewReorderChecklistOutput(items []ChecklistItem, todo *Todo) (*ReorderChecklistOutput, error) {
+	m := &ReorderChecklistOutput{
+		Items: items,
+		Todo:  todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate reorder checklist output: %w", err)
+	}
+	return m, nil
+}
+
+// DeleteChecklistItemInput holds the parameters required to remove a checklist item.
+type DeleteChecklistItemInput struct {
+	ID     int `validate:"required,gt=0"`
+	TodoID int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewDeleteChecklistItemInput creates a validated DeleteChecklistItemInput. Returns an error if validation fails.
+func NewDeleteChecklistItemInput(id int, todoID int, userID int) (*DeleteChecklistItemInput, error) {
+	m := &DeleteChecklistItemInput{
+		ID:     id,
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate delete checklist item input: %w", err)
+	}
+	return m, nil
+}
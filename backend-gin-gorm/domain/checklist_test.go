@@ -0,0 +1,186 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewChecklistItem tests
+func TestNewChecklistItem_shouldReturnItem_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// given
+	now := time.Now()
+
+	// when
+	item, err := domain.NewChecklistItem(1, 2, "step", true, 0, now, now)
+
+	// then
+	require.NoError(t, err, "expected no error for valid ChecklistItem")
+	assert.Equal(t, 1, item.ID, "expected ID to match")
+	assert.Equal(t, 2, item.TodoID, "expected TodoID to match")
+	assert.Equal(t, "step", item.Text, "expected Text to match")
+	assert.True(t, item.IsChecked, "expected IsChecked to match")
+	assert.Equal(t, 0, item.Position, "expected Position to match")
+}
+
+func TestNewChecklistItem_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	// given
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		id       int
+		todoID   int
+		text     string
+		position int
+	}{
+		{
+			name:     "ID is zero",
+			id:       0,
+			todoID:   1,
+			text:     "step",
+			position: 0,
+		},
+		{
+			name:     "TodoID is zero",
+			id:       1,
+			todoID:   0,
+			text:     "step",
+			position: 0,
+		},
+		{
+			name:     "text is empty",
+			id:       1,
+			todoID:   1,
+			text:     "",
+			position: 0,
+		},
+		{
+			name:     "position is negative",
+			id:       1,
+			todoID:   1,
+			text:     "step",
+			position: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			item, err := domain.NewChecklistItem(tt.id, tt.todoID, tt.text, false, tt.position, now, now)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, item, "expected nil ChecklistItem")
+			assert.Contains(t, err.Error(), "validate checklist item model", "error should mention validation")
+		})
+	}
+}
+
+// NewAddChecklistItemInput tests
+func TestNewAddChecklistItemInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		todoID int
+		userID int
+		text   string
+	}{
+		{
+			name:   "TodoID is zero",
+			todoID: 0,
+			userID: 1,
+			text:   "step",
+		},
+		{
+			name:   "UserID is zero",
+			todoID: 1,
+			userID: 0,
+			text:   "step",
+		},
+		{
+			name:   "text is empty",
+			todoID: 1,
+			userID: 1,
+			text:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewAddChecklistItemInput(tt.todoID, tt.userID, tt.text)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, input, "expected nil AddChecklistItemInput")
+			assert.Contains(t, err.Error(), "validate add checklist item input", "error should mention validation")
+		})
+	}
+}
+
+// NewReorderChecklistInput tests
+func TestNewReorderChecklistInput_shouldReturnInput_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// when
+	input, err := domain.NewReorderChecklistInput(1, 2, []int{3, 1, 2})
+
+	// then
+	require.NoError(t, err, "expected no error for valid ReorderChecklistInput")
+	assert.Equal(t, []int{3, 1, 2}, input.ItemIDs, "expected ItemIDs to match")
+}
+
+func TestNewReorderChecklistInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		itemIDs []int
+	}{
+		{
+			name:    "duplicate item IDs",
+			itemIDs: []int{1, 1},
+		},
+		{
+			name:    "item ID is zero",
+			itemIDs: []int{0},
+		},
+		{
+			name:    "too many item IDs",
+			itemIDs: sequence(domain.MaxChecklistItems + 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewReorderChecklistInput(1, 1, tt.itemIDs)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, input, "expected nil ReorderChecklistInput")
+			assert.Contains(t, err.Error(), "validate reorder checklist input", "error should mention validation")
+		})
+	}
+}
+
+func sequence(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i + 1
+	}
+	return s
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrCommentNotFound is returned when a requested comment does not exist.
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrForbidden is returned when the user can see a resource but is not allowed to perform the requested operation on it.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// CommentAuthor identifies the user who posted a comment.
+// LoginID is captured when the comment is posted so that it can be shown without a user lookup.
+type CommentAuthor struct {
+	UserID  int    `validate:"required,gt=0"`
+	LoginID string `validate:"required,max=255"`
+}
+
+// NewCommentAuthor creates a validated CommentAuthor. Returns an error if validation fails.
+func NewCommentAuthor(userID int, loginID string) (*CommentAuthor, error) {
+	m := &CommentAuthor{
+		UserID:  userID,
+		LoginID: loginID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate comment author: %w", err)
+	}
+	return m, nil
+}
+
+// Comment represents a message posted on a todo.
+// EditedAt is nil until the author edits the text.
+type Comment struct {
+	ID        int           `validate:"required,gt=0"`
+	TodoID    int           `validate:"required,gt=0"`
+	Author    CommentAuthor `validate:"required"`
+	Text      string        `validate:"required,max=2000"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	EditedAt  *time.Time
+}
+
+// NewComment creates a validated Comment. Returns an error if validation fails.
+func NewComment(id int, todoID int, author CommentAuthor, text string, createdAt, updatedAt time.Time, editedAt *time.Time) (*Comment, error) {
+	m := &Comment{
+		ID:        id,
+		TodoID:    todoID,
+		Author:    author,
+		Text:      text,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+		EditedAt:  editedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate comment model: %w", err)
+	}
+	return m, nil
+}
+
+// CanEdit reports whether the user may edit the comment. Only the author can edit a comment.
+func (c *Comment) CanEdit(userID int) bool {
+	return c.Author.UserID == userID
+}
+
+// CanDelete reports whether the user may delete the comment.
+// The author and the owner of the todo the comment was posted on can delete a comment.
+func (c *Comment) CanDelete(userID int, todoOwnerID int) bool {
+	return c.Author.UserID == userID || todoOwnerID == userID
+}
+
+// CreateCommentInput holds the parameters required to post a comment on a todo.
+type CreateCommentInput struct {
+	TodoID int           `validate:"required,gt=0"`
+	Author CommentAuthor `validate:"required"`
+	Text   string        `validate:"required,max=2000"`
+}
+
+// NewCreateCommentInput creates a validated CreateCommentInput. Returns an error if validation fails.
+func NewCreateCommentInput(todoID int, userID int, loginID string, text string) (*CreateCommentInput, error) {
+	m := &CreateCommentInput{
+		TodoID: todoID,
+		Author: CommentAuthor{
+			UserID:  userID,
+			LoginID: loginID,
+		},
+		Text: text,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create comment input: %w", err)
+	}
+	return m, nil
+}
+
+// CreateCommentOutput holds the result of posting a comment and the todo it was posted on.
+type CreateCommentOutput struct {
+	Comment *Comment `validate:"required"`
+	Todo    *Todo    `validate:"required"`
+}
+
+// NewCreateCommentOutput creates a validated CreateCommentOutput. Returns an error if validation fails.
+func NewCreateCommentOutput(comment *Comment, todo *Todo) (*CreateCommentOutput, error) {
+	m := &CreateCommentOutput{
+		Comment: comment,
+		Todo:    todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create comment output: %w", err)
+	}
+	return m, nil
+}
+
+// FindCommentsInput holds the parameters required to list the comments of a todo.
+type FindCommentsInput struct {
+	TodoID int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewFindCommentsInput creates a validated FindCommentsInput. Returns an error if validation fails.
+func NewFindCommentsInput(todoID int, userID int) (*FindCommentsInput, error) {
+	m := &FindCommentsInput{
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find comments input: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateCommentInput holds the parameters required to edit a comment.
+type UpdateCommentInput struct {
+	ID     int    `validate:"required,gt=0"`
+	TodoID int    `validate:"required,gt=0"`
+	UserID int    `validate:"required,gt=0"`
+	Text   string `validate:"required,max=2000"`
+}
+
+// NewUpdateCommentInput creates a validated UpdateCommentInput. Returns an error if validation fails.
+func NewUpdateCommentInput(id int, todoID int, userID int, text string) (*UpdateCommentInput, error) {
+	m := &UpdateCommentInput{
+		ID:     id,
+		TodoID: todoID,
+		UserID: userID,
+		Text:   text,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate update comment input: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateCommentOutput holds the result of a comment edit.
+type UpdateCommentOutput struct {
+	Comment *Comment `validate:"required"`
+}
+
+// NewUpdateCommentOutput creates a validated UpdateCommentOutput. Returns an error if validation fails.
+func NewUpdateCommentOutput(comment *Comment) (*UpdateCommentOutput, error) {
+	m := &UpdateCommentOutput{
+		Comment: comment,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate update comment output: %w", err)
+	}
+	return m, nil
+}
+
+// DeleteCommentInput holds the parameters required to delete a comment.
+type DeleteCommentInput struct {
+	ID     int `validate:"required,gt=0"`
+	TodoID int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewDeleteCommentInput creates a validated DeleteCommentInput. Returns an error if validation fails.
+func NewDeleteCommentInput(id int, todoID int, userID int) (*DeleteCommentInput, error) {
+	m := &DeleteCommentInput{
+		ID:     id,
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate delete comment input: %w", err)
+	}
+	return m, nil
+}
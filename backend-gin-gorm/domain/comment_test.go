@@ -0,0 +1,87 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func newTestComment(t *testing.T, authorID int) *domain.Comment {
+	t.Helper()
+	now := time.Now()
+	comment, err := domain.NewComment(1, 2, domain.CommentAuthor{UserID: authorID, LoginID: "author"}, "looks good", now, now, nil)
+	require.NoError(t, err)
+	return comment
+}
+
+// Comment authorization tests
+func TestComment_CanEdit_shouldAllowOnlyAuthor(t *testing.T) {
+	t.Parallel()
+
+	// given
+	comment := newTestComment(t, 10)
+
+	// then
+	assert.True(t, comment.CanEdit(10), "expected author to be able to edit")
+	assert.False(t, comment.CanEdit(20), "expected non-author to be unable to edit")
+}
+
+func TestComment_CanDelete_shouldAllowAuthorAndTodoOwner(t *testing.T) {
+	t.Parallel()
+
+	// given
+	comment := newTestComment(t, 10)
+
+	// then
+	assert.True(t, comment.CanDelete(10, 30), "expected author to be able to delete")
+	assert.True(t, comment.CanDelete(30, 30), "expected todo owner to be able to delete")
+	assert.False(t, comment.CanDelete(20, 30), "expected other users to be unable to delete")
+}
+
+// NewCreateCommentInput tests
+func TestNewCreateCommentInput_shouldReturnInput_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// when
+	input, err := domain.NewCreateCommentInput(1, 2, "user1", strings.Repeat("あ", 2000))
+
+	// then
+	require.NoError(t, err, "expected no error for valid CreateCommentInput")
+	assert.Equal(t, 1, input.TodoID, "expected TodoID to match")
+	assert.Equal(t, domain.CommentAuthor{UserID: 2, LoginID: "user1"}, input.Author, "expected Author to match")
+}
+
+func TestNewCreateCommentInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		todoID  int
+		userID  int
+		loginID string
+		text    string
+	}{
+		{name: "TodoID is zero", todoID: 0, userID: 1, loginID: "user1", text: "hi"},
+		{name: "UserID is zero", todoID: 1, userID: 0, loginID: "user1", text: "hi"},
+		{name: "loginID is empty", todoID: 1, userID: 1, loginID: "", text: "hi"},
+		{name: "text is empty", todoID: 1, userID: 1, loginID: "user1", text: ""},
+		{name: "text is too long", todoID: 1, userID: 1, loginID: "user1", text: strings.Repeat("a", 2001)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.NewCreateCommentInput(tt.todoID, tt.userID, tt.loginID, tt.text)
+
+			// then
+			require.Error(t, err, "expected error for invalid CreateCommentInput")
+		})
+	}
+}
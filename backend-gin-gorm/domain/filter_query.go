@@ -0,0 +1,267 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MaxFilterQueryLength is the maximum number of characters in a filter query.
+const MaxFilterQueryLength = 500
+
+// FilterOperator relates the field of a filter term to its value.
+type FilterOperator string
+
+// Operators of filter terms.
+const (
+	FilterOperatorMatch          FilterOperator = ":"
+	FilterOperatorLess           FilterOperator = "<"
+	FilterOperatorLessOrEqual    FilterOperator = "<="
+	FilterOperatorGreater        FilterOperator = ">"
+	FilterOperatorGreaterOrEqual FilterOperator = ">="
+)
+
+// FilterTerm is one term of a filter query, such as is:open, due<7d or "invoice".
+// Field and Operator are empty for a bare value. Field is lower case.
+// Pos and ValuePos are the 1-based columns at which the term and its value start.
+type FilterTerm struct {
+	Field    string
+	Operator FilterOperator
+	Value    string
+	Pos      int
+	ValuePos int
+}
+
+// FilterQuery is the syntax tree of a filter query: a list of terms that must all hold.
+type FilterQuery struct {
+	Terms []FilterTerm
+}
+
+// String formats the query in canonical form. Parsing the result gives back the same terms.
+func (q *FilterQuery) String() string {
+	terms := make([]string, len(q.Terms))
+	for i, term := range q.Terms {
+		if term.Field == "" {
+			terms[i] = formatFilterValue(term.Value, true)
+			continue
+		}
+		terms[i] = term.Field + string(term.Operator) + formatFilterValue(term.Value, false)
+	}
+	return strings.Join(terms, " ")
+}
+
+func formatFilterValue(value string, bare bool) string {
+	needsQuote := value == "" || strings.ContainsFunc(value, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '"' || r == '\\' || (bare && isFilterOperatorRune(r))
+	})
+	// A value starting with "=" would be read as part of a "<=" or ">=" operator
+	if !needsQuote && !bare && strings.HasPrefix(value, "=") {
+		needsQuote = true
+	}
+	if !needsQuote {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// FilterQueryError reports a syntax or meaning error in a filter query at a 1-based column.
+type FilterQueryError struct {
+	Pos int
+	Msg string
+}
+
+func (e *FilterQueryError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos, e.Msg)
+}
+
+func newFilterQueryError(pos int, format string, args ...any) *FilterQueryError {
+	return &FilterQueryError{
+		Pos: pos,
+		Msg: fmt.Sprintf(format, args...),
+	}
+}
+
+// ParseFilterQuery parses a filter query made of whitespace-separated terms. A term is either a value
+// or a field, an operator (one of : < <= > >=) and a value. Values containing whitespace, quotes or
+// operator characters are written in double quotes, escaping " and \ with a backslash.
+// Returns a *FilterQueryError pointing at the offending column if the query is malformed.
+func ParseFilterQuery(query string) (*FilterQuery, error) {
+	p := &filterQueryParser{
+		input: []rune(query),
+		pos:   0,
+	}
+	if len(p.input) > MaxFilterQueryLength {
+		return nil, newFilterQueryError(MaxFilterQueryLength+1, "query must be at most %d characters", MaxFilterQueryLength)
+	}
+
+	terms := make([]FilterTerm, 0)
+	for {
+		p.skipSpaces()
+		if p.atEnd() {
+			break
+		}
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, *term)
+	}
+
+	return &FilterQuery{
+		Terms: terms,
+	}, nil
+}
+
+type filterQueryParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *filterQueryParser) atEnd() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *filterQueryParser) peek() rune {
+	if p.atEnd() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// column returns the 1-based column of the current position.
+func (p *filterQueryParser) column() int {
+	return p.pos + 1
+}
+
+func (p *filterQueryParser) skipSpaces() {
+	for !p.atEnd() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *filterQueryParser) parseTerm() (*FilterTerm, error) {
+	start := p.column()
+	if p.peek() == '"' {
+		value, err := p.parseQuoted()
+		if err != nil {
+			return nil, err
+		}
+		return &FilterTerm{Field: "", Operator: "", Value: value, Pos: start, ValuePos: start}, nil
+	}
+
+	word := p.parseWhile(func(r rune) bool {
+		return !unicode.IsSpace(r) && r != '"' && !isFilterOperatorRune(r)
+	})
+	operator := p.parseOperator()
+	if operator == "" {
+		if p.peek() == '"' {
+			return nil, newFilterQueryError(p.column(), `unexpected '"'; quote the whole value`)
+		}
+		return &FilterTerm{Field: "", Operator: "", Value: word, Pos: start, ValuePos: start}, nil
+	}
+	if word == "" {
+		return nil, newFilterQueryError(start, "missing field name before %q", operator)
+	}
+	for i, r := range word {
+		if !isFilterFieldRune(r) {
+			return nil, newFilterQueryError(start+len([]rune(word[:i])), "invalid character %q in field name", r)
+		}
+	}
+
+	valuePos := p.column()
+	var value string
+	if p.peek() == '"' {
+		quoted, err := p.parseQuoted()
+		if err != nil {
+			return nil, err
+		}
+		value = quoted
+	} else {
+		value = p.parseWhile(func(r rune) bool {
+			return !unicode.IsSpace(r) && r != '"'
+		})
+		if value == "" {
+			if p.peek() == '"' {
+				return nil, newFilterQueryError(p.column(), `unexpected '"'; quote the whole value`)
+			}
+			return nil, newFilterQueryError(valuePos, "missing value after %q", word+string(operator))
+		}
+		if p.peek() == '"' {
+			return nil, newFilterQueryError(p.column(), `unexpected '"'; quote the whole value`)
+		}
+	}
+
+	return &FilterTerm{
+		Field:    strings.ToLower(word),
+		Operator: operator,
+		Value:    value,
+		Pos:      start,
+		ValuePos: valuePos,
+	}, nil
+}
+
+func (p *filterQueryParser) parseWhile(accept func(r rune) bool) string {
+	start := p.pos
+	for !p.atEnd() && accept(p.peek()) {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+func (p *filterQueryParser) parseOperator() FilterOperator {
+	switch p.peek() {
+	case ':':
+		p.pos++
+		return FilterOperatorMatch
+	case '<', '>':
+		operator := FilterOperator(p.peek())
+		p.pos++
+		if p.peek() == '=' {
+			p.pos++
+			operator += "="
+		}
+		return operator
+	default:
+		return ""
+	}
+}
+
+// parseQuoted parses a double-quoted value, which must be followed by whitespace or the end of the query.
+func (p *filterQueryParser) parseQuoted() (string, error) {
+	start := p.column()
+	p.pos++ // opening quote
+	var b strings.Builder
+	for {
+		if p.atEnd() {
+			return "", newFilterQueryError(start, "unterminated quoted value")
+		}
+		r := p.peek()
+		switch r {
+		case '"':
+			p.pos++
+			if !p.atEnd() && !unicode.IsSpace(p.peek()) {
+				return "", newFilterQueryError(p.column(), "expected whitespace after quoted value")
+			}
+			return b.String(), nil
+		case '\\':
+			p.pos++
+			if escaped := p.peek(); escaped == '"' || escaped == '\\' {
+				b.WriteRune(escaped)
+				p.pos++
+				continue
+			}
+			return "", newFilterQueryError(p.column()-1, `invalid escape; only \" and \\ are allowed`)
+		default:
+			b.WriteRune(r)
+			p.pos++
+		}
+	}
+}
+
+func isFilterOperatorRune(r rune) bool {
+	return r == ':' || r == '<' || r == '>'
+}
+
+func isFilterFieldRune(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
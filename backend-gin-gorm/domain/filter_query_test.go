@@ -0,0 +1,201 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ParseFilterQuery tests
+func TestParseFilterQuery_shouldReturnTerms_whenQueryIsValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []domain.FilterTerm
+	}{
+		{
+			name:     "empty query",
+			query:    "  ",
+			expected: []domain.FilterTerm{},
+		},
+		{
+			name:  "fields, operators and quoted values",
+			query: `is:open tag:work due<7d text:"pay \"the\" invoice"`,
+			expected: []domain.FilterTerm{
+				{Field: "is", Operator: domain.FilterOperatorMatch, Value: "open", Pos: 1, ValuePos: 4},
+				{Field: "tag", Operator: domain.FilterOperatorMatch, Value: "work", Pos: 9, ValuePos: 13},
+				{Field: "due", Operator: domain.FilterOperatorLess, Value: "7d", Pos: 18, ValuePos: 22},
+				{Field: "text", Operator: domain.FilterOperatorMatch, Value: `pay "the" invoice`, Pos: 25, ValuePos: 30},
+			},
+		},
+		{
+			name:  "two-character operators and values containing colons",
+			query: "created>=2025-01-01T09:00:00Z updated<=3w",
+			expected: []domain.FilterTerm{
+				{Field: "created", Operator: domain.FilterOperatorGreaterOrEqual, Value: "2025-01-01T09:00:00Z", Pos: 1, ValuePos: 10},
+				{Field: "updated", Operator: domain.FilterOperatorLessOrEqual, Value: "3w", Pos: 31, ValuePos: 40},
+			},
+		},
+		{
+			name:  "bare values and upper case field",
+			query: `請求書 "a b"  IS:done`,
+			expected: []domain.FilterTerm{
+				{Value: "請求書", Pos: 1, ValuePos: 1},
+				{Value: "a b", Pos: 5, ValuePos: 5},
+				{Field: "is", Operator: domain.FilterOperatorMatch, Value: "done", Pos: 12, ValuePos: 15},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			query, err := domain.ParseFilterQuery(tt.query)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, query.Terms)
+		})
+	}
+}
+
+func TestParseFilterQuery_shouldReturnErrorWithPosition_whenQueryIsMalformed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedPos int
+		expectedMsg string
+	}{
+		{
+			name:        "unterminated quote",
+			query:       `is:open text:"invoice`,
+			expectedPos: 14,
+			expectedMsg: "unterminated quoted value",
+		},
+		{
+			name:        "missing field name",
+			query:       "is:open :work",
+			expectedPos: 9,
+			expectedMsg: `missing field name before ":"`,
+		},
+		{
+			name:        "missing value",
+			query:       "due< is:open",
+			expectedPos: 5,
+			expectedMsg: `missing value after "due<"`,
+		},
+		{
+			name:        "quote inside a value",
+			query:       `tag:wo"rk"`,
+			expectedPos: 7,
+			expectedMsg: `unexpected '"'; quote the whole value`,
+		},
+		{
+			name:        "text after closing quote",
+			query:       `"a"b`,
+			expectedPos: 4,
+			expectedMsg: "expected whitespace after quoted value",
+		},
+		{
+			name:        "invalid escape",
+			query:       `text:"a\nb"`,
+			expectedPos: 8,
+			expectedMsg: `invalid escape; only \" and \\ are allowed`,
+		},
+		{
+			name:        "invalid field name",
+			query:       "請求:open",
+			expectedPos: 1,
+			expectedMsg: `invalid character '請' in field name`,
+		},
+		{
+			name:        "query is too long",
+			query:       strings.Repeat("a", domain.MaxFilterQueryLength+1),
+			expectedPos: domain.MaxFilterQueryLength + 1,
+			expectedMsg: "query must be at most 500 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			query, err := domain.ParseFilterQuery(tt.query)
+
+			// then
+			assert.Nil(t, query)
+			var queryErr *domain.FilterQueryError
+			require.ErrorAs(t, err, &queryErr)
+			assert.Equal(t, tt.expectedPos, queryErr.Pos)
+			assert.Equal(t, tt.expectedMsg, queryErr.Msg)
+		})
+	}
+}
+
+func TestFilterQuery_String_shouldQuoteValuesThatNeedIt(t *testing.T) {
+	t.Parallel()
+
+	// given
+	query, err := domain.ParseFilterQuery(`"a:b"  Text:"x y"  due<"=1"  "back\\slash" plain`)
+	require.NoError(t, err)
+
+	// when
+	s := query.String()
+
+	// then
+	assert.Equal(t, `"a:b" text:"x y" due<"=1" "back\\slash" plain`, s)
+}
+
+func FuzzParseFilterQuery(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		`is:open tag:work due<7d text:"invoice"`,
+		`created>=2025-01-01 updated<=2w "a \"b\" c"`,
+		`請求書 text:"見積\\書"`,
+		`:x "unterminated`,
+		`a<=b>=c::d`,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		query, err := domain.ParseFilterQuery(input)
+		if err != nil {
+			// errors point into the query, or just past its end
+			var queryErr *domain.FilterQueryError
+			require.ErrorAs(t, err, &queryErr, "error should be a FilterQueryError")
+			assert.GreaterOrEqual(t, queryErr.Pos, 1)
+			assert.LessOrEqual(t, queryErr.Pos, utf8.RuneCountInString(input)+1)
+			return
+		}
+
+		for _, term := range query.Terms {
+			assert.GreaterOrEqual(t, term.ValuePos, term.Pos)
+			assert.LessOrEqual(t, term.ValuePos, utf8.RuneCountInString(input))
+		}
+
+		// the canonical form parses back to the same terms, unless quoting made it too long
+		canonical := query.String()
+		if utf8.RuneCountInString(canonical) > domain.MaxFilterQueryLength {
+			return
+		}
+		reparsed, err := domain.ParseFilterQuery(canonical)
+		require.NoError(t, err, "canonical form %q should parse", canonical)
+		require.Len(t, reparsed.Terms, len(query.Terms))
+		for i, term := range query.Terms {
+			assert.Equal(t, term.Field, reparsed.Terms[i].Field)
+			assert.Equal(t, term.Operator, reparsed.Terms[i].Operator)
+			assert.Equal(t, term.Value, reparsed.Terms[i].Value)
+		}
+	})
+}
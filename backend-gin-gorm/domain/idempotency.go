@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned when an idempotency key is sent again with a request that differs
+	// from the one it was first used with.
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+	// ErrIdempotencyKeyInFlight is returned when the request first sent with an idempotency key is still being processed.
+	ErrIdempotencyKeyInFlight = errors.New("idempotency key in flight")
+)
+
+// IdempotentResponse is the response stored for an idempotency key and replayed for retries of the request.
+// Header holds the response headers worth replaying, such as Content-Type and ETag.
+type IdempotentResponse struct {
+	StatusCode int `validate:"gte=100,lte=599"`
+	Header     map[string]string
+	Body       []byte
+}
+
+// BeginIdempotentRequestInput identifies a request a user sent with an idempotency key.
+// Fingerprint is a digest of the request that tells a retry from a different request using the same key.
+type BeginIdempotentRequestInput struct {
+	UserID      int    `validate:"required,gt=0"`
+	Key         string `validate:"required,max=255,printascii"`
+	Fingerprint string `validate:"required,len=64,hexadecimal"`
+}
+
+// NewBeginIdempotentRequestInput creates a validated BeginIdempotentRequestInput. Returns an error if validation fails.
+func NewBeginIdempotentRequestInput(userID int, key string, fingerprint string) (*BeginIdempotentRequestInput, error) {
+	m := &BeginIdempotentRequestInput{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate begin idempotent request input: %w", err)
+	}
+	return m, nil
+}
+
+// ClaimIdempotencyKeyInput holds the parameters required to claim an idempotency key for a request.
+// A key whose ExpiresAt has passed as of Now is free to be claimed again.
+type ClaimIdempotencyKeyInput struct {
+	UserID      int       `validate:"required,gt=0"`
+	Key         string    `validate:"required,max=255"`
+	Fingerprint string    `validate:"required,len=64"`
+	Now         time.Time `validate:"required"`
+	ExpiresAt   time.Time `validate:"required,gtfield=Now"`
+}
+
+// NewClaimIdempotencyKeyInput creates a validated ClaimIdempotencyKeyInput. Returns an error if validation fails.
+func NewClaimIdempotencyKeyInput(request *BeginIdempotentRequestInput, now time.Time, expiresAt time.Time) (*ClaimIdempotencyKeyInput, error) {
+	m := &ClaimIdempotencyKeyInput{
+		UserID:      request.UserID,
+		Key:         request.Key,
+		Fingerprint: request.Fingerprint,
+		Now:         now,
+		ExpiresAt:   expiresAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate claim idempotency key input: %w", err)
+	}
+	return m, nil
+}
+
+// CompleteIdempotentRequestInput holds the response to store for the idempotency key of a processed request.
+type CompleteIdempotentRequestInput struct {
+	UserID   int                `validate:"required,gt=0"`
+	Key      string             `validate:"required,max=255"`
+	Response IdempotentResponse `validate:"required"`
+}
+
+// NewCompleteIdempotentRequestInput creates a validated CompleteIdempotentRequestInput. Returns an error if validation fails.
+func NewCompleteIdempotentRequestInput(userID int, key string, response IdempotentResponse) (*CompleteIdempotentRequestInput, error) {
+	m := &CompleteIdempotentRequestInput{
+		UserID:   userID,
+		Key:      key,
+		Response: response,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate complete idempotent request input: %w", err)
+	}
+	return m, nil
+}
+
+// ReleaseIdempotentRequestInput identifies the idempotency key of a request that failed without a response worth
+// replaying, so that a retry can run the request again.
+type ReleaseIdempotentRequestInput struct {
+	UserID int    `validate:"required,gt=0"`
+	Key    string `validate:"required,max=255"`
+}
+
+// NewReleaseIdempotentRequestInput creates a validated ReleaseIdempotentRequestInput. Returns an error if validation fails.
+func NewReleaseIdempotentRequestInput(userID int, key string) (*ReleaseIdempotentRequestInput, error) {
+	m := &ReleaseIdempotentRequestInput{
+		UserID: userID,
+		Key:    key,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate release idempotent request input: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeIdempotencyKeysInput holds the parameters required to delete idempotency keys that expired before a given time.
+type PurgeIdempotencyKeysInput struct {
+	ExpiredBefore time.Time `validate:"required"`
+	BatchSize     int       `validate:"gte=1,lte=1000"`
+}
+
+// NewPurgeIdempotencyKeysInput creates a validated PurgeIdempotencyKeysInput. Returns an error if validation fails.
+func NewPurgeIdempotencyKeysInput(expiredBefore time.Time, batchSize int) (*PurgeIdempotencyKeysInput, error) {
+	m := &PurgeIdempotencyKeysInput{
+		ExpiredBefore: expiredBefore,
+		BatchSize:     batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge idempotency keys input: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeIdempotencyKeysOutput holds the number of idempotency keys deleted by a purge.
+type PurgeIdempotencyKeysOutput struct {
+	PurgedCount int `validate:"gte=0"`
+}
+
+// NewPurgeIdempotencyKeysOutput creates a validated PurgeIdempotencyKeysOutput. Returns an error if validation fails.
+func NewPurgeIdempotencyKeysOutput(purgedCount int) (*PurgeIdempotencyKeysOutput, error) {
+	m := &PurgeIdempotencyKeysOutput{
+		PurgedCount: purgedCount,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge idempotency keys output: %w", err)
+	}
+	return m, nil
+}
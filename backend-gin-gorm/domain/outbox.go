@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// todoEventPayload is the JSON encoding of a todo event published from the outbox. It mirrors the todo event stream.
+type todoEventPayload struct {
+	ID         string                `json:"id"`
+	Type       TodoEventType         `json:"type"`
+	OccurredAt time.Time             `json:"occurredAt"`
+	TodoID     int                   `json:"todoId"`
+	Todo       *todoEventPayloadTodo `json:"todo,omitempty"`
+}
+
+type todoEventPayloadTodo struct {
+	ID          int        `json:"id"`
+	Text        string     `json:"text"`
+	IsComplete  bool       `json:"isComplete"`
+	Version     int        `json:"version"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	ArchivedAt  *time.Time `json:"archivedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// OutboxEvent is a todo event written to the outbox in the transaction of the change it reports,
+// so that it is published if and only if the change is committed.
+// ID is assigned when the event is stored. EventID identifies the event in every publication of it
+// so that consumers can drop duplicates; Payload is its JSON encoding.
+type OutboxEvent struct {
+	ID         int64
+	EventID    string        `validate:"required,len=32,hexadecimal"`
+	Type       TodoEventType `validate:"required,oneof=created updated deleted"`
+	UserID     int           `validate:"required,gt=0"`
+	TodoID     int           `validate:"required,gt=0"`
+	Payload    []byte        `validate:"required"`
+	OccurredAt time.Time     `validate:"required"`
+}
+
+// NewOutboxEvent assigns a new event ID to a created, updated or deleted todo event and encodes its payload.
+// Returns an error if the event cannot be written to the outbox.
+func NewOutboxEvent(event *TodoEvent) (*OutboxEvent, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("read random bytes: %w", err)
+	}
+	eventID := hex.EncodeToString(b)
+
+	p := todoEventPayload{
+		ID:         eventID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		TodoID:     event.TodoID,
+		Todo:       nil,
+	}
+	if event.Todo != nil {
+		p.Todo = &todoEventPayloadTodo{
+			ID:          event.Todo.ID,
+			Text:        event.Todo.Text,
+			IsComplete:  event.Todo.IsComplete,
+			Version:     event.Todo.Version,
+			CompletedAt: event.Todo.CompletedAt,
+			ArchivedAt:  event.Todo.ArchivedAt,
+			CreatedAt:   event.Todo.CreatedAt,
+			UpdatedAt:   event.Todo.UpdatedAt,
+		}
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("marshal todo event payload: %w", err)
+	}
+
+	m := &OutboxEvent{
+		ID:         0,
+		EventID:    eventID,
+		Type:       event.Type,
+		UserID:     event.UserID,
+		TodoID:     event.TodoID,
+		Payload:    payload,
+		OccurredAt: event.OccurredAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate outbox event: %w", err)
+	}
+	return m, nil
+}
+
+// ClaimOutboxEventsInput holds the parameters for claiming undispatched outbox events that are available as of Now.
+// Claimed events are not claimed again before LeaseUntil, so that an event whose relay stopped before marking it
+// dispatched is published again afterwards.
+type ClaimOutboxEventsInput struct {
+	Now        time.Time `validate:"required"`
+	LeaseUntil time.Time `validate:"required,gtfield=Now"`
+	BatchSize  int       `validate:"gte=1,lte=1000"`
+}
+
+// NewClaimOutboxEventsInput creates a validated ClaimOutboxEventsInput. Returns an error if validation fails.
+func NewClaimOutboxEventsInput(now time.Time, leaseUntil time.Time, batchSize int) (*ClaimOutboxEventsInput, error) {
+	m := &ClaimOutboxEventsInput{
+		Now:        now,
+		LeaseUntil: leaseUntil,
+		BatchSize:  batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate claim outbox events input: %w", err)
+	}
+	return m, nil
+}
+
+// RelayOutboxEventsInput holds the parameters for one run of the outbox relay.
+// Events are claimed and published in batches of BatchSize.
+type RelayOutboxEventsInput struct {
+	BatchSize int `validate:"gte=1,lte=1000"`
+}
+
+// NewRelayOutboxEventsInput creates a validated RelayOutboxEventsInput. Returns an error if validation fails.
+func NewRelayOutboxEventsInput(batchSize int) (*RelayOutboxEventsInput, error) {
+	m := &RelayOutboxEventsInput{
+		BatchSize: batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate relay outbox events input: %w", err)
+	}
+	return m, nil
+}
+
+// RelayOutboxEventsOutput holds the number of outbox events published and marked dispatched by a run of the relay.
+type RelayOutboxEventsOutput struct {
+	DispatchedCount int `validate:"gte=0"`
+}
+
+// NewRelayOutboxEventsOutput creates a validated RelayOutboxEventsOutput. Returns an error if validation fails.
+func NewRelayOutboxEventsOutput(dispatchedCount int) (*RelayOutboxEventsOutput, error) {
+	m := &RelayOutboxEventsOutput{
+		DispatchedCount: dispatchedCount,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate relay outbox events output: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeOutboxEventsInput holds the parameters required to delete outbox events dispatched before a given time.
+type PurgeOutboxEventsInput struct {
+	DispatchedBefore time.Time `validate:"required"`
+	BatchSize        int       `validate:"gte=1,lte=1000"`
+}
+
+// NewPurgeOutboxEventsInput creates a validated PurgeOutboxEventsInput. Returns an error if validation fails.
+func NewPurgeOutboxEventsInput(dispatchedBefore time.Time, batchSize int) (*PurgeOutboxEventsInput, error) {
+	m := &PurgeOutboxEventsInput{
+		DispatchedBefore: dispatchedBefore,
+		BatchSize:        batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge outbox events input: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeOutboxEventsOutput holds the number of outbox events deleted by a purge.
+type PurgeOutboxEventsOutput struct {
+	PurgedCount int `validate:"gte=0"`
+}
+
+// NewPurgeOutboxEventsOutput creates a validated PurgeOutboxEventsOutput. Returns an error if validation fails.
+func NewPurgeOutboxEventsOutput(purgedCount int) (*PurgeOutboxEventsOutput, error) {
+	m := &PurgeOutboxEventsOutput{
+		PurgedCount: purgedCount,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge outbox events output: %w", err)
+	}
+	return m, nil
+}
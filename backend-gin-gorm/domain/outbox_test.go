@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewOutboxEvent tests
+func TestNewOutboxEvent_shouldEncodePayload_whenTodoIsChanged(t *testing.T) {
+	t.Parallel()
+
+	// given
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	todo := &domain.Todo{ID: 7, UserID: 3, Text: "Buy milk", CreatedAt: now, UpdatedAt: now, Version: 2} //nolint:exhaustruct
+	event := domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)
+
+	// when
+	outboxEvent, err := domain.NewOutboxEvent(&event)
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, outboxEvent.EventID, 32)
+	assert.Equal(t, 3, outboxEvent.UserID)
+	assert.Equal(t, domain.TodoEventUpdated, outboxEvent.Type)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(outboxEvent.Payload, &payload))
+	assert.Equal(t, outboxEvent.EventID, payload["id"])
+	assert.Equal(t, "updated", payload["type"])
+	assert.InDelta(t, 7, payload["todoId"], 0)
+	assert.Equal(t, map[string]any{
+		"id":         float64(7),
+		"text":       "Buy milk",
+		"isComplete": false,
+		"version":    float64(2),
+		"createdAt":  "2025-01-01T00:00:00Z",
+		"updatedAt":  "2025-01-01T00:00:00Z",
+	}, payload["todo"])
+}
+
+func TestNewOutboxEvent_shouldOmitTodo_whenTodoIsDeleted(t *testing.T) {
+	t.Parallel()
+
+	// given
+	event := domain.NewTodoDeletedEvent(3, 7)
+
+	// when
+	outboxEvent, err := domain.NewOutboxEvent(&event)
+
+	// then
+	require.NoError(t, err)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(outboxEvent.Payload, &payload))
+	assert.Equal(t, "deleted", payload["type"])
+	assert.NotContains(t, payload, "todo")
+}
+
+func TestNewOutboxEvent_shouldReturnError_whenEventIsReset(t *testing.T) {
+	t.Parallel()
+
+	// given
+	event := domain.NewTodoResetEvent(3)
+
+	// when
+	_, err := domain.NewOutboxEvent(&event)
+
+	// then
+	require.Error(t, err)
+}
@@ -10,14 +10,36 @@ import (
 // ErrTodoNotFound is returned when a requested todo does not exist.
 var ErrTodoNotFound = errors.New("todo not found")
 
+// TodoVersionMismatchError is returned when a write was made conditional on a version of the todo
+// that is no longer current. Current holds the todo as it is now.
+type TodoVersionMismatchError struct {
+	Current *Todo
+}
+
+func (e *TodoVersionMismatchError) Error() string {
+	return fmt.Sprintf("todo version mismatch: current version is %d", e.Current.Version)
+}
+
 // Todo represents a single todo item belonging to a user.
+// Checklist holds the todo's embedded checklist ordered by position.
+// CommentCount is the number of comments posted on the todo.
+// CompletedAt is set while the todo is complete and records when it was completed.
+// ArchivedAt is set while the todo is archived; archiving is independent of completion.
+// DeletedAt is set while the todo is in the trash.
+// Version starts at 1 and is incremented by every change to the todo or its checklist.
 type Todo struct {
-	ID         int    `validate:"required,gt=0"`
-	UserID     int    `validate:"required,gt=0"`
-	Text       string `validate:"required,max=255"`
-	IsComplete bool
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID           int    `validate:"required,gt=0"`
+	UserID       int    `validate:"required,gt=0"`
+	Text         string `validate:"required,max=255"`
+	IsComplete   bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Checklist    []ChecklistItem `validate:"max=100,dive"`
+	CommentCount int             `validate:"gte=0"`
+	CompletedAt  *time.Time
+	ArchivedAt   *time.Time
+	DeletedAt    *time.Time
+	Version      int `validate:"gte=0"`
 }
 
 // NewTodo creates a validated Todo. Returns an error if validation fails.
@@ -29,6 +51,7 @@ func NewTodo(id int, userID int, text string, isComplete bool, createdAt, update
 		IsComplete: isComplete,
 		CreatedAt:  createdAt,
 		UpdatedAt:  updatedAt,
+		Checklist:  []ChecklistItem{},
 	}
 
 	if err := ValidateStruct(m); err != nil {
@@ -108,20 +131,23 @@ func NewCreateBulkTodosOutput(todos []Todo) (*CreateBulkTodosOutput, error) {
 }
 
 // UpdateTodoInput holds the parameters required to update an existing todo.
+// ExpectedVersion is nil for an unconditional update; otherwise the update only applies to that version.
 type UpdateTodoInput struct {
-	ID         int    `validate:"required,gt=0"`
-	UserID     int    `validate:"required,gt=0"`
-	Text       string `validate:"required,max=255"`
-	IsComplete bool
+	ID              int    `validate:"required,gt=0"`
+	UserID          int    `validate:"required,gt=0"`
+	Text            string `validate:"required,max=255"`
+	IsComplete      bool
+	ExpectedVersion *int `validate:"omitempty,gte=0"`
 }
 
 // NewUpdateTodoInput creates a validated UpdateTodoInput. Returns an error if validation fails.
-func NewUpdateTodoInput(id int, userID int, text string, isComplete bool) (*UpdateTodoInput, error) {
+func NewUpdateTodoInput(id int, userID int, text string, isComplete bool, expectedVersion *int) (*UpdateTodoInput, error) {
 	m := &UpdateTodoInput{
-		ID:         id,
-		UserID:     userID,
-		Text:       text,
-		IsComplete: isComplete,
+		ID:              id,
+		UserID:          userID,
+		Text:            text,
+		IsComplete:      isComplete,
+		ExpectedVersion: expectedVersion,
 	}
 	if err := ValidateStruct(m); err != nil {
 		return nil, fmt.Errorf("validate update todo input: %w", err)
@@ -145,20 +171,124 @@ func NewUpdateTodoOutput(todo *Todo) (*UpdateTodoOutput, error) {
 	return m, nil
 }
 
+// PatchTodoInput holds the parameters required to partially update an existing todo.
+// Nil fields are left untouched. ExpectedVersion is nil for an unconditional patch; otherwise the patch only applies to that version.
+type PatchTodoInput struct {
+	ID              int     `validate:"required,gt=0"`
+	UserID          int     `validate:"required,gt=0"`
+	Text            *string `validate:"omitempty,min=1,max=255"`
+	IsComplete      *bool
+	ExpectedVersion *int `validate:"omitempty,gte=0"`
+}
+
+// NewPatchTodoInput creates a validated PatchTodoInput. Returns an error if validation fails.
+func NewPatchTodoInput(id int, userID int, text *string, isComplete *bool, expectedVersion *int) (*PatchTodoInput, error) {
+	m := &PatchTodoInput{
+		ID:              id,
+		UserID:          userID,
+		Text:            text,
+		IsComplete:      isComplete,
+		ExpectedVersion: expectedVersion,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate patch todo input: %w", err)
+	}
+	return m, nil
+}
+
 // DeleteTodoInput holds the parameters required to delete a todo.
+// ExpectedVersion is nil for an unconditional delete; otherwise the todo is only deleted at that version.
 type DeleteTodoInput struct {
-	ID     int `validate:"required,gt=0"`
-	UserID int `validate:"required,gt=0"`
+	ID              int  `validate:"required,gt=0"`
+	UserID          int  `validate:"required,gt=0"`
+	ExpectedVersion *int `validate:"omitempty,gte=0"`
 }
 
 // NewDeleteTodoInput creates a validated DeleteTodoInput. Returns an error if validation fails.
-func NewDeleteTodoInput(id int, userID int) (*DeleteTodoInput, error) {
+func NewDeleteTodoInput(id int, userID int, expectedVersion *int) (*DeleteTodoInput, error) {
 	m := &DeleteTodoInput{
+		ID:              id,
+		UserID:          userID,
+		ExpectedVersion: expectedVersion,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate delete todo input: %w", err)
+	}
+	return m, nil
+}
+
+// RestoreTodoInput holds the parameters required to move a todo out of the trash.
+type RestoreTodoInput struct {
+	ID     int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewRestoreTodoInput creates a validated RestoreTodoInput. Returns an error if validation fails.
+func NewRestoreTodoInput(id int, userID int) (*RestoreTodoInput, error) {
+	m := &RestoreTodoInput{
 		ID:     id,
 		UserID: userID,
 	}
 	if err := ValidateStruct(m); err != nil {
-		return nil, fmt.Errorf("validate delete todo input: %w", err)
+		return nil, fmt.Errorf("validate restore todo input: %w", err)
+	}
+	return m, nil
+}
+
+// RestoreTodoOutput holds the result of a todo restore.
+type RestoreTodoOutput struct {
+	Todo *Todo `validate:"required"`
+}
+
+// NewRestoreTodoOutput creates a validated RestoreTodoOutput. Returns an error if validation fails.
+func NewRestoreTodoOutput(todo *Todo) (*RestoreTodoOutput, error) {
+	m := &RestoreTodoOutput{
+		Todo: todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate restore todo output: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeTodosInput holds the parameters for permanently deleting todos that have been in the trash since before DeletedBefore.
+// At most BatchSize todos are purged per call.
+type PurgeTodosInput struct {
+	DeletedBefore time.Time `validate:"required"`
+	BatchSize     int       `validate:"gte=1,lte=1000"`
+}
+
+// NewPurgeTodosInput creates a validated PurgeTodosInput. Returns an error if validation fails.
+func NewPurgeTodosInput(deletedBefore time.Time, batchSize int) (*PurgeTodosInput, error) {
+	m := &PurgeTodosInput{
+		DeletedBefore: deletedBefore,
+		BatchSize:     batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge todos input: %w", err)
+	}
+	return m, nil
+}
+
+// PurgedTodos describes the todos removed by a purge.
+// AttachmentStorageKeys lists the blobs that belonged to them and must be deleted from the blob store.
+type PurgedTodos struct {
+	TodoIDs               []int
+	AttachmentStorageKeys []string
+}
+
+// PurgeTodosOutput holds the result of a purge.
+type PurgeTodosOutput struct {
+	PurgedCount int `validate:"gte=0"`
+}
+
+// NewPurgeTodosOutput creates a validated PurgeTodosOutput. Returns an error if validation fails.
+func NewPurgeTodosOutput(purgedCount int) (*PurgeTodosOutput, error) {
+	m := &PurgeTodosOutput{
+		PurgedCount: purgedCount,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge todos output: %w", err)
 	}
 	return m, nil
 }
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArchiveTodoInput holds the parameters required to archive or unarchive a todo.
+type ArchiveTodoInput struct {
+	ID       int `validate:"required,gt=0"`
+	UserID   int `validate:"required,gt=0"`
+	Archived bool
+}
+
+// NewArchiveTodoInput creates a validated ArchiveTodoInput. Returns an error if validation fails.
+func NewArchiveTodoInput(id int, userID int, archived bool) (*ArchiveTodoInput, error) {
+	m := &ArchiveTodoInput{
+		ID:       id,
+		UserID:   userID,
+		Archived: archived,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate archive todo input: %w", err)
+	}
+	return m, nil
+}
+
+// ArchiveTodoOutput holds the result of archiving or unarchiving a todo.
+type ArchiveTodoOutput struct {
+	Todo *Todo `validate:"required"`
+}
+
+// NewArchiveTodoOutput creates a validated ArchiveTodoOutput. Returns an error if validation fails.
+func NewArchiveTodoOutput(todo *Todo) (*ArchiveTodoOutput, error) {
+	m := &ArchiveTodoOutput{
+		Todo: todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate archive todo output: %w", err)
+	}
+	return m, nil
+}
+
+// ArchiveCompletedTodosInput holds the parameters required to archive all completed todos of a user.
+type ArchiveCompletedTodosInput struct {
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewArchiveCompletedTodosInput creates a validated ArchiveCompletedTodosInput. Returns an error if validation fails.
+func NewArchiveCompletedTodosInput(userID int) (*ArchiveCompletedTodosInput, error) {
+	m := &ArchiveCompletedTodosInput{
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate archive completed todos input: %w", err)
+	}
+	return m, nil
+}
+
+// AutoArchiveTodosInput holds the parameters for archiving todos of any user that were completed before CompletedBefore.
+// At most BatchSize todos are archived per call.
+type AutoArchiveTodosInput struct {
+	CompletedBefore time.Time `validate:"required"`
+	BatchSize       int       `validate:"gte=1,lte=1000"`
+}
+
+// NewAutoArchiveTodosInput creates a validated AutoArchiveTodosInput. Returns an error if validation fails.
+func NewAutoArchiveTodosInput(completedBefore time.Time, batchSize int) (*AutoArchiveTodosInput, error) {
+	m := &AutoArchiveTodosInput{
+		CompletedBefore: completedBefore,
+		BatchSize:       batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate auto archive todos input: %w", err)
+	}
+	return m, nil
+}
+
+// ArchiveTodosOutput holds the todos archived by a bulk archive and their number.
+type ArchiveTodosOutput struct {
+	ArchivedCount int    `validate:"gte=0"`
+	Todos         []Todo `validate:"dive"`
+}
+
+// NewArchiveTodosOutput creates a validated ArchiveTodosOutput. Returns an error if validation fails.
+func NewArchiveTodosOutput(todos []Todo) (*ArchiveTodosOutput, error) {
+	m := &ArchiveTodosOutput{
+		ArchivedCount: len(todos),
+		Todos:         todos,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate archive todos output: %w", err)
+	}
+	return m, nil
+}
+
+// AutoArchiveTodosOutput holds the number of todos archived by an automatic archive.
+// UserIDs lists the owners of the archived todos, each once.
+type AutoArchiveTodosOutput struct {
+	ArchivedCount int   `validate:"gte=0"`
+	UserIDs       []int `validate:"dive,gt=0"`
+}
+
+// NewAutoArchiveTodosOutput creates a validated AutoArchiveTodosOutput. Returns an error if validation fails.
+func NewAutoArchiveTodosOutput(archivedCount int, userIDs []int) (*AutoArchiveTodosOutput, error) {
+	m := &AutoArchiveTodosOutput{
+		ArchivedCount: archivedCount,
+		UserIDs:       userIDs,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate auto archive todos output: %w", err)
+	}
+	return m, nil
+}
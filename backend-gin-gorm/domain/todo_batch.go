@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BatchOperationType is the kind of change a batch operation makes.
+type BatchOperationType string
+
+const (
+	// BatchOperationCreate creates a todo.
+	BatchOperationCreate BatchOperationType = "create"
+	// BatchOperationUpdate partially updates a todo.
+	BatchOperationUpdate BatchOperationType = "update"
+	// BatchOperationDelete moves a todo to the trash.
+	BatchOperationDelete BatchOperationType = "delete"
+)
+
+var (
+	// ErrBatchDependencyFailed is the result of an operation whose temporary ID names a todo that an earlier,
+	// failed create of the batch should have created.
+	ErrBatchDependencyFailed = errors.New("referenced todo was not created")
+	// ErrBatchRolledBack is the result of a successful operation of an atomic batch that was rolled back
+	// because a later operation failed.
+	ErrBatchRolledBack = errors.New("rolled back")
+	// ErrBatchNotExecuted is the result of an operation of an atomic batch that was not run
+	// because an earlier operation failed.
+	ErrBatchNotExecuted = errors.New("not executed")
+)
+
+// BatchOperationError reports the operation at Index of a batch, counted from 0, that is invalid or failed.
+type BatchOperationError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchOperationError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchOperationError) Unwrap() error {
+	return e.Err
+}
+
+// BatchOperation is one operation of a batch.
+// A create takes Text and may name the todo it creates with TempID so that later operations can refer to it.
+// An update or delete targets the todo with ID or, when TempID is set instead, the todo an earlier create named so.
+// Nil Text and IsComplete of an update are left untouched, and ExpectedVersion makes an update or delete
+// conditional as If-Match does.
+type BatchOperation struct {
+	Type            BatchOperationType `validate:"required,oneof=create update delete"`
+	ID              int                `validate:"omitempty,gt=0"`
+	TempID          string             `validate:"max=64"`
+	Text            *string            `validate:"omitempty,min=1,max=255"`
+	IsComplete      *bool
+	ExpectedVersion *int `validate:"omitempty,gte=0"`
+}
+
+// BatchTodosInput holds up to 100 operations to run in order for a user.
+// When Atomic is set the operations run in a single transaction that is rolled back if any of them fails.
+type BatchTodosInput struct {
+	UserID     int              `validate:"required,gt=0"`
+	Operations []BatchOperation `validate:"required,min=1,max=100,dive"`
+	Atomic     bool
+}
+
+// NewBatchTodosInput creates a validated BatchTodosInput.
+// Returns an error if validation fails and a *BatchOperationError if an operation does not fit its type
+// or refers to a temporary ID no earlier create defines.
+func NewBatchTodosInput(userID int, operations []BatchOperation, atomic bool) (*BatchTodosInput, error) {
+	m := &BatchTodosInput{
+		UserID:     userID,
+		Operations: operations,
+		Atomic:     atomic,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate batch todos input: %w", err)
+	}
+
+	tempIDs := make(map[string]bool)
+	for i, op := range operations {
+		if err := validateBatchOperation(&op, tempIDs); err != nil {
+			return nil, &BatchOperationError{Index: i, Err: err}
+		}
+		if op.Type == BatchOperationCreate && op.TempID != "" {
+			tempIDs[op.TempID] = true
+		}
+	}
+	return m, nil
+}
+
+// TodoIDs returns the IDs of the existing todos the operations target.
+// The todos created by the batch are targeted by temporary ID and are not included.
+func (m *BatchTodosInput) TodoIDs() []int {
+	ids := make([]int, 0, len(m.Operations))
+	for _, op := range m.Operations {
+		if op.Type != BatchOperationCreate && op.ID > 0 {
+			ids = append(ids, op.ID)
+		}
+	}
+	return ids
+}
+
+// validateBatchOperation checks the fields of an operation against its type.
+// tempIDs holds the temporary IDs defined by the creates before the operation.
+func validateBatchOperation(op *BatchOperation, tempIDs map[string]bool) error {
+	if op.Type == BatchOperationCreate {
+		return validateBatchCreate(op, tempIDs)
+	}
+	return validateBatchTarget(op, tempIDs)
+}
+
+func validateBatchCreate(op *BatchOperation, tempIDs map[string]bool) error {
+	switch {
+	case op.Text == nil:
+		return errors.New("create requires text")
+	case op.ID != 0 || op.IsComplete != nil || op.ExpectedVersion != nil:
+		return errors.New("create only takes text and a temporary id")
+	case tempIDs[op.TempID]:
+		return fmt.Errorf("temporary id %q is already defined", op.TempID)
+	}
+	return nil
+}
+
+// validateBatchTarget checks that an update or delete targets exactly one todo.
+func validateBatchTarget(op *BatchOperation, tempIDs map[string]bool) error {
+	switch {
+	case (op.ID == 0) == (op.TempID == ""):
+		return fmt.Errorf("%s requires either an id or a temporary id", op.Type)
+	case op.TempID != "" && !tempIDs[op.TempID]:
+		return fmt.Errorf("temporary id %q is not defined by an earlier create", op.TempID)
+	case op.Type == BatchOperationDelete && (op.Text != nil || op.IsComplete != nil):
+		return errors.New("delete cannot take a patch")
+	}
+	return nil
+}
+
+// BatchOperationResult is the outcome of one operation of a batch.
+// ID is the todo the operation created or targeted and is 0 when it is unknown.
+// Err is nil if the operation succeeded; Todo holds the todo a successful create or update left behind,
+// or the current todo when Err is a *TodoVersionMismatchError. OwnerID is the owner of the todo a successful delete
+// moved to the trash and is 0 otherwise.
+type BatchOperationResult struct {
+	Type    BatchOperationType
+	ID      int
+	TempID  string
+	Todo    *Todo
+	OwnerID int
+	Err     error
+}
+
+// BatchTodosOutput holds the results of a batch in the order of its operations.
+// Committed is false when an atomic batch was rolled back and none of its changes were kept.
+type BatchTodosOutput struct {
+	Results   []BatchOperationResult `validate:"required,min=1,max=100"`
+	Committed bool
+}
+
+// NewBatchTodosOutput creates a validated BatchTodosOutput. Returns an error if validation fails.
+func NewBatchTodosOutput(results []BatchOperationResult, committed bool) (*BatchTodosOutput, error) {
+	m := &BatchTodosOutput{
+		Results:   results,
+		Committed: committed,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate batch todos output: %w", err)
+	}
+	return m, nil
+}
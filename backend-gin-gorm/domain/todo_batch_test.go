@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestNewBatchTodosInput_shouldReturnBatchOperationError_whenOperationDoesNotFitItsType(t *testing.T) {
+	t.Parallel()
+
+	text := "task"
+	isComplete := true
+	tests := []struct {
+		name            string
+		operations      []domain.BatchOperation
+		expectedIndex   int
+		expectedMessage string
+	}{
+		{
+			name:            "create without text",
+			operations:      []domain.BatchOperation{{Type: domain.BatchOperationCreate}},
+			expectedIndex:   0,
+			expectedMessage: "operation 0: create requires text",
+		},
+		{
+			name:            "create with id",
+			operations:      []domain.BatchOperation{{Type: domain.BatchOperationCreate, ID: 1, Text: &text}},
+			expectedIndex:   0,
+			expectedMessage: "operation 0: create only takes text and a temporary id",
+		},
+		{
+			name:            "update without target",
+			operations:      []domain.BatchOperation{{Type: domain.BatchOperationUpdate, IsComplete: &isComplete}},
+			expectedIndex:   0,
+			expectedMessage: "operation 0: update requires either an id or a temporary id",
+		},
+		{
+			name: "temp id defined later",
+			operations: []domain.BatchOperation{
+				{Type: domain.BatchOperationCreate, Text: &text},
+				{Type: domain.BatchOperationDelete, TempID: "a"},
+				{Type: domain.BatchOperationCreate, TempID: "a", Text: &text},
+			},
+			expectedIndex:   1,
+			expectedMessage: `operation 1: temporary id "a" is not defined by an earlier create`,
+		},
+		{
+			name:            "delete with patch",
+			operations:      []domain.BatchOperation{{Type: domain.BatchOperationDelete, ID: 1, IsComplete: &isComplete}},
+			expectedIndex:   0,
+			expectedMessage: "operation 0: delete cannot take a patch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewBatchTodosInput(1, tt.operations, false)
+
+			// then
+			var opErr *domain.BatchOperationError
+			require.ErrorAs(t, err, &opErr)
+			assert.Equal(t, tt.expectedIndex, opErr.Index)
+			assert.EqualError(t, err, tt.expectedMessage)
+			assert.Nil(t, input)
+		})
+	}
+}
+
+func TestNewBatchTodosInput_shouldAcceptTempIDs_whenDefinedByEarlierCreate(t *testing.T) {
+	t.Parallel()
+
+	// given
+	text := "task"
+	operations := []domain.BatchOperation{
+		{Type: domain.BatchOperationCreate, TempID: "a", Text: &text},
+		{Type: domain.BatchOperationCreate, Text: &text},
+		{Type: domain.BatchOperationCreate, Text: &text},
+		{Type: domain.BatchOperationUpdate, TempID: "a", Text: &text},
+		{Type: domain.BatchOperationDelete, TempID: "a"},
+	}
+
+	// when
+	input, err := domain.NewBatchTodosInput(1, operations, true)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, operations, input.Operations)
+	assert.True(t, input.Atomic)
+}
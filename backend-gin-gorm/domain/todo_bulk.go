@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// BulkMode selects how a bulk update or delete treats todos it cannot change.
+type BulkMode string
+
+const (
+	// BulkModeAllOrNothing applies the operation to every todo in one transaction and changes nothing if any todo fails.
+	BulkModeAllOrNothing BulkMode = "allOrNothing"
+	// BulkModeBestEffort applies the operation to each todo on its own and reports a result per todo.
+	BulkModeBestEffort BulkMode = "bestEffort"
+)
+
+// BulkTodoError is returned by an all-or-nothing bulk operation when the todo with ID could not be changed.
+// Err is the reason, such as ErrTodoNotFound.
+type BulkTodoError struct {
+	ID  int
+	Err error
+}
+
+func (e *BulkTodoError) Error() string {
+	return fmt.Sprintf("todo %d: %v", e.ID, e.Err)
+}
+
+func (e *BulkTodoError) Unwrap() error {
+	return e.Err
+}
+
+// PatchBulkTodosInput holds the parameters required to apply the same partial update to up to 100 todos of a user.
+// Nil fields are left untouched.
+type PatchBulkTodosInput struct {
+	UserID     int     `validate:"required,gt=0"`
+	IDs        []int   `validate:"required,min=1,max=100,unique,dive,gt=0"`
+	Text       *string `validate:"omitempty,min=1,max=255"`
+	IsComplete *bool
+	Mode       BulkMode `validate:"required,oneof=allOrNothing bestEffort"`
+}
+
+// NewPatchBulkTodosInput creates a validated PatchBulkTodosInput. Returns an error if validation fails.
+func NewPatchBulkTodosInput(userID int, ids []int, text *string, isComplete *bool, mode BulkMode) (*PatchBulkTodosInput, error) {
+	m := &PatchBulkTodosInput{
+		UserID:     userID,
+		IDs:        ids,
+		Text:       text,
+		IsComplete: isComplete,
+		Mode:       mode,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate patch bulk todos input: %w", err)
+	}
+	return m, nil
+}
+
+// PatchTodoInput returns the patch of the todo with the given ID.
+func (m *PatchBulkTodosInput) PatchTodoInput(id int) *PatchTodoInput {
+	return &PatchTodoInput{
+		ID:              id,
+		UserID:          m.UserID,
+		Text:            m.Text,
+		IsComplete:      m.IsComplete,
+		ExpectedVersion: nil,
+	}
+}
+
+// DeleteBulkTodosInput holds the parameters required to move up to 100 todos of a user to the trash.
+type DeleteBulkTodosInput struct {
+	UserID int      `validate:"required,gt=0"`
+	IDs    []int    `validate:"required,min=1,max=100,unique,dive,gt=0"`
+	Mode   BulkMode `validate:"required,oneof=allOrNothing bestEffort"`
+}
+
+// NewDeleteBulkTodosInput creates a validated DeleteBulkTodosInput. Returns an error if validation fails.
+func NewDeleteBulkTodosInput(userID int, ids []int, mode BulkMode) (*DeleteBulkTodosInput, error) {
+	m := &DeleteBulkTodosInput{
+		UserID: userID,
+		IDs:    ids,
+		Mode:   mode,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate delete bulk todos input: %w", err)
+	}
+	return m, nil
+}
+
+// DeleteTodoInput returns the delete of the todo with the given ID.
+func (m *DeleteBulkTodosInput) DeleteTodoInput(id int) *DeleteTodoInput {
+	return &DeleteTodoInput{
+		ID:              id,
+		UserID:          m.UserID,
+		ExpectedVersion: nil,
+	}
+}
+
+// BulkTodoResult is the outcome of a bulk operation for one todo.
+// Err is nil if the todo was changed; Todo holds the updated todo of a successful update and is nil otherwise.
+// OwnerID is the owner of a todo a successful delete moved to the trash and is 0 otherwise.
+type BulkTodoResult struct {
+	ID      int
+	Todo    *Todo
+	OwnerID int
+	Err     error
+}
+
+// BulkTodosOutput holds the results of a bulk operation in the order of the requested IDs.
+type BulkTodosOutput struct {
+	Results []BulkTodoResult `validate:"required,min=1,max=100"`
+}
+
+// NewBulkTodosOutput creates a validated BulkTodosOutput. Returns an error if validation fails.
+func NewBulkTodosOutput(results []BulkTodoResult) (*BulkTodosOutput, error) {
+	m := &BulkTodosOutput{
+		Results: results,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate bulk todos output: %w", err)
+	}
+	return m, nil
+}
+
+// PatchTodoFunc is a function type for partially updating a single todo item.
+type PatchTodoFunc func(ctx context.Context, input *PatchTodoInput) (*Todo, error)
+
+// DeleteTodoFunc is a function type for moving a single todo item to the trash. It returns the ID of the owner of the todo.
+type DeleteTodoFunc func(ctx context.Context, input *DeleteTodoInput) (int, error)
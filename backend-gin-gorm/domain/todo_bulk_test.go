@@ -0,0 +1,87 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestNewPatchBulkTodosInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	isComplete := true
+	empty := ""
+	tests := []struct {
+		name string
+		ids  []int
+		text *string
+		mode domain.BulkMode
+	}{
+		{name: "no ids", ids: []int{}, mode: domain.BulkModeAllOrNothing},
+		{name: "duplicate ids", ids: []int{1, 1}, mode: domain.BulkModeAllOrNothing},
+		{name: "zero id", ids: []int{0}, mode: domain.BulkModeAllOrNothing},
+		{name: "101 ids", ids: bulkTodoIDs(101), mode: domain.BulkModeAllOrNothing},
+		{name: "empty text", ids: []int{1}, text: &empty, mode: domain.BulkModeAllOrNothing},
+		{name: "unknown mode", ids: []int{1}, mode: domain.BulkMode("sometimes")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewPatchBulkTodosInput(1, tt.ids, tt.text, &isComplete, tt.mode)
+
+			// then
+			require.Error(t, err)
+			assert.Nil(t, input)
+			assert.Contains(t, err.Error(), "validate patch bulk todos input")
+		})
+	}
+}
+
+func TestPatchBulkTodosInput_PatchTodoInput_shouldApplySamePatchToEachTodo(t *testing.T) {
+	t.Parallel()
+
+	// given
+	isComplete := true
+	input, err := domain.NewPatchBulkTodosInput(2, bulkTodoIDs(100), nil, &isComplete, domain.BulkModeBestEffort)
+	require.NoError(t, err)
+
+	// when
+	patch := input.PatchTodoInput(7)
+
+	// then
+	assert.Equal(t, &domain.PatchTodoInput{ID: 7, UserID: 2, IsComplete: &isComplete}, patch)
+}
+
+func TestNewDeleteBulkTodosInput_shouldReturnError_whenModeIsMissing(t *testing.T) {
+	t.Parallel()
+
+	// when
+	input, err := domain.NewDeleteBulkTodosInput(1, []int{1, 2}, "")
+
+	// then
+	require.Error(t, err)
+	assert.Nil(t, input)
+}
+
+func TestBulkTodoError_shouldUnwrapReason(t *testing.T) {
+	t.Parallel()
+
+	// when
+	err := &domain.BulkTodoError{ID: 3, Err: domain.ErrTodoNotFound}
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Equal(t, "todo 3: todo not found", err.Error())
+}
+
+func bulkTodoIDs(n int) []int {
+	ids := make([]int, n)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+	return ids
+}
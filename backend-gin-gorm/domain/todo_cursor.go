@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded or does not match the request.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+const (
+	// DefaultTodoPageLimit is the number of todos returned per page when the client does not specify a limit.
+	DefaultTodoPageLimit = 50
+	// MaxTodoPageLimit is the largest page size a client may request.
+	MaxTodoPageLimit = 100
+)
+
+// TodoCursor marks the position after the last todo of a page.
+// Pages are keyed on the sort value and the ID of that todo, so todos inserted while a client is paging never shift the following pages.
+// Sort records the order the cursor was issued for and AfterValue the sort value of the last todo; it is empty when sorting by ID.
+type TodoCursor struct {
+	Sort       string `json:"s" validate:"required"`
+	AfterValue string `json:"v,omitempty"`
+	AfterID    int    `json:"id" validate:"required,gt=0"`
+}
+
+// NewTodoCursor creates a validated TodoCursor. Returns an error if validation fails.
+func NewTodoCursor(sort TodoSort, afterValue string, afterID int) (*TodoCursor, error) {
+	m := &TodoCursor{
+		Sort:       sort.String(),
+		AfterValue: afterValue,
+		AfterID:    afterID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate todo cursor: %w", err)
+	}
+	return m, nil
+}
+
+// Encode returns the opaque string representation of the cursor handed to clients.
+func (c *TodoCursor) Encode() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal todo cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeTodoCursor parses a cursor produced by TodoCursor.Encode. Returns ErrInvalidCursor if it is malformed.
+func DecodeTodoCursor(s string) (*TodoCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode todo cursor: %w", ErrInvalidCursor)
+	}
+	var cursor TodoCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, fmt.Errorf("unmarshal todo cursor: %w", ErrInvalidCursor)
+	}
+	if err := ValidateStruct(&cursor); err != nil {
+		return nil, fmt.Errorf("validate todo cursor: %w", ErrInvalidCursor)
+	}
+	return &cursor, nil
+}
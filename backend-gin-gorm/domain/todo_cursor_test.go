@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestTodoCursor_shouldRoundTrip_whenEncodedAndDecoded(t *testing.T) {
+	t.Parallel()
+
+	// given
+	sort, err := domain.NewTodoSort(domain.TodoSortFieldCreatedAt, domain.SortDirectionDesc)
+	require.NoError(t, err)
+	cursor, err := domain.NewTodoCursor(*sort, "2025-01-02T03:04:05.123456Z", 42)
+	require.NoError(t, err)
+
+	// when
+	encoded, err := cursor.Encode()
+	require.NoError(t, err)
+	decoded, err := domain.DecodeTodoCursor(encoded)
+
+	// then
+	require.NoError(t, err, "expected no error for an encoded cursor")
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeTodoCursor_shouldReturnErrInvalidCursor_whenMalformed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{
+			name:   "not base64",
+			cursor: "!!!",
+		},
+		{
+			name:   "not JSON",
+			cursor: "bm90LWpzb24",
+		},
+		{
+			name:   "missing sort and ID",
+			cursor: "e30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			cursor, err := domain.DecodeTodoCursor(tt.cursor)
+
+			// then
+			require.ErrorIs(t, err, domain.ErrInvalidCursor)
+			assert.Nil(t, cursor)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTodoEventID is returned when a todo event ID cannot be parsed.
+	ErrInvalidTodoEventID = errors.New("invalid todo event ID")
+	// ErrTodoEventStreamClosed is returned when a client subscribes to todo events while the server is shutting down.
+	ErrTodoEventStreamClosed = errors.New("todo event stream closed")
+)
+
+// TodoEventType is the kind of change a todo event reports.
+type TodoEventType string
+
+const (
+	// TodoEventCreated reports a todo that was created.
+	TodoEventCreated TodoEventType = "created"
+	// TodoEventUpdated reports a todo that was changed, archived, unarchived or restored from the trash.
+	TodoEventUpdated TodoEventType = "updated"
+	// TodoEventDeleted reports a todo that was moved to the trash.
+	TodoEventDeleted TodoEventType = "deleted"
+	// TodoEventReset tells the client that it may have missed changes and has to fetch its todos again.
+	TodoEventReset TodoEventType = "reset"
+)
+
+// TodoEventID identifies a todo event. Seq numbers the events published since the event stream started at Epoch,
+// so that an ID handed out before a restart is never mistaken for one handed out after it.
+type TodoEventID struct {
+	Epoch int64
+	Seq   int64
+}
+
+// String returns the representation of the ID sent to clients as the SSE event ID.
+func (id TodoEventID) String() string {
+	return strconv.FormatInt(id.Epoch, 10) + "-" + strconv.FormatInt(id.Seq, 10)
+}
+
+// ParseTodoEventID parses an ID produced by TodoEventID.String. Returns ErrInvalidTodoEventID if it is malformed.
+func ParseTodoEventID(s string) (TodoEventID, error) {
+	epochS, seqS, ok := strings.Cut(s, "-")
+	if !ok {
+		return TodoEventID{}, ErrInvalidTodoEventID
+	}
+	epoch, err := strconv.ParseInt(epochS, 10, 64)
+	if err != nil || epoch <= 0 {
+		return TodoEventID{}, ErrInvalidTodoEventID
+	}
+	seq, err := strconv.ParseInt(seqS, 10, 64)
+	if err != nil || seq < 0 {
+		return TodoEventID{}, ErrInvalidTodoEventID
+	}
+	return TodoEventID{Epoch: epoch, Seq: seq}, nil
+}
+
+// TodoEvent reports a change to a todo of a user to the clients of the user.
+// ID is assigned when the event is published. Todo is the todo after the change;
+// it is nil for a deleted event, which only carries TodoID, and for a reset event.
+type TodoEvent struct {
+	ID         TodoEventID
+	Type       TodoEventType `validate:"required,oneof=created updated deleted reset"`
+	UserID     int           `validate:"required,gt=0"`
+	TodoID     int           `validate:"gte=0"`
+	Todo       *Todo
+	OccurredAt time.Time
+}
+
+// NewTodoChangedEvent returns a created or updated event for the todo.
+func NewTodoChangedEvent(eventType TodoEventType, todo *Todo) TodoEvent {
+	return TodoEvent{
+		ID:         TodoEventID{},
+		Type:       eventType,
+		UserID:     todo.UserID,
+		TodoID:     todo.ID,
+		Todo:       todo,
+		OccurredAt: time.Now(),
+	}
+}
+
+// NewTodoDeletedEvent returns a deleted event for the todo of the user.
+func NewTodoDeletedEvent(userID int, todoID int) TodoEvent {
+	return TodoEvent{
+		ID:         TodoEventID{},
+		Type:       TodoEventDeleted,
+		UserID:     userID,
+		TodoID:     todoID,
+		Todo:       nil,
+		OccurredAt: time.Now(),
+	}
+}
+
+// NewTodoResetEvent returns a reset event for the user.
+func NewTodoResetEvent(userID int) TodoEvent {
+	return TodoEvent{
+		ID:         TodoEventID{},
+		Type:       TodoEventReset,
+		UserID:     userID,
+		TodoID:     0,
+		Todo:       nil,
+		OccurredAt: time.Now(),
+	}
+}
+
+// SubscribeTodoEventsInput holds the parameters required to subscribe to the todo events of a user.
+// LastEventID is the ID of the last event the client received before it reconnected, or nil for a new client.
+type SubscribeTodoEventsInput struct {
+	UserID      int `validate:"required,gt=0"`
+	LastEventID *TodoEventID
+}
+
+// NewSubscribeTodoEventsInput creates a validated SubscribeTodoEventsInput. Returns an error if validation fails.
+func NewSubscribeTodoEventsInput(userID int, lastEventID *TodoEventID) (*SubscribeTodoEventsInput, error) {
+	m := &SubscribeTodoEventsInput{
+		UserID:      userID,
+		LastEventID: lastEventID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate subscribe todo events input: %w", err)
+	}
+	return m, nil
+}
+
+// TodoEventSubscription delivers the todo events of a user.
+// Replay holds the events the client missed since the last event ID it sent, or a single reset event
+// if they are no longer known. Events delivers the events published afterwards and is closed when the
+// subscription ends: when the server shuts down or the client fell too far behind, in which case it
+// should reconnect with the ID of the last event it received. Close ends the subscription.
+type TodoEventSubscription struct {
+	Replay []TodoEvent
+	Events <-chan TodoEvent
+	Close  func()
+}
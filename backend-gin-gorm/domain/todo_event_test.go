@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestTodoEventID_shouldRoundTrip_whenFormattedAndParsed(t *testing.T) {
+	t.Parallel()
+
+	// given
+	id := domain.TodoEventID{Epoch: 1735787045000, Seq: 42}
+
+	// when
+	parsed, err := domain.ParseTodoEventID(id.String())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+}
+
+func TestParseTodoEventID_shouldReturnErrInvalidTodoEventID_whenMalformed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{name: "no separator", id: "42"},
+		{name: "no epoch", id: "-42"},
+		{name: "negative sequence", id: "1735787045000--1"},
+		{name: "not a number", id: "abc-def"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.ParseTodoEventID(tt.id)
+
+			// then
+			require.ErrorIs(t, err, domain.ErrInvalidTodoEventID)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fields of a todo filter query.
+const (
+	todoFilterFieldIs      = "is"
+	todoFilterFieldText    = "text"
+	todoFilterFieldCreated = "created"
+	todoFilterFieldUpdated = "updated"
+)
+
+var relativeAgePattern = regexp.MustCompile(`^([0-9]{1,4})([hdw])$`)
+
+var relativeAgeUnits = map[string]time.Duration{
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// NewTodoFilterFromQuery translates a filter query into a TodoFilter. The supported terms are:
+//
+//   - is:open, is:done: incomplete or completed todos
+//   - text:VALUE or a bare VALUE: todos whose text contains VALUE; at most one per query
+//   - created and updated compared with a date (2025-01-31), a date-time in RFC 3339 form,
+//     or an age of hours, days or weeks (7d) measured back from now, so that created<7d means
+//     "created less than 7 days ago". created:DATE matches the whole day.
+//
+// Returns a *FilterQueryError pointing at the offending term if a term is unknown, malformed or contradicts another.
+func NewTodoFilterFromQuery(query *FilterQuery, now time.Time) (*TodoFilter, error) {
+	t := &todoFilterTranslator{
+		filter: &TodoFilter{}, //nolint:exhaustruct
+		now:    now,
+	}
+	for i := range query.Terms {
+		if err := t.translate(&query.Terms[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := ValidateStruct(t.filter); err != nil {
+		return nil, newFilterQueryError(t.textPos, "text must be at most 255 characters")
+	}
+	return t.filter, nil
+}
+
+// ParseTodoFilterQuery parses a filter query and translates it into a TodoFilter.
+// Returns a *FilterQueryError if the query is malformed or not supported.
+func ParseTodoFilterQuery(query string, now time.Time) (*TodoFilter, error) {
+	ast, err := ParseFilterQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return NewTodoFilterFromQuery(ast, now)
+}
+
+type todoFilterTranslator struct {
+	filter  *TodoFilter
+	now     time.Time
+	textPos int
+}
+
+func (t *todoFilterTranslator) translate(term *FilterTerm) error {
+	switch term.Field {
+	case "", todoFilterFieldText:
+		return t.translateText(term)
+	case todoFilterFieldIs:
+		return t.translateIs(term)
+	case todoFilterFieldCreated:
+		return t.translateTime(term, &t.filter.CreatedFrom, &t.filter.CreatedTo)
+	case todoFilterFieldUpdated:
+		return t.translateTime(term, &t.filter.UpdatedFrom, &t.filter.UpdatedTo)
+	default:
+		return newFilterQueryError(term.Pos, "unknown field %q; supported fields are is, text, created and updated", term.Field)
+	}
+}
+
+func (t *todoFilterTranslator) translateText(term *FilterTerm) error {
+	if term.Field != "" && term.Operator != FilterOperatorMatch {
+		return newFilterQueryError(term.Pos, "text only supports ':'")
+	}
+	if t.filter.TextContains != "" {
+		return newFilterQueryError(term.Pos, "only one text term is allowed; quote the value to search for a phrase")
+	}
+	if term.Value == "" {
+		return newFilterQueryError(term.ValuePos, "text must not be empty")
+	}
+	t.filter.TextContains = term.Value
+	t.textPos = term.ValuePos
+	return nil
+}
+
+func (t *todoFilterTranslator) translateIs(term *FilterTerm) error {
+	if term.Operator != FilterOperatorMatch {
+		return newFilterQueryError(term.Pos, "is only supports ':'")
+	}
+	var isComplete bool
+	switch strings.ToLower(term.Value) {
+	case "open":
+		isComplete = false
+	case "done":
+		isComplete = true
+	default:
+		return newFilterQueryError(term.ValuePos, "unknown state %q; use open or done", term.Value)
+	}
+	if t.filter.IsComplete != nil && *t.filter.IsComplete != isComplete {
+		return newFilterQueryError(term.Pos, "is:%s contradicts an earlier is term", term.Value)
+	}
+	t.filter.IsComplete = &isComplete
+	return nil
+}
+
+// translateTime narrows the [from, to) range of a timestamp by a comparison with a date, a date-time or an age.
+func (t *todoFilterTranslator) translateTime(term *FilterTerm, from **time.Time, to **time.Time) error {
+	start, end, isAge, err := t.parseTimeValue(term)
+	if err != nil {
+		return err
+	}
+
+	operator := term.Operator
+	if isAge {
+		if operator == FilterOperatorMatch {
+			return newFilterQueryError(term.Pos, "compare an age with < or >, as in %s<%s", term.Field, term.Value)
+		}
+		// An age counts backwards: being younger than the age means being after the instant
+		operator = map[FilterOperator]FilterOperator{
+			FilterOperatorLess:           FilterOperatorGreater,
+			FilterOperatorLessOrEqual:    FilterOperatorGreaterOrEqual,
+			FilterOperatorGreater:        FilterOperatorLess,
+			FilterOperatorGreaterOrEqual: FilterOperatorLessOrEqual,
+		}[operator]
+	}
+
+	var lower, upper *time.Time
+	switch operator {
+	case FilterOperatorMatch:
+		lower, upper = &start, &end
+	case FilterOperatorGreaterOrEqual:
+		lower = &start
+	case FilterOperatorGreater:
+		lower = &end
+	case FilterOperatorLess:
+		upper = &start
+	case FilterOperatorLessOrEqual:
+		upper = &end
+	}
+
+	if lower != nil {
+		if *from != nil {
+			return newFilterQueryError(term.Pos, "%s already has a lower bound", term.Field)
+		}
+		*from = lower
+	}
+	if upper != nil {
+		if *to != nil {
+			return newFilterQueryError(term.Pos, "%s already has an upper bound", term.Field)
+		}
+		*to = upper
+	}
+	if *from != nil && *to != nil && !(*from).Before(**to) {
+		return newFilterQueryError(term.Pos, "%s range is empty", term.Field)
+	}
+	return nil
+}
+
+// parseTimeValue returns the interval [start, end) a time value denotes: a whole day for a date,
+// a single microsecond, the precision of stored timestamps, for a date-time, and the instant the age
+// reaches back to for an age.
+func (t *todoFilterTranslator) parseTimeValue(term *FilterTerm) (time.Time, time.Time, bool, error) {
+	if m := relativeAgePattern.FindStringSubmatch(term.Value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		instant := t.now.Add(-time.Duration(n) * relativeAgeUnits[m[2]])
+		return instant, instant, true, nil
+	}
+	if date, err := time.Parse(time.DateOnly, term.Value); err == nil {
+		return date, date.AddDate(0, 0, 1), false, nil
+	}
+	if dateTime, err := time.Parse(time.RFC3339, term.Value); err == nil {
+		return dateTime, dateTime.Add(time.Microsecond), false, nil
+	}
+	return time.Time{}, time.Time{}, false, newFilterQueryError(term.ValuePos, "invalid %s value %q; use a date such as 2025-01-31, an RFC 3339 date-time or an age such as 7d", term.Field, term.Value)
+}
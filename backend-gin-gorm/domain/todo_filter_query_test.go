@@ -0,0 +1,182 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func parseTodoFilterQuery(t *testing.T, query string, now time.Time) (*domain.TodoFilter, error) {
+	t.Helper()
+	ast, err := domain.ParseFilterQuery(query)
+	require.NoError(t, err)
+	return domain.NewTodoFilterFromQuery(ast, now)
+}
+
+// NewTodoFilterFromQuery tests
+func TestNewTodoFilterFromQuery_shouldTranslateTerms_whenQueryIsSupported(t *testing.T) {
+	t.Parallel()
+
+	// given
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	isComplete := false
+	isDone := true
+	date := func(year int, month time.Month, day int) *time.Time {
+		v := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	instant := func(v time.Time) *time.Time { return &v }
+
+	tests := []struct {
+		name     string
+		query    string
+		expected domain.TodoFilter
+	}{
+		{
+			name:     "state and quoted text",
+			query:    `is:open text:"pay invoice"`,
+			expected: domain.TodoFilter{IsComplete: &isComplete, TextContains: "pay invoice"},
+		},
+		{
+			name:     "bare text and done",
+			query:    `請求書 is:DONE`,
+			expected: domain.TodoFilter{IsComplete: &isDone, TextContains: "請求書"},
+		},
+		{
+			name:     "younger than an age",
+			query:    "created<7d",
+			expected: domain.TodoFilter{CreatedFrom: instant(now.Add(-7 * 24 * time.Hour))},
+		},
+		{
+			name:     "older than an age",
+			query:    "updated>=2w",
+			expected: domain.TodoFilter{UpdatedTo: instant(now.Add(-14 * 24 * time.Hour))},
+		},
+		{
+			name:     "whole day",
+			query:    "created:2025-01-31",
+			expected: domain.TodoFilter{CreatedFrom: date(2025, 1, 31), CreatedTo: date(2025, 2, 1)},
+		},
+		{
+			name:     "date bounds",
+			query:    "updated>2025-01-01 updated<=2025-01-31",
+			expected: domain.TodoFilter{UpdatedFrom: date(2025, 1, 2), UpdatedTo: date(2025, 2, 1)},
+		},
+		{
+			name:     "date-time bound",
+			query:    "created>=2025-01-01T09:00:00+09:00",
+			expected: domain.TodoFilter{CreatedFrom: instant(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			filter, err := parseTodoFilterQuery(t, tt.query, now)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.IsComplete, filter.IsComplete)
+			assert.Equal(t, tt.expected.TextContains, filter.TextContains)
+			for _, pair := range [][2]*time.Time{
+				{tt.expected.CreatedFrom, filter.CreatedFrom},
+				{tt.expected.CreatedTo, filter.CreatedTo},
+				{tt.expected.UpdatedFrom, filter.UpdatedFrom},
+				{tt.expected.UpdatedTo, filter.UpdatedTo},
+			} {
+				if pair[0] == nil {
+					assert.Nil(t, pair[1])
+					continue
+				}
+				require.NotNil(t, pair[1])
+				assert.True(t, pair[0].Equal(*pair[1]), "expected %s, got %s", pair[0], pair[1])
+			}
+		})
+	}
+}
+
+func TestNewTodoFilterFromQuery_shouldReturnErrorWithPosition_whenTermIsNotSupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedPos int
+		expectedMsg string
+	}{
+		{
+			name:        "unknown field",
+			query:       "is:open tag:work",
+			expectedPos: 9,
+			expectedMsg: `unknown field "tag"; supported fields are is, text, created and updated`,
+		},
+		{
+			name:        "unknown state",
+			query:       "is:closed",
+			expectedPos: 4,
+			expectedMsg: `unknown state "closed"; use open or done`,
+		},
+		{
+			name:        "contradicting states",
+			query:       "is:open is:done",
+			expectedPos: 9,
+			expectedMsg: "is:done contradicts an earlier is term",
+		},
+		{
+			name:        "comparison on text",
+			query:       "text<abc",
+			expectedPos: 1,
+			expectedMsg: "text only supports ':'",
+		},
+		{
+			name:        "second text term",
+			query:       "pay invoice",
+			expectedPos: 5,
+			expectedMsg: "only one text term is allowed; quote the value to search for a phrase",
+		},
+		{
+			name:        "invalid date",
+			query:       "created>2025-13-01",
+			expectedPos: 9,
+			expectedMsg: `invalid created value "2025-13-01"; use a date such as 2025-01-31, an RFC 3339 date-time or an age such as 7d`,
+		},
+		{
+			name:        "age matched exactly",
+			query:       "updated:7d",
+			expectedPos: 1,
+			expectedMsg: "compare an age with < or >, as in updated<7d",
+		},
+		{
+			name:        "second lower bound",
+			query:       "created<7d created>=2025-01-01",
+			expectedPos: 12,
+			expectedMsg: "created already has a lower bound",
+		},
+		{
+			name:        "empty range",
+			query:       "created>=2025-02-01 created<2025-01-01",
+			expectedPos: 21,
+			expectedMsg: "created range is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			filter, err := parseTodoFilterQuery(t, tt.query, time.Now())
+
+			// then
+			assert.Nil(t, filter)
+			var queryErr *domain.FilterQueryError
+			require.ErrorAs(t, err, &queryErr)
+			assert.Equal(t, tt.expectedPos, queryErr.Pos)
+			assert.Equal(t, tt.expectedMsg, queryErr.Msg)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TodoSortField is a field todos can be sorted by.
+type TodoSortField string
+
+// Fields todos can be sorted by.
+const (
+	TodoSortFieldID        TodoSortField = "id"
+	TodoSortFieldCreatedAt TodoSortField = "createdAt"
+	TodoSortFieldUpdatedAt TodoSortField = "updatedAt"
+	TodoSortFieldText      TodoSortField = "text"
+)
+
+// SortDirection is the direction of a sort.
+type SortDirection string
+
+// Sort directions.
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+// TodoSort is the order of a todo list. Todos with the same sort value are ordered by ID in the same direction.
+type TodoSort struct {
+	Field     TodoSortField `validate:"oneof=id createdAt updatedAt text"`
+	Direction SortDirection `validate:"oneof=asc desc"`
+}
+
+// DefaultTodoSort orders todos by ID, which is their creation order.
+var DefaultTodoSort = TodoSort{
+	Field:     TodoSortFieldID,
+	Direction: SortDirectionAsc,
+}
+
+// NewTodoSort creates a validated TodoSort. Returns an error if validation fails.
+func NewTodoSort(field TodoSortField, direction SortDirection) (*TodoSort, error) {
+	m := &TodoSort{
+		Field:     field,
+		Direction: direction,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate todo sort: %w", err)
+	}
+	return m, nil
+}
+
+// String returns the sort in "field:direction" form.
+func (s TodoSort) String() string {
+	return string(s.Field) + ":" + string(s.Direction)
+}
+
+// TodoFilter narrows a todo list. Zero-value fields do not filter.
+// IsComplete selects completed or incomplete todos; the From bounds are inclusive and the To bounds exclusive.
+// TextContains matches todos whose text contains it literally.
+type TodoFilter struct {
+	IsComplete   *bool
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	UpdatedFrom  *time.Time
+	UpdatedTo    *time.Time
+	TextContains string `validate:"max=255"`
+}
+
+// Matches reports whether the todo satisfies the filter as the repository applies it.
+// TextContains is matched ignoring case, like the default collation of the database.
+func (f *TodoFilter) Matches(todo *Todo) bool {
+	switch {
+	case f.IsComplete != nil && todo.IsComplete != *f.IsComplete:
+		return false
+	case f.CreatedFrom != nil && todo.CreatedAt.Before(*f.CreatedFrom):
+		return false
+	case f.CreatedTo != nil && !todo.CreatedAt.Before(*f.CreatedTo):
+		return false
+	case f.UpdatedFrom != nil && todo.UpdatedAt.Before(*f.UpdatedFrom):
+		return false
+	case f.UpdatedTo != nil && !todo.UpdatedAt.Before(*f.UpdatedTo):
+		return false
+	case f.TextContains != "" && !strings.Contains(strings.ToLower(todo.Text), strings.ToLower(f.TextContains)):
+		return false
+	}
+	return true
+}
+
+func (f *TodoFilter) validateRanges() error {
+	if f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return errors.New("createdFrom must be before createdTo")
+	}
+	if f.UpdatedFrom != nil && f.UpdatedTo != nil && !f.UpdatedFrom.Before(*f.UpdatedTo) {
+		return errors.New("updatedFrom must be before updatedTo")
+	}
+	return nil
+}
+
+// FindTodosInput holds the parameters required to list a page of a user's unarchived todos.
+// Cursor is nil when the first page is requested; otherwise it must have been issued for the same Sort.
+type FindTodosInput struct {
+	UserID int `validate:"required,gt=0"`
+	Filter TodoFilter
+	Sort   TodoSort `validate:"required"`
+	Limit  int      `validate:"gte=1,lte=100"`
+	Cursor *TodoCursor
+}
+
+// NewFindTodosInput creates a validated FindTodosInput. Returns an error if validation fails
+// and an error wrapping ErrInvalidCursor if the cursor was issued for a different sort.
+func NewFindTodosInput(userID int, filter TodoFilter, sort TodoSort, limit int, cursor *TodoCursor) (*FindTodosInput, error) {
+	m := &FindTodosInput{
+		UserID: userID,
+		Filter: filter,
+		Sort:   sort,
+		Limit:  limit,
+		Cursor: cursor,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find todos input: %w", err)
+	}
+	if err := m.Filter.validateRanges(); err != nil {
+		return nil, fmt.Errorf("validate find todos input: %w", err)
+	}
+	if cursor != nil && cursor.Sort != sort.String() {
+		return nil, fmt.Errorf("cursor was issued for sort %q: %w", cursor.Sort, ErrInvalidCursor)
+	}
+	return m, nil
+}
+
+// TodoPage holds one page of todos. NextCursor is nil when there are no more todos.
+type TodoPage struct {
+	Todos      []Todo `validate:"max=100,dive"`
+	NextCursor *TodoCursor
+}
+
+// FindTodoInput holds the parameters required to fetch a single todo of a user.
+type FindTodoInput struct {
+	ID     int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewFindTodoInput creates a validated FindTodoInput. Returns an error if validation fails.
+func NewFindTodoInput(id int, userID int) (*FindTodoInput, error) {
+	m := &FindTodoInput{
+		ID:     id,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find todo input: %w", err)
+	}
+	return m, nil
+}
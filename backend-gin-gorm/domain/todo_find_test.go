@@ -0,0 +1,155 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestNewTodoSort_shouldReturnError_whenUnsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		field     domain.TodoSortField
+		direction domain.SortDirection
+	}{
+		{
+			name:      "unknown field",
+			field:     "userId",
+			direction: domain.SortDirectionAsc,
+		},
+		{
+			name:      "unknown direction",
+			field:     domain.TodoSortFieldText,
+			direction: "up",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			sort, err := domain.NewTodoSort(tt.field, tt.direction)
+
+			// then
+			require.Error(t, err)
+			assert.Nil(t, sort)
+		})
+	}
+}
+
+func TestNewFindTodosInput_shouldReturnInput_whenValid(t *testing.T) {
+	t.Parallel()
+
+	// given
+	isComplete := true
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	filter := domain.TodoFilter{IsComplete: &isComplete, CreatedFrom: &from, CreatedTo: &to, TextContains: "invoice"}
+	cursor, err := domain.NewTodoCursor(domain.DefaultTodoSort, "", 10)
+	require.NoError(t, err)
+
+	// when
+	input, err := domain.NewFindTodosInput(1, filter, domain.DefaultTodoSort, domain.DefaultTodoPageLimit, cursor)
+
+	// then
+	require.NoError(t, err, "expected no error for valid FindTodosInput")
+	assert.Equal(t, filter, input.Filter)
+	assert.Equal(t, cursor, input.Cursor)
+}
+
+func TestNewFindTodosInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	// given
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name   string
+		filter domain.TodoFilter
+		limit  int
+	}{
+		{
+			name:   "limit is zero",
+			filter: domain.TodoFilter{},
+			limit:  0,
+		},
+		{
+			name:   "limit exceeds maximum",
+			filter: domain.TodoFilter{},
+			limit:  domain.MaxTodoPageLimit + 1,
+		},
+		{
+			name:   "created range is reversed",
+			filter: domain.TodoFilter{CreatedFrom: &to, CreatedTo: &from},
+			limit:  domain.DefaultTodoPageLimit,
+		},
+		{
+			name:   "updated range is empty",
+			filter: domain.TodoFilter{UpdatedFrom: &from, UpdatedTo: &from},
+			limit:  domain.DefaultTodoPageLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			input, err := domain.NewFindTodosInput(1, tt.filter, domain.DefaultTodoSort, tt.limit, nil)
+
+			// then
+			require.Error(t, err)
+			assert.Nil(t, input)
+		})
+	}
+}
+
+func TestNewFindTodosInput_shouldReturnErrInvalidCursor_whenCursorWasIssuedForAnotherSort(t *testing.T) {
+	t.Parallel()
+
+	// given
+	cursor, err := domain.NewTodoCursor(domain.DefaultTodoSort, "", 10)
+	require.NoError(t, err)
+	sort, err := domain.NewTodoSort(domain.TodoSortFieldText, domain.SortDirectionAsc)
+	require.NoError(t, err)
+
+	// when
+	input, err := domain.NewFindTodosInput(1, domain.TodoFilter{}, *sort, domain.DefaultTodoPageLimit, cursor)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrInvalidCursor)
+	assert.Nil(t, input)
+}
+
+func TestNewFindTodoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		id     int
+		userID int
+	}{
+		{name: "ID is zero", id: 0, userID: 1},
+		{name: "UserID is zero", id: 1, userID: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewFindTodoInput(tt.id, tt.userID)
+
+			// then
+			require.Error(t, err)
+			assert.Nil(t, input)
+			assert.Contains(t, err.Error(), "validate find todo input")
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TodoListAll is the ID of the list of all unarchived todos of a user.
+const TodoListAll = "all"
+
+// todoListViewPrefix prefixes the ID of the list of the todos a saved view matches.
+const todoListViewPrefix = "view:"
+
+// todoListSharedPrefix prefixes the ID of the list of the todos of another user that is shared with the user.
+const todoListSharedPrefix = "shared:"
+
+// ErrInvalidTodoListID is returned when a todo list ID is neither TodoListAll nor the ID of a view or shared list.
+var ErrInvalidTodoListID = errors.New("invalid todo list ID")
+
+// TodoListIDOfView returns the ID of the list of the todos the view matches.
+func TodoListIDOfView(viewID int) string {
+	return todoListViewPrefix + strconv.Itoa(viewID)
+}
+
+// TodoListIDOfSharedList returns the ID of the list of the todos of listOwnerID shared with their members.
+func TodoListIDOfSharedList(listOwnerID int) string {
+	return todoListSharedPrefix + strconv.Itoa(listOwnerID)
+}
+
+// TodoList is a list of todos a client can follow: all unarchived todos of a user, those a saved view matches,
+// or all unarchived todos of another user who shares their list. OwnerID is the user whose todos the list holds.
+// View is nil for the list of all todos and for shared lists.
+type TodoList struct {
+	ID      string `validate:"required"`
+	OwnerID int    `validate:"required,gt=0"`
+	View    *View
+}
+
+// Contains reports whether the todo is in the list as of now. Archived and trashed todos are in no list.
+// Returns a *FilterQueryError if the filter of the view is no longer supported.
+func (l *TodoList) Contains(todo *Todo, now time.Time) (bool, error) {
+	if todo.UserID != l.OwnerID || todo.ArchivedAt != nil || todo.DeletedAt != nil {
+		return false, nil
+	}
+	if l.View == nil {
+		return true, nil
+	}
+	filter, err := l.View.TodoFilter(now)
+	if err != nil {
+		return false, err
+	}
+	return filter.Matches(todo), nil
+}
+
+// FindTodoListInput holds the parameters required to look up a todo list a user can follow.
+// ListOwnerID is the user whose todos the list holds, the user themselves unless the list is shared with them.
+// ViewID is 0 for the list of all todos and for shared lists.
+type FindTodoListInput struct {
+	UserID      int `validate:"required,gt=0"`
+	ListOwnerID int `validate:"required,gt=0"`
+	ViewID      int `validate:"gte=0"`
+}
+
+// NewFindTodoListInput creates a validated FindTodoListInput from a list ID.
+// Returns an error wrapping ErrInvalidTodoListID if the list ID is malformed.
+func NewFindTodoListInput(listID string, userID int) (*FindTodoListInput, error) {
+	m := &FindTodoListInput{
+		UserID:      userID,
+		ListOwnerID: userID,
+		ViewID:      0,
+	}
+	if listID != TodoListAll {
+		if viewIDS, ok := strings.CutPrefix(listID, todoListViewPrefix); ok {
+			viewID, err := parseTodoListIDNumber(listID, viewIDS)
+			if err != nil {
+				return nil, err
+			}
+			m.ViewID = viewID
+		} else if listOwnerIDS, ok := strings.CutPrefix(listID, todoListSharedPrefix); ok {
+			listOwnerID, err := parseTodoListIDNumber(listID, listOwnerIDS)
+			if err != nil {
+				return nil, err
+			}
+			// The user follows their own todos as TodoListAll
+			if listOwnerID == userID {
+				return nil, fmt.Errorf("list ID %q: %w", listID, ErrInvalidTodoListID)
+			}
+			m.ListOwnerID = listOwnerID
+		} else {
+			return nil, fmt.Errorf("list ID %q: %w", listID, ErrInvalidTodoListID)
+		}
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find todo list input: %w", err)
+	}
+	return m, nil
+}
+
+func parseTodoListIDNumber(listID string, s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("list ID %q: %w", listID, ErrInvalidTodoListID)
+	}
+	return n, nil
+}
+
+// ListID returns the ID of the list the input looks up.
+func (m *FindTodoListInput) ListID() string {
+	switch {
+	case m.ListOwnerID != m.UserID:
+		return TodoListIDOfSharedList(m.ListOwnerID)
+	case m.ViewID > 0:
+		return TodoListIDOfView(m.ViewID)
+	default:
+		return TodoListAll
+	}
+}
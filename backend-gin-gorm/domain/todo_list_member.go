@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrTodoListNotFound is returned when a todo list is neither the user's own nor shared with the user.
+	ErrTodoListNotFound = errors.New("todo list not found")
+	// ErrOwnTodoList is returned when the user accepts an invitation to the todo list they own.
+	ErrOwnTodoList = errors.New("todo list is owned by the user")
+	// ErrTodoListMemberNotFound is returned when a user is not a member of a todo list.
+	ErrTodoListMemberNotFound = errors.New("todo list member not found")
+	// ErrTodoListInvitationNotFound is returned when an invitation does not exist or is not addressed to the user.
+	ErrTodoListInvitationNotFound = errors.New("todo list invitation not found")
+)
+
+// TodoListRole is the permission a user has in a todo list, the todos of one user shared with others.
+// A viewer reads the todos, an editor also changes them and an owner also manages the members.
+// The user whose todos the list holds is its owner without being a member. Operations the role of the user
+// does not allow fail with ErrForbidden.
+type TodoListRole string
+
+const (
+	// TodoListViewer may read the todos of the list.
+	TodoListViewer TodoListRole = "viewer"
+	// TodoListEditor may read, update and delete the todos of the list.
+	TodoListEditor TodoListRole = "editor"
+	// TodoListOwner may do what an editor may and invite and remove members.
+	TodoListOwner TodoListRole = "owner"
+)
+
+var todoListRoleRanks = map[TodoListRole]int{
+	TodoListViewer: 1,
+	TodoListEditor: 2,
+	TodoListOwner:  3,
+}
+
+// Allows reports whether the role grants at least the permissions of required.
+func (r TodoListRole) Allows(required TodoListRole) bool {
+	return todoListRoleRanks[r] >= todoListRoleRanks[required]
+}
+
+// TodoListMember is a user a todo list is shared with. ListOwnerID is the user whose todos the list holds.
+// LoginID is captured when the invitation is accepted so that members can be shown without a user lookup.
+type TodoListMember struct {
+	ListOwnerID int          `validate:"required,gt=0"`
+	UserID      int          `validate:"required,gt=0,nefield=ListOwnerID"`
+	LoginID     string       `validate:"required,max=255"`
+	Role        TodoListRole `validate:"required,oneof=viewer editor owner"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewTodoListMember creates a validated TodoListMember. Returns an error if validation fails.
+func NewTodoListMember(listOwnerID int, userID int, loginID string, role TodoListRole, createdAt, updatedAt time.Time) (*TodoListMember, error) {
+	m := &TodoListMember{
+		ListOwnerID: listOwnerID,
+		UserID:      userID,
+		LoginID:     loginID,
+		Role:        role,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate todo list member model: %w", err)
+	}
+	return m, nil
+}
+
+// TodoListInvitation is a pending invitation to join the todo list of ListOwnerID with Role.
+// It is addressed to a login ID, so that a user can be invited without a user lookup, and is removed once answered.
+type TodoListInvitation struct {
+	ID             int          `validate:"required,gt=0"`
+	ListOwnerID    int          `validate:"required,gt=0"`
+	InviteeLoginID string       `validate:"required,max=255"`
+	Role           TodoListRole `validate:"required,oneof=viewer editor owner"`
+	InviterUserID  int          `validate:"required,gt=0"`
+	InviterLoginID string       `validate:"required,max=255"`
+	CreatedAt      time.Time
+}
+
+// NewTodoListInvitation creates a validated TodoListInvitation. Returns an error if validation fails.
+func NewTodoListInvitation(id int, listOwnerID int, inviteeLoginID string, role TodoListRole, inviterUserID int, inviterLoginID string, createdAt time.Time) (*TodoListInvitation, error) {
+	m := &TodoListInvitation{
+		ID:             id,
+		ListOwnerID:    listOwnerID,
+		InviteeLoginID: inviteeLoginID,
+		Role:           role,
+		InviterUserID:  inviterUserID,
+		InviterLoginID: inviterLoginID,
+		CreatedAt:      createdAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate todo list invitation model: %w", err)
+	}
+	return m, nil
+}
+
+// InviteTodoListMemberInput holds the parameters required for the user to invite a login ID to the todo list of ListOwnerID.
+// LoginID is the login ID of the inviting user, who cannot invite themselves.
+type InviteTodoListMemberInput struct {
+	ListOwnerID    int          `validate:"required,gt=0"`
+	UserID         int          `validate:"required,gt=0"`
+	LoginID        string       `validate:"required,max=255"`
+	InviteeLoginID string       `validate:"required,max=255,nefield=LoginID"`
+	Role           TodoListRole `validate:"required,oneof=viewer editor owner"`
+}
+
+// NewInviteTodoListMemberInput creates a validated InviteTodoListMemberInput. Returns an error if validation fails.
+func NewInviteTodoListMemberInput(listOwnerID int, userID int, loginID string, inviteeLoginID string, role TodoListRole) (*InviteTodoListMemberInput, error) {
+	m := &InviteTodoListMemberInput{
+		ListOwnerID:    listOwnerID,
+		UserID:         userID,
+		LoginID:        loginID,
+		InviteeLoginID: inviteeLoginID,
+		Role:           role,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate invite todo list member input: %w", err)
+	}
+	return m, nil
+}
+
+// InviteTodoListMemberOutput holds the invitation created or renewed by an invite.
+type InviteTodoListMemberOutput struct {
+	Invitation *TodoListInvitation `validate:"required"`
+}
+
+// NewInviteTodoListMemberOutput creates a validated InviteTodoListMemberOutput. Returns an error if validation fails.
+func NewInviteTodoListMemberOutput(invitation *TodoListInvitation) (*InviteTodoListMemberOutput, error) {
+	m := &InviteTodoListMemberOutput{
+		Invitation: invitation,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate invite todo list member output: %w", err)
+	}
+	return m, nil
+}
+
+// AnswerTodoListInvitationInput holds the parameters required for the user to accept or decline an invitation
+// addressed to their login ID.
+type AnswerTodoListInvitationInput struct {
+	InvitationID int    `validate:"required,gt=0"`
+	UserID       int    `validate:"required,gt=0"`
+	LoginID      string `validate:"required,max=255"`
+	Accept       bool
+}
+
+// NewAnswerTodoListInvitationInput creates a validated AnswerTodoListInvitationInput. Returns an error if validation fails.
+func NewAnswerTodoListInvitationInput(invitationID int, userID int, loginID string, accept bool) (*AnswerTodoListInvitationInput, error) {
+	m := &AnswerTodoListInvitationInput{
+		InvitationID: invitationID,
+		UserID:       userID,
+		LoginID:      loginID,
+		Accept:       accept,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate answer todo list invitation input: %w", err)
+	}
+	return m, nil
+}
+
+// FindTodoListMembersInput holds the parameters required for the user to list the members of the todo list of ListOwnerID.
+type FindTodoListMembersInput struct {
+	ListOwnerID int `validate:"required,gt=0"`
+	UserID      int `validate:"required,gt=0"`
+}
+
+// NewFindTodoListMembersInput creates a validated FindTodoListMembersInput. Returns an error if validation fails.
+func NewFindTodoListMembersInput(listOwnerID int, userID int) (*FindTodoListMembersInput, error) {
+	m := &FindTodoListMembersInput{
+		ListOwnerID: listOwnerID,
+		UserID:      userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find todo list members input: %w", err)
+	}
+	return m, nil
+}
+
+// RemoveTodoListMemberInput holds the parameters required for the user to remove MemberUserID from the todo list of ListOwnerID.
+// A member may remove themselves to leave the list.
+type RemoveTodoListMemberInput struct {
+	ListOwnerID  int `validate:"required,gt=0"`
+	UserID       int `validate:"required,gt=0"`
+	MemberUserID int `validate:"required,gt=0"`
+}
+
+// NewRemoveTodoListMemberInput creates a validated RemoveTodoListMemberInput. Returns an error if validation fails.
+func NewRemoveTodoListMemberInput(listOwnerID int, userID int, memberUserID int) (*RemoveTodoListMemberInput, error) {
+	m := &RemoveTodoListMemberInput{
+		ListOwnerID:  listOwnerID,
+		UserID:       userID,
+		MemberUserID: memberUserID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate remove todo list member input: %w", err)
+	}
+	return m, nil
+}
+
+// FindTodoListTodosInput holds the parameters required for the user to list one page of the unarchived todos
+// of the todo list of ListOwnerID, in the default order.
+type FindTodoListTodosInput struct {
+	ListOwnerID int `validate:"required,gt=0"`
+	UserID      int `validate:"required,gt=0"`
+	Limit       int `validate:"gte=1,lte=100"`
+	Cursor      *TodoCursor
+}
+
+// NewFindTodoListTodosInput creates a validated FindTodoListTodosInput. Returns an error if validation fails.
+func NewFindTodoListTodosInput(listOwnerID int, userID int, limit int, cursor *TodoCursor) (*FindTodoListTodosInput, error) {
+	m := &FindTodoListTodosInput{
+		ListOwnerID: listOwnerID,
+		UserID:      userID,
+		Limit:       limit,
+		Cursor:      cursor,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find todo list todos input: %w", err)
+	}
+	return m, nil
+}
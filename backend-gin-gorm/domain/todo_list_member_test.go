@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListRole tests
+func TestTodoListRole_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role     domain.TodoListRole
+		required domain.TodoListRole
+		want     bool
+	}{
+		{role: domain.TodoListViewer, required: domain.TodoListViewer, want: true},
+		{role: domain.TodoListViewer, required: domain.TodoListEditor, want: false},
+		{role: domain.TodoListEditor, required: domain.TodoListViewer, want: true},
+		{role: domain.TodoListEditor, required: domain.TodoListOwner, want: false},
+		{role: domain.TodoListOwner, required: domain.TodoListEditor, want: true},
+		{role: "", required: domain.TodoListViewer, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"_"+string(tt.required), func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			got := tt.role.Allows(tt.required)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// NewInviteTodoListMemberInput tests
+func TestNewInviteTodoListMemberInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inviteeLoginID string
+		role           domain.TodoListRole
+	}{
+		{
+			name:           "invitee is the inviting user",
+			inviteeLoginID: "user1",
+			role:           domain.TodoListViewer,
+		},
+		{
+			name:           "invitee is empty",
+			inviteeLoginID: "",
+			role:           domain.TodoListViewer,
+		},
+		{
+			name:           "role is unknown",
+			inviteeLoginID: "user2",
+			role:           "admin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.NewInviteTodoListMemberInput(1, 1, "user1", tt.inviteeLoginID, tt.role)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+// NewTodoListMember tests
+func TestNewTodoListMember_shouldReturnError_whenMemberOwnsTheList(t *testing.T) {
+	t.Parallel()
+
+	// when
+	_, err := domain.NewTodoListMember(1, 1, "user1", domain.TodoListEditor, time.Now(), time.Now())
+
+	// then
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewFindTodoListInput tests
+func TestNewFindTodoListInput_shouldReturnInput_whenValidListID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		listID      string
+		listOwnerID int
+		viewID      int
+	}{
+		{name: "all todos", listID: domain.TodoListAll, listOwnerID: 1, viewID: 0},
+		{name: "view", listID: "view:12", listOwnerID: 1, viewID: 12},
+		{name: "shared list", listID: "shared:7", listOwnerID: 7, viewID: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			input, err := domain.NewFindTodoListInput(tt.listID, 1)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.listOwnerID, input.ListOwnerID)
+			assert.Equal(t, tt.viewID, input.ViewID)
+			assert.Equal(t, tt.listID, input.ListID())
+		})
+	}
+}
+
+func TestNewFindTodoListInput_shouldReturnErrInvalidTodoListID_whenMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, listID := range []string{"", "view:", "view:0", "view:-1", "view:abc", "label:1", "shared:", "shared:0", "shared:abc", "shared:1"} {
+		t.Run(listID, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.NewFindTodoListInput(listID, 1)
+
+			// then
+			require.ErrorIs(t, err, domain.ErrInvalidTodoListID)
+		})
+	}
+}
+
+// TodoList.Contains tests
+func TestTodoList_Contains_shouldMatchFilterOfView(t *testing.T) {
+	t.Parallel()
+
+	// given
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	view, err := domain.NewView(3, 1, "Open milk", "is:open text:MILK created<7d", domain.DefaultTodoSort, now, now)
+	require.NoError(t, err)
+	list := &domain.TodoList{ID: domain.TodoListIDOfView(view.ID), OwnerID: 1, View: view}
+	all := &domain.TodoList{ID: domain.TodoListAll, OwnerID: 1, View: nil}
+	archivedAt := now
+
+	tests := []struct {
+		name      string
+		todo      domain.Todo
+		inView    bool
+		inAllList bool
+	}{
+		{name: "matching todo", todo: domain.Todo{UserID: 1, Text: "buy milk", CreatedAt: now.Add(-time.Hour)}, inView: true, inAllList: true},
+		{name: "completed todo", todo: domain.Todo{UserID: 1, Text: "buy milk", IsComplete: true, CreatedAt: now.Add(-time.Hour)}, inView: false, inAllList: true},
+		{name: "old todo", todo: domain.Todo{UserID: 1, Text: "buy milk", CreatedAt: now.AddDate(0, 0, -8)}, inView: false, inAllList: true},
+		{name: "other text", todo: domain.Todo{UserID: 1, Text: "buy bread", CreatedAt: now.Add(-time.Hour)}, inView: false, inAllList: true},
+		{name: "todo of other user", todo: domain.Todo{UserID: 2, Text: "buy milk", CreatedAt: now.Add(-time.Hour)}, inView: false, inAllList: false},
+		{name: "archived todo", todo: domain.Todo{UserID: 1, Text: "buy milk", CreatedAt: now.Add(-time.Hour), ArchivedAt: &archivedAt}, inView: false, inAllList: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			inView, err := list.Contains(&tt.todo, now)
+			require.NoError(t, err)
+			inAllList, err := all.Contains(&tt.todo, now)
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, tt.inView, inView)
+			assert.Equal(t, tt.inAllList, inAllList)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PushChangeType is the kind of change a client made to its replica while offline.
+type PushChangeType string
+
+const (
+	// PushChangeCreate creates a todo.
+	PushChangeCreate PushChangeType = "create"
+	// PushChangeUpdate changes the fields of a todo.
+	PushChangeUpdate PushChangeType = "update"
+	// PushChangeDelete moves a todo to the trash.
+	PushChangeDelete PushChangeType = "delete"
+)
+
+// TodoField names a field of a todo that a pushed update is merged on and whose changes the history of the todo records.
+type TodoField string
+
+const (
+	// TodoFieldText is the text of a todo.
+	TodoFieldText TodoField = "text"
+	// TodoFieldIsComplete is the completion state of a todo.
+	TodoFieldIsComplete TodoField = "isComplete"
+)
+
+// TodoFieldValues holds the values of the fields of a todo that a client can edit offline and a revert restores.
+type TodoFieldValues struct {
+	Text       string `validate:"required,max=255"`
+	IsComplete bool
+}
+
+// TodoFieldValuesOf returns the values of the mergeable fields of the todo.
+func TodoFieldValuesOf(todo *Todo) TodoFieldValues {
+	return TodoFieldValues{
+		Text:       todo.Text,
+		IsComplete: todo.IsComplete,
+	}
+}
+
+// MergeTodoFields merges, field by field, the changes a client made to its copy base of a todo, giving local,
+// with the changes made on the server since, giving server.
+// A field changed on one side only takes that side's value, and a field both sides changed to the same value keeps it.
+// A field both sides changed to different values is a conflict: it keeps the server value and is listed in conflicts.
+func MergeTodoFields(base, local, server TodoFieldValues) (TodoFieldValues, []TodoField) {
+	merged := server
+	conflicts := make([]TodoField, 0)
+
+	switch {
+	case local.Text == base.Text || local.Text == server.Text:
+	case server.Text == base.Text:
+		merged.Text = local.Text
+	default:
+		conflicts = append(conflicts, TodoFieldText)
+	}
+
+	switch {
+	case local.IsComplete == base.IsComplete || local.IsComplete == server.IsComplete:
+	case server.IsComplete == base.IsComplete:
+		merged.IsComplete = local.IsComplete
+	default:
+		conflicts = append(conflicts, TodoFieldIsComplete)
+	}
+
+	return merged, conflicts
+}
+
+// PushChange is a change a client made to its replica while offline.
+// A create takes Local and may carry a TempID by which the client knows the todo until it learns its ID.
+// An update and a delete target the todo with ID and carry the BaseVersion of the copy the change was made to;
+// an update also carries the values of that copy in Base and the values after the change in Local.
+type PushChange struct {
+	Type        PushChangeType   `validate:"required,oneof=create update delete"`
+	ID          int              `validate:"omitempty,gt=0"`
+	TempID      string           `validate:"max=64"`
+	BaseVersion int              `validate:"gte=0"`
+	Base        *TodoFieldValues `validate:"omitempty"`
+	Local       *TodoFieldValues `validate:"omitempty"`
+}
+
+// PushTodoChangesInput holds up to 100 changes a client pushes for a user, in the order the client made them.
+type PushTodoChangesInput struct {
+	UserID  int          `validate:"required,gt=0"`
+	Changes []PushChange `validate:"required,min=1,max=100,dive"`
+}
+
+// NewPushTodoChangesInput creates a validated PushTodoChangesInput.
+// Returns an error if validation fails and a *BatchOperationError if a change does not fit its type.
+func NewPushTodoChangesInput(userID int, changes []PushChange) (*PushTodoChangesInput, error) {
+	m := &PushTodoChangesInput{
+		UserID:  userID,
+		Changes: changes,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate push todo changes input: %w", err)
+	}
+	for i, change := range changes {
+		if err := validatePushChange(&change); err != nil {
+			return nil, &BatchOperationError{Index: i, Err: err}
+		}
+	}
+	return m, nil
+}
+
+// validatePushChange checks the fields of a change against its type.
+func validatePushChange(change *PushChange) error {
+	switch change.Type {
+	case PushChangeCreate:
+		if change.Local == nil || change.ID != 0 || change.BaseVersion != 0 || change.Base != nil {
+			return errors.New("create only takes local and a temporary id")
+		}
+	case PushChangeUpdate:
+		if change.ID == 0 || change.BaseVersion == 0 || change.Base == nil || change.Local == nil || change.TempID != "" {
+			return errors.New("update requires an id, a base version, base and local")
+		}
+	case PushChangeDelete:
+		if change.ID == 0 || change.BaseVersion == 0 || change.Base != nil || change.Local != nil || change.TempID != "" {
+			return errors.New("delete only takes an id and a base version")
+		}
+	}
+	return nil
+}
+
+// PushChangeStatus is the outcome of a pushed change.
+type PushChangeStatus string
+
+const (
+	// PushChangeApplied means that the todo was not changed on the server since the base version and the change was applied as made.
+	PushChangeApplied PushChangeStatus = "applied"
+	// PushChangeMerged means that the todo was changed on the server too and the changes were merged without conflict.
+	PushChangeMerged PushChangeStatus = "merged"
+	// PushChangeConflict means that the server kept its values of the conflicting fields of an update
+	// or did not delete a todo changed since the base version.
+	PushChangeConflict PushChangeStatus = "conflict"
+	// PushChangeNotFound means that the todo of an update or delete no longer exists or is in the trash.
+	PushChangeNotFound PushChangeStatus = "not_found"
+	// PushChangeFailed means that the change could not be processed; Err of the result tells why.
+	PushChangeFailed PushChangeStatus = "failed"
+)
+
+// PushChangeResult is the outcome of one pushed change.
+// Todo is the todo as the change left it on the server and is nil for a delete that was applied.
+// For a conflict, Local holds the values the client pushed and Conflicts the fields of an update
+// whose server values were kept; it is empty for a delete. OwnerID is the owner of the todo a delete that was applied
+// moved to the trash and is 0 otherwise.
+type PushChangeResult struct {
+	Type      PushChangeType
+	ID        int
+	TempID    string
+	Status    PushChangeStatus
+	Todo      *Todo
+	OwnerID   int
+	Local     *TodoFieldValues
+	Conflicts []TodoField
+	Err       error
+}
+
+// PushTodoChangesOutput holds the results of a push in the order of its changes.
+type PushTodoChangesOutput struct {
+	Results []PushChangeResult `validate:"required,min=1,max=100"`
+}
+
+// NewPushTodoChangesOutput creates a validated PushTodoChangesOutput. Returns an error if validation fails.
+func NewPushTodoChangesOutput(results []PushChangeResult) (*PushTodoChangesOutput, error) {
+	m := &PushTodoChangesOutput{
+		Results: results,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate push todo changes output: %w", err)
+	}
+	return m, nil
+}
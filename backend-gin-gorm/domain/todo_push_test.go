@@ -0,0 +1,115 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestMergeTodoFields(t *testing.T) {
+	t.Parallel()
+
+	base := domain.TodoFieldValues{Text: "buy milk", IsComplete: false}
+	tests := []struct {
+		name          string
+		local         domain.TodoFieldValues
+		server        domain.TodoFieldValues
+		wantMerged    domain.TodoFieldValues
+		wantConflicts []domain.TodoField
+	}{
+		{
+			name:          "only the server changed",
+			local:         base,
+			server:        domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			wantMerged:    domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			wantConflicts: []domain.TodoField{},
+		},
+		{
+			name:          "only the client changed",
+			local:         domain.TodoFieldValues{Text: "buy bread", IsComplete: true},
+			server:        base,
+			wantMerged:    domain.TodoFieldValues{Text: "buy bread", IsComplete: true},
+			wantConflicts: []domain.TodoField{},
+		},
+		{
+			name:          "each side changed a different field",
+			local:         domain.TodoFieldValues{Text: "buy milk", IsComplete: true},
+			server:        domain.TodoFieldValues{Text: "buy oat milk", IsComplete: false},
+			wantMerged:    domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			wantConflicts: []domain.TodoField{},
+		},
+		{
+			name:          "both sides made the same change",
+			local:         domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			server:        domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			wantMerged:    domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			wantConflicts: []domain.TodoField{},
+		},
+		{
+			name:          "both sides changed the text differently",
+			local:         domain.TodoFieldValues{Text: "buy bread", IsComplete: true},
+			server:        domain.TodoFieldValues{Text: "buy oat milk", IsComplete: false},
+			wantMerged:    domain.TodoFieldValues{Text: "buy oat milk", IsComplete: true},
+			wantConflicts: []domain.TodoField{domain.TodoFieldText},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			merged, conflicts := domain.MergeTodoFields(base, tt.local, tt.server)
+
+			// then
+			assert.Equal(t, tt.wantMerged, merged)
+			assert.Equal(t, tt.wantConflicts, conflicts)
+		})
+	}
+}
+
+func TestNewPushTodoChangesInput_shouldReturnBatchOperationError_whenChangeDoesNotFitItsType(t *testing.T) {
+	t.Parallel()
+
+	values := &domain.TodoFieldValues{Text: "buy milk", IsComplete: false}
+	tests := []struct {
+		name   string
+		change domain.PushChange
+	}{
+		{
+			name:   "create without local",
+			change: domain.PushChange{Type: domain.PushChangeCreate, TempID: "a"},
+		},
+		{
+			name:   "update without base",
+			change: domain.PushChange{Type: domain.PushChangeUpdate, ID: 1, BaseVersion: 1, Local: values},
+		},
+		{
+			name:   "update without base version",
+			change: domain.PushChange{Type: domain.PushChangeUpdate, ID: 1, Base: values, Local: values},
+		},
+		{
+			name:   "delete with local",
+			change: domain.PushChange{Type: domain.PushChangeDelete, ID: 1, BaseVersion: 1, Local: values},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			valid := domain.PushChange{Type: domain.PushChangeDelete, ID: 2, BaseVersion: 3}
+
+			// when
+			input, err := domain.NewPushTodoChangesInput(1, []domain.PushChange{valid, tt.change})
+
+			// then
+			var changeErr *domain.BatchOperationError
+			require.ErrorAs(t, err, &changeErr)
+			assert.Equal(t, 1, changeErr.Index)
+			assert.Nil(t, input)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTodoRevisionNotFound is returned when a requested revision of a todo does not exist.
+var ErrTodoRevisionNotFound = errors.New("todo revision not found")
+
+// TodoRevisionAction is the kind of write a revision of a todo records.
+type TodoRevisionAction string
+
+const (
+	// TodoRevisionCreated records the creation of a todo.
+	TodoRevisionCreated TodoRevisionAction = "created"
+	// TodoRevisionUpdated records a change to the text or completion of a todo.
+	TodoRevisionUpdated TodoRevisionAction = "updated"
+	// TodoRevisionDeleted records a todo that was moved to the trash.
+	TodoRevisionDeleted TodoRevisionAction = "deleted"
+	// TodoRevisionRestored records a todo that was restored from the trash.
+	TodoRevisionRestored TodoRevisionAction = "restored"
+	// TodoRevisionReverted records a todo whose fields were set back to their values at an earlier revision.
+	TodoRevisionReverted TodoRevisionAction = "reverted"
+	// TodoRevisionArchived records a todo that was archived, by the user or automatically.
+	TodoRevisionArchived TodoRevisionAction = "archived"
+	// TodoRevisionUnarchived records a todo that was taken out of the archive.
+	TodoRevisionUnarchived TodoRevisionAction = "unarchived"
+)
+
+// ChangedTodoFields returns the fields whose values differ between before and after, in a fixed order.
+func ChangedTodoFields(before, after TodoFieldValues) []TodoField {
+	fields := make([]TodoField, 0)
+	if before.Text != after.Text {
+		fields = append(fields, TodoFieldText)
+	}
+	if before.IsComplete != after.IsComplete {
+		fields = append(fields, TodoFieldIsComplete)
+	}
+	return fields
+}
+
+// TodoRevision records the values of the fields of a todo after a write and who made it.
+// Revisions of a todo are numbered from 1 in the order of the writes. ChangedFields lists the fields the write
+// changed; a creation lists every field and a deletion, restore, archive or unarchive none. RevertedFrom is the revision a revert
+// restored and is nil for other writes.
+type TodoRevision struct {
+	TodoID        int                `validate:"required,gt=0"`
+	Revision      int                `validate:"required,gt=0"`
+	Action        TodoRevisionAction `validate:"required,oneof=created updated deleted restored reverted archived unarchived"`
+	UserID        int                `validate:"required,gt=0"`
+	Values        TodoFieldValues    `validate:"required"`
+	ChangedFields []TodoField        `validate:"dive,oneof=text isComplete"`
+	RevertedFrom  *int               `validate:"omitempty,gt=0"`
+	CreatedAt     time.Time
+}
+
+// NewTodoRevision creates a validated TodoRevision. Returns an error if validation fails.
+func NewTodoRevision(todoID int, revision int, action TodoRevisionAction, userID int, values TodoFieldValues, changedFields []TodoField, revertedFrom *int, createdAt time.Time) (*TodoRevision, error) {
+	m := &TodoRevision{
+		TodoID:        todoID,
+		Revision:      revision,
+		Action:        action,
+		UserID:        userID,
+		Values:        values,
+		ChangedFields: changedFields,
+		RevertedFrom:  revertedFrom,
+		CreatedAt:     createdAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate todo revision model: %w", err)
+	}
+	return m, nil
+}
+
+// FindTodoHistoryInput holds the parameters required to list the revisions of a todo.
+type FindTodoHistoryInput struct {
+	TodoID int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewFindTodoHistoryInput creates a validated FindTodoHistoryInput. Returns an error if validation fails.
+func NewFindTodoHistoryInput(todoID int, userID int) (*FindTodoHistoryInput, error) {
+	m := &FindTodoHistoryInput{
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find todo history input: %w", err)
+	}
+	return m, nil
+}
+
+// RevertTodoInput holds the parameters required to set the fields of a todo back to their values at one of its revisions.
+// ExpectedVersion is nil for an unconditional revert; otherwise the revert only applies to that version.
+type RevertTodoInput struct {
+	TodoID          int  `validate:"required,gt=0"`
+	UserID          int  `validate:"required,gt=0"`
+	Revision        int  `validate:"required,gt=0"`
+	ExpectedVersion *int `validate:"omitempty,gte=0"`
+}
+
+// NewRevertTodoInput creates a validated RevertTodoInput. Returns an error if validation fails.
+func NewRevertTodoInput(todoID int, userID int, revision int, expectedVersion *int) (*RevertTodoInput, error) {
+	m := &RevertTodoInput{
+		TodoID:          todoID,
+		UserID:          userID,
+		Revision:        revision,
+		ExpectedVersion: expectedVersion,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate revert todo input: %w", err)
+	}
+	return m, nil
+}
+
+// RevertTodoOutput holds the result of a todo revert.
+type RevertTodoOutput struct {
+	Todo *Todo `validate:"required"`
+}
+
+// NewRevertTodoOutput creates a validated RevertTodoOutput. Returns an error if validation fails.
+func NewRevertTodoOutput(todo *Todo) (*RevertTodoOutput, error) {
+	m := &RevertTodoOutput{
+		Todo: todo,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate revert todo output: %w", err)
+	}
+	return m, nil
+}
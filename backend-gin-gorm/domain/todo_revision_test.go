@@ -0,0 +1,125 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChangedTodoFields tests
+func TestChangedTodoFields_shouldReturnFieldsThatDiffer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		before   domain.TodoFieldValues
+		after    domain.TodoFieldValues
+		expected []domain.TodoField
+	}{
+		{
+			name:     "nothing changed",
+			before:   domain.TodoFieldValues{Text: "Buy milk", IsComplete: false},
+			after:    domain.TodoFieldValues{Text: "Buy milk", IsComplete: false},
+			expected: []domain.TodoField{},
+		},
+		{
+			name:     "text changed",
+			before:   domain.TodoFieldValues{Text: "Buy milk", IsComplete: false},
+			after:    domain.TodoFieldValues{Text: "Buy oat milk", IsComplete: false},
+			expected: []domain.TodoField{domain.TodoFieldText},
+		},
+		{
+			name:     "both changed",
+			before:   domain.TodoFieldValues{Text: "Buy milk", IsComplete: false},
+			after:    domain.TodoFieldValues{Text: "Buy oat milk", IsComplete: true},
+			expected: []domain.TodoField{domain.TodoFieldText, domain.TodoFieldIsComplete},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			fields := domain.ChangedTodoFields(tt.before, tt.after)
+
+			// then
+			assert.Equal(t, tt.expected, fields)
+		})
+	}
+}
+
+// NewTodoRevision tests
+func TestNewTodoRevision_shouldReturnRevision_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// given
+	revertedFrom := 1
+	values := domain.TodoFieldValues{Text: "Buy milk", IsComplete: true}
+
+	// when
+	revision, err := domain.NewTodoRevision(10, 3, domain.TodoRevisionReverted, 20, values, []domain.TodoField{domain.TodoFieldIsComplete}, &revertedFrom, time.Now())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 3, revision.Revision)
+	assert.Equal(t, values, revision.Values)
+	assert.Equal(t, &revertedFrom, revision.RevertedFrom)
+}
+
+func TestNewTodoRevision_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		revision      int
+		action        domain.TodoRevisionAction
+		changedFields []domain.TodoField
+	}{
+		{
+			name:          "revision is zero",
+			revision:      0,
+			action:        domain.TodoRevisionUpdated,
+			changedFields: []domain.TodoField{},
+		},
+		{
+			name:          "action is unknown",
+			revision:      1,
+			action:        "moved",
+			changedFields: []domain.TodoField{},
+		},
+		{
+			name:          "changed field is unknown",
+			revision:      1,
+			action:        domain.TodoRevisionUpdated,
+			changedFields: []domain.TodoField{"version"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.NewTodoRevision(10, tt.revision, tt.action, 20, domain.TodoFieldValues{Text: "Buy milk"}, tt.changedFields, nil, time.Now())
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+// NewRevertTodoInput tests
+func TestNewRevertTodoInput_shouldReturnError_whenRevisionIsNotPositive(t *testing.T) {
+	t.Parallel()
+
+	// when
+	_, err := domain.NewRevertTodoInput(1, 2, 0, nil)
+
+	// then
+	require.Error(t, err)
+}
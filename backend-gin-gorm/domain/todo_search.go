@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	// DefaultTodoSearchLimit is the number of hits returned when no limit is requested.
+	DefaultTodoSearchLimit = 20
+	// MaxTodoSearchTerms is the maximum number of terms a search query may contain.
+	MaxTodoSearchTerms = 10
+
+	// todoSnippetContextRunes is the number of runes kept before the first match in a snippet; twice as many are kept after it.
+	todoSnippetContextRunes = 40
+	todoSnippetEllipsis     = "…"
+)
+
+// TodoSearchField names the part of a todo a search hit was found in.
+type TodoSearchField string
+
+// Parts of a todo that are searched.
+const (
+	TodoSearchFieldText    TodoSearchField = "text"
+	TodoSearchFieldComment TodoSearchField = "comment"
+)
+
+// SearchTodosInput holds the parameters for a full-text search over a user's todos.
+// Terms are the whitespace-separated words of Query; a todo matches when its text,
+// or one of its comments, contains every term. Whether case is ignored depends on the database collation.
+type SearchTodosInput struct {
+	UserID int      `validate:"required,gt=0"`
+	Query  string   `validate:"required,max=255"`
+	Terms  []string `validate:"min=1,max=10,dive,required"`
+	Limit  int      `validate:"gte=1,lte=100"`
+}
+
+// NewSearchTodosInput creates a validated SearchTodosInput. Returns an error if validation fails.
+func NewSearchTodosInput(userID int, query string, limit int) (*SearchTodosInput, error) {
+	m := &SearchTodosInput{
+		UserID: userID,
+		Query:  query,
+		Terms:  splitSearchTerms(query),
+		Limit:  limit,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate search todos input: %w", err)
+	}
+	return m, nil
+}
+
+// splitSearchTerms splits a query into its distinct whitespace-separated terms.
+func splitSearchTerms(query string) []string {
+	seen := make(map[string]struct{})
+	terms := make([]string, 0)
+	for _, term := range strings.Fields(query) {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// SnippetFragment is a piece of a snippet. Highlighted fragments are occurrences of a search term.
+type SnippetFragment struct {
+	Text        string
+	Highlighted bool
+}
+
+// TodoSearchSnippet is an excerpt of a searched field with the search terms highlighted.
+type TodoSearchSnippet struct {
+	Field     TodoSearchField
+	Fragments []SnippetFragment
+}
+
+// NewTodoSearchSnippet highlights the terms in text, case-insensitively, and trims it to the part around the first match.
+// Returns nil if none of the terms occur in text.
+func NewTodoSearchSnippet(field TodoSearchField, text string, terms []string) *TodoSearchSnippet {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// Mark the runes covered by any term
+	highlighted := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		termRunes := []rune(strings.ToLower(term))
+		for i := 0; len(termRunes) > 0 && i+len(termRunes) <= len(lower); i++ {
+			if string(lower[i:i+len(termRunes)]) != string(termRunes) {
+				continue
+			}
+			for j := i; j < i+len(termRunes); j++ {
+				highlighted[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+
+	start := max(first-todoSnippetContextRunes, 0)
+	end := min(first+2*todoSnippetContextRunes, len(runes))
+	fragments := make([]SnippetFragment, 0)
+	if start > 0 {
+		fragments = append(fragments, SnippetFragment{Text: todoSnippetEllipsis, Highlighted: false})
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && highlighted[j] == highlighted[i] {
+			j++
+		}
+		fragments = append(fragments, SnippetFragment{Text: string(runes[i:j]), Highlighted: highlighted[i]})
+		i = j
+	}
+	if end < len(runes) {
+		fragments = append(fragments, SnippetFragment{Text: todoSnippetEllipsis, Highlighted: false})
+	}
+
+	return &TodoSearchSnippet{
+		Field:     field,
+		Fragments: fragments,
+	}
+}
+
+// TodoSearchHit is a todo matching a search, with its relevance score and highlighted snippets.
+// Hits with a higher Score are more relevant; scores are only comparable within one search.
+type TodoSearchHit struct {
+	Todo     Todo
+	Score    float64
+	Snippets []TodoSearchSnippet
+}
@@ -0,0 +1,135 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewSearchTodosInput tests
+func TestNewSearchTodosInput_shouldSplitQueryIntoDistinctTerms_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// when
+	input, err := domain.NewSearchTodosInput(1, "  invoice  請求書 invoice\tsend ", 20)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"invoice", "請求書", "send"}, input.Terms)
+	assert.Equal(t, 20, input.Limit)
+}
+
+func TestNewSearchTodosInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		userID int
+		query  string
+		limit  int
+	}{
+		{
+			name:   "UserID is zero",
+			userID: 0,
+			query:  "invoice",
+			limit:  20,
+		},
+		{
+			name:   "query is empty",
+			userID: 1,
+			query:  "",
+			limit:  20,
+		},
+		{
+			name:   "query is only whitespace",
+			userID: 1,
+			query:  " \t ",
+			limit:  20,
+		},
+		{
+			name:   "query has too many terms",
+			userID: 1,
+			query:  "a b c d e f g h i j k",
+			limit:  20,
+		},
+		{
+			name:   "query is too long",
+			userID: 1,
+			query:  strings.Repeat("a", 256),
+			limit:  20,
+		},
+		{
+			name:   "limit is zero",
+			userID: 1,
+			query:  "invoice",
+			limit:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewSearchTodosInput(tt.userID, tt.query, tt.limit)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, input, "expected nil SearchTodosInput")
+			assert.Contains(t, err.Error(), "validate search todos input", "error should mention validation")
+		})
+	}
+}
+
+// NewTodoSearchSnippet tests
+func TestNewTodoSearchSnippet_shouldHighlightEveryOccurrenceOfEveryTerm(t *testing.T) {
+	t.Parallel()
+
+	// when
+	snippet := domain.NewTodoSearchSnippet(domain.TodoSearchFieldText, "Send Invoice and invoice copy", []string{"invoice", "copy"})
+
+	// then
+	require.NotNil(t, snippet)
+	assert.Equal(t, domain.TodoSearchFieldText, snippet.Field)
+	assert.Equal(t, []domain.SnippetFragment{
+		{Text: "Send ", Highlighted: false},
+		{Text: "Invoice", Highlighted: true},
+		{Text: " and ", Highlighted: false},
+		{Text: "invoice", Highlighted: true},
+		{Text: " ", Highlighted: false},
+		{Text: "copy", Highlighted: true},
+	}, snippet.Fragments)
+}
+
+func TestNewTodoSearchSnippet_shouldTrimTextAroundFirstMatch_whenTextIsLong(t *testing.T) {
+	t.Parallel()
+
+	// given
+	text := strings.Repeat("あ", 100) + "請求書" + strings.Repeat("い", 100)
+
+	// when
+	snippet := domain.NewTodoSearchSnippet(domain.TodoSearchFieldComment, text, []string{"請求書"})
+
+	// then
+	require.NotNil(t, snippet)
+	assert.Equal(t, []domain.SnippetFragment{
+		{Text: "…", Highlighted: false},
+		{Text: strings.Repeat("あ", 40), Highlighted: false},
+		{Text: "請求書", Highlighted: true},
+		{Text: strings.Repeat("い", 77), Highlighted: false},
+		{Text: "…", Highlighted: false},
+	}, snippet.Fragments)
+}
+
+func TestNewTodoSearchSnippet_shouldReturnNil_whenNoTermOccurs(t *testing.T) {
+	t.Parallel()
+
+	// when
+	snippet := domain.NewTodoSearchSnippet(domain.TodoSearchFieldText, "Buy milk", []string{"invoice"})
+
+	// then
+	assert.Nil(t, snippet)
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrInvalidSyncToken is returned when a sync token cannot be decoded.
+	ErrInvalidSyncToken = errors.New("invalid sync token")
+	// ErrSyncTokenExpired is returned when a sync token is older than the trash retention, so that todos deleted
+	// since it was issued may have been purged without a trace; the client has to sync from scratch.
+	ErrSyncTokenExpired = errors.New("sync token expired")
+)
+
+const (
+	// DefaultSyncLimit is the number of changed todos returned per sync when the client does not specify a limit.
+	DefaultSyncLimit = 100
+	// MaxSyncLimit is the largest number of changed todos a client may request per sync.
+	MaxSyncLimit = 500
+)
+
+// SyncToken marks how far a client has synced the todos of its user.
+// Every write to the todos of a user takes the next number of the user's change sequence, and the writes commit
+// in the order of their numbers. Seq and AfterID are the change sequence number and the ID of the last todo
+// the client received; todos a single write changed share a number, so a sync can stop in the middle of them.
+// IssuedAt tells when the token was handed out, for expiry.
+type SyncToken struct {
+	Seq      int64     `json:"s" validate:"gte=0"`
+	AfterID  int       `json:"id" validate:"gte=0"`
+	IssuedAt time.Time `json:"t" validate:"required"`
+}
+
+// NewSyncToken creates a validated SyncToken. Returns an error if validation fails.
+func NewSyncToken(seq int64, afterID int, issuedAt time.Time) (*SyncToken, error) {
+	m := &SyncToken{
+		Seq:      seq,
+		AfterID:  afterID,
+		IssuedAt: issuedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate sync token: %w", err)
+	}
+	return m, nil
+}
+
+// Precedes reports whether the token was issued before the change with sequence number seq to the todo with the ID.
+func (t *SyncToken) Precedes(seq int64, id int) bool {
+	return seq > t.Seq || (seq == t.Seq && id > t.AfterID)
+}
+
+// Encode returns the opaque string representation of the token handed to clients.
+func (t *SyncToken) Encode() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal sync token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeSyncToken parses a token produced by SyncToken.Encode. Returns ErrInvalidSyncToken if it is malformed.
+func DecodeSyncToken(s string) (*SyncToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode sync token: %w", ErrInvalidSyncToken)
+	}
+	var token SyncToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, fmt.Errorf("unmarshal sync token: %w", ErrInvalidSyncToken)
+	}
+	if err := ValidateStruct(&token); err != nil {
+		return nil, fmt.Errorf("validate sync token: %w", ErrInvalidSyncToken)
+	}
+	return &token, nil
+}
+
+// SyncTodosInput holds the parameters required to fetch the changes to the todos of a user since a sync token.
+// Since is nil for the first sync, which returns every todo that is not in the trash.
+type SyncTodosInput struct {
+	UserID int `validate:"required,gt=0"`
+	Since  *SyncToken
+	Limit  int `validate:"gte=1,lte=500"`
+}
+
+// NewSyncTodosInput creates a validated SyncTodosInput. Returns an error if validation fails.
+func NewSyncTodosInput(userID int, since *SyncToken, limit int) (*SyncTodosInput, error) {
+	m := &SyncTodosInput{
+		UserID: userID,
+		Since:  since,
+		Limit:  limit,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate sync todos input: %w", err)
+	}
+	return m, nil
+}
+
+// TodoChange is a todo as its last write left it, including a todo in the trash.
+// Seq is the change sequence number of that write and CreatedSeq the one of the write that created the todo.
+type TodoChange struct {
+	Todo       Todo
+	Seq        int64
+	CreatedSeq int64
+}
+
+// TodoChangePage holds changed todos in the order of their change sequence numbers and IDs.
+// HasMore is set when more changed todos follow them.
+type TodoChangePage struct {
+	Changes []TodoChange `validate:"max=500"`
+	HasMore bool
+}
+
+// TodoTombstone reports a todo that was moved to the trash.
+type TodoTombstone struct {
+	ID        int `validate:"required,gt=0"`
+	DeletedAt time.Time
+}
+
+// SyncTodosOutput holds the changes to the todos of a user since a sync token.
+// Created holds the todos created since the token and Updated the other changed todos, archived ones included;
+// a todo moved to the trash is reported in Deleted only. Token is the token to send with the next sync,
+// which should follow at once when HasMore is set.
+type SyncTodosOutput struct {
+	Created []Todo
+	Updated []Todo
+	Deleted []TodoTombstone
+	Token   *SyncToken `validate:"required"`
+	HasMore bool
+}
@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+func TestSyncToken_shouldRoundTrip_whenEncodedAndDecoded(t *testing.T) {
+	t.Parallel()
+
+	// given
+	token, err := domain.NewSyncToken(12, 34, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+
+	// when
+	encoded, err := token.Encode()
+	require.NoError(t, err)
+	decoded, err := domain.DecodeSyncToken(encoded)
+
+	// then
+	require.NoError(t, err, "expected no error for an encoded sync token")
+	assert.Equal(t, token, decoded)
+}
+
+func TestDecodeSyncToken_shouldReturnErrInvalidSyncToken_whenMalformed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "not base64",
+			token: "!!!",
+		},
+		{
+			name:  "not JSON",
+			token: "bm90LWpzb24",
+		},
+		{
+			name:  "missing issue time",
+			token: "eyJzIjoxLCJpZCI6Mn0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			token, err := domain.DecodeSyncToken(tt.token)
+
+			// then
+			require.ErrorIs(t, err, domain.ErrInvalidSyncToken)
+			assert.Nil(t, token)
+		})
+	}
+}
+
+func TestSyncToken_Precedes_shouldCompareSequenceNumberThenID(t *testing.T) {
+	t.Parallel()
+
+	// given
+	token, err := domain.NewSyncToken(5, 10, time.Now())
+	require.NoError(t, err)
+
+	// then
+	assert.True(t, token.Precedes(6, 1), "a later change should follow the token")
+	assert.True(t, token.Precedes(5, 11), "a todo after the last one received in the same change should follow the token")
+	assert.False(t, token.Precedes(5, 10), "the last todo received should not follow the token")
+	assert.False(t, token.Precedes(4, 99), "an earlier change should not follow the token")
+}
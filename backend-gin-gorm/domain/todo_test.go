@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -176,7 +177,7 @@ func TestNewUpdateTodoInput_shouldReturnInput_whenValidInput(t *testing.T) {
 	t.Parallel()
 
 	// when
-	input, err := domain.NewUpdateTodoInput(1, 2, "Updated todo", true)
+	input, err := domain.NewUpdateTodoInput(1, 2, "Updated todo", true, nil)
 
 	// then
 	require.NoError(t, err, "expected no error for valid UpdateTodoInput")
@@ -185,6 +186,19 @@ func TestNewUpdateTodoInput_shouldReturnInput_whenValidInput(t *testing.T) {
 	assert.Equal(t, 2, input.UserID, "expected UserID to match")
 	assert.Equal(t, "Updated todo", input.Text, "expected Text to match")
 	assert.True(t, input.IsComplete, "expected IsComplete to match")
+	assert.Nil(t, input.ExpectedVersion, "expected an unconditional update")
+}
+
+func TestNewUpdateTodoInput_shouldReturnError_whenExpectedVersionIsNegative(t *testing.T) {
+	t.Parallel()
+
+	// when
+	version := -1
+	input, err := domain.NewUpdateTodoInput(1, 2, "Updated todo", true, &version)
+
+	// then
+	require.Error(t, err, "expected error for negative ExpectedVersion")
+	assert.Nil(t, input, "expected nil UpdateTodoInput")
 }
 
 func TestNewUpdateTodoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
@@ -220,7 +234,7 @@ func TestNewUpdateTodoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			// when
-			input, err := domain.NewUpdateTodoInput(tt.id, tt.userID, tt.text, true)
+			input, err := domain.NewUpdateTodoInput(tt.id, tt.userID, tt.text, true, nil)
 
 			// then
 			require.Error(t, err, "expected error for invalid input")
@@ -230,6 +244,74 @@ func TestNewUpdateTodoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
 	}
 }
 
+// NewPatchTodoInput tests
+func TestNewPatchTodoInput_shouldReturnInput_whenFieldsAreOmitted(t *testing.T) {
+	t.Parallel()
+
+	// given
+	isComplete := true
+
+	// when
+	input, err := domain.NewPatchTodoInput(1, 2, nil, &isComplete, nil)
+
+	// then
+	require.NoError(t, err, "expected no error for valid PatchTodoInput")
+	assert.Nil(t, input.Text, "expected Text to be left untouched")
+	require.NotNil(t, input.IsComplete)
+	assert.True(t, *input.IsComplete, "expected IsComplete to match")
+}
+
+func TestNewPatchTodoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	empty := ""
+	tooLong := strings.Repeat("a", 256)
+	tests := []struct {
+		name   string
+		id     int
+		userID int
+		text   *string
+	}{
+		{
+			name:   "ID is zero",
+			id:     0,
+			userID: 1,
+			text:   nil,
+		},
+		{
+			name:   "UserID is zero",
+			id:     1,
+			userID: 0,
+			text:   nil,
+		},
+		{
+			name:   "text is empty",
+			id:     1,
+			userID: 2,
+			text:   &empty,
+		},
+		{
+			name:   "text is too long",
+			id:     1,
+			userID: 2,
+			text:   &tooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewPatchTodoInput(tt.id, tt.userID, tt.text, nil, nil)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, input, "expected nil PatchTodoInput")
+			assert.Contains(t, err.Error(), "validate patch todo input", "error should mention validation")
+		})
+	}
+}
+
 // NewUpdateTodoOutput tests
 func TestNewUpdateTodoOutput_shouldReturnOutput_whenValidInput(t *testing.T) {
 	t.Parallel()
@@ -265,7 +347,7 @@ func TestNewDeleteTodoInput_shouldReturnInput_whenValidInput(t *testing.T) {
 	t.Parallel()
 
 	// when
-	input, err := domain.NewDeleteTodoInput(1, 2)
+	input, err := domain.NewDeleteTodoInput(1, 2, nil)
 
 	// then
 	require.NoError(t, err, "expected no error for valid DeleteTodoInput")
@@ -308,7 +390,7 @@ func TestNewDeleteTodoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			// when
-			input, err := domain.NewDeleteTodoInput(tt.id, tt.userID)
+			input, err := domain.NewDeleteTodoInput(tt.id, tt.userID, nil)
 
 			// then
 			require.Error(t, err, "expected error for invalid input")
@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrTodoUndoNotFound is returned when an undo token is unknown, expired, already used or belongs to another user.
+	ErrTodoUndoNotFound = errors.New("todo undo not found")
+	// ErrTodoUndoConflict is returned when a todo changed after the write an undo token reverses.
+	ErrTodoUndoConflict = errors.New("todo changed since the write to undo")
+)
+
+// TodoUndoAction is the kind of write an undo token reverses.
+type TodoUndoAction string
+
+const (
+	// TodoUndoUpdate reverses an update of the text or completion of todos.
+	TodoUndoUpdate TodoUndoAction = "update"
+	// TodoUndoDelete reverses a move of todos to the trash.
+	TodoUndoDelete TodoUndoAction = "delete"
+)
+
+// TodoUndoTarget names a todo changed by a write that can be undone.
+// Version is the version the write left the todo at, if known; the todo is then only undoable while still at it.
+type TodoUndoTarget struct {
+	TodoID  int  `validate:"required,gt=0"`
+	Version *int `validate:"omitempty,gte=0"`
+}
+
+// CreateTodoUndoInput holds the parameters required to issue an undo token for a write by the user to up to 100 todos.
+type CreateTodoUndoInput struct {
+	UserID  int              `validate:"required,gt=0"`
+	Action  TodoUndoAction   `validate:"required,oneof=update delete"`
+	Targets []TodoUndoTarget `validate:"required,min=1,max=100,dive"`
+}
+
+// NewCreateTodoUndoInput creates a validated CreateTodoUndoInput. Returns an error if validation fails.
+func NewCreateTodoUndoInput(userID int, action TodoUndoAction, targets []TodoUndoTarget) (*CreateTodoUndoInput, error) {
+	m := &CreateTodoUndoInput{
+		UserID:  userID,
+		Action:  action,
+		Targets: targets,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create todo undo input: %w", err)
+	}
+	return m, nil
+}
+
+// RecordTodoUndoInput holds the parameters required to record the undo of a write under a token until ExpiresAt.
+type RecordTodoUndoInput struct {
+	UserID    int              `validate:"required,gt=0"`
+	Action    TodoUndoAction   `validate:"required,oneof=update delete"`
+	Targets   []TodoUndoTarget `validate:"required,min=1,max=100,dive"`
+	Token     string           `validate:"required,len=32,hexadecimal"`
+	ExpiresAt time.Time        `validate:"required"`
+}
+
+// NewRecordTodoUndoInput creates a validated RecordTodoUndoInput. Returns an error if validation fails.
+func NewRecordTodoUndoInput(request *CreateTodoUndoInput, token string, expiresAt time.Time) (*RecordTodoUndoInput, error) {
+	m := &RecordTodoUndoInput{
+		UserID:    request.UserID,
+		Action:    request.Action,
+		Targets:   request.Targets,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate record todo undo input: %w", err)
+	}
+	return m, nil
+}
+
+// TodoUndo is an issued undo token and the time until which it can be used.
+type TodoUndo struct {
+	Token     string    `validate:"required,len=32,hexadecimal"`
+	ExpiresAt time.Time `validate:"required"`
+}
+
+// NewTodoUndo creates a validated TodoUndo. Returns an error if validation fails.
+func NewTodoUndo(token string, expiresAt time.Time) (*TodoUndo, error) {
+	m := &TodoUndo{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate todo undo model: %w", err)
+	}
+	return m, nil
+}
+
+// GenerateTodoUndoToken returns a new random undo token.
+func GenerateTodoUndoToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// UndoTodosInput holds the parameters required to reverse the write an undo token of the user was issued for.
+type UndoTodosInput struct {
+	UserID int    `validate:"required,gt=0"`
+	Token  string `validate:"required,len=32,hexadecimal"`
+}
+
+// NewUndoTodosInput creates a validated UndoTodosInput. Returns an error if validation fails.
+func NewUndoTodosInput(userID int, token string) (*UndoTodosInput, error) {
+	m := &UndoTodosInput{
+		UserID: userID,
+		Token:  token,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate undo todos input: %w", err)
+	}
+	return m, nil
+}
+
+// ApplyTodoUndoInput holds the parameters required to apply an undo token that has not expired as of Now.
+type ApplyTodoUndoInput struct {
+	UserID int       `validate:"required,gt=0"`
+	Token  string    `validate:"required,len=32,hexadecimal"`
+	Now    time.Time `validate:"required"`
+}
+
+// NewApplyTodoUndoInput creates a validated ApplyTodoUndoInput. Returns an error if validation fails.
+func NewApplyTodoUndoInput(request *UndoTodosInput, now time.Time) (*ApplyTodoUndoInput, error) {
+	m := &ApplyTodoUndoInput{
+		UserID: request.UserID,
+		Token:  request.Token,
+		Now:    now,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate apply todo undo input: %w", err)
+	}
+	return m, nil
+}
+
+// UndoTodosOutput holds the todos as an undo left them.
+type UndoTodosOutput struct {
+	Todos []Todo `validate:"required,min=1,dive"`
+}
+
+// NewUndoTodosOutput creates a validated UndoTodosOutput. Returns an error if validation fails.
+func NewUndoTodosOutput(todos []Todo) (*UndoTodosOutput, error) {
+	m := &UndoTodosOutput{
+		Todos: todos,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate undo todos output: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeTodoUndosInput holds the parameters required to delete undo tokens that expired before a given time.
+type PurgeTodoUndosInput struct {
+	ExpiredBefore time.Time `validate:"required"`
+	BatchSize     int       `validate:"gte=1,lte=1000"`
+}
+
+// NewPurgeTodoUndosInput creates a validated PurgeTodoUndosInput. Returns an error if validation fails.
+func NewPurgeTodoUndosInput(expiredBefore time.Time, batchSize int) (*PurgeTodoUndosInput, error) {
+	m := &PurgeTodoUndosInput{
+		ExpiredBefore: expiredBefore,
+		BatchSize:     batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge todo undos input: %w", err)
+	}
+	return m, nil
+}
+
+// PurgeTodoUndosOutput holds the number of undo records deleted by a purge.
+type PurgeTodoUndosOutput struct {
+	PurgedCount int `validate:"gte=0"`
+}
+
+// NewPurgeTodoUndosOutput creates a validated PurgeTodoUndosOutput. Returns an error if validation fails.
+func NewPurgeTodoUndosOutput(purgedCount int) (*PurgeTodoUndosOutput, error) {
+	m := &PurgeTodoUndosOutput{
+		PurgedCount: purgedCount,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate purge todo undos output: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// GenerateTodoUndoToken tests
+func TestGenerateTodoUndoToken_shouldReturnUniqueValidTokens(t *testing.T) {
+	t.Parallel()
+
+	// when
+	first, err := domain.GenerateTodoUndoToken()
+	require.NoError(t, err)
+	second, err := domain.GenerateTodoUndoToken()
+	require.NoError(t, err)
+
+	// then
+	assert.NotEqual(t, first, second)
+	_, err = domain.NewTodoUndo(first, time.Now())
+	require.NoError(t, err, "a generated token should be a valid undo token")
+}
+
+// NewCreateTodoUndoInput tests
+func TestNewCreateTodoUndoInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		action  domain.TodoUndoAction
+		targets []domain.TodoUndoTarget
+	}{
+		{
+			name:    "no targets",
+			action:  domain.TodoUndoDelete,
+			targets: []domain.TodoUndoTarget{},
+		},
+		{
+			name:    "action is unknown",
+			action:  "archive",
+			targets: []domain.TodoUndoTarget{{TodoID: 1}},
+		},
+		{
+			name:    "todo ID is zero",
+			action:  domain.TodoUndoUpdate,
+			targets: []domain.TodoUndoTarget{{TodoID: 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.NewCreateTodoUndoInput(1, tt.action, tt.targets)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+// NewUndoTodosInput tests
+func TestNewUndoTodosInput_shouldReturnError_whenTokenMalformed(t *testing.T) {
+	t.Parallel()
+
+	// when
+	_, err := domain.NewUndoTodosInput(1, "not-a-token")
+
+	// then
+	require.Error(t, err)
+}
@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxViewsPerUser is the maximum number of views a user can save.
+const MaxViewsPerUser = 50
+
+var (
+	// ErrViewNotFound is returned when a requested view does not exist for the user.
+	ErrViewNotFound = errors.New("view not found")
+	// ErrDuplicateViewName is returned when the user already has a view with the same name.
+	ErrDuplicateViewName = errors.New("duplicate view name")
+	// ErrViewLimitExceeded is returned when the user already has MaxViewsPerUser views.
+	ErrViewLimitExceeded = errors.New("view limit exceeded")
+)
+
+// View is a named, saved todo filter of a user, shown as a smart list.
+// Filter holds the filter query text rather than its translation so that relative ages such as created<7d
+// are measured from the time the view is used.
+type View struct {
+	ID        int      `validate:"required,gt=0"`
+	UserID    int      `validate:"required,gt=0"`
+	Name      string   `validate:"required,max=100"`
+	Filter    string   `validate:"max=500"`
+	Sort      TodoSort `validate:"required"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewView creates a validated View. Returns an error if validation fails.
+func NewView(id int, userID int, name string, filter string, sort TodoSort, createdAt, updatedAt time.Time) (*View, error) {
+	m := &View{
+		ID:        id,
+		UserID:    userID,
+		Name:      name,
+		Filter:    filter,
+		Sort:      sort,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate view model: %w", err)
+	}
+	return m, nil
+}
+
+// TodoFilter translates the filter query of the view as of now.
+// Returns a *FilterQueryError if the query is no longer supported.
+func (v *View) TodoFilter(now time.Time) (*TodoFilter, error) {
+	return ParseTodoFilterQuery(v.Filter, now)
+}
+
+// ViewWithCount is a view with the number of unarchived todos it currently matches.
+type ViewWithCount struct {
+	View      View
+	TodoCount int
+}
+
+// validateViewFilter checks that a filter query can be used by a view.
+// Returns a *FilterQueryError if it cannot.
+func validateViewFilter(filter string) error {
+	if _, err := ParseTodoFilterQuery(filter, time.Now()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateViewInput holds the parameters required to save a view.
+type CreateViewInput struct {
+	UserID int      `validate:"required,gt=0"`
+	Name   string   `validate:"required,max=100"`
+	Filter string   `validate:"max=500"`
+	Sort   TodoSort `validate:"required"`
+}
+
+// NewCreateViewInput creates a validated CreateViewInput.
+// Returns an error if validation fails and an error wrapping a *FilterQueryError if the filter query is invalid.
+func NewCreateViewInput(userID int, name string, filter string, sort TodoSort) (*CreateViewInput, error) {
+	m := &CreateViewInput{
+		UserID: userID,
+		Name:   name,
+		Filter: filter,
+		Sort:   sort,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create view input: %w", err)
+	}
+	if err := validateViewFilter(filter); err != nil {
+		return nil, fmt.Errorf("validate create view input: %w", err)
+	}
+	return m, nil
+}
+
+// CreateViewOutput holds the result of saving a view.
+type CreateViewOutput struct {
+	View *View `validate:"required"`
+}
+
+// NewCreateViewOutput creates a validated CreateViewOutput. Returns an error if validation fails.
+func NewCreateViewOutput(view *View) (*CreateViewOutput, error) {
+	m := &CreateViewOutput{
+		View: view,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create view output: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateViewInput holds the parameters required to replace the name, filter and sort of a view.
+type UpdateViewInput struct {
+	ID     int      `validate:"required,gt=0"`
+	UserID int      `validate:"required,gt=0"`
+	Name   string   `validate:"required,max=100"`
+	Filter string   `validate:"max=500"`
+	Sort   TodoSort `validate:"required"`
+}
+
+// NewUpdateViewInput creates a validated UpdateViewInput.
+// Returns an error if validation fails and an error wrapping a *FilterQueryError if the filter query is invalid.
+func NewUpdateViewInput(id int, userID int, name string, filter string, sort TodoSort) (*UpdateViewInput, error) {
+	m := &UpdateViewInput{
+		ID:     id,
+		UserID: userID,
+		Name:   name,
+		Filter: filter,
+		Sort:   sort,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate update view input: %w", err)
+	}
+	if err := validateViewFilter(filter); err != nil {
+		return nil, fmt.Errorf("validate update view input: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateViewOutput holds the result of a view update.
+type UpdateViewOutput struct {
+	View *View `validate:"required"`
+}
+
+// NewUpdateViewOutput creates a validated UpdateViewOutput. Returns an error if validation fails.
+func NewUpdateViewOutput(view *View) (*UpdateViewOutput, error) {
+	m := &UpdateViewOutput{
+		View: view,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate update view output: %w", err)
+	}
+	return m, nil
+}
+
+// DeleteViewInput holds the parameters required to delete a view.
+type DeleteViewInput struct {
+	ID     int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewDeleteViewInput creates a validated DeleteViewInput. Returns an error if validation fails.
+func NewDeleteViewInput(id int, userID int) (*DeleteViewInput, error) {
+	m := &DeleteViewInput{
+		ID:     id,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate delete view input: %w", err)
+	}
+	return m, nil
+}
+
+// FindViewTodosInput holds the parameters required to list a page of the todos a view matches.
+// Cursor is nil when the first page is requested; otherwise it must have been issued for the sort of the view.
+type FindViewTodosInput struct {
+	ID     int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+	Limit  int `validate:"gte=1,lte=100"`
+	Cursor *TodoCursor
+}
+
+// NewFindViewTodosInput creates a validated FindViewTodosInput. Returns an error if validation fails.
+func NewFindViewTodosInput(id int, userID int, limit int, cursor *TodoCursor) (*FindViewTodosInput, error) {
+	m := &FindViewTodosInput{
+		ID:     id,
+		UserID: userID,
+		Limit:  limit,
+		Cursor: cursor,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find view todos input: %w", err)
+	}
+	return m, nil
+}
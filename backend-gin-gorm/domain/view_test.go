@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewCreateViewInput tests
+func TestNewCreateViewInput_shouldReturnInput_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// when
+	input, err := domain.NewCreateViewInput(1, "This week", "is:open created<7d", domain.DefaultTodoSort)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "This week", input.Name)
+	assert.Equal(t, "is:open created<7d", input.Filter)
+}
+
+func TestNewCreateViewInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		userID     int
+		viewName   string
+		filter     string
+		sort       domain.TodoSort
+		isQueryErr bool
+	}{
+		{
+			name:     "UserID is zero",
+			userID:   0,
+			viewName: "Open",
+			filter:   "is:open",
+			sort:     domain.DefaultTodoSort,
+		},
+		{
+			name:     "name is empty",
+			userID:   1,
+			viewName: "",
+			filter:   "is:open",
+			sort:     domain.DefaultTodoSort,
+		},
+		{
+			name:     "name is too long",
+			userID:   1,
+			viewName: strings.Repeat("a", 101),
+			filter:   "is:open",
+			sort:     domain.DefaultTodoSort,
+		},
+		{
+			name:     "sort is invalid",
+			userID:   1,
+			viewName: "Open",
+			filter:   "is:open",
+			sort:     domain.TodoSort{Field: "priority", Direction: domain.SortDirectionAsc},
+		},
+		{
+			name:       "filter is malformed",
+			userID:     1,
+			viewName:   "Open",
+			filter:     `text:"open`,
+			sort:       domain.DefaultTodoSort,
+			isQueryErr: true,
+		},
+		{
+			name:       "filter uses an unknown field",
+			userID:     1,
+			viewName:   "Open",
+			filter:     "due<7d",
+			sort:       domain.DefaultTodoSort,
+			isQueryErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			input, err := domain.NewCreateViewInput(tt.userID, tt.viewName, tt.filter, tt.sort)
+
+			// then
+			require.Error(t, err, "expected error for invalid input")
+			assert.Nil(t, input, "expected nil CreateViewInput")
+			assert.Contains(t, err.Error(), "validate create view input", "error should mention validation")
+			if tt.isQueryErr {
+				var queryErr *domain.FilterQueryError
+				require.ErrorAs(t, err, &queryErr, "filter errors should be FilterQueryErrors")
+			}
+		})
+	}
+}
+
+// View.TodoFilter tests
+func TestView_TodoFilter_shouldMeasureAgesFromNow(t *testing.T) {
+	t.Parallel()
+
+	// given
+	view, err := domain.NewView(1, 1, "This week", "created<7d", domain.DefaultTodoSort, time.Now(), time.Now())
+	require.NoError(t, err)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	// when
+	filter, err := view.TodoFilter(now)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, filter.CreatedFrom)
+	assert.Equal(t, time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC), *filter.CreatedFrom)
+	assert.Nil(t, filter.CreatedTo)
+}
@@ -0,0 +1,451 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxWebhooksPerUser is the maximum number of webhooks a user can register.
+	MaxWebhooksPerUser = 10
+	// DefaultWebhookDeliveryLimit is the number of deliveries listed when the client does not specify a limit.
+	DefaultWebhookDeliveryLimit = 50
+	// MaxWebhookDeliveryLimit is the largest number of deliveries a client may list at once.
+	MaxWebhookDeliveryLimit = 100
+)
+
+var (
+	// ErrWebhookNotFound is returned when a requested webhook does not exist for the user.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrWebhookLimitExceeded is returned when the user already has MaxWebhooksPerUser webhooks.
+	ErrWebhookLimitExceeded = errors.New("webhook limit exceeded")
+	// ErrInvalidWebhookURL is returned when a webhook URL is not an absolute http or https URL or its host is not a public address.
+	ErrInvalidWebhookURL = errors.New("invalid webhook URL")
+)
+
+// Webhook is an endpoint a user registered to be notified of changes to their todos.
+// Events lists the todo event types it is notified of. Secret is the key its payloads are signed with.
+type Webhook struct {
+	ID        int             `validate:"required,gt=0"`
+	UserID    int             `validate:"required,gt=0"`
+	URL       string          `validate:"required,max=2048"`
+	Events    []TodoEventType `validate:"required,min=1,dive,oneof=created updated deleted"`
+	Secret    string          `validate:"required"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebhook creates a validated Webhook. Returns an error if validation fails.
+func NewWebhook(id int, userID int, rawURL string, events []TodoEventType, secret string, createdAt, updatedAt time.Time) (*Webhook, error) {
+	m := &Webhook{
+		ID:        id,
+		UserID:    userID,
+		URL:       rawURL,
+		Events:    events,
+		Secret:    secret,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate webhook model: %w", err)
+	}
+	return m, nil
+}
+
+// Subscribes reports whether the webhook is notified of events of the type.
+func (w *Webhook) Subscribes(eventType TodoEventType) bool {
+	return slices.Contains(w.Events, eventType)
+}
+
+// GenerateWebhookSecret returns a new random secret for signing the payloads of a webhook.
+func GenerateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// SignWebhookPayload returns the signature of a payload sent at the given time, in the form t=<unix seconds>,v1=<hex>.
+// The v1 value is the HMAC-SHA256, keyed with the secret, of the timestamp, a dot and the payload, so that receivers
+// can reject payloads that were tampered with or replayed long after they were sent.
+func SignWebhookPayload(secret string, timestamp time.Time, payload []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsPublicWebhookAddr reports whether webhook requests may be sent to the address.
+// Loopback, private, link-local and unspecified addresses are rejected so that a webhook cannot be used to reach
+// the server itself or the network it runs in.
+func IsPublicWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsUnspecified()
+}
+
+// validateWebhookURL checks that a webhook URL is an absolute http or https URL whose host is not localhost or
+// an IP address that IsPublicWebhookAddr rejects. Host names are resolved and checked again when the webhook is
+// created and each time a request is sent.
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrInvalidWebhookURL
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicWebhookAddr(addr) {
+		return ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// CreateWebhookInput holds the parameters required to register a webhook.
+type CreateWebhookInput struct {
+	UserID int             `validate:"required,gt=0"`
+	URL    string          `validate:"required,max=2048"`
+	Events []TodoEventType `validate:"required,min=1,max=3,unique,dive,oneof=created updated deleted"`
+}
+
+// NewCreateWebhookInput creates a validated CreateWebhookInput.
+// Returns an error if validation fails and an error wrapping ErrInvalidWebhookURL if the URL is not an http or https URL
+// or its host is not public.
+func NewCreateWebhookInput(userID int, rawURL string, events []TodoEventType) (*CreateWebhookInput, error) {
+	m := &CreateWebhookInput{
+		UserID: userID,
+		URL:    rawURL,
+		Events: events,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create webhook input: %w", err)
+	}
+	if err := validateWebhookURL(rawURL); err != nil {
+		return nil, fmt.Errorf("validate create webhook input: %w", err)
+	}
+	return m, nil
+}
+
+// CreateWebhookOutput holds the result of registering a webhook. The webhook carries its secret,
+// which is only handed out on creation.
+type CreateWebhookOutput struct {
+	Webhook *Webhook `validate:"required"`
+}
+
+// NewCreateWebhookOutput creates a validated CreateWebhookOutput. Returns an error if validation fails.
+func NewCreateWebhookOutput(webhook *Webhook) (*CreateWebhookOutput, error) {
+	m := &CreateWebhookOutput{
+		Webhook: webhook,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate create webhook output: %w", err)
+	}
+	return m, nil
+}
+
+// DeleteWebhookInput holds the parameters required to remove a webhook along with its deliveries.
+type DeleteWebhookInput struct {
+	ID     int `validate:"required,gt=0"`
+	UserID int `validate:"required,gt=0"`
+}
+
+// NewDeleteWebhookInput creates a validated DeleteWebhookInput. Returns an error if validation fails.
+func NewDeleteWebhookInput(id int, userID int) (*DeleteWebhookInput, error) {
+	m := &DeleteWebhookInput{
+		ID:     id,
+		UserID: userID,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate delete webhook input: %w", err)
+	}
+	return m, nil
+}
+
+// WebhookEvent is an outbox event to be delivered to the webhooks of its user.
+// ID identifies the event in every delivery of it so that receivers can drop duplicates.
+type WebhookEvent struct {
+	ID      string        `validate:"required,len=32,hexadecimal"`
+	UserID  int           `validate:"required,gt=0"`
+	Type    TodoEventType `validate:"required,oneof=created updated deleted"`
+	Payload []byte        `validate:"required"`
+}
+
+// NewWebhookEvent returns the webhook event for an outbox event. Its payload is sent to webhooks as is.
+func NewWebhookEvent(event *OutboxEvent) (*WebhookEvent, error) {
+	m := &WebhookEvent{
+		ID:      event.EventID,
+		UserID:  event.UserID,
+		Type:    event.Type,
+		Payload: event.Payload,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate webhook event: %w", err)
+	}
+	return m, nil
+}
+
+// WebhookDeliveryStatus is the state of the delivery of an event to a webhook.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is a delivery that has not succeeded yet and will be attempted at NextAttemptAt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded is a delivery the webhook acknowledged with a 2xx response.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryDead is a delivery that failed on every attempt the retry policy allows and is no longer attempted.
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery is the delivery of an event to a webhook and the outcome of its latest attempt.
+// LastStatusCode is nil until a response was received; LastError describes why the latest attempt failed.
+type WebhookDelivery struct {
+	ID             int                   `validate:"required,gt=0"`
+	WebhookID      int                   `validate:"required,gt=0"`
+	EventID        string                `validate:"required,len=32"`
+	EventType      TodoEventType         `validate:"required,oneof=created updated deleted"`
+	Payload        []byte                `validate:"required"`
+	Status         WebhookDeliveryStatus `validate:"required,oneof=pending succeeded dead"`
+	Attempts       int                   `validate:"gte=0"`
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      string `validate:"max=1000"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewWebhookDelivery creates a validated WebhookDelivery without the outcome of its latest attempt.
+// Returns an error if validation fails.
+func NewWebhookDelivery(id int, webhookID int, eventID string, eventType TodoEventType, payload []byte, status WebhookDeliveryStatus, attempts int, nextAttemptAt time.Time, createdAt, updatedAt time.Time) (*WebhookDelivery, error) {
+	m := &WebhookDelivery{
+		ID:             id,
+		WebhookID:      webhookID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         status,
+		Attempts:       attempts,
+		NextAttemptAt:  nextAttemptAt,
+		LastAttemptAt:  nil,
+		LastStatusCode: nil,
+		LastError:      "",
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate webhook delivery model: %w", err)
+	}
+	return m, nil
+}
+
+// FindWebhookDeliveriesInput holds the parameters for listing the most recent deliveries of a webhook.
+type FindWebhookDeliveriesInput struct {
+	WebhookID int `validate:"required,gt=0"`
+	UserID    int `validate:"required,gt=0"`
+	Limit     int `validate:"gte=1,lte=100"`
+}
+
+// NewFindWebhookDeliveriesInput creates a validated FindWebhookDeliveriesInput. Returns an error if validation fails.
+func NewFindWebhookDeliveriesInput(webhookID int, userID int, limit int) (*FindWebhookDeliveriesInput, error) {
+	m := &FindWebhookDeliveriesInput{
+		WebhookID: webhookID,
+		UserID:    userID,
+		Limit:     limit,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate find webhook deliveries input: %w", err)
+	}
+	return m, nil
+}
+
+// WebhookRetryPolicy decides when a failed delivery is attempted again.
+// The delay after the n-th failed attempt is BaseDelay doubled n-1 times, capped at MaxDelay.
+// A delivery that failed MaxAttempts times is dead.
+type WebhookRetryPolicy struct {
+	MaxAttempts int           `validate:"gte=1"`
+	BaseDelay   time.Duration `validate:"gt=0"`
+	MaxDelay    time.Duration `validate:"gtefield=BaseDelay"`
+}
+
+// NewWebhookRetryPolicy creates a validated WebhookRetryPolicy. Returns an error if validation fails.
+func NewWebhookRetryPolicy(maxAttempts int, baseDelay time.Duration, maxDelay time.Duration) (*WebhookRetryPolicy, error) {
+	m := &WebhookRetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   baseDelay,
+		MaxDelay:    maxDelay,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate webhook retry policy: %w", err)
+	}
+	return m, nil
+}
+
+// NextAttemptAt returns when to attempt a delivery again after its attempts-th attempt failed at failedAt,
+// or false if the delivery is dead.
+func (p *WebhookRetryPolicy) NextAttemptAt(attempts int, failedAt time.Time) (time.Time, bool) {
+	if attempts >= p.MaxAttempts {
+		return time.Time{}, false
+	}
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return failedAt.Add(min(delay, p.MaxDelay)), true
+}
+
+// ClaimWebhookDeliveriesInput holds the parameters for claiming pending deliveries that are due as of Now.
+// Claimed deliveries count an attempt and are not claimed again before LeaseUntil, so that a delivery whose
+// worker stopped before recording the outcome is attempted again afterwards.
+type ClaimWebhookDeliveriesInput struct {
+	Now        time.Time `validate:"required"`
+	LeaseUntil time.Time `validate:"required,gtfield=Now"`
+	BatchSize  int       `validate:"gte=1,lte=1000"`
+}
+
+// NewClaimWebhookDeliveriesInput creates a validated ClaimWebhookDeliveriesInput. Returns an error if validation fails.
+func NewClaimWebhookDeliveriesInput(now time.Time, leaseUntil time.Time, batchSize int) (*ClaimWebhookDeliveriesInput, error) {
+	m := &ClaimWebhookDeliveriesInput{
+		Now:        now,
+		LeaseUntil: leaseUntil,
+		BatchSize:  batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate claim webhook deliveries input: %w", err)
+	}
+	return m, nil
+}
+
+// ClaimedWebhookDelivery is a delivery claimed for an attempt, with the webhook to send it to.
+type ClaimedWebhookDelivery struct {
+	Delivery WebhookDelivery
+	Webhook  Webhook
+}
+
+// WebhookRequest is one attempt to deliver an event to a webhook.
+type WebhookRequest struct {
+	URL        string
+	WebhookID  int
+	DeliveryID int
+	EventID    string
+	EventType  TodoEventType
+	Signature  string
+	Payload    []byte
+}
+
+// NewWebhookRequest returns the request that attempts a claimed delivery, signed as of now.
+func NewWebhookRequest(claimed *ClaimedWebhookDelivery, now time.Time) *WebhookRequest {
+	return &WebhookRequest{
+		URL:        claimed.Webhook.URL,
+		WebhookID:  claimed.Webhook.ID,
+		DeliveryID: claimed.Delivery.ID,
+		EventID:    claimed.Delivery.EventID,
+		EventType:  claimed.Delivery.EventType,
+		Signature:  SignWebhookPayload(claimed.Webhook.Secret, now, claimed.Delivery.Payload),
+		Payload:    claimed.Delivery.Payload,
+	}
+}
+
+// RecordWebhookAttemptInput holds the outcome of an attempt to deliver an event.
+// NextAttemptAt is only used when Status is pending.
+type RecordWebhookAttemptInput struct {
+	DeliveryID    int                   `validate:"required,gt=0"`
+	Status        WebhookDeliveryStatus `validate:"required,oneof=pending succeeded dead"`
+	NextAttemptAt time.Time
+	StatusCode    *int
+	Error         string `validate:"max=1000"`
+}
+
+// maxWebhookErrorLength is the length at which the error of a failed attempt is cut off.
+const maxWebhookErrorLength = 1000
+
+// NewRecordWebhookAttemptInput decides the outcome of an attempt of a claimed delivery that ended at attemptedAt.
+// statusCode is the status of the response, or nil if none was received, in which case sendErr tells why.
+// The delivery succeeded on a 2xx response; otherwise the policy decides whether it is retried or dead.
+func NewRecordWebhookAttemptInput(delivery *WebhookDelivery, policy *WebhookRetryPolicy, attemptedAt time.Time, statusCode *int, sendErr error) (*RecordWebhookAttemptInput, error) {
+	m := &RecordWebhookAttemptInput{
+		DeliveryID:    delivery.ID,
+		Status:        WebhookDeliverySucceeded,
+		NextAttemptAt: time.Time{},
+		StatusCode:    statusCode,
+		Error:         "",
+	}
+	switch {
+	case sendErr != nil:
+		m.Error = sendErr.Error()
+	case statusCode == nil:
+		m.Error = "no response"
+	case *statusCode < 200 || *statusCode > 299:
+		m.Error = fmt.Sprintf("unexpected status code %d", *statusCode)
+	}
+	if m.Error != "" {
+		if len(m.Error) > maxWebhookErrorLength {
+			m.Error = strings.ToValidUTF8(m.Error[:maxWebhookErrorLength], "")
+		}
+		next, ok := policy.NextAttemptAt(delivery.Attempts, attemptedAt)
+		if ok {
+			m.Status = WebhookDeliveryPending
+			m.NextAttemptAt = next
+		} else {
+			m.Status = WebhookDeliveryDead
+		}
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate record webhook attempt input: %w", err)
+	}
+	return m, nil
+}
+
+// DeliverWebhooksInput holds the parameters for one run of the webhook delivery worker.
+// Deliveries are claimed in batches of BatchSize.
+type DeliverWebhooksInput struct {
+	BatchSize int `validate:"gte=1,lte=1000"`
+}
+
+// NewDeliverWebhooksInput creates a validated DeliverWebhooksInput. Returns an error if validation fails.
+func NewDeliverWebhooksInput(batchSize int) (*DeliverWebhooksInput, error) {
+	m := &DeliverWebhooksInput{
+		BatchSize: batchSize,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate deliver webhooks input: %w", err)
+	}
+	return m, nil
+}
+
+// DeliverWebhooksOutput counts the outcomes of the attempts of a run of the webhook delivery worker.
+type DeliverWebhooksOutput struct {
+	SucceededCount int `validate:"gte=0"`
+	RetryingCount  int `validate:"gte=0"`
+	DeadCount      int `validate:"gte=0"`
+}
+
+// NewDeliverWebhooksOutput creates a validated DeliverWebhooksOutput. Returns an error if validation fails.
+func NewDeliverWebhooksOutput(succeededCount int, retryingCount int, deadCount int) (*DeliverWebhooksOutput, error) {
+	m := &DeliverWebhooksOutput{
+		SucceededCount: succeededCount,
+		RetryingCount:  retryingCount,
+		DeadCount:      deadCount,
+	}
+	if err := ValidateStruct(m); err != nil {
+		return nil, fmt.Errorf("validate deliver webhooks output: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,365 @@
+package domain_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NewCreateWebhookInput tests
+func TestNewCreateWebhookInput_shouldReturnInput_whenValidInput(t *testing.T) {
+	t.Parallel()
+
+	// when
+	input, err := domain.NewCreateWebhookInput(1, "https://example.com/hooks/todo", []domain.TodoEventType{domain.TodoEventCreated, domain.TodoEventDeleted})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hooks/todo", input.URL)
+	assert.Equal(t, []domain.TodoEventType{domain.TodoEventCreated, domain.TodoEventDeleted}, input.Events)
+}
+
+func TestNewCreateWebhookInput_shouldReturnError_whenInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		userID   int
+		url      string
+		events   []domain.TodoEventType
+		isURLErr bool
+	}{
+		{
+			name:   "UserID is zero",
+			userID: 0,
+			url:    "https://example.com",
+			events: []domain.TodoEventType{domain.TodoEventCreated},
+		},
+		{
+			name:   "URL is too long",
+			userID: 1,
+			url:    "https://example.com/" + strings.Repeat("a", 2048),
+			events: []domain.TodoEventType{domain.TodoEventCreated},
+		},
+		{
+			name:   "events is empty",
+			userID: 1,
+			url:    "https://example.com",
+			events: []domain.TodoEventType{},
+		},
+		{
+			name:   "event is reset",
+			userID: 1,
+			url:    "https://example.com",
+			events: []domain.TodoEventType{domain.TodoEventReset},
+		},
+		{
+			name:   "event is repeated",
+			userID: 1,
+			url:    "https://example.com",
+			events: []domain.TodoEventType{domain.TodoEventCreated, domain.TodoEventCreated},
+		},
+		{
+			name:     "URL is not http",
+			userID:   1,
+			url:      "ftp://example.com",
+			events:   []domain.TodoEventType{domain.TodoEventCreated},
+			isURLErr: true,
+		},
+		{
+			name:     "URL is relative",
+			userID:   1,
+			url:      "/hooks/todo",
+			events:   []domain.TodoEventType{domain.TodoEventCreated},
+			isURLErr: true,
+		},
+		{
+			name:     "host is localhost",
+			userID:   1,
+			url:      "http://localhost:8080/hooks/todo",
+			events:   []domain.TodoEventType{domain.TodoEventCreated},
+			isURLErr: true,
+		},
+		{
+			name:     "host is a private address",
+			userID:   1,
+			url:      "http://10.0.0.5/hooks/todo",
+			events:   []domain.TodoEventType{domain.TodoEventCreated},
+			isURLErr: true,
+		},
+		{
+			name:     "host is the cloud metadata address",
+			userID:   1,
+			url:      "http://169.254.169.254/latest/meta-data",
+			events:   []domain.TodoEventType{domain.TodoEventCreated},
+			isURLErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := domain.NewCreateWebhookInput(tt.userID, tt.url, tt.events)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, tt.isURLErr, errors.Is(err, domain.ErrInvalidWebhookURL))
+		})
+	}
+}
+
+// IsPublicWebhookAddr tests
+func TestIsPublicWebhookAddr_shouldRejectAddressesOfInternalNetworks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "93.184.216.34", expected: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{addr: "127.0.0.1", expected: false},
+		{addr: "::1", expected: false},
+		{addr: "10.1.2.3", expected: false},
+		{addr: "172.16.0.1", expected: false},
+		{addr: "192.168.1.1", expected: false},
+		{addr: "fd00::1", expected: false},
+		{addr: "169.254.169.254", expected: false},
+		{addr: "fe80::1", expected: false},
+		{addr: "0.0.0.0", expected: false},
+		{addr: "::", expected: false},
+		{addr: "::ffff:127.0.0.1", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			public := domain.IsPublicWebhookAddr(netip.MustParseAddr(tt.addr))
+
+			// then
+			assert.Equal(t, tt.expected, public)
+		})
+	}
+}
+
+// Webhook tests
+func TestWebhook_Subscribes_shouldReportEventTypesOfWebhook(t *testing.T) {
+	t.Parallel()
+
+	// given
+	webhook := &domain.Webhook{Events: []domain.TodoEventType{domain.TodoEventUpdated}} //nolint:exhaustruct
+
+	// when, then
+	assert.True(t, webhook.Subscribes(domain.TodoEventUpdated))
+	assert.False(t, webhook.Subscribes(domain.TodoEventCreated))
+}
+
+// GenerateWebhookSecret tests
+func TestGenerateWebhookSecret_shouldReturnDifferentSecrets(t *testing.T) {
+	t.Parallel()
+
+	// when
+	secret1, err1 := domain.GenerateWebhookSecret()
+	secret2, err2 := domain.GenerateWebhookSecret()
+
+	// then
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.True(t, strings.HasPrefix(secret1, "whsec_"))
+	assert.Len(t, secret1, len("whsec_")+64)
+	assert.NotEqual(t, secret1, secret2)
+}
+
+// SignWebhookPayload tests
+func TestSignWebhookPayload_shouldSignTimestampAndPayload(t *testing.T) {
+	t.Parallel()
+
+	// given
+	payload := []byte(`{"id":"abc"}`)
+	timestamp := time.Unix(1735689600, 0)
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte(`1735689600.{"id":"abc"}`))
+
+	// when
+	signature := domain.SignWebhookPayload("whsec_test", timestamp, payload)
+
+	// then
+	assert.Equal(t, "t=1735689600,v1="+hex.EncodeToString(mac.Sum(nil)), signature)
+	assert.NotEqual(t, signature, domain.SignWebhookPayload("whsec_other", timestamp, payload))
+	assert.NotEqual(t, signature, domain.SignWebhookPayload("whsec_test", timestamp.Add(time.Second), payload))
+}
+
+// NewWebhookEvent tests
+func TestNewWebhookEvent_shouldKeepEventIDAndPayloadOfOutboxEvent(t *testing.T) {
+	t.Parallel()
+
+	// given
+	todoEvent := domain.NewTodoDeletedEvent(3, 7)
+	outboxEvent, err := domain.NewOutboxEvent(&todoEvent)
+	require.NoError(t, err)
+
+	// when
+	webhookEvent, err := domain.NewWebhookEvent(outboxEvent)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, outboxEvent.EventID, webhookEvent.ID)
+	assert.Equal(t, 3, webhookEvent.UserID)
+	assert.Equal(t, domain.TodoEventDeleted, webhookEvent.Type)
+	assert.Equal(t, outboxEvent.Payload, webhookEvent.Payload)
+}
+
+// WebhookRetryPolicy tests
+func TestWebhookRetryPolicy_NextAttemptAt_shouldBackOffExponentiallyUpToMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	// given
+	policy, err := domain.NewWebhookRetryPolicy(6, 10*time.Second, time.Minute)
+	require.NoError(t, err)
+	failedAt := time.Now()
+
+	tests := []struct {
+		attempts int
+		delay    time.Duration
+	}{
+		{attempts: 1, delay: 10 * time.Second},
+		{attempts: 2, delay: 20 * time.Second},
+		{attempts: 3, delay: 40 * time.Second},
+		{attempts: 4, delay: time.Minute},
+		{attempts: 5, delay: time.Minute},
+	}
+
+	for _, tt := range tests {
+		// when
+		next, ok := policy.NextAttemptAt(tt.attempts, failedAt)
+
+		// then
+		assert.True(t, ok, "attempts=%d", tt.attempts)
+		assert.Equal(t, failedAt.Add(tt.delay), next, "attempts=%d", tt.attempts)
+	}
+}
+
+func TestWebhookRetryPolicy_NextAttemptAt_shouldReturnFalse_whenMaxAttemptsReached(t *testing.T) {
+	t.Parallel()
+
+	// given
+	policy, err := domain.NewWebhookRetryPolicy(3, time.Second, time.Minute)
+	require.NoError(t, err)
+
+	// when
+	_, ok := policy.NextAttemptAt(3, time.Now())
+
+	// then
+	assert.False(t, ok)
+}
+
+func TestNewWebhookRetryPolicy_shouldReturnError_whenMaxDelayIsShorterThanBaseDelay(t *testing.T) {
+	t.Parallel()
+
+	// when
+	_, err := domain.NewWebhookRetryPolicy(3, time.Minute, time.Second)
+
+	// then
+	require.Error(t, err)
+}
+
+// NewRecordWebhookAttemptInput tests
+func TestNewRecordWebhookAttemptInput_shouldDecideOutcomeOfAttempt(t *testing.T) {
+	t.Parallel()
+
+	policy, err := domain.NewWebhookRetryPolicy(3, time.Second, time.Minute)
+	require.NoError(t, err)
+	attemptedAt := time.Now()
+	ok := 204
+	serverError := 500
+
+	tests := []struct {
+		name          string
+		attempts      int
+		statusCode    *int
+		sendErr       error
+		status        domain.WebhookDeliveryStatus
+		nextAttemptAt time.Time
+		errorMessage  string
+	}{
+		{
+			name:       "2xx response",
+			attempts:   1,
+			statusCode: &ok,
+			status:     domain.WebhookDeliverySucceeded,
+		},
+		{
+			name:          "5xx response with attempts left",
+			attempts:      2,
+			statusCode:    &serverError,
+			status:        domain.WebhookDeliveryPending,
+			nextAttemptAt: attemptedAt.Add(2 * time.Second),
+			errorMessage:  "unexpected status code 500",
+		},
+		{
+			name:          "no response with attempts left",
+			attempts:      1,
+			sendErr:       errors.New("connection refused"),
+			status:        domain.WebhookDeliveryPending,
+			nextAttemptAt: attemptedAt.Add(time.Second),
+			errorMessage:  "connection refused",
+		},
+		{
+			name:         "5xx response on last attempt",
+			attempts:     3,
+			statusCode:   &serverError,
+			status:       domain.WebhookDeliveryDead,
+			errorMessage: "unexpected status code 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			delivery := &domain.WebhookDelivery{ID: 5, Attempts: tt.attempts} //nolint:exhaustruct
+
+			// when
+			input, err := domain.NewRecordWebhookAttemptInput(delivery, policy, attemptedAt, tt.statusCode, tt.sendErr)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, 5, input.DeliveryID)
+			assert.Equal(t, tt.status, input.Status)
+			assert.Equal(t, tt.nextAttemptAt, input.NextAttemptAt)
+			assert.Equal(t, tt.statusCode, input.StatusCode)
+			assert.Equal(t, tt.errorMessage, input.Error)
+		})
+	}
+}
+
+func TestNewRecordWebhookAttemptInput_shouldTruncateError_whenErrorIsTooLong(t *testing.T) {
+	t.Parallel()
+
+	// given
+	policy, err := domain.NewWebhookRetryPolicy(3, time.Second, time.Minute)
+	require.NoError(t, err)
+	delivery := &domain.WebhookDelivery{ID: 5, Attempts: 1} //nolint:exhaustruct
+
+	// when
+	input, err := domain.NewRecordWebhookAttemptInput(delivery, policy, time.Now(), nil, errors.New(strings.Repeat("a", 2000)))
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, input.Error, 1000)
+}
@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// LocalBlobStoreConfig holds settings for storing blobs on the local filesystem.
+type LocalBlobStoreConfig struct {
+	Dir string `yaml:"dir" validate:"required"`
+}
+
+// S3BlobStoreConfig holds settings for an S3-compatible object store such as AWS S3 or MinIO.
+// TimeoutSec bounds each request including the transfer of its body.
+type S3BlobStoreConfig struct {
+	Endpoint        string `yaml:"endpoint" validate:"required,url"`
+	Region          string `yaml:"region" validate:"required"`
+	Bucket          string `yaml:"bucket" validate:"required"`
+	AccessKeyID     string `yaml:"accessKeyId" validate:"required"`
+	SecretAccessKey string `yaml:"secretAccessKey" validate:"required"`
+	TimeoutSec      int    `yaml:"timeoutSec" validate:"gte=1"`
+}
+
+// BlobStoreConfig selects and configures the blob store backing todo attachments.
+type BlobStoreConfig struct {
+	Type  string                `yaml:"type" validate:"oneof=local s3"`
+	Local *LocalBlobStoreConfig `yaml:"local"`
+	S3    *S3BlobStoreConfig    `yaml:"s3"`
+}
+
+// BlobStore abstracts an object store addressed by opaque keys.
+// Get and Delete return domain.ErrBlobNotFound when the key does not exist.
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// InitBlobStoreFunc is a function type that initializes a blob store for a specific type.
+type InitBlobStoreFunc func(context.Context, *BlobStoreConfig) (BlobStore, error)
+
+// InitBlobStore initializes the blob store selected by the configured type.
+func InitBlobStore(ctx context.Context, cfg *BlobStoreConfig) (BlobStore, error) {
+	initBlobStores := map[string]InitBlobStoreFunc{
+		"local": initLocalBlobStore,
+		"s3":    initS3BlobStore,
+	}
+
+	initBlobStoreFunc, ok := initBlobStores[cfg.Type]
+	if !ok {
+		return nil, fmt.Errorf("invalid blob store type: %s", cfg.Type)
+	}
+
+	store, err := initBlobStoreFunc(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("init blob store: %w", err)
+	}
+
+	return store, nil
+}
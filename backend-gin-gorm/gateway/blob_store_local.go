@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// LocalBlobStore stores blobs as files below a root directory.
+// Keys are slash-separated relative paths; os.Root rejects any key that would escape the directory.
+type LocalBlobStore struct {
+	root *os.Root
+}
+
+func initLocalBlobStore(_ context.Context, cfg *BlobStoreConfig) (BlobStore, error) {
+	if cfg.Local == nil {
+		return nil, errors.New("local blob store config is missing")
+	}
+	return NewLocalBlobStore(cfg.Local.Dir)
+}
+
+// NewLocalBlobStore returns a LocalBlobStore rooted at dir, creating the directory if needed.
+func NewLocalBlobStore(dir string) (*LocalBlobStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create blob directory: %w", err)
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open blob directory: %w", err)
+	}
+	return &LocalBlobStore{
+		root: root,
+	}, nil
+}
+
+// Put writes body to a temporary file and renames it into place, so readers never observe a partial blob.
+func (s *LocalBlobStore) Put(_ context.Context, key string, body io.Reader, size int64, _ string) error {
+	if dir := path.Dir(key); dir != "." {
+		if err := s.root.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("create blob directory: %w", err)
+		}
+	}
+
+	tmpKey := key + ".tmp"
+	f, err := s.root.OpenFile(tmpKey, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("create blob file: %w", err)
+	}
+	written, copyErr := io.Copy(f, body)
+	closeErr := f.Close()
+	if err := errors.Join(copyErr, closeErr); err != nil {
+		_ = s.root.Remove(tmpKey)
+		return fmt.Errorf("write blob file: %w", err)
+	}
+	if written != size {
+		_ = s.root.Remove(tmpKey)
+		return fmt.Errorf("write blob file: wrote %d bytes, expected %d", written, size)
+	}
+
+	if err := s.root.Rename(tmpKey, key); err != nil {
+		_ = s.root.Remove(tmpKey)
+		return fmt.Errorf("rename blob file: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the blob for reading.
+func (s *LocalBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	f, err := s.root.Open(key)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("open blob file: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes the blob.
+func (s *LocalBlobStore) Delete(_ context.Context, key string) error {
+	if err := s.root.Remove(key); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return domain.ErrBlobNotFound
+		}
+		return fmt.Errorf("remove blob file: %w", err)
+	}
+	return nil
+}
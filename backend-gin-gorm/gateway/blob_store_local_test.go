@@ -0,0 +1,87 @@
+package gateway_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func TestLocalBlobStore_shouldRoundTripBlob_whenPutThenGet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	store, err := gateway.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	content := []byte("hello attachment")
+
+	// when
+	err = store.Put(ctx, "todo/1/abc", bytes.NewReader(content), int64(len(content)), "text/plain")
+	require.NoError(t, err, "Put() should not return an error")
+	rc, err := store.Get(ctx, "todo/1/abc")
+	require.NoError(t, err, "Get() should not return an error")
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, content, got)
+}
+
+func TestLocalBlobStore_shouldReturnErrBlobNotFound_whenDeletedOrMissing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	store, err := gateway.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, "todo/1/abc", bytes.NewReader([]byte("x")), 1, "text/plain"))
+
+	// when
+	err = store.Delete(ctx, "todo/1/abc")
+
+	// then
+	require.NoError(t, err, "Delete() should not return an error")
+	_, err = store.Get(ctx, "todo/1/abc")
+	require.ErrorIs(t, err, domain.ErrBlobNotFound)
+	require.ErrorIs(t, store.Delete(ctx, "todo/1/abc"), domain.ErrBlobNotFound)
+}
+
+func TestLocalBlobStore_shouldReturnError_whenSizeDoesNotMatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	store, err := gateway.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	// when
+	err = store.Put(ctx, "short", bytes.NewReader([]byte("abc")), 10, "text/plain")
+
+	// then
+	require.Error(t, err)
+	_, err = store.Get(ctx, "short")
+	require.ErrorIs(t, err, domain.ErrBlobNotFound, "a failed Put() should not leave a blob behind")
+}
+
+func TestLocalBlobStore_shouldReturnError_whenKeyEscapesRoot(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	store, err := gateway.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	// when
+	err = store.Put(ctx, "../escape", bytes.NewReader([]byte("x")), 1, "text/plain")
+
+	// then
+	require.Error(t, err)
+}
@@ -0,0 +1,201 @@
+package gateway_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+// fakeS3 is an in-memory stand-in for an S3-compatible server using path-style URLs.
+type fakeS3 struct {
+	mu           sync.Mutex
+	objects      map[string][]byte
+	contentTypes map[string]string
+	authHeaders  []string
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	t.Helper()
+	f := &fakeS3{
+		objects:      map[string][]byte{},
+		contentTypes: map[string]string{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(server.Close)
+	return f, server
+}
+
+func (f *fakeS3) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.authHeaders = append(f.authHeaders, r.Header.Get("Authorization"))
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	key := r.URL.Path
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = body
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		body, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3BlobStore(t *testing.T, server *httptest.Server) *gateway.S3BlobStore {
+	t.Helper()
+	store, err := gateway.NewS3BlobStore(&gateway.S3BlobStoreConfig{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "attachments",
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+	}, server.Client())
+	require.NoError(t, err)
+	return store
+}
+
+func TestS3BlobStore_shouldRoundTripBlob_whenPutThenGet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	fake, server := newFakeS3(t)
+	store := newTestS3BlobStore(t, server)
+	content := []byte("%PDF-1.4 receipt")
+
+	// when
+	err := store.Put(ctx, "todo/1/abc", bytes.NewReader(content), int64(len(content)), "application/pdf")
+	require.NoError(t, err, "Put() should not return an error")
+	rc, err := store.Get(ctx, "todo/1/abc")
+	require.NoError(t, err, "Get() should not return an error")
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, content, got)
+	assert.Equal(t, "application/pdf", fake.contentTypes["/attachments/todo/1/abc"], "object should be stored under the bucket path")
+	assert.Contains(t, fake.authHeaders[0], "/us-east-1/s3/aws4_request", "request should be signed for the configured region")
+}
+
+func TestS3BlobStore_shouldReturnErrBlobNotFound_whenObjectIsMissing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	_, server := newFakeS3(t)
+	store := newTestS3BlobStore(t, server)
+
+	// when
+	_, err := store.Get(ctx, "missing")
+
+	// then
+	require.ErrorIs(t, err, domain.ErrBlobNotFound)
+}
+
+func TestS3BlobStore_shouldDeleteObject_whenObjectExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	_, server := newFakeS3(t)
+	store := newTestS3BlobStore(t, server)
+	require.NoError(t, store.Put(ctx, "todo/1/abc", bytes.NewReader([]byte("x")), 1, "text/plain"))
+
+	// when
+	err := store.Delete(ctx, "todo/1/abc")
+
+	// then
+	require.NoError(t, err, "Delete() should not return an error")
+	_, err = store.Get(ctx, "todo/1/abc")
+	require.ErrorIs(t, err, domain.ErrBlobNotFound)
+}
+
+func TestS3BlobStore_shouldReturnError_whenServerRejectsRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	_, server := newFakeS3(t)
+	store, err := gateway.NewS3BlobStore(&gateway.S3BlobStoreConfig{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "attachments",
+		AccessKeyID:     "wrong-key",
+		SecretAccessKey: "test-secret",
+	}, server.Client())
+	require.NoError(t, err)
+
+	// when
+	err = store.Put(ctx, "todo/1/abc", bytes.NewReader([]byte("x")), 1, "text/plain")
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected status 403")
+}
+
+func TestInitBlobStore_shouldReturnS3BlobStoreThatTimesOut_whenEndpointStalls(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+	store, err := gateway.InitBlobStore(ctx, &gateway.BlobStoreConfig{ //nolint:exhaustruct
+		Type: "s3",
+		S3: &gateway.S3BlobStoreConfig{
+			Endpoint:        server.URL,
+			Region:          "us-east-1",
+			Bucket:          "attachments",
+			AccessKeyID:     "test-key",
+			SecretAccessKey: "test-secret",
+			TimeoutSec:      1,
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	start := time.Now()
+	_, err = store.Get(ctx, "todo/1/abc")
+
+	// then
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second, "the request should be given up after the configured timeout")
+}
@@ -65,4 +65,5 @@ func InitDB(ctx context.Context, dbConfig *DBConfig, logConfig *LogConfig, appNa
 type DialectRDBMS interface {
 	Name() string
 	BoolDefaultValue() string
+	SupportsFullTextSearch() bool
 }
@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -16,6 +17,9 @@ import (
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 )
 
+// mysqlErrDuplicateEntry is the MySQL error number of ER_DUP_ENTRY.
+const mysqlErrDuplicateEntry = 1062
+
 // DialectMySQL implements DialectRDBMS for MySQL.
 type DialectMySQL struct {
 }
@@ -30,6 +34,11 @@ func (d *DialectMySQL) BoolDefaultValue() string {
 	return "0"
 }
 
+// SupportsFullTextSearch returns true as MySQL provides FULLTEXT indexes with the ngram parser.
+func (d *DialectMySQL) SupportsFullTextSearch() bool {
+	return true
+}
+
 // MySQLConfig holds MySQL connection parameters.
 type MySQLConfig struct {
 	Username string `yaml:"username" validate:"required"`
@@ -105,3 +114,9 @@ func OpenMySQL(cfg *MySQLConfig, logLevel slog.Level, appName string) (*gorm.DB,
 
 	return OpenMySQLWithDSN(c.FormatDSN(), logLevel, appName)
 }
+
+// isDuplicateKeyError reports whether err is a MySQL duplicate entry error on a unique key.
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,35 @@
+package gateway
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// LogEventPublisher publishes outbox events by writing them to the log.
+// It is meant for development and for following the events of a deployment without a message broker.
+type LogEventPublisher struct {
+	logger *slog.Logger
+}
+
+// NewLogEventPublisher returns a new LogEventPublisher.
+func NewLogEventPublisher() *LogEventPublisher {
+	return &LogEventPublisher{
+		logger: slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-LogEventPublisher")),
+	}
+}
+
+// PublishEvents writes each event to the log. It never fails.
+func (p *LogEventPublisher) PublishEvents(ctx context.Context, events []domain.OutboxEvent) error {
+	for _, event := range events {
+		p.logger.InfoContext(ctx, "todo event",
+			slog.String("eventId", event.EventID),
+			slog.String("type", string(event.Type)),
+			slog.Int("userId", event.UserID),
+			slog.Int("todoId", event.TodoID),
+			slog.String("payload", string(event.Payload)),
+		)
+	}
+	return nil
+}
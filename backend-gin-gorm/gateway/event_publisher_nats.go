@@ -0,0 +1,233 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// NATSConfig holds the settings for publishing outbox events to a NATS server.
+// URL is of the form nats://[user:password@]host[:port]. Events are published to SubjectPrefix followed by
+// a dot and the event type, for example "todo.events.created".
+type NATSConfig struct {
+	URL           string `yaml:"url" validate:"required,url"`
+	SubjectPrefix string `yaml:"subjectPrefix" validate:"required"`
+	TimeoutSec    int    `yaml:"timeoutSec" validate:"gte=1"`
+}
+
+// natsDefaultPort is the port of a NATS URL without one.
+const natsDefaultPort = "4222"
+
+// natsConnectOptions is the CONNECT message sent after the server greeted the client with INFO.
+// Verbose is off, so the server only answers with -ERR on a failure and with PONG to a PING.
+type natsConnectOptions struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Headers  bool   `json:"headers"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	Version  string `json:"version"`
+	Protocol int    `json:"protocol"`
+	User     string `json:"user,omitempty"`
+	Pass     string `json:"pass,omitempty"`
+}
+
+// natsServerInfo is the part of the INFO message of the server the publisher needs.
+type natsServerInfo struct {
+	Headers bool `json:"headers"`
+}
+
+// NATSEventPublisher publishes outbox events to a NATS server. It speaks the NATS client protocol itself rather
+// than depending on a client library, and only implements what publishing needs.
+// Each event is published with HPUB and a Nats-Msg-Id header holding its event ID, so that a JetStream stream
+// with duplicate detection stores an event published twice once. A batch is only reported as published after
+// the server answered a PING sent after it, which it does once it has processed the messages before it.
+// The connection is opened on first use and opened again after a failure.
+type NATSEventPublisher struct {
+	address       string
+	user          string
+	pass          string
+	subjectPrefix string
+	timeout       time.Duration
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewNATSEventPublisher returns a new NATSEventPublisher for the server at cfg.URL.
+// Returns an error if the URL is not a NATS URL.
+func NewNATSEventPublisher(cfg *NATSConfig) (*NATSEventPublisher, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse nats url: %w", err)
+	}
+	if u.Scheme != "nats" || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid nats url: %s", cfg.URL)
+	}
+	port := u.Port()
+	if port == "" {
+		port = natsDefaultPort
+	}
+	pass, _ := u.User.Password()
+
+	return &NATSEventPublisher{
+		address:       net.JoinHostPort(u.Hostname(), port),
+		user:          u.User.Username(),
+		pass:          pass,
+		subjectPrefix: cfg.SubjectPrefix,
+		timeout:       time.Duration(cfg.TimeoutSec) * time.Second,
+		mu:            sync.Mutex{},
+		conn:          nil,
+		reader:        nil,
+	}, nil
+}
+
+// PublishEvents publishes the events and waits until the server has processed them.
+// Returns an error if any of them may not have been published; the connection is then closed.
+func (p *NATSEventPublisher) PublishEvents(ctx context.Context, events []domain.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.publish(ctx, events); err != nil {
+		p.close()
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection to the server, if one is open.
+func (p *NATSEventPublisher) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.close()
+}
+
+func (p *NATSEventPublisher) publish(ctx context.Context, events []domain.OutboxEvent) error {
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(p.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := p.conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("set nats deadline: %w", err)
+	}
+
+	var b strings.Builder
+	for _, event := range events {
+		subject := p.subjectPrefix + "." + string(event.Type)
+		header := "NATS/1.0\r\nNats-Msg-Id: " + event.EventID + "\r\n\r\n"
+		fmt.Fprintf(&b, "HPUB %s %d %d\r\n%s%s\r\n", subject, len(header), len(header)+len(event.Payload), header, event.Payload)
+	}
+	b.WriteString("PING\r\n")
+	if _, err := p.conn.Write([]byte(b.String())); err != nil {
+		return fmt.Errorf("write nats messages: %w", err)
+	}
+
+	return p.waitForPong()
+}
+
+// connect opens a connection to the server and completes the handshake.
+func (p *NATSEventPublisher) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: p.timeout} //nolint:exhaustruct
+	conn, err := dialer.DialContext(ctx, "tcp", p.address)
+	if err != nil {
+		return fmt.Errorf("dial nats: %w", err)
+	}
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+
+	if err := conn.SetDeadline(time.Now().Add(p.timeout)); err != nil {
+		return fmt.Errorf("set nats deadline: %w", err)
+	}
+	line, err := p.readLine()
+	if err != nil {
+		return fmt.Errorf("read nats info: %w", err)
+	}
+	infoJSON, ok := strings.CutPrefix(line, "INFO ")
+	if !ok {
+		return fmt.Errorf("unexpected nats greeting: %q", line)
+	}
+	var info natsServerInfo
+	if err := json.Unmarshal([]byte(infoJSON), &info); err != nil {
+		return fmt.Errorf("unmarshal nats info: %w", err)
+	}
+	if !info.Headers {
+		return errors.New("nats server does not support headers")
+	}
+
+	options, err := json.Marshal(natsConnectOptions{
+		Verbose:  false,
+		Pedantic: false,
+		Headers:  true,
+		Name:     domain.AppName,
+		Lang:     "go",
+		Version:  "1.0.0",
+		Protocol: 1,
+		User:     p.user,
+		Pass:     p.pass,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal nats connect options: %w", err)
+	}
+	// The PING makes the server report a failed CONNECT, such as a wrong password, before anything is published
+	if _, err := conn.Write([]byte("CONNECT " + string(options) + "\r\nPING\r\n")); err != nil {
+		return fmt.Errorf("write nats connect: %w", err)
+	}
+	return p.waitForPong()
+}
+
+// waitForPong reads from the server until it answers the last PING, answering the PINGs of the server on the way.
+func (p *NATSEventPublisher) waitForPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return fmt.Errorf("read nats reply: %w", err)
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return fmt.Errorf("write nats pong: %w", err)
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+		// +OK and INFO updates need no answer
+	}
+}
+
+func (p *NATSEventPublisher) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (p *NATSEventPublisher) close() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+	}
+	p.conn = nil
+	p.reader = nil
+}
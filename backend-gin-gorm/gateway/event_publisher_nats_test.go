@@ -0,0 +1,195 @@
+package gateway_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+type natsMessage struct {
+	subject string
+	header  string
+	payload string
+}
+
+// fakeNATSServer speaks enough of the NATS server protocol to receive published messages.
+// publishReply, if set, is sent in answer to each HPUB.
+type fakeNATSServer struct {
+	listener     net.Listener
+	info         string
+	publishReply string
+
+	mu       sync.Mutex
+	connects []string
+	messages []natsMessage
+}
+
+func newFakeNATSServer(t *testing.T, info string, publishReply string) *fakeNATSServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeNATSServer{listener: listener, info: info, publishReply: publishReply} //nolint:exhaustruct
+	t.Cleanup(func() { _ = listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *fakeNATSServer) url() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *fakeNATSServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeNATSServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	if _, err := conn.Write([]byte("INFO " + s.info + "\r\n")); err != nil {
+		return
+	}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "CONNECT "):
+			s.mu.Lock()
+			s.connects = append(s.connects, strings.TrimPrefix(line, "CONNECT "))
+			s.mu.Unlock()
+		case line == "PING":
+			if _, err := conn.Write([]byte("PONG\r\n")); err != nil {
+				return
+			}
+		case strings.HasPrefix(line, "HPUB "):
+			fields := strings.Fields(line)
+			headerLen, _ := strconv.Atoi(fields[2])
+			totalLen, _ := strconv.Atoi(fields[3])
+			body := make([]byte, totalLen+2)
+			if _, err := io.ReadFull(reader, body); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, natsMessage{subject: fields[1], header: string(body[:headerLen]), payload: string(body[headerLen:totalLen])})
+			s.mu.Unlock()
+			if s.publishReply != "" {
+				if _, err := conn.Write([]byte(s.publishReply + "\r\n")); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *fakeNATSServer) received() ([]string, []natsMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.connects...), append([]natsMessage(nil), s.messages...)
+}
+
+func newTestOutboxEvent(t *testing.T, eventType domain.TodoEventType) domain.OutboxEvent {
+	t.Helper()
+	event := domain.NewTodoDeletedEvent(3, 7)
+	event.Type = eventType
+	outboxEvent, err := domain.NewOutboxEvent(&event)
+	require.NoError(t, err)
+	return *outboxEvent
+}
+
+func TestNATSEventPublisher_PublishEvents_shouldPublishEachEventWithMessageID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	server := newFakeNATSServer(t, `{"server_id":"test","headers":true}`, "")
+	publisher, err := gateway.NewNATSEventPublisher(&gateway.NATSConfig{URL: server.url(), SubjectPrefix: "todo.events", TimeoutSec: 1})
+	require.NoError(t, err)
+	defer publisher.Close()
+	events := []domain.OutboxEvent{newTestOutboxEvent(t, domain.TodoEventCreated), newTestOutboxEvent(t, domain.TodoEventDeleted)}
+
+	// when
+	err = publisher.PublishEvents(ctx, events)
+
+	// then
+	require.NoError(t, err)
+	connects, messages := server.received()
+	require.Len(t, connects, 1)
+	assert.Contains(t, connects[0], `"headers":true`)
+	assert.Contains(t, connects[0], `"verbose":false`)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "todo.events.created", messages[0].subject)
+	assert.Equal(t, "NATS/1.0\r\nNats-Msg-Id: "+events[0].EventID+"\r\n\r\n", messages[0].header)
+	assert.JSONEq(t, string(events[0].Payload), messages[0].payload)
+	assert.Equal(t, "todo.events.deleted", messages[1].subject)
+
+	// - the connection is reused for the next batch
+	require.NoError(t, publisher.PublishEvents(ctx, events[:1]))
+	connects, messages = server.received()
+	assert.Len(t, connects, 1)
+	assert.Len(t, messages, 3)
+}
+
+func TestNATSEventPublisher_PublishEvents_shouldReturnError_whenServerRejectsMessage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	server := newFakeNATSServer(t, `{"server_id":"test","headers":true}`, "-ERR 'Permissions Violation for Publish'")
+	publisher, err := gateway.NewNATSEventPublisher(&gateway.NATSConfig{URL: server.url(), SubjectPrefix: "todo.events", TimeoutSec: 1})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	// when
+	err = publisher.PublishEvents(ctx, []domain.OutboxEvent{newTestOutboxEvent(t, domain.TodoEventCreated)})
+
+	// then
+	require.ErrorContains(t, err, "Permissions Violation for Publish")
+}
+
+func TestNATSEventPublisher_PublishEvents_shouldReturnError_whenServerDoesNotSupportHeaders(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	server := newFakeNATSServer(t, `{"server_id":"test"}`, "")
+	publisher, err := gateway.NewNATSEventPublisher(&gateway.NATSConfig{URL: server.url(), SubjectPrefix: "todo.events", TimeoutSec: 1})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	// when
+	err = publisher.PublishEvents(ctx, []domain.OutboxEvent{newTestOutboxEvent(t, domain.TodoEventCreated)})
+
+	// then
+	require.Error(t, err)
+	_, messages := server.received()
+	assert.Empty(t, messages)
+}
+
+func TestNewNATSEventPublisher_shouldReturnError_whenURLIsNotNATS(t *testing.T) {
+	t.Parallel()
+
+	// when
+	_, err := gateway.NewNATSEventPublisher(&gateway.NATSConfig{URL: "http://localhost:4222", SubjectPrefix: "todo.events", TimeoutSec: 1})
+
+	// then
+	require.Error(t, err)
+}
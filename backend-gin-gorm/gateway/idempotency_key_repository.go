@@ -0,0 +1,172 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// idempotencyKeyStatusInFlight is the status code of a key whose request has not completed yet.
+const idempotencyKeyStatusInFlight = 0
+
+// IdempotencyKeyEntity is the GORM model for the "idempotency_key" table.
+// StatusCode is idempotencyKeyStatusInFlight until the response of the request is stored.
+type IdempotencyKeyEntity struct {
+	UserID         int    `gorm:"primaryKey;autoIncrement:false"`
+	IdempotencyKey string `gorm:"primaryKey;type:varchar(255)"`
+	Fingerprint    string `gorm:"type:char(64);not null"`
+	StatusCode     int    `gorm:"not null"`
+	ResponseHeader *string
+	ResponseBody   []byte
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	ExpiresAt      time.Time `gorm:"not null"`
+}
+
+func (e *IdempotencyKeyEntity) TableName() string {
+	return "idempotency_key"
+}
+
+func (e *IdempotencyKeyEntity) toIdempotentResponse() (*domain.IdempotentResponse, error) {
+	header := make(map[string]string)
+	if e.ResponseHeader != nil {
+		if err := json.Unmarshal([]byte(*e.ResponseHeader), &header); err != nil {
+			return nil, fmt.Errorf("unmarshal response header: %w", err)
+		}
+	}
+
+	return &domain.IdempotentResponse{
+		StatusCode: e.StatusCode,
+		Header:     header,
+		Body:       e.ResponseBody,
+	}, nil
+}
+
+// IdempotencyKeyRepository implements idempotency key persistence operations using GORM.
+type IdempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyKeyRepository returns a new IdempotencyKeyRepository backed by the given GORM DB.
+func NewIdempotencyKeyRepository(db *gorm.DB) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// ClaimIdempotencyKey marks an idempotency key of the user as in flight for a request and returns nil,
+// or returns the stored response if the key was already used for the same request.
+// An expired key is claimed as if it were new.
+// Returns ErrIdempotencyKeyReused if the key was used for a different request and ErrIdempotencyKeyInFlight
+// if the request it was first used for has not completed.
+func (r *IdempotencyKeyRepository) ClaimIdempotencyKey(ctx context.Context, input *domain.ClaimIdempotencyKeyInput) (*domain.IdempotentResponse, error) {
+	claimed := &IdempotencyKeyEntity{ //nolint:exhaustruct
+		UserID:         input.UserID,
+		IdempotencyKey: input.Key,
+		Fingerprint:    input.Fingerprint,
+		StatusCode:     idempotencyKeyStatusInFlight,
+		ExpiresAt:      input.ExpiresAt,
+	}
+
+	// Inserting first lets the primary key settle races between concurrent first uses of a key
+	result := r.db.WithContext(ctx).Create(claimed)
+	if result.Error == nil {
+		return nil, nil //nolint:nilnil
+	}
+	if !isDuplicateKeyError(result.Error) {
+		return nil, fmt.Errorf("create idempotency key: %w", result.Error)
+	}
+
+	var response *domain.IdempotentResponse
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entity IdempotencyKeyEntity
+		query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}) //nolint:exhaustruct
+		if result := query.Where("user_id = ? AND idempotency_key = ?", input.UserID, input.Key).First(&entity); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				// The key was released or purged since the insert failed; a retry can claim it
+				return domain.ErrIdempotencyKeyInFlight
+			}
+			return fmt.Errorf("find idempotency key: %w", result.Error)
+		}
+
+		switch {
+		case !entity.ExpiresAt.After(input.Now):
+			if result := tx.Model(&entity).Updates(map[string]any{
+				"fingerprint":     input.Fingerprint,
+				"status_code":     idempotencyKeyStatusInFlight,
+				"response_header": nil,
+				"response_body":   nil,
+				"expires_at":      input.ExpiresAt,
+			}); result.Error != nil {
+				return fmt.Errorf("reclaim expired idempotency key: %w", result.Error)
+			}
+			return nil
+		case entity.Fingerprint != input.Fingerprint:
+			return domain.ErrIdempotencyKeyReused
+		case entity.StatusCode == idempotencyKeyStatusInFlight:
+			return domain.ErrIdempotencyKeyInFlight
+		}
+
+		var err error
+		response, err = entity.toIdempotentResponse()
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claim idempotency key: %w", err)
+	}
+
+	return response, nil
+}
+
+// CompleteIdempotentRequest stores the response for an in-flight idempotency key of the user.
+// A key that is no longer in flight, because it expired and was claimed again, is left unchanged.
+func (r *IdempotencyKeyRepository) CompleteIdempotentRequest(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error {
+	header, err := json.Marshal(input.Response.Header)
+	if err != nil {
+		return fmt.Errorf("marshal response header: %w", err)
+	}
+
+	query := r.db.WithContext(ctx).Model(&IdempotencyKeyEntity{}) //nolint:exhaustruct
+	query = query.Where("user_id = ? AND idempotency_key = ? AND status_code = ?", input.UserID, input.Key, idempotencyKeyStatusInFlight)
+	if result := query.Updates(map[string]any{
+		"status_code":     input.Response.StatusCode,
+		"response_header": string(header),
+		"response_body":   input.Response.Body,
+	}); result.Error != nil {
+		return fmt.Errorf("complete idempotency key: %w", result.Error)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotentRequest deletes an in-flight idempotency key of the user so that the request can be sent again.
+func (r *IdempotencyKeyRepository) ReleaseIdempotentRequest(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND idempotency_key = ? AND status_code = ?", input.UserID, input.Key, idempotencyKeyStatusInFlight).
+		Delete(&IdempotencyKeyEntity{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return fmt.Errorf("release idempotency key: %w", result.Error)
+	}
+
+	return nil
+}
+
+// PurgeIdempotencyKeys deletes up to input.BatchSize idempotency keys of any user that expired before input.ExpiredBefore
+// and returns how many were deleted.
+func (r *IdempotencyKeyRepository) PurgeIdempotencyKeys(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (int, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", input.ExpiredBefore).
+		Limit(input.BatchSize).
+		Delete(&IdempotencyKeyEntity{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return 0, fmt.Errorf("purge idempotency keys: %w", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
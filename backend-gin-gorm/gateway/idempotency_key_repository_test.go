@@ -0,0 +1,180 @@
+package gateway_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func cleanupIdempotencyKeyTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := db.Exec("DELETE FROM idempotency_key WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table idempotency_key: %v", err)
+	}
+}
+
+func newTestClaimIdempotencyKeyInput(t *testing.T, userID int, key string, body string, now time.Time) *domain.ClaimIdempotencyKeyInput {
+	t.Helper()
+	sum := sha256.Sum256([]byte(body))
+	request, err := domain.NewBeginIdempotentRequestInput(userID, key, hex.EncodeToString(sum[:]))
+	require.NoError(t, err)
+	input, err := domain.NewClaimIdempotencyKeyInput(request, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	return input
+}
+
+func completeTestIdempotentRequest(t *testing.T, ctx context.Context, repo *gateway.IdempotencyKeyRepository, userID int, key string) {
+	t.Helper()
+	input, err := domain.NewCompleteIdempotentRequestInput(userID, key, domain.IdempotentResponse{
+		StatusCode: 201,
+		Header:     map[string]string{"ETag": `"1"`},
+		Body:       []byte(`{"id":1}`),
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.CompleteIdempotentRequest(ctx, input))
+}
+
+func TestIdempotencyKeyRepository_ClaimIdempotencyKey_shouldReturnNil_whenKeyIsNew(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(db)
+	input := newTestClaimIdempotencyKeyInput(t, userID, "key-1", "body", time.Now())
+
+	// when
+	response, err := repo.ClaimIdempotencyKey(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, response)
+}
+
+func TestIdempotencyKeyRepository_ClaimIdempotencyKey_shouldReturnStoredResponse_whenRequestCompleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(db)
+	input := newTestClaimIdempotencyKeyInput(t, userID, "key-1", "body", time.Now())
+	_, err := repo.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	completeTestIdempotentRequest(t, ctx, repo, userID, "key-1")
+
+	// when
+	response, err := repo.ClaimIdempotencyKey(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, response)
+	assert.Equal(t, 201, response.StatusCode)
+	assert.Equal(t, map[string]string{"ETag": `"1"`}, response.Header)
+	assert.JSONEq(t, `{"id":1}`, string(response.Body))
+}
+
+func TestIdempotencyKeyRepository_ClaimIdempotencyKey_shouldReturnError_whenKeyCannotBeClaimed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(db)
+	now := time.Now()
+	_, err := repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "key-1", "body", now))
+	require.NoError(t, err)
+
+	// when
+	_, inFlightErr := repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "key-1", "body", now))
+	_, reusedErr := repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "key-1", "other body", now))
+
+	// then
+	require.ErrorIs(t, inFlightErr, domain.ErrIdempotencyKeyInFlight)
+	require.ErrorIs(t, reusedErr, domain.ErrIdempotencyKeyReused)
+}
+
+func TestIdempotencyKeyRepository_ClaimIdempotencyKey_shouldClaimKeyAgain_whenKeyExpired(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(db)
+	now := time.Now()
+	_, err := repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "key-1", "body", now))
+	require.NoError(t, err)
+	completeTestIdempotentRequest(t, ctx, repo, userID, "key-1")
+
+	// when
+	// 有効期限が切れた後は別のリクエストでも同じキーを使える
+	response, err := repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "key-1", "other body", now.Add(2*time.Hour)))
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, response)
+}
+
+func TestIdempotencyKeyRepository_ReleaseIdempotentRequest_shouldFreeKey_whenRequestFailed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(db)
+	input := newTestClaimIdempotencyKeyInput(t, userID, "key-1", "body", time.Now())
+	_, err := repo.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	releaseInput, err := domain.NewReleaseIdempotentRequestInput(userID, "key-1")
+	require.NoError(t, err)
+
+	// when
+	err = repo.ReleaseIdempotentRequest(ctx, releaseInput)
+
+	// then
+	require.NoError(t, err)
+	response, err := repo.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	assert.Nil(t, response)
+}
+
+func TestIdempotencyKeyRepository_PurgeIdempotencyKeys_shouldDeleteExpiredKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(db)
+	now := time.Now()
+	_, err := repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "expired", "body", now.Add(-2*time.Hour)))
+	require.NoError(t, err)
+	_, err = repo.ClaimIdempotencyKey(ctx, newTestClaimIdempotencyKeyInput(t, userID, "live", "body", now))
+	require.NoError(t, err)
+	input, err := domain.NewPurgeIdempotencyKeysInput(now, 1000)
+	require.NoError(t, err)
+
+	// when
+	purged, err := repo.PurgeIdempotencyKeys(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, 1)
+	var keys []string
+	require.NoError(t, db.Raw("SELECT idempotency_key FROM idempotency_key WHERE user_id = ?", userID).Scan(&keys).Error)
+	assert.Equal(t, []string{"live"}, keys)
+}
@@ -0,0 +1,180 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// maxOutboxErrorLength is the length at which the error of a failed publication is cut off.
+const maxOutboxErrorLength = 1000
+
+// OutboxEventEntity is the GORM model for the "outbox" table.
+// AvailableAt is when the event may be claimed by a relay; a claim moves it to the end of the lease.
+// DispatchedAt is set once the event has been published and is nil until then.
+type OutboxEventEntity struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	EventID      string    `gorm:"type:char(32);not null"`
+	EventType    string    `gorm:"type:varchar(20);not null"`
+	UserID       int       `gorm:"not null"`
+	TodoID       int       `gorm:"not null"`
+	Payload      string    `gorm:"type:mediumtext;not null"`
+	OccurredAt   time.Time `gorm:"not null"`
+	Attempts     int       `gorm:"not null"`
+	AvailableAt  time.Time `gorm:"not null"`
+	DispatchedAt *time.Time
+	LastError    string    `gorm:"type:varchar(1000);not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (e *OutboxEventEntity) TableName() string {
+	return "outbox"
+}
+
+func (e *OutboxEventEntity) toOutboxEvent() domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:         e.ID,
+		EventID:    e.EventID,
+		Type:       domain.TodoEventType(e.EventType),
+		UserID:     e.UserID,
+		TodoID:     e.TodoID,
+		Payload:    []byte(e.Payload),
+		OccurredAt: e.OccurredAt,
+	}
+}
+
+// OutboxEventEntities is a slice of OutboxEventEntity with batch conversion support.
+type OutboxEventEntities []OutboxEventEntity
+
+func (e OutboxEventEntities) toOutboxEvents() []domain.OutboxEvent {
+	events := make([]domain.OutboxEvent, len(e))
+	for i, eventE := range e {
+		events[i] = eventE.toOutboxEvent()
+	}
+	return events
+}
+
+// insertOutboxEvents writes the todo events to the outbox in the transaction of the change they report.
+// The events are available to the relay as soon as the transaction commits.
+func insertOutboxEvents(tx *gorm.DB, events ...domain.TodoEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	entities := make([]OutboxEventEntity, 0, len(events))
+	for i := range events {
+		event, err := domain.NewOutboxEvent(&events[i])
+		if err != nil {
+			return fmt.Errorf("new outbox event: %w", err)
+		}
+		entities = append(entities, OutboxEventEntity{ //nolint:exhaustruct
+			EventID:     event.EventID,
+			EventType:   string(event.Type),
+			UserID:      event.UserID,
+			TodoID:      event.TodoID,
+			Payload:     string(event.Payload),
+			OccurredAt:  event.OccurredAt,
+			AvailableAt: now,
+		})
+	}
+
+	if result := tx.Create(&entities); result.Error != nil {
+		return fmt.Errorf("create outbox events: %w", result.Error)
+	}
+	return nil
+}
+
+// OutboxRepository implements the operations of the outbox relay using GORM.
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository returns a new OutboxRepository backed by the given GORM DB.
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// ClaimOutboxEvents claims up to input.BatchSize undispatched events of any user that are available as of input.Now,
+// in the order they were written, and returns them. Each claimed event counts an attempt and is not available again
+// before input.LeaseUntil. Rows locked by another relay are skipped.
+func (r *OutboxRepository) ClaimOutboxEvents(ctx context.Context, input *domain.ClaimOutboxEventsInput) ([]domain.OutboxEvent, error) {
+	var entities OutboxEventEntities
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}) //nolint:exhaustruct
+		query = query.Where("dispatched_at IS NULL AND available_at <= ?", input.Now)
+		if result := query.Order("id").Limit(input.BatchSize).Find(&entities); result.Error != nil {
+			return fmt.Errorf("find available outbox events: %w", result.Error)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(entities))
+		for i, entity := range entities {
+			ids[i] = entity.ID
+		}
+		columns := map[string]any{"attempts": gorm.Expr("attempts + 1"), "available_at": input.LeaseUntil}
+		if result := tx.Model(&OutboxEventEntity{}).Where("id IN ?", ids).Updates(columns); result.Error != nil { //nolint:exhaustruct
+			return fmt.Errorf("lease outbox events: %w", result.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claim outbox events: %w", err)
+	}
+
+	return entities.toOutboxEvents(), nil
+}
+
+// MarkOutboxEventsDispatched records that the events were published at dispatchedAt, so that they are not claimed again.
+func (r *OutboxRepository) MarkOutboxEventsDispatched(ctx context.Context, ids []int64, dispatchedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query := r.db.WithContext(ctx).Model(&OutboxEventEntity{}).Where("id IN ?", ids) //nolint:exhaustruct
+	if result := query.Updates(map[string]any{"dispatched_at": dispatchedAt, "last_error": ""}); result.Error != nil {
+		return fmt.Errorf("mark outbox events dispatched: %w", result.Error)
+	}
+	return nil
+}
+
+// RecordOutboxPublishFailure records why the events could not be published.
+// The events stay leased and are claimed again once the lease ends.
+func (r *OutboxRepository) RecordOutboxPublishFailure(ctx context.Context, ids []int64, publishErr error) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	lastError := publishErr.Error()
+	if len(lastError) > maxOutboxErrorLength {
+		lastError = strings.ToValidUTF8(lastError[:maxOutboxErrorLength], "")
+	}
+	query := r.db.WithContext(ctx).Model(&OutboxEventEntity{}).Where("id IN ?", ids) //nolint:exhaustruct
+	if result := query.Update("last_error", lastError); result.Error != nil {
+		return fmt.Errorf("record outbox publish failure: %w", result.Error)
+	}
+	return nil
+}
+
+// PurgeOutboxEvents deletes up to input.BatchSize events that were dispatched before input.DispatchedBefore
+// and returns how many were deleted.
+func (r *OutboxRepository) PurgeOutboxEvents(ctx context.Context, input *domain.PurgeOutboxEventsInput) (int, error) {
+	result := r.db.WithContext(ctx).
+		Where("dispatched_at < ?", input.DispatchedBefore).
+		Limit(input.BatchSize).
+		Delete(&OutboxEventEntity{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return 0, fmt.Errorf("purge outbox events: %w", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
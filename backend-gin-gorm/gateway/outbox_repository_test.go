@@ -0,0 +1,211 @@
+package gateway_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func cleanupOutboxTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := db.Exec("DELETE FROM outbox WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table outbox: %v", err)
+	}
+}
+
+// findOutboxEvents returns the outbox rows of the user in the order they were written.
+func findOutboxEvents(t *testing.T, userID int) []gateway.OutboxEventEntity {
+	t.Helper()
+	var entities []gateway.OutboxEventEntity
+	if err := db.Where("user_id = ?", userID).Order("id").Find(&entities).Error; err != nil {
+		t.Fatalf("Failed to find outbox events: %v", err)
+	}
+	return entities
+}
+
+func TestOutboxRepository_shouldWriteEvents_whenTodosAreChanged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupOutboxTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+
+	// when
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", true, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	deleteInput, err := domain.NewDeleteTodoInput(createdTodo.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = repo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+
+	// then
+	entities := findOutboxEvents(t, userID)
+	require.Len(t, entities, 3)
+	assert.Equal(t, string(domain.TodoEventCreated), entities[0].EventType)
+	assert.Equal(t, string(domain.TodoEventUpdated), entities[1].EventType)
+	assert.Equal(t, string(domain.TodoEventDeleted), entities[2].EventType)
+	for _, entity := range entities {
+		assert.Equal(t, createdTodo.ID, entity.TodoID)
+		assert.Len(t, entity.EventID, 32)
+		assert.Nil(t, entity.DispatchedAt)
+	}
+	assert.Contains(t, entities[1].Payload, `"text":"Updated Text"`)
+}
+
+func TestOutboxRepository_shouldNotWriteEvent_whenUpdateIsRejected(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupOutboxTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+	staleVersion := createdTodo.Version - 1
+
+	// when
+	input, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", true, &staleVersion)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, input)
+
+	// then
+	var mismatch *domain.TodoVersionMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	entities := findOutboxEvents(t, userID)
+	require.Len(t, entities, 1, "only the creation should be in the outbox")
+	assert.Equal(t, string(domain.TodoEventCreated), entities[0].EventType)
+}
+
+func TestOutboxRepository_shouldWriteUpdatedEvents_whenChecklistIsChanged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupOutboxTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+
+	// when
+	addInput, err := domain.NewAddChecklistItemInput(todo.ID, userID, "step")
+	require.NoError(t, err)
+	item, _, err := repo.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateChecklistItemInput(item.ID, todo.ID, userID, "step 1", true)
+	require.NoError(t, err)
+	_, _, err = repo.UpdateChecklistItem(ctx, updateInput)
+	require.NoError(t, err)
+	reorderInput, err := domain.NewReorderChecklistInput(todo.ID, userID, []int{item.ID})
+	require.NoError(t, err)
+	_, _, err = repo.ReorderChecklist(ctx, reorderInput)
+	require.NoError(t, err)
+	deleteInput, err := domain.NewDeleteChecklistItemInput(item.ID, todo.ID, userID)
+	require.NoError(t, err)
+	_, err = repo.DeleteChecklistItem(ctx, deleteInput)
+	require.NoError(t, err)
+
+	// then
+	// チェックリストの変更は todo のバージョンを上げるので、購読者にも updated イベントとして届くことを確認
+	entities := findOutboxEvents(t, userID)
+	require.Len(t, entities, 5)
+	assert.Equal(t, string(domain.TodoEventCreated), entities[0].EventType)
+	for i, entity := range entities[1:] {
+		assert.Equal(t, string(domain.TodoEventUpdated), entity.EventType)
+		assert.Equal(t, todo.ID, entity.TodoID)
+		assert.Contains(t, entity.Payload, fmt.Sprintf(`"version":%d`, todo.Version+i+1))
+	}
+}
+
+func TestOutboxRepository_shouldNotWriteEvent_whenChecklistChangeIsRejected(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupOutboxTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+	input, err := domain.NewDeleteChecklistItemInput(1, todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteChecklistItem(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrChecklistItemNotFound)
+	entities := findOutboxEvents(t, userID)
+	require.Len(t, entities, 1, "only the creation should be in the outbox")
+	assert.Equal(t, string(domain.TodoEventCreated), entities[0].EventType)
+}
+
+func TestOutboxRepository_shouldWriteUpdatedEvents_whenCommentIsPostedOrDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupOutboxTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+
+	// when
+	comment := createTestComment(t, ctx, repo, todo.ID, userID, "Hello")
+	deleteInput, err := domain.NewDeleteCommentInput(comment.ID, todo.ID, userID)
+	require.NoError(t, err)
+	_, err = repo.DeleteComment(ctx, deleteInput)
+	require.NoError(t, err)
+
+	// then
+	entities := findOutboxEvents(t, userID)
+	require.Len(t, entities, 3)
+	assert.Equal(t, string(domain.TodoEventUpdated), entities[1].EventType)
+	assert.Equal(t, string(domain.TodoEventUpdated), entities[2].EventType)
+	assert.Contains(t, entities[2].Payload, fmt.Sprintf(`"version":%d`, todo.Version+2))
+}
+
+func TestOutboxRepository_PurgeOutboxEvents_shouldDeleteDispatchedEvents(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupOutboxTable(t, userID)
+	repo := gateway.NewOutboxRepository(db)
+	createTestTodo(t, ctx, userID, "Dispatched")
+	createTestTodo(t, ctx, userID, "Pending")
+	entities := findOutboxEvents(t, userID)
+	require.Len(t, entities, 2)
+	dispatchedAt := time.Now().Add(-time.Hour)
+	require.NoError(t, repo.MarkOutboxEventsDispatched(ctx, []int64{entities[0].ID}, dispatchedAt))
+
+	// when
+	input, err := domain.NewPurgeOutboxEventsInput(time.Now(), 1000)
+	require.NoError(t, err)
+	purgedCount, err := repo.PurgeOutboxEvents(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, purgedCount, 1)
+	remaining := findOutboxEvents(t, userID)
+	require.Len(t, remaining, 1, "the pending event should be kept")
+	assert.Equal(t, entities[1].ID, remaining[0].ID)
+}
@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoAttachmentEntity is the GORM model for the "todo_attachment" table.
+type TodoAttachmentEntity struct {
+	ID          int       `gorm:"primaryKey;autoIncrement"`
+	TodoID      int       `gorm:"not null"`
+	FileName    string    `gorm:"type:varchar(255);not null"`
+	ContentType string    `gorm:"type:varchar(255);not null"`
+	Size        int64     `gorm:"not null"`
+	StorageKey  string    `gorm:"type:varchar(255);not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (e *TodoAttachmentEntity) TableName() string {
+	return "todo_attachment"
+}
+
+func (e *TodoAttachmentEntity) toAttachment() (*domain.Attachment, error) {
+	attachment, err := domain.NewAttachment(e.ID, e.TodoID, e.FileName, e.ContentType, e.Size, e.StorageKey, e.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to attachment model: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// TodoAttachmentEntities is a slice of TodoAttachmentEntity with batch conversion support.
+type TodoAttachmentEntities []TodoAttachmentEntity
+
+func (e TodoAttachmentEntities) toAttachments() ([]domain.Attachment, error) {
+	attachments := make([]domain.Attachment, len(e))
+	for i, attachmentE := range e {
+		attachment, err := attachmentE.toAttachment()
+		if err != nil {
+			return nil, fmt.Errorf("to attachment: %w", err)
+		}
+		attachments[i] = *attachment
+	}
+
+	return attachments, nil
+}
+
+// TodoAttachmentRepository implements attachment metadata persistence using GORM.
+// Blob contents are stored separately through a BlobStore.
+type TodoAttachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoAttachmentRepository returns a new TodoAttachmentRepository backed by the given GORM DB.
+func NewTodoAttachmentRepository(db *gorm.DB) *TodoAttachmentRepository {
+	return &TodoAttachmentRepository{
+		db: db,
+	}
+}
+
+// CheckAttachableTodo checks that the user may attach files to the todo, so that content is only stored for todos
+// the user can edit. Returns ErrTodoNotFound if the todo is not visible to the user and ErrForbidden if the user may not edit it.
+func (r *TodoAttachmentRepository) CheckAttachableTodo(ctx context.Context, todoID int, userID int) error {
+	if _, err := authorizeTodo(r.db.WithContext(ctx), todoID, userID, domain.TodoListEditor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateAttachment inserts attachment metadata. Returns ErrTodoNotFound if the todo is not visible to the user
+// and ErrForbidden if the user may not edit it.
+func (r *TodoAttachmentRepository) CreateAttachment(ctx context.Context, input *domain.CreateAttachmentInput) (*domain.Attachment, error) {
+	entity := &TodoAttachmentEntity{ //nolint:exhaustruct
+		TodoID:      input.TodoID,
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		StorageKey:  input.StorageKey,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		if result := tx.Create(entity); result.Error != nil {
+			return fmt.Errorf("create attachment: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload created attachment: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create attachment: %w", err)
+	}
+
+	attachment, err := entity.toAttachment()
+	if err != nil {
+		return nil, fmt.Errorf("to attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// FindAttachments returns the attachments of a todo ordered by ID. Returns ErrTodoNotFound if the todo is not visible to the user.
+func (r *TodoAttachmentRepository) FindAttachments(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error) {
+	db := r.db.WithContext(ctx)
+	if _, err := authorizeTodo(db, input.TodoID, input.UserID, domain.TodoListViewer); err != nil {
+		return nil, err
+	}
+
+	var entities TodoAttachmentEntities
+	if result := db.Where("todo_id = ?", input.TodoID).Order("id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find attachments: %w", result.Error)
+	}
+
+	attachments, err := entities.toAttachments()
+	if err != nil {
+		return nil, fmt.Errorf("to attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// FindAttachment returns a single attachment of a todo visible to the user.
+// Returns ErrTodoNotFound or ErrAttachmentNotFound if either does not exist for the user.
+func (r *TodoAttachmentRepository) FindAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error) {
+	db := r.db.WithContext(ctx)
+	if _, err := authorizeTodo(db, input.TodoID, input.UserID, domain.TodoListViewer); err != nil {
+		return nil, err
+	}
+
+	var entity TodoAttachmentEntity
+	if result := db.Where("id = ? AND todo_id = ?", input.ID, input.TodoID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("find attachment: %w", result.Error)
+	}
+
+	attachment, err := entity.toAttachment()
+	if err != nil {
+		return nil, fmt.Errorf("to attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// DeleteAttachment removes attachment metadata and returns what was deleted so the caller can remove the blob.
+// Returns ErrTodoNotFound or ErrAttachmentNotFound if either does not exist for the user and ErrForbidden if the user may not edit the todo.
+func (r *TodoAttachmentRepository) DeleteAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error) {
+	var entity TodoAttachmentEntity
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		if result := tx.Where("id = ? AND todo_id = ?", input.ID, input.TodoID).First(&entity); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return domain.ErrAttachmentNotFound
+			}
+			return fmt.Errorf("find attachment: %w", result.Error)
+		}
+
+		if result := tx.Delete(&entity); result.Error != nil {
+			return fmt.Errorf("delete attachment: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete attachment: %w", err)
+	}
+
+	attachment, err := entity.toAttachment()
+	if err != nil {
+		return nil, fmt.Errorf("to attachment: %w", err)
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,97 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func createTestAttachment(t *testing.T, ctx context.Context, repo *gateway.TodoAttachmentRepository, todoID int, userID int, storageKey string) *domain.Attachment {
+	t.Helper()
+	input, err := domain.NewCreateAttachmentInput(todoID, userID, "receipt.pdf", "application/pdf", 1234, storageKey)
+	require.NoError(t, err)
+	attachment, err := repo.CreateAttachment(ctx, input)
+	require.NoError(t, err, "Failed to insert test data")
+	return attachment
+}
+
+// CreateAttachment Tests
+
+func TestTodoAttachmentRepository_CreateAttachment_shouldReturnError_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoAttachmentRepository(db)
+	input, err := domain.NewCreateAttachmentInput(todo.ID, userID+1, "receipt.pdf", "application/pdf", 1, "key-"+t.Name())
+	require.NoError(t, err)
+
+	// when
+	attachment, err := repo.CreateAttachment(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, attachment)
+}
+
+// FindAttachments Tests
+
+func TestTodoAttachmentRepository_FindAttachments_shouldReturnAttachments_whenTodoHasAttachments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoAttachmentRepository(db)
+	first := createTestAttachment(t, ctx, repo, todo.ID, userID, "key-1-"+t.Name())
+	second := createTestAttachment(t, ctx, repo, todo.ID, userID, "key-2-"+t.Name())
+	input, err := domain.NewFindAttachmentsInput(todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	attachments, err := repo.FindAttachments(ctx, input)
+
+	// then
+	require.NoError(t, err, "FindAttachments() should not return an error")
+	require.Len(t, attachments, 2)
+	assert.Equal(t, first.ID, attachments[0].ID)
+	assert.Equal(t, second.ID, attachments[1].ID)
+	assert.Equal(t, "receipt.pdf", attachments[0].FileName)
+	assert.Equal(t, int64(1234), attachments[0].Size)
+}
+
+// DeleteAttachment Tests
+
+func TestTodoAttachmentRepository_DeleteAttachment_shouldReturnDeletedAttachment_whenAttachmentExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoAttachmentRepository(db)
+	created := createTestAttachment(t, ctx, repo, todo.ID, userID, "key-"+t.Name())
+	input, err := domain.NewAttachmentInput(created.ID, todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	deleted, err := repo.DeleteAttachment(ctx, input)
+
+	// then
+	require.NoError(t, err, "DeleteAttachment() should not return an error")
+	assert.Equal(t, created.StorageKey, deleted.StorageKey)
+	_, err = repo.FindAttachment(ctx, input)
+	require.ErrorIs(t, err, domain.ErrAttachmentNotFound)
+}
@@ -0,0 +1,76 @@
+package gateway
+
+import (
+	"fmt"
+	"slices"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TodoChangeSequenceEntity is the GORM model for the "todo_change_sequence" table.
+// LastSeq is the change sequence number last given to a write to the todos of the user.
+type TodoChangeSequenceEntity struct {
+	UserID  int   `gorm:"primaryKey;autoIncrement:false"`
+	LastSeq int64 `gorm:"not null"`
+}
+
+func (e *TodoChangeSequenceEntity) TableName() string {
+	return "todo_change_sequence"
+}
+
+// nextTodoChangeSeq returns the next change sequence number of the user, to be stored in the change_seq column
+// of the todos the transaction writes.
+// The row of the user stays locked until the transaction ends, so the writes to the todos of a user commit
+// in the order of their sequence numbers and a sync never skips a change that commits late.
+// It must be called before the transaction locks any todo so that every writer takes the locks in the same order.
+func nextTodoChangeSeq(tx *gorm.DB, userID int) (int64, error) {
+	entity := &TodoChangeSequenceEntity{
+		UserID:  userID,
+		LastSeq: 1,
+	}
+	result := tx.Clauses(clause.OnConflict{ //nolint:exhaustruct
+		DoUpdates: clause.Assignments(map[string]any{"last_seq": gorm.Expr("last_seq + 1")}),
+	}).Create(entity)
+	if result.Error != nil {
+		return 0, fmt.Errorf("increment todo change sequence: %w", result.Error)
+	}
+
+	var seq int64
+	if result := tx.Model(&TodoChangeSequenceEntity{}).Select("last_seq").Where("user_id = ?", userID).Scan(&seq); result.Error != nil { //nolint:exhaustruct
+		return 0, fmt.Errorf("find todo change sequence: %w", result.Error)
+	}
+	return seq, nil
+}
+
+// nextTodoChangeSeqs returns the next change sequence number of each of the users, for a transaction that writes
+// todos of more than one user. The sequences are taken in ascending order of user ID so that concurrent writers
+// lock the rows of the users in the same order. Like nextTodoChangeSeq, it must be called before locking any todo.
+func nextTodoChangeSeqs(tx *gorm.DB, userIDs []int) (map[int]int64, error) {
+	sorted := slices.Clone(userIDs)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	seqs := make(map[int]int64, len(sorted))
+	for _, userID := range sorted {
+		seq, err := nextTodoChangeSeq(tx, userID)
+		if err != nil {
+			return nil, err
+		}
+		seqs[userID] = seq
+	}
+	return seqs, nil
+}
+
+// nextTodoChangeSeqsForTodos returns the next change sequence number of each of the users and of each owner of
+// the todos, for a transaction that is about to write them. Todos that do not exist are skipped; writing them fails
+// on its own. Like nextTodoChangeSeq, it must be called before locking any todo.
+func nextTodoChangeSeqsForTodos(tx *gorm.DB, userIDs []int, todoIDs []int) (map[int]int64, error) {
+	var ownerIDs []int
+	if len(todoIDs) > 0 {
+		if result := tx.Unscoped().Model(&TodoEntity{}).Where("id IN ?", todoIDs).Distinct().Pluck("user_id", &ownerIDs); result.Error != nil { //nolint:exhaustruct
+			return nil, fmt.Errorf("find owners of todos: %w", result.Error)
+		}
+	}
+	return nextTodoChangeSeqs(tx, append(ownerIDs, userIDs...))
+}
@@ -0,0 +1,335 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoChecklistItemEntity is the GORM model for the "todo_checklist_item" table.
+type TodoChecklistItemEntity struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	TodoID    int       `gorm:"not null"`
+	Text      string    `gorm:"type:varchar(255);not null"`
+	IsChecked bool      `gorm:"not null;default:false"`
+	Position  int       `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (e *TodoChecklistItemEntity) TableName() string {
+	return "todo_checklist_item"
+}
+
+func (e *TodoChecklistItemEntity) toChecklistItem() (*domain.ChecklistItem, error) {
+	item, err := domain.NewChecklistItem(e.ID, e.TodoID, e.Text, e.IsChecked, e.Position, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to checklist item model: %w", err)
+	}
+
+	return item, nil
+}
+
+// TodoChecklistItemEntities is a slice of TodoChecklistItemEntity with batch conversion support.
+type TodoChecklistItemEntities []TodoChecklistItemEntity
+
+func (e TodoChecklistItemEntities) toChecklistItems() ([]domain.ChecklistItem, error) {
+	items := make([]domain.ChecklistItem, len(e))
+	for i, itemE := range e {
+		item, err := itemE.toChecklistItem()
+		if err != nil {
+			return nil, fmt.Errorf("to checklist item: %w", err)
+		}
+		items[i] = *item
+	}
+
+	return items, nil
+}
+
+// TodoChecklistRepository implements checklist persistence operations using GORM.
+// Every operation locks the parent todo row so that positions stay consistent under concurrent edits.
+// Every write also bumps the todo's version and writes an updated event for the todo to the outbox.
+type TodoChecklistRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoChecklistRepository returns a new TodoChecklistRepository backed by the given GORM DB.
+func NewTodoChecklistRepository(db *gorm.DB) *TodoChecklistRepository {
+	return &TodoChecklistRepository{
+		db: db,
+	}
+}
+
+// AddChecklistItem appends an item to the end of the checklist. Returns ErrTodoNotFound if the todo is not visible to the user
+// and ErrForbidden if the user may not edit it.
+func (r *TodoChecklistRepository) AddChecklistItem(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.ChecklistItem, *domain.Todo, error) {
+	var todo *domain.Todo
+	entity := &TodoChecklistItemEntity{ //nolint:exhaustruct
+		TodoID:    input.TodoID,
+		Text:      input.Text,
+		IsChecked: false,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		var stats struct {
+			Count       int
+			MaxPosition int
+		}
+		query := tx.Model(&TodoChecklistItemEntity{}).Where("todo_id = ?", input.TodoID) //nolint:exhaustruct
+		if result := query.Select("COUNT(*) AS count, COALESCE(MAX(position), -1) AS max_position").Scan(&stats); result.Error != nil {
+			return fmt.Errorf("count checklist items: %w", result.Error)
+		}
+		if stats.Count >= domain.MaxChecklistItems {
+			return domain.ErrChecklistFull
+		}
+
+		entity.Position = stats.MaxPosition + 1
+		if result := tx.Create(entity); result.Error != nil {
+			return fmt.Errorf("create checklist item: %w", result.Error)
+		}
+		if err := bumpTodoVersion(tx, input.TodoID, seq); err != nil {
+			return err
+		}
+		todo, err = findTodoByID(tx, input.TodoID)
+		if err != nil {
+			return fmt.Errorf("reload todo: %w", err)
+		}
+		if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+			return err
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload created checklist item: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("add checklist item: %w", err)
+	}
+
+	item, err := entity.toChecklistItem()
+	if err != nil {
+		return nil, nil, fmt.Errorf("to checklist item: %w", err)
+	}
+
+	return item, todo, nil
+}
+
+// UpdateChecklistItem updates the text and checked state of an item.
+// Returns ErrTodoNotFound or ErrChecklistItemNotFound if either does not exist for the user and ErrForbidden if the user may not edit the todo.
+func (r *TodoChecklistRepository) UpdateChecklistItem(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.ChecklistItem, *domain.Todo, error) {
+	var entity TodoChecklistItemEntity
+	var todo *domain.Todo
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		if result := tx.Where("id = ? AND todo_id = ?", input.ID, input.TodoID).First(&entity); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return domain.ErrChecklistItemNotFound
+			}
+			return fmt.Errorf("find checklist item: %w", result.Error)
+		}
+
+		if result := tx.Model(&entity).Updates(map[string]any{
+			"text":       input.Text,
+			"is_checked": input.IsChecked,
+		}); result.Error != nil {
+			return fmt.Errorf("update checklist item: %w", result.Error)
+		}
+		if err := bumpTodoVersion(tx, input.TodoID, seq); err != nil {
+			return err
+		}
+		todo, err = findTodoByID(tx, input.TodoID)
+		if err != nil {
+			return fmt.Errorf("reload todo: %w", err)
+		}
+		if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+			return err
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(&entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload updated checklist item: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("update checklist item: %w", err)
+	}
+
+	item, err := entity.toChecklistItem()
+	if err != nil {
+		return nil, nil, fmt.Errorf("to checklist item: %w", err)
+	}
+
+	return item, todo, nil
+}
+
+// ReorderChecklist rewrites item positions to follow input.ItemIDs.
+// Returns ErrChecklistOrderMismatch if ItemIDs is not a permutation of the todo's current items and ErrForbidden if the user may not edit the todo.
+func (r *TodoChecklistRepository) ReorderChecklist(ctx context.Context, input *domain.ReorderChecklistInput) ([]domain.ChecklistItem, *domain.Todo, error) {
+	var entities TodoChecklistItemEntities
+	var todo *domain.Todo
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		var currentIDs []int
+		if result := tx.Model(&TodoChecklistItemEntity{}).Where("todo_id = ?", input.TodoID).Pluck("id", &currentIDs); result.Error != nil { //nolint:exhaustruct
+			return fmt.Errorf("find checklist item ids: %w", result.Error)
+		}
+		if !sameIDSet(currentIDs, input.ItemIDs) {
+			return domain.ErrChecklistOrderMismatch
+		}
+
+		for position, itemID := range input.ItemIDs {
+			query := tx.Model(&TodoChecklistItemEntity{}).Where("id = ? AND todo_id = ?", itemID, input.TodoID) //nolint:exhaustruct
+			if result := query.Update("position", position); result.Error != nil {
+				return fmt.Errorf("update checklist item position: %w", result.Error)
+			}
+		}
+		if err := bumpTodoVersion(tx, input.TodoID, seq); err != nil {
+			return err
+		}
+		todo, err = findTodoByID(tx, input.TodoID)
+		if err != nil {
+			return fmt.Errorf("reload todo: %w", err)
+		}
+		if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+			return err
+		}
+
+		if result := tx.Where("todo_id = ?", input.TodoID).Order("position, id").Find(&entities); result.Error != nil {
+			return fmt.Errorf("find checklist items: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("reorder checklist: %w", err)
+	}
+
+	items, err := entities.toChecklistItems()
+	if err != nil {
+		return nil, nil, fmt.Errorf("to checklist items: %w", err)
+	}
+
+	return items, todo, nil
+}
+
+// DeleteChecklistItem removes an item from the checklist.
+// Returns ErrTodoNotFound or ErrChecklistItemNotFound if either does not exist for the user and ErrForbidden if the user may not edit the todo.
+func (r *TodoChecklistRepository) DeleteChecklistItem(ctx context.Context, input *domain.DeleteChecklistItemInput) (*domain.Todo, error) {
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ? AND todo_id = ?", input.ID, input.TodoID).Delete(&TodoChecklistItemEntity{}) //nolint:exhaustruct
+		if result.Error != nil {
+			return fmt.Errorf("delete checklist item: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrChecklistItemNotFound
+		}
+		if err := bumpTodoVersion(tx, input.TodoID, seq); err != nil {
+			return err
+		}
+		todo, err = findTodoByID(tx, input.TodoID)
+		if err != nil {
+			return fmt.Errorf("reload todo: %w", err)
+		}
+		if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete checklist item: %w", err)
+	}
+
+	return todo, nil
+}
+
+// lockOwnedTodo takes a row lock on the todo owned by the user. Returns ErrTodoNotFound if it does not exist.
+func lockOwnedTodo(tx *gorm.DB, todoID int, userID int) error {
+	var entity TodoEntity
+	query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Select("id") //nolint:exhaustruct
+	if result := query.Where("id = ? AND user_id = ?", todoID, userID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return domain.ErrTodoNotFound
+		}
+		return fmt.Errorf("lock todo: %w", result.Error)
+	}
+
+	return nil
+}
+
+func sameIDSet(current []int, requested []int) bool {
+	if len(current) != len(requested) {
+		return false
+	}
+	set := make(map[int]struct{}, len(current))
+	for _, id := range current {
+		set[id] = struct{}{}
+	}
+	for _, id := range requested {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,224 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+// createTestTodo inserts a todo for the user and returns it.
+func createTestTodo(t *testing.T, ctx context.Context, userID int, text string) *domain.Todo {
+	t.Helper()
+	input, err := domain.NewCreateTodoInput(userID, text)
+	require.NoError(t, err, "Failed to create input")
+	todo, err := gateway.NewTodoRepository(db).CreateTodo(ctx, input)
+	require.NoError(t, err, "Failed to insert test data")
+	return todo
+}
+
+// AddChecklistItem Tests
+
+func TestTodoChecklistRepository_AddChecklistItem_shouldAppendItems_whenTodoExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo with checklist")
+	repo := gateway.NewTodoChecklistRepository(db)
+
+	// when
+	var added []*domain.ChecklistItem
+	for _, text := range []string{"step 1", "step 2", "step 3"} {
+		input, err := domain.NewAddChecklistItemInput(todo.ID, userID, text)
+		require.NoError(t, err)
+		item, _, err := repo.AddChecklistItem(ctx, input)
+		require.NoError(t, err, "AddChecklistItem() should not return an error")
+		added = append(added, item)
+	}
+
+	// then
+	for i, item := range added {
+		assert.Equal(t, i, item.Position, "items should be appended in order")
+		assert.False(t, item.IsChecked, "new items should be unchecked")
+	}
+
+	todos, err := findTodos(ctx, gateway.NewTodoRepository(db), userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Len(t, todos[0].Checklist, 3, "FindTodos() should return the checklist")
+	assert.Equal(t, "step 1", todos[0].Checklist[0].Text)
+	assert.Equal(t, "step 3", todos[0].Checklist[2].Text)
+}
+
+func TestTodoChecklistRepository_AddChecklistItem_shouldReturnError_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Protected todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+
+	input, err := domain.NewAddChecklistItemInput(todo.ID, otherUserID, "step")
+	require.NoError(t, err)
+
+	// when
+	item, _, err := repo.AddChecklistItem(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, item)
+}
+
+// UpdateChecklistItem Tests
+
+func TestTodoChecklistRepository_UpdateChecklistItem_shouldToggleItem_whenItemExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+	addInput, err := domain.NewAddChecklistItemInput(todo.ID, userID, "step")
+	require.NoError(t, err)
+	item, _, err := repo.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+
+	updateInput, err := domain.NewUpdateChecklistItemInput(item.ID, todo.ID, userID, "step edited", true)
+	require.NoError(t, err)
+
+	// when
+	updated, _, err := repo.UpdateChecklistItem(ctx, updateInput)
+
+	// then
+	require.NoError(t, err, "UpdateChecklistItem() should not return an error")
+	assert.Equal(t, "step edited", updated.Text)
+	assert.True(t, updated.IsChecked)
+	assert.Equal(t, item.Position, updated.Position, "position should not change")
+}
+
+func TestTodoChecklistRepository_UpdateChecklistItem_shouldReturnError_whenItemNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+
+	input, err := domain.NewUpdateChecklistItemInput(999999999, todo.ID, userID, "step", true)
+	require.NoError(t, err)
+
+	// when
+	_, _, err = repo.UpdateChecklistItem(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrChecklistItemNotFound)
+}
+
+// ReorderChecklist Tests
+
+func TestTodoChecklistRepository_ReorderChecklist_shouldRewritePositions_whenAllItemsListed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+	ids := make([]int, 0, 3)
+	for _, text := range []string{"a", "b", "c"} {
+		input, err := domain.NewAddChecklistItemInput(todo.ID, userID, text)
+		require.NoError(t, err)
+		item, _, err := repo.AddChecklistItem(ctx, input)
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+
+	input, err := domain.NewReorderChecklistInput(todo.ID, userID, []int{ids[2], ids[0], ids[1]})
+	require.NoError(t, err)
+
+	// when
+	items, _, err := repo.ReorderChecklist(ctx, input)
+
+	// then
+	require.NoError(t, err, "ReorderChecklist() should not return an error")
+	require.Len(t, items, 3)
+	assert.Equal(t, "c", items[0].Text)
+	assert.Equal(t, "a", items[1].Text)
+	assert.Equal(t, "b", items[2].Text)
+	for i, item := range items {
+		assert.Equal(t, i, item.Position)
+	}
+}
+
+func TestTodoChecklistRepository_ReorderChecklist_shouldReturnError_whenItemsMissing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+	ids := make([]int, 0, 2)
+	for _, text := range []string{"a", "b"} {
+		input, err := domain.NewAddChecklistItemInput(todo.ID, userID, text)
+		require.NoError(t, err)
+		item, _, err := repo.AddChecklistItem(ctx, input)
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+
+	input, err := domain.NewReorderChecklistInput(todo.ID, userID, []int{ids[1]})
+	require.NoError(t, err)
+
+	// when
+	_, _, err = repo.ReorderChecklist(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrChecklistOrderMismatch)
+}
+
+// DeleteChecklistItem Tests
+
+func TestTodoChecklistRepository_DeleteChecklistItem_shouldRemoveItem_whenItemExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoChecklistRepository(db)
+	addInput, err := domain.NewAddChecklistItemInput(todo.ID, userID, "step")
+	require.NoError(t, err)
+	item, _, err := repo.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+
+	deleteInput, err := domain.NewDeleteChecklistItemInput(item.ID, todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteChecklistItem(ctx, deleteInput)
+
+	// then
+	require.NoError(t, err, "DeleteChecklistItem() should not return an error")
+
+	_, err = repo.DeleteChecklistItem(ctx, deleteInput)
+	require.ErrorIs(t, err, domain.ErrChecklistItemNotFound, "deleting twice should report not found")
+}
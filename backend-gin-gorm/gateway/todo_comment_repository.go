@@ -0,0 +1,272 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoCommentEntity is the GORM model for the "todo_comment" table.
+type TodoCommentEntity struct {
+	ID            int        `gorm:"primaryKey;autoIncrement"`
+	TodoID        int        `gorm:"not null"`
+	AuthorUserID  int        `gorm:"not null"`
+	AuthorLoginID string     `gorm:"type:varchar(255);not null"`
+	Text          string     `gorm:"type:varchar(2000);not null"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime"`
+	EditedAt      *time.Time `gorm:"default:null"`
+}
+
+func (e *TodoCommentEntity) TableName() string {
+	return "todo_comment"
+}
+
+func (e *TodoCommentEntity) toComment() (*domain.Comment, error) {
+	author, err := domain.NewCommentAuthor(e.AuthorUserID, e.AuthorLoginID)
+	if err != nil {
+		return nil, fmt.Errorf("to comment author: %w", err)
+	}
+
+	comment, err := domain.NewComment(e.ID, e.TodoID, *author, e.Text, e.CreatedAt, e.UpdatedAt, e.EditedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to comment model: %w", err)
+	}
+
+	return comment, nil
+}
+
+// TodoCommentEntities is a slice of TodoCommentEntity with batch conversion support.
+type TodoCommentEntities []TodoCommentEntity
+
+func (e TodoCommentEntities) toComments() ([]domain.Comment, error) {
+	comments := make([]domain.Comment, len(e))
+	for i, commentE := range e {
+		comment, err := commentE.toComment()
+		if err != nil {
+			return nil, fmt.Errorf("to comment: %w", err)
+		}
+		comments[i] = *comment
+	}
+
+	return comments, nil
+}
+
+// TodoCommentRepository implements comment persistence operations using GORM.
+// Edits and deletes lock the parent todo row and apply the domain authorization rules inside the same transaction.
+type TodoCommentRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoCommentRepository returns a new TodoCommentRepository backed by the given GORM DB.
+func NewTodoCommentRepository(db *gorm.DB) *TodoCommentRepository {
+	return &TodoCommentRepository{
+		db: db,
+	}
+}
+
+// CreateComment inserts a comment on a todo and bumps the todo's version, since its comment count changes.
+// Returns ErrTodoNotFound if the todo is not visible to the author.
+func (r *TodoCommentRepository) CreateComment(ctx context.Context, input *domain.CreateCommentInput) (*domain.Comment, *domain.Todo, error) {
+	var todo *domain.Todo
+	entity := &TodoCommentEntity{ //nolint:exhaustruct
+		TodoID:        input.TodoID,
+		AuthorUserID:  input.Author.UserID,
+		AuthorLoginID: input.Author.LoginID,
+		Text:          input.Text,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.Author.UserID, domain.TodoListViewer)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, ownerID); err != nil {
+			return err
+		}
+
+		if result := tx.Create(entity); result.Error != nil {
+			return fmt.Errorf("create comment: %w", result.Error)
+		}
+		if err := bumpTodoVersion(tx, input.TodoID, seq); err != nil {
+			return err
+		}
+		todo, err = findTodoByID(tx, input.TodoID)
+		if err != nil {
+			return fmt.Errorf("reload todo: %w", err)
+		}
+		if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+			return err
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload created comment: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create comment: %w", err)
+	}
+
+	comment, err := entity.toComment()
+	if err != nil {
+		return nil, nil, fmt.Errorf("to comment: %w", err)
+	}
+
+	return comment, todo, nil
+}
+
+// FindComments returns the comments of a todo in posting order. Returns ErrTodoNotFound if the todo is not visible to the user.
+func (r *TodoCommentRepository) FindComments(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error) {
+	db := r.db.WithContext(ctx)
+	if _, err := authorizeTodo(db, input.TodoID, input.UserID, domain.TodoListViewer); err != nil {
+		return nil, err
+	}
+
+	var entities TodoCommentEntities
+	if result := db.Where("todo_id = ?", input.TodoID).Order("id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find comments: %w", result.Error)
+	}
+
+	comments, err := entities.toComments()
+	if err != nil {
+		return nil, fmt.Errorf("to comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// UpdateComment replaces the text of a comment and records the edit time.
+// Returns ErrTodoNotFound or ErrCommentNotFound if either does not exist for the user, and ErrForbidden if the user is not the author.
+func (r *TodoCommentRepository) UpdateComment(ctx context.Context, input *domain.UpdateCommentInput) (*domain.Comment, error) {
+	var entity TodoCommentEntity
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockVisibleTodo(tx, input.TodoID, input.UserID); err != nil {
+			return err
+		}
+
+		comment, err := findComment(tx, &entity, input.ID, input.TodoID)
+		if err != nil {
+			return err
+		}
+		if !comment.CanEdit(input.UserID) {
+			return domain.ErrForbidden
+		}
+
+		if result := tx.Model(&entity).Updates(map[string]any{
+			"text":      input.Text,
+			"edited_at": gorm.Expr("CURRENT_TIMESTAMP(6)"),
+		}); result.Error != nil {
+			return fmt.Errorf("update comment: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(&entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload updated comment: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update comment: %w", err)
+	}
+
+	comment, err := entity.toComment()
+	if err != nil {
+		return nil, fmt.Errorf("to comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment removes a comment and bumps the todo's version, since its comment count changes.
+// Returns ErrTodoNotFound or ErrCommentNotFound if either does not exist for the user,
+// and ErrForbidden if the user is neither the author nor the owner of the todo.
+func (r *TodoCommentRepository) DeleteComment(ctx context.Context, input *domain.DeleteCommentInput) (*domain.Todo, error) {
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		todoOwnerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListViewer)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, todoOwnerID)
+		if err != nil {
+			return err
+		}
+		if err := lockOwnedTodo(tx, input.TodoID, todoOwnerID); err != nil {
+			return err
+		}
+
+		var entity TodoCommentEntity
+		comment, err := findComment(tx, &entity, input.ID, input.TodoID)
+		if err != nil {
+			return err
+		}
+		if !comment.CanDelete(input.UserID, todoOwnerID) {
+			return domain.ErrForbidden
+		}
+
+		if result := tx.Delete(&entity); result.Error != nil {
+			return fmt.Errorf("delete comment: %w", result.Error)
+		}
+		if err := bumpTodoVersion(tx, input.TodoID, seq); err != nil {
+			return err
+		}
+		todo, err = findTodoByID(tx, input.TodoID)
+		if err != nil {
+			return fmt.Errorf("reload todo: %w", err)
+		}
+		if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete comment: %w", err)
+	}
+
+	return todo, nil
+}
+
+func findComment(tx *gorm.DB, entity *TodoCommentEntity, commentID int, todoID int) (*domain.Comment, error) {
+	if result := tx.Where("id = ? AND todo_id = ?", commentID, todoID).First(entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("find comment: %w", result.Error)
+	}
+
+	comment, err := entity.toComment()
+	if err != nil {
+		return nil, fmt.Errorf("to comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// lockVisibleTodo takes a row lock on a todo the user can see and returns the ID of its owner.
+// A todo is visible to its owner and the members of the todo lists it is shared in. Returns ErrTodoNotFound otherwise.
+func lockVisibleTodo(tx *gorm.DB, todoID int, userID int) (int, error) {
+	ownerID, err := authorizeTodo(tx, todoID, userID, domain.TodoListViewer)
+	if err != nil {
+		return 0, err
+	}
+	if err := lockOwnedTodo(tx, todoID, ownerID); err != nil {
+		return 0, err
+	}
+
+	return ownerID, nil
+}
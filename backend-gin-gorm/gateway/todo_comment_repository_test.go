@@ -0,0 +1,294 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func createTestComment(t *testing.T, ctx context.Context, repo *gateway.TodoCommentRepository, todoID int, userID int, text string) *domain.Comment {
+	t.Helper()
+	input, err := domain.NewCreateCommentInput(todoID, userID, "user1", text)
+	require.NoError(t, err)
+	comment, _, err := repo.CreateComment(ctx, input)
+	require.NoError(t, err, "Failed to insert test data")
+	return comment
+}
+
+// createTestCommentByOtherUser inserts a comment written by someone other than the todo owner directly into the table.
+func createTestCommentByOtherUser(t *testing.T, todoID int, authorUserID int) *gateway.TodoCommentEntity {
+	t.Helper()
+	entity := &gateway.TodoCommentEntity{ //nolint:exhaustruct
+		TodoID:        todoID,
+		AuthorUserID:  authorUserID,
+		AuthorLoginID: "other",
+		Text:          "Comment by other user",
+	}
+	require.NoError(t, db.Create(entity).Error, "Failed to insert test data")
+	return entity
+}
+
+// findTestTodo reads a todo as its owner sees it.
+func findTestTodo(t *testing.T, ctx context.Context, todoID int, userID int) *domain.Todo {
+	t.Helper()
+	input, err := domain.NewFindTodoInput(todoID, userID)
+	require.NoError(t, err)
+	todo, err := gateway.NewTodoRepository(db).FindTodo(ctx, input)
+	require.NoError(t, err, "Failed to find test data")
+	return todo
+}
+
+// CreateComment Tests
+
+func TestTodoCommentRepository_CreateComment_shouldReturnCommentWithAuthor_whenTodoExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+
+	// when
+	comment := createTestComment(t, ctx, repo, todo.ID, userID, "First comment")
+
+	// then
+	assert.Positive(t, comment.ID)
+	assert.Equal(t, todo.ID, comment.TodoID)
+	assert.Equal(t, userID, comment.Author.UserID)
+	assert.Equal(t, "user1", comment.Author.LoginID)
+	assert.Equal(t, "First comment", comment.Text)
+	assert.Nil(t, comment.EditedAt, "a new comment should not have an edit time")
+}
+
+func TestTodoCommentRepository_CreateComment_shouldBumpTodoVersion_whenCommentIsPosted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+
+	// when
+	createTestComment(t, ctx, repo, todo.ID, userID, "First comment")
+
+	// then
+	// コメント数が変わるので、条件付き GET が古い表現を返さないようにバージョンが上がることを確認
+	after := findTestTodo(t, ctx, todo.ID, userID)
+	assert.Equal(t, 1, after.CommentCount)
+	assert.Equal(t, todo.Version+1, after.Version, "posting a comment should bump the todo version")
+	assert.False(t, after.UpdatedAt.Before(todo.UpdatedAt), "posting a comment should not move updated_at back")
+}
+
+func TestTodoCommentRepository_CreateComment_shouldReturnError_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	input, err := domain.NewCreateCommentInput(todo.ID, userID+1, "user2", "Hello")
+	require.NoError(t, err)
+
+	// when
+	comment, _, err := repo.CreateComment(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, comment)
+}
+
+// FindComments Tests
+
+func TestTodoCommentRepository_FindComments_shouldReturnCommentsInPostingOrder_whenTodoHasComments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	first := createTestComment(t, ctx, repo, todo.ID, userID, "First")
+	second := createTestComment(t, ctx, repo, todo.ID, userID, "Second")
+	input, err := domain.NewFindCommentsInput(todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	comments, err := repo.FindComments(ctx, input)
+
+	// then
+	require.NoError(t, err, "FindComments() should not return an error")
+	require.Len(t, comments, 2)
+	assert.Equal(t, first.ID, comments[0].ID)
+	assert.Equal(t, second.ID, comments[1].ID)
+}
+
+// UpdateComment Tests
+
+func TestTodoCommentRepository_UpdateComment_shouldSetEditedAt_whenAuthorEdits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	created := createTestComment(t, ctx, repo, todo.ID, userID, "Typo")
+	input, err := domain.NewUpdateCommentInput(created.ID, todo.ID, userID, "Fixed")
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateComment(ctx, input)
+
+	// then
+	require.NoError(t, err, "UpdateComment() should not return an error")
+	assert.Equal(t, "Fixed", updated.Text)
+	require.NotNil(t, updated.EditedAt, "an edited comment should have an edit time")
+	assert.False(t, updated.EditedAt.Before(created.CreatedAt))
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt, "CreatedAt should not change")
+}
+
+func TestTodoCommentRepository_UpdateComment_shouldReturnForbidden_whenUserIsNotAuthor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	other := createTestCommentByOtherUser(t, todo.ID, userID+1)
+	input, err := domain.NewUpdateCommentInput(other.ID, todo.ID, userID, "Edited by owner")
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateComment(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	assert.Nil(t, updated)
+}
+
+func TestTodoCommentRepository_UpdateComment_shouldReturnError_whenCommentNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	input, err := domain.NewUpdateCommentInput(999999999, todo.ID, userID, "Text")
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.UpdateComment(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrCommentNotFound)
+}
+
+// DeleteComment Tests
+
+func TestTodoCommentRepository_DeleteComment_shouldDeleteComment_whenTodoOwnerDeletesOtherUsersComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	other := createTestCommentByOtherUser(t, todo.ID, userID+1)
+	input, err := domain.NewDeleteCommentInput(other.ID, todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteComment(ctx, input)
+
+	// then
+	require.NoError(t, err, "DeleteComment() should not return an error")
+	var count int64
+	require.NoError(t, db.Model(&gateway.TodoCommentEntity{}).Where("id = ?", other.ID).Count(&count).Error) //nolint:exhaustruct
+	assert.Equal(t, int64(0), count, "comment should be deleted")
+}
+
+func TestTodoCommentRepository_DeleteComment_shouldReturnError_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	created := createTestComment(t, ctx, repo, todo.ID, userID, "Mine")
+	input, err := domain.NewDeleteCommentInput(created.ID, todo.ID, userID+1)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteComment(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+}
+
+func TestTodoCommentRepository_DeleteComment_shouldBumpTodoVersion_whenCommentIsDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	created := createTestComment(t, ctx, repo, todo.ID, userID, "Mine")
+	before := findTestTodo(t, ctx, todo.ID, userID)
+	input, err := domain.NewDeleteCommentInput(created.ID, todo.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteComment(ctx, input)
+
+	// then
+	require.NoError(t, err, "DeleteComment() should not return an error")
+	after := findTestTodo(t, ctx, todo.ID, userID)
+	assert.Equal(t, 0, after.CommentCount)
+	assert.Equal(t, before.Version+1, after.Version, "deleting a comment should bump the todo version")
+}
+
+func TestTodoCommentRepository_DeleteComment_shouldNotBumpTodoVersion_whenUserIsNotAllowed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todo := createTestTodo(t, ctx, userID, "Todo")
+	repo := gateway.NewTodoCommentRepository(db)
+	created := createTestComment(t, ctx, repo, todo.ID, userID, "Mine")
+	before := findTestTodo(t, ctx, todo.ID, userID)
+	input, err := domain.NewDeleteCommentInput(created.ID, todo.ID, userID+1)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteComment(ctx, input)
+
+	// then
+	require.Error(t, err)
+	after := findTestTodo(t, ctx, todo.ID, userID)
+	assert.Equal(t, before.Version, after.Version, "a rejected delete should not bump the todo version")
+}
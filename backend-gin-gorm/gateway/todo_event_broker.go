@@ -0,0 +1,202 @@
+package gateway
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// todoEventSubscriberBufferSize is the number of events a subscriber may fall behind before it is dropped.
+const todoEventSubscriberBufferSize = 64
+
+// TodoListMemberIDsFinder defines the interface for looking up the members a todo list is shared with.
+type TodoListMemberIDsFinder interface {
+	FindTodoListMemberIDs(ctx context.Context, listOwnerID int) ([]int, error)
+}
+
+// keptTodoEvent is an event in the replay buffer together with the users it was delivered to.
+type keptTodoEvent struct {
+	event   domain.TodoEvent
+	userIDs []int
+}
+
+// todoEventSubscriber is the receiving end of one subscription.
+type todoEventSubscriber struct {
+	userID int
+	events chan domain.TodoEvent
+}
+
+// TodoEventBroker is an in-process pub/sub of todo events.
+// It keeps the latest events of all users in a bounded replay buffer so that a client that reconnects
+// with the ID of the last event it received gets the events it missed. Events are only delivered to
+// subscribers of the same process.
+type TodoEventBroker struct {
+	mu           sync.Mutex
+	epoch        int64
+	seq          int64
+	evictedSeq   int64
+	replay       []keptTodoEvent
+	replaySize   int
+	subscribers  map[int]map[*todoEventSubscriber]struct{}
+	closed       bool
+	memberFinder TodoListMemberIDsFinder
+	logger       *slog.Logger
+}
+
+// NewTodoEventBroker returns a TodoEventBroker that keeps up to replaySize events for replay and delivers
+// the events of a todo to the owner and the members of the todo list it is in, looked up with memberFinder.
+func NewTodoEventBroker(replaySize int, memberFinder TodoListMemberIDsFinder) *TodoEventBroker {
+	return &TodoEventBroker{
+		mu:           sync.Mutex{},
+		epoch:        time.Now().UnixMilli(),
+		seq:          0,
+		evictedSeq:   0,
+		replay:       make([]keptTodoEvent, 0, replaySize),
+		replaySize:   replaySize,
+		subscribers:  make(map[int]map[*todoEventSubscriber]struct{}),
+		closed:       false,
+		memberFinder: memberFinder,
+		logger:       slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-TodoEventBroker")),
+	}
+}
+
+// PublishTodoEvents assigns IDs to the events, keeps them for replay and delivers them to the subscribers of the
+// owners of their todos and of the members of the owners' todo lists. Members are looked up on each publish so that
+// a user receives the events of a list from the moment they join it until they leave it.
+// A subscriber whose buffer is full is dropped so that a slow client cannot hold up the others.
+func (b *TodoEventBroker) PublishTodoEvents(ctx context.Context, events ...domain.TodoEvent) {
+	recipients := b.findRecipients(ctx, events)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range events {
+		b.seq++
+		event.ID = domain.TodoEventID{Epoch: b.epoch, Seq: b.seq}
+		userIDs := recipients[event.UserID]
+		b.keepForReplay(keptTodoEvent{event: event, userIDs: userIDs})
+
+		for _, userID := range userIDs {
+			for subscriber := range b.subscribers[userID] {
+				select {
+				case subscriber.events <- event:
+				default:
+					b.unsubscribe(subscriber)
+				}
+			}
+		}
+	}
+}
+
+// findRecipients returns the users each owner's events are delivered to: the owner and the members of their todo list.
+// If the members cannot be looked up, the events are only delivered to the owner.
+func (b *TodoEventBroker) findRecipients(ctx context.Context, events []domain.TodoEvent) map[int][]int {
+	recipients := make(map[int][]int)
+	for _, event := range events {
+		if _, ok := recipients[event.UserID]; ok {
+			continue
+		}
+		memberIDs, err := b.memberFinder.FindTodoListMemberIDs(ctx, event.UserID)
+		if err != nil {
+			b.logger.ErrorContext(ctx, "failed to find todo list members", slog.Int("listOwnerId", event.UserID), slog.Any("error", err))
+			memberIDs = nil
+		}
+		recipients[event.UserID] = append([]int{event.UserID}, memberIDs...)
+	}
+	return recipients
+}
+
+func (b *TodoEventBroker) keepForReplay(kept keptTodoEvent) {
+	if b.replaySize == 0 {
+		b.evictedSeq = kept.event.ID.Seq
+		return
+	}
+	if len(b.replay) == b.replaySize {
+		b.evictedSeq = b.replay[0].event.ID.Seq
+		// Shift rather than reslice so that the backing array does not grow without bound
+		copy(b.replay, b.replay[1:])
+		b.replay = b.replay[:len(b.replay)-1]
+	}
+	b.replay = append(b.replay, kept)
+}
+
+// SubscribeTodoEvents subscribes to the events of the user's todos and of the todo lists shared with them published from now on.
+// If input.LastEventID is set, the events delivered to the user after it are replayed, or a reset event
+// if some of them have left the replay buffer or the ID was handed out before the broker started.
+// Returns ErrTodoEventStreamClosed after Close.
+func (b *TodoEventBroker) SubscribeTodoEvents(_ context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, domain.ErrTodoEventStreamClosed
+	}
+
+	replay := make([]domain.TodoEvent, 0)
+	if input.LastEventID != nil {
+		lastEventID := *input.LastEventID
+		if lastEventID.Epoch != b.epoch || lastEventID.Seq < b.evictedSeq || lastEventID.Seq > b.seq {
+			reset := domain.NewTodoResetEvent(input.UserID)
+			reset.ID = domain.TodoEventID{Epoch: b.epoch, Seq: b.seq}
+			replay = append(replay, reset)
+		} else {
+			for _, kept := range b.replay {
+				if kept.event.ID.Seq > lastEventID.Seq && slices.Contains(kept.userIDs, input.UserID) {
+					replay = append(replay, kept.event)
+				}
+			}
+		}
+	}
+
+	subscriber := &todoEventSubscriber{
+		userID: input.UserID,
+		events: make(chan domain.TodoEvent, todoEventSubscriberBufferSize),
+	}
+	if b.subscribers[input.UserID] == nil {
+		b.subscribers[input.UserID] = make(map[*todoEventSubscriber]struct{})
+	}
+	b.subscribers[input.UserID][subscriber] = struct{}{}
+
+	return &domain.TodoEventSubscription{
+		Replay: replay,
+		Events: subscriber.events,
+		Close: func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.unsubscribe(subscriber)
+		},
+	}, nil
+}
+
+// unsubscribe removes the subscriber and closes its channel. It must be called with the lock held.
+func (b *TodoEventBroker) unsubscribe(subscriber *todoEventSubscriber) {
+	userSubscribers, ok := b.subscribers[subscriber.userID]
+	if !ok {
+		return
+	}
+	if _, ok := userSubscribers[subscriber]; !ok {
+		return
+	}
+	delete(userSubscribers, subscriber)
+	if len(userSubscribers) == 0 {
+		delete(b.subscribers, subscriber.userID)
+	}
+	close(subscriber.events)
+}
+
+// Close ends all subscriptions and rejects new ones, so that open event streams finish and the web server can shut down.
+func (b *TodoEventBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for _, userSubscribers := range b.subscribers {
+		for subscriber := range userSubscribers {
+			b.unsubscribe(subscriber)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+// todoListMemberIDsStub maps the owner of a todo list to the members it is shared with.
+type todoListMemberIDsStub map[int][]int
+
+func (s todoListMemberIDsStub) FindTodoListMemberIDs(_ context.Context, listOwnerID int) ([]int, error) {
+	return s[listOwnerID], nil
+}
+
+func subscribeTodoEvents(t *testing.T, broker *gateway.TodoEventBroker, userID int, lastEventID *domain.TodoEventID) *domain.TodoEventSubscription {
+	t.Helper()
+	input, err := domain.NewSubscribeTodoEventsInput(userID, lastEventID)
+	require.NoError(t, err)
+	subscription, err := broker.SubscribeTodoEvents(context.Background(), input)
+	require.NoError(t, err)
+	t.Cleanup(subscription.Close)
+	return subscription
+}
+
+func Test_TodoEventBroker_PublishTodoEvents_shouldDeliverToSubscribersOfUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	broker := gateway.NewTodoEventBroker(10, todoListMemberIDsStub{})
+	mine := subscribeTodoEvents(t, broker, 1, nil)
+	others := subscribeTodoEvents(t, broker, 2, nil)
+
+	// when
+	broker.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(1, 100))
+
+	// then
+	event := <-mine.Events
+	assert.Equal(t, domain.TodoEventDeleted, event.Type)
+	assert.Equal(t, 100, event.TodoID)
+	assert.Equal(t, int64(1), event.ID.Seq)
+	assert.Empty(t, mine.Replay)
+	assert.Empty(t, others.Events, "events of other users should not be delivered")
+}
+
+func Test_TodoEventBroker_PublishTodoEvents_shouldDeliverToMembersOfSharedList(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	members := todoListMemberIDsStub{1: {2}}
+	broker := gateway.NewTodoEventBroker(10, members)
+	owner := subscribeTodoEvents(t, broker, 1, nil)
+	member := subscribeTodoEvents(t, broker, 2, nil)
+	others := subscribeTodoEvents(t, broker, 3, nil)
+
+	// when
+	broker.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(1, 100))
+
+	// then
+	assert.Equal(t, 100, (<-owner.Events).TodoID)
+	event := <-member.Events
+	assert.Equal(t, 100, event.TodoID)
+	assert.Equal(t, 1, event.UserID, "the event should name the owner of the todo")
+	assert.Empty(t, others.Events, "events should not be delivered to users the list is not shared with")
+
+	// - members are looked up on each publish, so a user who joins the list receives its next events
+	members[1] = []int{2, 3}
+	broker.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(1, 101))
+	assert.Equal(t, 101, (<-others.Events).TodoID)
+}
+
+func Test_TodoEventBroker_SubscribeTodoEvents_shouldReplayEventsOfSharedList_whenLastEventIDIsBuffered(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	broker := gateway.NewTodoEventBroker(10, todoListMemberIDsStub{1: {2}})
+	first := subscribeTodoEvents(t, broker, 2, nil)
+	broker.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(2, 200), domain.NewTodoDeletedEvent(1, 100), domain.NewTodoDeletedEvent(3, 300))
+	lastEventID := (<-first.Events).ID
+
+	// when
+	subscription := subscribeTodoEvents(t, broker, 2, &lastEventID)
+
+	// then
+	require.Len(t, subscription.Replay, 1)
+	assert.Equal(t, 100, subscription.Replay[0].TodoID)
+}
+
+func Test_TodoEventBroker_SubscribeTodoEvents_shouldReplayMissedEvents_whenLastEventIDIsBuffered(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	broker := gateway.NewTodoEventBroker(10, todoListMemberIDsStub{})
+	first := subscribeTodoEvents(t, broker, 1, nil)
+	broker.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(1, 100), domain.NewTodoDeletedEvent(2, 200), domain.NewTodoDeletedEvent(1, 101))
+	lastEventID := (<-first.Events).ID
+
+	// when
+	subscription := subscribeTodoEvents(t, broker, 1, &lastEventID)
+
+	// then
+	require.Len(t, subscription.Replay, 1)
+	assert.Equal(t, 101, subscription.Replay[0].TodoID)
+}
+
+func Test_TodoEventBroker_SubscribeTodoEvents_shouldReplayReset_whenLastEventIDIsNoLongerBuffered(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	broker := gateway.NewTodoEventBroker(2, todoListMemberIDsStub{})
+	first := subscribeTodoEvents(t, broker, 1, nil)
+	broker.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(1, 100), domain.NewTodoDeletedEvent(1, 101), domain.NewTodoDeletedEvent(1, 102), domain.NewTodoDeletedEvent(1, 103))
+	lastEventID := (<-first.Events).ID
+	unknownEpoch := domain.TodoEventID{Epoch: 1, Seq: 0}
+
+	tests := []struct {
+		name        string
+		lastEventID domain.TodoEventID
+	}{
+		{name: "evicted from the replay buffer", lastEventID: lastEventID},
+		{name: "handed out before a restart", lastEventID: unknownEpoch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			subscription := subscribeTodoEvents(t, broker, 1, &tt.lastEventID)
+
+			// then
+			require.Len(t, subscription.Replay, 1)
+			assert.Equal(t, domain.TodoEventReset, subscription.Replay[0].Type)
+			assert.Equal(t, int64(4), subscription.Replay[0].ID.Seq, "a reset should resume from the latest event")
+		})
+	}
+}
+
+func Test_TodoEventBroker_Close_shouldEndSubscriptionsAndRejectNewOnes(t *testing.T) {
+	t.Parallel()
+
+	// given
+	broker := gateway.NewTodoEventBroker(10, todoListMemberIDsStub{})
+	subscription := subscribeTodoEvents(t, broker, 1, nil)
+
+	// when
+	broker.Close()
+
+	// then
+	_, ok := <-subscription.Events
+	assert.False(t, ok, "the events channel should be closed")
+	input, err := domain.NewSubscribeTodoEventsInput(1, nil)
+	require.NoError(t, err)
+	_, err = broker.SubscribeTodoEvents(context.Background(), input)
+	require.ErrorIs(t, err, domain.ErrTodoEventStreamClosed)
+}
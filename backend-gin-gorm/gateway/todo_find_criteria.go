@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// todoSortColumns maps each sort field to its column.
+// Only these column names are ever written into ORDER BY clauses and keyset conditions.
+var todoSortColumns = map[domain.TodoSortField]string{
+	domain.TodoSortFieldID:        "id",
+	domain.TodoSortFieldCreatedAt: "created_at",
+	domain.TodoSortFieldUpdatedAt: "updated_at",
+	domain.TodoSortFieldText:      "text",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// todoFilterScope narrows a todo query by the filter. Every value is passed as a bind parameter.
+func todoFilterScope(filter *domain.TodoFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter.IsComplete != nil {
+			db = db.Where("is_complete = ?", *filter.IsComplete)
+		}
+		if filter.CreatedFrom != nil {
+			db = db.Where("created_at >= ?", *filter.CreatedFrom)
+		}
+		if filter.CreatedTo != nil {
+			db = db.Where("created_at < ?", *filter.CreatedTo)
+		}
+		if filter.UpdatedFrom != nil {
+			db = db.Where("updated_at >= ?", *filter.UpdatedFrom)
+		}
+		if filter.UpdatedTo != nil {
+			db = db.Where("updated_at < ?", *filter.UpdatedTo)
+		}
+		if filter.TextContains != "" {
+			db = db.Where("text LIKE ?", "%"+likeEscaper.Replace(filter.TextContains)+"%")
+		}
+		return db
+	}
+}
+
+// todoSortScope orders a todo query by the sort and, when a cursor is given, starts it after the cursor.
+// Todos with the same sort value are ordered by ID so that the order, and therefore the keyset, is total.
+func todoSortScope(sort domain.TodoSort, cursor *domain.TodoCursor) (func(db *gorm.DB) *gorm.DB, error) {
+	column, ok := todoSortColumns[sort.Field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort field %q", sort.Field)
+	}
+	desc := sort.Direction == domain.SortDirectionDesc
+	op := ">"
+	if desc {
+		op = "<"
+	}
+
+	var afterValue any
+	if cursor != nil && sort.Field != domain.TodoSortFieldID {
+		v, err := parseTodoSortValue(sort.Field, cursor.AfterValue)
+		if err != nil {
+			return nil, fmt.Errorf("parse cursor value: %w", err)
+		}
+		afterValue = v
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil {
+			if sort.Field == domain.TodoSortFieldID {
+				db = db.Where(fmt.Sprintf("id %s ?", op), cursor.AfterID)
+			} else {
+				db = db.Where(fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))", column, op), afterValue, afterValue, cursor.AfterID)
+			}
+		}
+
+		columns := []clause.OrderByColumn{{Column: clause.Column{Name: column}, Desc: desc}} //nolint:exhaustruct
+		if sort.Field != domain.TodoSortFieldID {
+			columns = append(columns, clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: desc}) //nolint:exhaustruct
+		}
+		return db.Order(clause.OrderBy{Columns: columns}) //nolint:exhaustruct
+	}, nil
+}
+
+// todoSortValue returns the value of the sort field of the todo in the form stored in cursors.
+func todoSortValue(e *TodoEntity, field domain.TodoSortField) string {
+	switch field {
+	case domain.TodoSortFieldCreatedAt:
+		return e.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case domain.TodoSortFieldUpdatedAt:
+		return e.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case domain.TodoSortFieldText:
+		return e.Text
+	default:
+		return ""
+	}
+}
+
+func parseTodoSortValue(field domain.TodoSortField, value string) (any, error) {
+	switch field {
+	case domain.TodoSortFieldCreatedAt, domain.TodoSortFieldUpdatedAt:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		return t, nil
+	default:
+		return value, nil
+	}
+}
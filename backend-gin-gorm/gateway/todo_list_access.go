@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// findTodoListRole returns the role of the user in the todo list of listOwnerID: TodoListOwner for the owner
+// and the role of the membership for a member. Returns ErrTodoListNotFound if the list is not shared with the user.
+func findTodoListRole(db *gorm.DB, listOwnerID int, userID int) (domain.TodoListRole, error) {
+	if listOwnerID == userID {
+		return domain.TodoListOwner, nil
+	}
+
+	var entity TodoListMemberEntity
+	if result := db.Select("role").Where("list_owner_id = ? AND user_id = ?", listOwnerID, userID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return "", domain.ErrTodoListNotFound
+		}
+		return "", fmt.Errorf("find todo list member: %w", result.Error)
+	}
+	return domain.TodoListRole(entity.Role), nil
+}
+
+// authorizeTodoList checks that the role of the user in the todo list of listOwnerID allows what required does.
+// Returns ErrTodoListNotFound if the list is not shared with the user and ErrForbidden if the role does not allow it.
+func authorizeTodoList(db *gorm.DB, listOwnerID int, userID int, required domain.TodoListRole) error {
+	role, err := findTodoListRole(db, listOwnerID, userID)
+	if err != nil {
+		return err
+	}
+	if !role.Allows(required) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeTodo checks that the user may access the todo with the permissions of required and returns the owner
+// of the todo, whose todo list it is in. Returns ErrTodoNotFound if there is no such todo or its list is not shared
+// with the user, so that the todos of others are not revealed, and ErrForbidden if the role of the user does not allow the access.
+// Todos in the trash are only found through an Unscoped db. The owner of a todo never changes, so writers call it
+// before taking the change sequence number of the owner and locking the todo.
+func authorizeTodo(db *gorm.DB, todoID int, userID int, required domain.TodoListRole) (int, error) {
+	var ownerIDs []int
+	if result := db.Model(&TodoEntity{}).Where("id = ?", todoID).Pluck("user_id", &ownerIDs); result.Error != nil { //nolint:exhaustruct
+		return 0, fmt.Errorf("find todo owner: %w", result.Error)
+	}
+	if len(ownerIDs) == 0 {
+		return 0, domain.ErrTodoNotFound
+	}
+
+	err := authorizeTodoList(db, ownerIDs[0], userID, required)
+	if errors.Is(err, domain.ErrTodoListNotFound) {
+		return 0, domain.ErrTodoNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return ownerIDs[0], nil
+}
@@ -0,0 +1,196 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+// The access checks of todo_list_access.go are unexported, so they are tested through the repository methods that use them.
+
+func TestTodoListMemberRepository_FindTodoListRole_shouldReturnRoleOfUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+	viewerID := ownerID + 2
+	strangerID := ownerID + 3
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, editorID, domain.TodoListEditor)
+	shareTestTodoList(t, ctx, repo, ownerID, viewerID, domain.TodoListViewer)
+
+	tests := []struct {
+		name    string
+		userID  int
+		want    domain.TodoListRole
+		wantErr error
+	}{
+		{name: "owner", userID: ownerID, want: domain.TodoListOwner, wantErr: nil},
+		{name: "editor", userID: editorID, want: domain.TodoListEditor, wantErr: nil},
+		{name: "viewer", userID: viewerID, want: domain.TodoListViewer, wantErr: nil},
+		{name: "not a member", userID: strangerID, want: "", wantErr: domain.ErrTodoListNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			role, err := repo.FindTodoListRole(ctx, ownerID, tt.userID)
+
+			// then
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, role)
+		})
+	}
+}
+
+func TestTodoListMemberRepository_FindTodoListMembers_shouldReturnNotFound_whenUserIsNotMember(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+	strangerID := ownerID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, viewerID, domain.TodoListViewer)
+	viewerInput, err := domain.NewFindTodoListMembersInput(ownerID, viewerID)
+	require.NoError(t, err)
+	strangerInput, err := domain.NewFindTodoListMembersInput(ownerID, strangerID)
+	require.NoError(t, err)
+
+	// when
+	members, viewerErr := repo.FindTodoListMembers(ctx, viewerInput)
+	_, strangerErr := repo.FindTodoListMembers(ctx, strangerInput)
+
+	// then
+	require.NoError(t, viewerErr, "a viewer should see the members of the list")
+	assert.Len(t, members, 1)
+	require.ErrorIs(t, strangerErr, domain.ErrTodoListNotFound, "the list should not be revealed to a user it is not shared with")
+}
+
+func TestTodoListMemberRepository_InviteTodoListMember_shouldReturnForbidden_whenUserIsViewer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, viewerID, domain.TodoListViewer)
+	input, err := domain.NewInviteTodoListMemberInput(ownerID, viewerID, testLoginIDOf(viewerID), testLoginIDOf(ownerID+2), domain.TodoListViewer)
+	require.NoError(t, err)
+
+	// when
+	invitation, err := repo.InviteTodoListMember(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	assert.Nil(t, invitation)
+}
+
+func TestTodoRepository_shouldAuthorizeTodoByRole(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+	viewerID := ownerID + 2
+	strangerID := ownerID + 3
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	memberRepo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, memberRepo, ownerID, editorID, domain.TodoListEditor)
+	shareTestTodoList(t, ctx, memberRepo, ownerID, viewerID, domain.TodoListViewer)
+	repo := gateway.NewTodoRepository(db)
+
+	tests := []struct {
+		name     string
+		userID   int
+		readErr  error
+		writeErr error
+	}{
+		{name: "owner", userID: ownerID, readErr: nil, writeErr: nil},
+		{name: "editor", userID: editorID, readErr: nil, writeErr: nil},
+		{name: "viewer", userID: viewerID, readErr: nil, writeErr: domain.ErrForbidden},
+		{name: "not a member", userID: strangerID, readErr: domain.ErrTodoNotFound, writeErr: domain.ErrTodoNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			todo := createTestTodo(t, ctx, ownerID, "Shared todo")
+			findInput, err := domain.NewFindTodoInput(todo.ID, tt.userID)
+			require.NoError(t, err)
+			updateInput, err := domain.NewUpdateTodoInput(todo.ID, tt.userID, "Updated", false, nil)
+			require.NoError(t, err)
+
+			// when
+			_, readErr := repo.FindTodo(ctx, findInput)
+			_, writeErr := repo.UpdateTodo(ctx, updateInput)
+
+			// then
+			if tt.readErr == nil {
+				require.NoError(t, readErr)
+			} else {
+				require.ErrorIs(t, readErr, tt.readErr)
+			}
+			if tt.writeErr == nil {
+				require.NoError(t, writeErr)
+				return
+			}
+			require.ErrorIs(t, writeErr, tt.writeErr)
+			// 拒否された書き込みは todo を変えない
+			ownerInput, err := domain.NewFindTodoInput(todo.ID, ownerID)
+			require.NoError(t, err)
+			current, err := repo.FindTodo(ctx, ownerInput)
+			require.NoError(t, err)
+			assert.Equal(t, "Shared todo", current.Text)
+			assert.Equal(t, todo.Version, current.Version)
+		})
+	}
+}
+
+func TestTodoRepository_DeleteTodo_shouldReturnOwnerID_whenEditorDeletesSharedTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	cleanupOutboxTable(t, ownerID)
+	cleanupOutboxTable(t, editorID)
+	shareTestTodoList(t, ctx, gateway.NewTodoListMemberRepository(db), ownerID, editorID, domain.TodoListEditor)
+	repo := gateway.NewTodoRepository(db)
+	todo := createTestTodo(t, ctx, ownerID, "Shared todo")
+	input, err := domain.NewDeleteTodoInput(todo.ID, editorID, nil)
+	require.NoError(t, err)
+
+	// when
+	deletedOwnerID, err := repo.DeleteTodo(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ownerID, deletedOwnerID)
+	entities := findOutboxEvents(t, ownerID)
+	require.Len(t, entities, 2, "the delete should be reported to the owner of the todo")
+	assert.Equal(t, string(domain.TodoEventDeleted), entities[1].EventType)
+	assert.Empty(t, findOutboxEvents(t, editorID))
+}
@@ -0,0 +1,295 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListMemberEntity is the GORM model for the "todo_list_member" table.
+type TodoListMemberEntity struct {
+	ListOwnerID int       `gorm:"primaryKey;autoIncrement:false"`
+	UserID      int       `gorm:"primaryKey;autoIncrement:false"`
+	LoginID     string    `gorm:"type:varchar(255);not null"`
+	Role        string    `gorm:"type:varchar(10);not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (e *TodoListMemberEntity) TableName() string {
+	return "todo_list_member"
+}
+
+func (e *TodoListMemberEntity) toTodoListMember() (*domain.TodoListMember, error) {
+	member, err := domain.NewTodoListMember(e.ListOwnerID, e.UserID, e.LoginID, domain.TodoListRole(e.Role), e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to todo list member model: %w", err)
+	}
+	return member, nil
+}
+
+// TodoListMemberEntities is a slice of TodoListMemberEntity with batch conversion support.
+type TodoListMemberEntities []TodoListMemberEntity
+
+func (e TodoListMemberEntities) toTodoListMembers() ([]domain.TodoListMember, error) {
+	members := make([]domain.TodoListMember, len(e))
+	for i, memberE := range e {
+		member, err := memberE.toTodoListMember()
+		if err != nil {
+			return nil, fmt.Errorf("to todo list member: %w", err)
+		}
+		members[i] = *member
+	}
+	return members, nil
+}
+
+// TodoListInvitationEntity is the GORM model for the "todo_list_invitation" table.
+type TodoListInvitationEntity struct {
+	ID             int       `gorm:"primaryKey;autoIncrement"`
+	ListOwnerID    int       `gorm:"not null"`
+	InviteeLoginID string    `gorm:"type:varchar(255);not null"`
+	Role           string    `gorm:"type:varchar(10);not null"`
+	InviterUserID  int       `gorm:"not null"`
+	InviterLoginID string    `gorm:"type:varchar(255);not null"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+func (e *TodoListInvitationEntity) TableName() string {
+	return "todo_list_invitation"
+}
+
+func (e *TodoListInvitationEntity) toTodoListInvitation() (*domain.TodoListInvitation, error) {
+	invitation, err := domain.NewTodoListInvitation(e.ID, e.ListOwnerID, e.InviteeLoginID, domain.TodoListRole(e.Role), e.InviterUserID, e.InviterLoginID, e.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to todo list invitation model: %w", err)
+	}
+	return invitation, nil
+}
+
+// TodoListInvitationEntities is a slice of TodoListInvitationEntity with batch conversion support.
+type TodoListInvitationEntities []TodoListInvitationEntity
+
+func (e TodoListInvitationEntities) toTodoListInvitations() ([]domain.TodoListInvitation, error) {
+	invitations := make([]domain.TodoListInvitation, len(e))
+	for i, invitationE := range e {
+		invitation, err := invitationE.toTodoListInvitation()
+		if err != nil {
+			return nil, fmt.Errorf("to todo list invitation: %w", err)
+		}
+		invitations[i] = *invitation
+	}
+	return invitations, nil
+}
+
+// TodoListMemberRepository implements the sharing of todo lists, their members and invitations, using GORM.
+type TodoListMemberRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoListMemberRepository returns a new TodoListMemberRepository backed by the given GORM DB.
+func NewTodoListMemberRepository(db *gorm.DB) *TodoListMemberRepository {
+	return &TodoListMemberRepository{
+		db: db,
+	}
+}
+
+// FindTodoListRole returns the role of the user in the todo list of listOwnerID, TodoListOwner for their own list.
+// Returns ErrTodoListNotFound if the list is not shared with the user.
+func (r *TodoListMemberRepository) FindTodoListRole(ctx context.Context, listOwnerID int, userID int) (domain.TodoListRole, error) {
+	return findTodoListRole(r.db.WithContext(ctx), listOwnerID, userID)
+}
+
+// InviteTodoListMember invites input.InviteeLoginID to the todo list of input.ListOwnerID on behalf of the user.
+// Inviting a login ID that already has a pending invitation to the list renews it with the new role and inviter.
+// Returns ErrTodoListNotFound if the list is not shared with the user and ErrForbidden if the user is not an owner of it.
+func (r *TodoListMemberRepository) InviteTodoListMember(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.TodoListInvitation, error) {
+	entity := &TodoListInvitationEntity{ //nolint:exhaustruct
+		ListOwnerID:    input.ListOwnerID,
+		InviteeLoginID: input.InviteeLoginID,
+		Role:           string(input.Role),
+		InviterUserID:  input.UserID,
+		InviterLoginID: input.LoginID,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := authorizeTodoList(tx, input.ListOwnerID, input.UserID, domain.TodoListOwner); err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{ //nolint:exhaustruct
+			DoUpdates: clause.Assignments(map[string]any{
+				"role":             entity.Role,
+				"inviter_user_id":  entity.InviterUserID,
+				"inviter_login_id": entity.InviterLoginID,
+				"created_at":       gorm.Expr("CURRENT_TIMESTAMP(6)"),
+			}),
+		}).Create(entity)
+		if result.Error != nil {
+			return fmt.Errorf("create todo list invitation: %w", result.Error)
+		}
+
+		// Re-read by the unique key, as the ID is not reported when an invitation is renewed
+		query := tx.Where("list_owner_id = ? AND invitee_login_id = ?", input.ListOwnerID, input.InviteeLoginID)
+		if result := query.First(entity); result.Error != nil {
+			return fmt.Errorf("reload todo list invitation: %w", result.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invite todo list member: %w", err)
+	}
+
+	invitation, err := entity.toTodoListInvitation()
+	if err != nil {
+		return nil, fmt.Errorf("to todo list invitation: %w", err)
+	}
+
+	return invitation, nil
+}
+
+// FindTodoListInvitations returns the pending invitations addressed to the login ID, oldest first.
+func (r *TodoListMemberRepository) FindTodoListInvitations(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error) {
+	var entities TodoListInvitationEntities
+	if result := r.db.WithContext(ctx).Where("invitee_login_id = ?", loginID).Order("id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find todo list invitations: %w", result.Error)
+	}
+	invitations, err := entities.toTodoListInvitations()
+	if err != nil {
+		return nil, fmt.Errorf("to todo list invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+// AnswerTodoListInvitation accepts or declines an invitation addressed to the login ID of the user and removes it.
+// Accepting makes the user a member of the list with the role of the invitation, replacing the role of a member,
+// and returns the membership; declining returns nil. Returns ErrTodoListInvitationNotFound if there is no such invitation
+// for the user and ErrOwnTodoList, leaving the invitation in place, if the user accepts an invitation to their own list.
+func (r *TodoListMemberRepository) AnswerTodoListInvitation(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error) {
+	var member *TodoListMemberEntity
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invitation TodoListInvitationEntity
+		query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where("id = ? AND invitee_login_id = ?", input.InvitationID, input.LoginID)
+		if result := query.First(&invitation); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return domain.ErrTodoListInvitationNotFound
+			}
+			return fmt.Errorf("lock todo list invitation: %w", result.Error)
+		}
+		if input.Accept && invitation.ListOwnerID == input.UserID {
+			return domain.ErrOwnTodoList
+		}
+
+		if result := tx.Delete(&invitation); result.Error != nil {
+			return fmt.Errorf("delete todo list invitation: %w", result.Error)
+		}
+		if !input.Accept {
+			return nil
+		}
+
+		member = &TodoListMemberEntity{ //nolint:exhaustruct
+			ListOwnerID: invitation.ListOwnerID,
+			UserID:      input.UserID,
+			LoginID:     input.LoginID,
+			Role:        invitation.Role,
+		}
+		result := tx.Clauses(clause.OnConflict{ //nolint:exhaustruct
+			DoUpdates: clause.AssignmentColumns([]string{"login_id", "role"}),
+		}).Create(member)
+		if result.Error != nil {
+			return fmt.Errorf("create todo list member: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.Where("list_owner_id = ? AND user_id = ?", member.ListOwnerID, member.UserID).First(member); result.Error != nil {
+			return fmt.Errorf("reload todo list member: %w", result.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("answer todo list invitation: %w", err)
+	}
+	if member == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	todoListMember, err := member.toTodoListMember()
+	if err != nil {
+		return nil, fmt.Errorf("to todo list member: %w", err)
+	}
+
+	return todoListMember, nil
+}
+
+// FindTodoListMembers returns the members of the todo list of input.ListOwnerID in the order they joined.
+// Returns ErrTodoListNotFound if the list is not shared with the user.
+func (r *TodoListMemberRepository) FindTodoListMembers(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error) {
+	db := r.db.WithContext(ctx)
+	if err := authorizeTodoList(db, input.ListOwnerID, input.UserID, domain.TodoListViewer); err != nil {
+		return nil, err
+	}
+
+	var entities TodoListMemberEntities
+	if result := db.Where("list_owner_id = ?", input.ListOwnerID).Order("created_at, user_id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find todo list members: %w", result.Error)
+	}
+	members, err := entities.toTodoListMembers()
+	if err != nil {
+		return nil, fmt.Errorf("to todo list members: %w", err)
+	}
+	return members, nil
+}
+
+// RemoveTodoListMember removes input.MemberUserID from the todo list of input.ListOwnerID. Owners of the list can
+// remove any member and members can remove themselves. Returns ErrTodoListNotFound if the list is not shared with
+// the user, ErrForbidden if the user may not remove the member and ErrTodoListMemberNotFound if there is no such member.
+func (r *TodoListMemberRepository) RemoveTodoListMember(ctx context.Context, input *domain.RemoveTodoListMemberInput) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if input.MemberUserID != input.UserID {
+			if err := authorizeTodoList(tx, input.ListOwnerID, input.UserID, domain.TodoListOwner); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Where("list_owner_id = ? AND user_id = ?", input.ListOwnerID, input.MemberUserID).Delete(&TodoListMemberEntity{}) //nolint:exhaustruct
+		if result.Error != nil {
+			return fmt.Errorf("delete todo list member: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrTodoListMemberNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("remove todo list member: %w", err)
+	}
+
+	return nil
+}
+
+// FindSharedTodoLists returns the memberships of the user, one for each todo list shared with them, in the order they joined.
+func (r *TodoListMemberRepository) FindSharedTodoLists(ctx context.Context, userID int) ([]domain.TodoListMember, error) {
+	var entities TodoListMemberEntities
+	if result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at, list_owner_id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find shared todo lists: %w", result.Error)
+	}
+	members, err := entities.toTodoListMembers()
+	if err != nil {
+		return nil, fmt.Errorf("to todo list members: %w", err)
+	}
+	return members, nil
+}
+
+// FindTodoListMemberIDs returns the user IDs of the members of the todo list of listOwnerID, not including the owner.
+func (r *TodoListMemberRepository) FindTodoListMemberIDs(ctx context.Context, listOwnerID int) ([]int, error) {
+	memberIDs := make([]int, 0)
+	if result := r.db.WithContext(ctx).Model(&TodoListMemberEntity{}).Where("list_owner_id = ?", listOwnerID).Order("user_id").Pluck("user_id", &memberIDs); result.Error != nil { //nolint:exhaustruct
+		return nil, fmt.Errorf("find todo list member IDs: %w", result.Error)
+	}
+	return memberIDs, nil
+}
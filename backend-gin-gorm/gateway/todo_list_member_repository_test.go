@@ -0,0 +1,325 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func cleanupTodoListMemberTable(t *testing.T, listOwnerID int) {
+	t.Helper()
+	if err := db.Exec("DELETE FROM todo_list_member WHERE list_owner_id = ?", listOwnerID).Error; err != nil {
+		t.Fatalf("Failed to delete from table todo_list_member: %v", err)
+	}
+	if err := db.Exec("DELETE FROM todo_list_invitation WHERE list_owner_id = ?", listOwnerID).Error; err != nil {
+		t.Fatalf("Failed to delete from table todo_list_invitation: %v", err)
+	}
+}
+
+func testLoginIDOf(userID int) string {
+	return "user" + strconv.Itoa(userID)
+}
+
+// shareTestTodoList invites memberID to the todo list of ownerID with role and accepts the invitation.
+func shareTestTodoList(t *testing.T, ctx context.Context, repo *gateway.TodoListMemberRepository, ownerID int, memberID int, role domain.TodoListRole) *domain.TodoListMember {
+	t.Helper()
+	inviteInput, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, testLoginIDOf(ownerID), testLoginIDOf(memberID), role)
+	require.NoError(t, err)
+	invitation, err := repo.InviteTodoListMember(ctx, inviteInput)
+	require.NoError(t, err, "Failed to insert test data")
+	answerInput, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, memberID, testLoginIDOf(memberID), true)
+	require.NoError(t, err)
+	member, err := repo.AnswerTodoListInvitation(ctx, answerInput)
+	require.NoError(t, err, "Failed to insert test data")
+	return member
+}
+
+// InviteTodoListMember Tests
+
+func TestTodoListMemberRepository_InviteTodoListMember_shouldRenewInvitation_whenLoginIDInvitedAgain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	inviteeLoginID := testLoginIDOf(ownerID + 1)
+	input, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, testLoginIDOf(ownerID), inviteeLoginID, domain.TodoListViewer)
+	require.NoError(t, err)
+	first, err := repo.InviteTodoListMember(ctx, input)
+	require.NoError(t, err)
+
+	// when
+	input.Role = domain.TodoListEditor
+	second, err := repo.InviteTodoListMember(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID, "the pending invitation should be renewed")
+	assert.Equal(t, domain.TodoListEditor, second.Role)
+	invitations, err := repo.FindTodoListInvitations(ctx, inviteeLoginID)
+	require.NoError(t, err)
+	var roles []domain.TodoListRole
+	for _, invitation := range invitations {
+		if invitation.ListOwnerID == ownerID {
+			roles = append(roles, invitation.Role)
+		}
+	}
+	assert.Equal(t, []domain.TodoListRole{domain.TodoListEditor}, roles)
+}
+
+func TestTodoListMemberRepository_InviteTodoListMember_shouldReturnForbidden_whenUserIsEditor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, editorID, domain.TodoListEditor)
+	input, err := domain.NewInviteTodoListMemberInput(ownerID, editorID, testLoginIDOf(editorID), testLoginIDOf(ownerID+2), domain.TodoListViewer)
+	require.NoError(t, err)
+
+	// when
+	invitation, err := repo.InviteTodoListMember(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	assert.Nil(t, invitation)
+}
+
+// AnswerTodoListInvitation Tests
+
+func TestTodoListMemberRepository_AnswerTodoListInvitation_shouldShareList_whenAccepted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	memberID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+
+	// when
+	member := shareTestTodoList(t, ctx, repo, ownerID, memberID, domain.TodoListViewer)
+
+	// then
+	assert.Equal(t, ownerID, member.ListOwnerID)
+	assert.Equal(t, memberID, member.UserID)
+	assert.Equal(t, domain.TodoListViewer, member.Role)
+	role, err := repo.FindTodoListRole(ctx, ownerID, memberID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TodoListViewer, role)
+	lists, err := repo.FindSharedTodoLists(ctx, memberID)
+	require.NoError(t, err)
+	var ownerIDs []int
+	for _, list := range lists {
+		ownerIDs = append(ownerIDs, list.ListOwnerID)
+	}
+	assert.Contains(t, ownerIDs, ownerID)
+}
+
+func TestTodoListMemberRepository_AnswerTodoListInvitation_shouldReturnNotFound_whenAddressedToOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	inviteInput, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, testLoginIDOf(ownerID), testLoginIDOf(ownerID+1), domain.TodoListViewer)
+	require.NoError(t, err)
+	invitation, err := repo.InviteTodoListMember(ctx, inviteInput)
+	require.NoError(t, err)
+	input, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, ownerID+2, testLoginIDOf(ownerID+2), true)
+	require.NoError(t, err)
+
+	// when
+	member, err := repo.AnswerTodoListInvitation(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoListInvitationNotFound)
+	assert.Nil(t, member)
+}
+
+func TestTodoListMemberRepository_AnswerTodoListInvitation_shouldNotShareList_whenDeclined(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	inviteeID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	inviteInput, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, testLoginIDOf(ownerID), testLoginIDOf(inviteeID), domain.TodoListEditor)
+	require.NoError(t, err)
+	invitation, err := repo.InviteTodoListMember(ctx, inviteInput)
+	require.NoError(t, err)
+	input, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, inviteeID, testLoginIDOf(inviteeID), false)
+	require.NoError(t, err)
+
+	// when
+	member, err := repo.AnswerTodoListInvitation(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, member)
+	_, err = repo.FindTodoListRole(ctx, ownerID, inviteeID)
+	require.ErrorIs(t, err, domain.ErrTodoListNotFound)
+	_, err = repo.AnswerTodoListInvitation(ctx, input)
+	require.ErrorIs(t, err, domain.ErrTodoListInvitationNotFound, "a declined invitation should be removed")
+}
+
+// RemoveTodoListMember Tests
+
+func TestTodoListMemberRepository_RemoveTodoListMember_shouldRemoveMember_whenMemberLeaves(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	memberID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, memberID, domain.TodoListViewer)
+	input, err := domain.NewRemoveTodoListMemberInput(ownerID, memberID, memberID)
+	require.NoError(t, err)
+
+	// when
+	err = repo.RemoveTodoListMember(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	_, err = repo.FindTodoListRole(ctx, ownerID, memberID)
+	require.ErrorIs(t, err, domain.ErrTodoListNotFound)
+}
+
+func TestTodoListMemberRepository_RemoveTodoListMember_shouldReturnForbidden_whenViewerRemovesOtherMember(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+	editorID := ownerID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, viewerID, domain.TodoListViewer)
+	shareTestTodoList(t, ctx, repo, ownerID, editorID, domain.TodoListEditor)
+	input, err := domain.NewRemoveTodoListMemberInput(ownerID, viewerID, editorID)
+	require.NoError(t, err)
+
+	// when
+	err = repo.RemoveTodoListMember(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	members, err := repo.FindTodoListMembers(ctx, &domain.FindTodoListMembersInput{ListOwnerID: ownerID, UserID: ownerID})
+	require.NoError(t, err)
+	assert.Len(t, members, 2, "no member should be removed")
+}
+
+// FindTodoListMemberIDs Tests
+
+func TestTodoListMemberRepository_FindTodoListMemberIDs_shouldReturnMembersOfList(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+	editorID := ownerID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(db)
+	shareTestTodoList(t, ctx, repo, ownerID, viewerID, domain.TodoListViewer)
+	shareTestTodoList(t, ctx, repo, ownerID, editorID, domain.TodoListEditor)
+
+	// when
+	memberIDs, err := repo.FindTodoListMemberIDs(ctx, ownerID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []int{viewerID, editorID}, memberIDs)
+}
+
+// Sharing authorization Tests
+
+func TestTodoRepository_UpdateTodo_shouldUpdateSharedTodo_whenUserIsEditor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	todo := createTestTodo(t, ctx, ownerID, "Shared todo")
+	shareTestTodoList(t, ctx, gateway.NewTodoListMemberRepository(db), ownerID, editorID, domain.TodoListEditor)
+	repo := gateway.NewTodoRepository(db)
+	input, err := domain.NewUpdateTodoInput(todo.ID, editorID, "Edited by a member", true, nil)
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateTodo(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ownerID, updated.UserID, "the todo should stay with its owner")
+	assert.Equal(t, "Edited by a member", updated.Text)
+	assert.True(t, updated.IsComplete)
+}
+
+func TestTodoRepository_UpdateTodo_shouldReturnForbidden_whenUserIsViewer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	todo := createTestTodo(t, ctx, ownerID, "Shared todo")
+	shareTestTodoList(t, ctx, gateway.NewTodoListMemberRepository(db), ownerID, viewerID, domain.TodoListViewer)
+	repo := gateway.NewTodoRepository(db)
+	input, err := domain.NewUpdateTodoInput(todo.ID, viewerID, "Edited by a viewer", false, nil)
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateTodo(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	assert.Nil(t, updated)
+	found, err := repo.FindTodo(ctx, &domain.FindTodoInput{ID: todo.ID, UserID: viewerID})
+	require.NoError(t, err, "a viewer should still read the todo")
+	assert.Equal(t, "Shared todo", found.Text)
+}
+
+func TestTodoRepository_DeleteTodo_shouldReturnNotFound_whenListIsNotShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	todo := createTestTodo(t, ctx, ownerID, "Private todo")
+	repo := gateway.NewTodoRepository(db)
+	input, err := domain.NewDeleteTodoInput(todo.ID, ownerID+1, nil)
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.DeleteTodo(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+}
@@ -7,18 +7,37 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 )
 
 // TodoEntity is the GORM model for the "todo" table.
+// ChecklistItems is only populated when explicitly preloaded.
+// CommentCount is a read-only column that is only populated when selected with selectTodoWithCommentCount.
+// CompletedAt is maintained by UpdateTodo and ArchivedAt by the archive operations.
+// DeletedAt enables GORM soft delete: deleted todos stay in the table as trash until they are purged.
+// Version is incremented with incrementTodoVersion by every write to the todo, its checklist or its comments.
+// The same writes set ChangeSeq to a change sequence number taken from nextTodoChangeSeq; CreatedChangeSeq keeps
+// the one of the write that created the todo. Both are 0 for todos created before change sequences were introduced.
+// Every write that changes a todo also writes an event reporting the change to the outbox with insertOutboxEvents.
+// Creates, updates, deletes, restores, reverts and archives also record the resulting text and completion as a revision
+// of the todo with insertTodoRevision.
 type TodoEntity struct {
-	ID         int       `gorm:"primaryKey;autoIncrement"`
-	UserID     int       `gorm:"not null"`
-	Text       string    `gorm:"type:varchar(255);not null"`
-	IsComplete bool      `gorm:"not null;default:false"`
-	CreatedAt  time.Time `gorm:"autoCreateTime"`
-	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+	ID               int                       `gorm:"primaryKey;autoIncrement"`
+	UserID           int                       `gorm:"not null"`
+	Text             string                    `gorm:"type:varchar(255);not null"`
+	IsComplete       bool                      `gorm:"not null;default:false"`
+	CreatedAt        time.Time                 `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time                 `gorm:"autoUpdateTime"`
+	ChecklistItems   TodoChecklistItemEntities `gorm:"foreignKey:TodoID"`
+	CommentCount     int                       `gorm:"->;-:migration"`
+	CompletedAt      *time.Time
+	ArchivedAt       *time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+	Version          int            `gorm:"not null;default:1"`
+	CreatedChangeSeq int64          `gorm:"not null;default:0"`
+	ChangeSeq        int64          `gorm:"not null;default:0"`
 }
 
 func (e *TodoEntity) TableName() string {
@@ -31,6 +50,19 @@ func (e *TodoEntity) toTodo() (*domain.Todo, error) {
 		return nil, fmt.Errorf("to todo model: %w", err)
 	}
 
+	checklist, err := e.ChecklistItems.toChecklistItems()
+	if err != nil {
+		return nil, fmt.Errorf("to checklist items: %w", err)
+	}
+	todo.Checklist = checklist
+	todo.CommentCount = e.CommentCount
+	todo.CompletedAt = e.CompletedAt
+	todo.ArchivedAt = e.ArchivedAt
+	todo.Version = e.Version
+	if e.DeletedAt.Valid {
+		todo.DeletedAt = &e.DeletedAt.Time
+	}
+
 	return todo, nil
 }
 
@@ -51,28 +83,114 @@ func (e TodoEntities) toTodos() ([]domain.Todo, error) {
 }
 
 // TodoRepository implements todo persistence operations using GORM.
+// changeSeqs holds the change sequence numbers a bulk transaction took for the owners of its todos before it
+// locked any of them; the creates, patches and deletes it runs use these instead of taking their own.
 type TodoRepository struct {
-	db *gorm.DB
+	db         *gorm.DB
+	changeSeqs map[int]int64
 }
 
 // NewTodoRepository returns a new TodoRepository backed by the given GORM DB.
 func NewTodoRepository(db *gorm.DB) *TodoRepository {
 	return &TodoRepository{
-		db: db,
+		db:         db,
+		changeSeqs: nil,
 	}
 }
 
-// FindTodos returns all todos for the given user, ordered by ID.
-func (r *TodoRepository) FindTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+// newTodoRepositoryWithChangeSeqs returns a TodoRepository for a transaction that already took the change sequence
+// numbers of the owners of the todos it writes.
+func newTodoRepositoryWithChangeSeqs(tx *gorm.DB, changeSeqs map[int]int64) *TodoRepository {
+	return &TodoRepository{
+		db:         tx,
+		changeSeqs: changeSeqs,
+	}
+}
+
+// nextChangeSeq returns the change sequence number for a write to the todos of ownerID, taking the next one
+// unless the transaction took it in advance.
+func (r *TodoRepository) nextChangeSeq(tx *gorm.DB, ownerID int) (int64, error) {
+	if r.changeSeqs == nil {
+		return nextTodoChangeSeq(tx, ownerID)
+	}
+	seq, ok := r.changeSeqs[ownerID]
+	if !ok {
+		return 0, fmt.Errorf("change sequence of user %d was not taken for the transaction", ownerID)
+	}
+	return seq, nil
+}
+
+// FindTodos returns a page of the user's unarchived todos matching input.Filter with their checklists and comment counts,
+// ordered by input.Sort. The page starts after input.Cursor and NextCursor is set only when more todos follow it.
+// Returns an error wrapping ErrInvalidCursor if the cursor value cannot be used with the sort.
+func (r *TodoRepository) FindTodos(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error) {
+	sortScope, err := todoSortScope(input.Sort, input.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("sort todos: %w", err)
+	}
+
 	var entities TodoEntities
-	if result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("id").Find(&entities); result.Error != nil {
+	query := r.db.WithContext(ctx).Scopes(selectTodoWithCommentCount, todoFilterScope(&input.Filter), sortScope).Preload("ChecklistItems", preloadChecklistItems)
+	// Fetch one extra row to find out whether another page follows
+	if result := query.Where("user_id = ? AND archived_at IS NULL", input.UserID).Limit(input.Limit + 1).Find(&entities); result.Error != nil {
 		return nil, fmt.Errorf("find todos: %w", result.Error)
 	}
+
+	var nextCursor *domain.TodoCursor
+	if len(entities) > input.Limit {
+		entities = entities[:input.Limit]
+		last := &entities[len(entities)-1]
+		cursor, err := domain.NewTodoCursor(input.Sort, todoSortValue(last, input.Sort.Field), last.ID)
+		if err != nil {
+			return nil, fmt.Errorf("new todo cursor: %w", err)
+		}
+		nextCursor = cursor
+	}
+
 	todos, err := entities.toTodos()
 	if err != nil {
 		return nil, fmt.Errorf("to todos: %w", err)
 	}
-	return todos, nil
+	return &domain.TodoPage{
+		Todos:      todos,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// FindTodo returns a todo of the user, or of a todo list shared with the user, with its checklist and comment count.
+// Returns ErrTodoNotFound if not found or in the trash; archived todos are returned.
+func (r *TodoRepository) FindTodo(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error) {
+	db := r.db.WithContext(ctx)
+	ownerID, err := authorizeTodo(db, input.ID, input.UserID, domain.TodoListViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	var entity TodoEntity
+	query := db.Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	if result := query.Where("id = ? AND user_id = ?", input.ID, ownerID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTodoNotFound
+		}
+		return nil, fmt.Errorf("find todo: %w", result.Error)
+	}
+
+	todo, err := entity.toTodo()
+	if err != nil {
+		return nil, fmt.Errorf("to todo: %w", err)
+	}
+
+	return todo, nil
+}
+
+// CountTodos returns the number of the user's unarchived todos matching the filter.
+func (r *TodoRepository) CountTodos(ctx context.Context, userID int, filter *domain.TodoFilter) (int, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&TodoEntity{}).Scopes(todoFilterScope(filter)) //nolint:exhaustruct
+	if result := query.Where("user_id = ? AND archived_at IS NULL", userID).Count(&count); result.Error != nil {
+		return 0, fmt.Errorf("count todos: %w", result.Error)
+	}
+	return int(count), nil
 }
 
 // CreateTodo inserts a new todo record and returns the created domain model.
@@ -87,67 +205,615 @@ func (r *TodoRepository) CreateTodo(ctx context.Context, input *domain.CreateTod
 		return nil, errors.New("simulated database error")
 	}
 
-	if result := r.db.WithContext(ctx).Create(entity); result.Error != nil {
-		return nil, fmt.Errorf("create todo: %w", result.Error)
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		seq, err := r.nextChangeSeq(tx, input.UserID)
+		if err != nil {
+			return err
+		}
+		entity.CreatedChangeSeq = seq
+		entity.ChangeSeq = seq
+
+		if result := tx.Create(entity); result.Error != nil {
+			return fmt.Errorf("create todo: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload created todo: %w", result.Error)
+		}
+
+		todo, err = entity.toTodo()
+		if err != nil {
+			return fmt.Errorf("to todo: %w", err)
+		}
+		changedFields := []domain.TodoField{domain.TodoFieldText, domain.TodoFieldIsComplete}
+		if err := insertTodoRevision(tx, newTodoRevisionEntity(todo.ID, input.UserID, domain.TodoRevisionCreated, domain.TodoFieldValuesOf(todo), changedFields)); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventCreated, todo))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create todo: %w", err)
 	}
 
-	// Re-read to get DB-precision timestamps
-	if result := r.db.WithContext(ctx).First(entity, entity.ID); result.Error != nil {
-		return nil, fmt.Errorf("reload created todo: %w", result.Error)
+	return todo, nil
+}
+
+// UpdateTodo updates a todo the user may edit, their own or one of a todo list shared with them as editor or owner.
+// Returns ErrTodoNotFound if not found, ErrForbidden if the user may only view the todo
+// and a *TodoVersionMismatchError if input.ExpectedVersion is set and not current.
+// CompletedAt is set when the todo becomes complete and cleared when it is reopened.
+func (r *TodoRepository) UpdateTodo(ctx context.Context, input *domain.UpdateTodoInput) (*domain.Todo, error) {
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.ID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		// Lock the todo by ID and the ID of its owner, which the change sequence number was taken for
+		before, err := lockOwnedTodoAtVersion(tx, input.ID, ownerID, input.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		// Update only the changed fields (preserves CreatedAt)
+		if result := tx.Model(&TodoEntity{}).Where("id = ?", input.ID).Updates(map[string]any{ //nolint:exhaustruct
+			"text":         input.Text,
+			"is_complete":  input.IsComplete,
+			"completed_at": gorm.Expr("IF(?, COALESCE(completed_at, CURRENT_TIMESTAMP(6)), NULL)", input.IsComplete),
+			"version":      incrementTodoVersion,
+			"change_seq":   seq,
+		}); result.Error != nil {
+			return fmt.Errorf("update todo: %w", result.Error)
+		}
+
+		todo, err = findTodoByID(tx, input.ID)
+		if err != nil {
+			return fmt.Errorf("reload updated todo: %w", err)
+		}
+		after := domain.TodoFieldValuesOf(todo)
+		if err := insertTodoRevision(tx, newTodoRevisionEntity(todo.ID, input.UserID, domain.TodoRevisionUpdated, after, domain.ChangedTodoFields(before, after))); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update todo: %w", err)
 	}
 
-	todo, err := entity.toTodo()
+	return todo, nil
+}
+
+// PatchTodo updates only the fields of a todo the user may edit that are set in input. Returns ErrTodoNotFound if not found,
+// ErrForbidden if the user may only view the todo and a *TodoVersionMismatchError if input.ExpectedVersion is set and not current.
+// A patch without fields leaves the todo, including UpdatedAt and Version, unchanged.
+func (r *TodoRepository) PatchTodo(ctx context.Context, input *domain.PatchTodoInput) (*domain.Todo, error) {
+	columns := make(map[string]any)
+	if input.Text != nil {
+		columns["text"] = *input.Text
+	}
+	if input.IsComplete != nil {
+		columns["is_complete"] = *input.IsComplete
+		columns["completed_at"] = gorm.Expr("IF(?, COALESCE(completed_at, CURRENT_TIMESTAMP(6)), NULL)", *input.IsComplete)
+	}
+	if len(columns) > 0 {
+		columns["version"] = incrementTodoVersion
+	}
+
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.ID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		if len(columns) > 0 {
+			seq, err := r.nextChangeSeq(tx, ownerID)
+			if err != nil {
+				return err
+			}
+			columns["change_seq"] = seq
+		}
+
+		before, err := lockOwnedTodoAtVersion(tx, input.ID, ownerID, input.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if len(columns) > 0 {
+			if result := tx.Model(&TodoEntity{}).Where("id = ?", input.ID).Updates(columns); result.Error != nil { //nolint:exhaustruct
+				return fmt.Errorf("patch todo: %w", result.Error)
+			}
+		}
+
+		todo, err = findTodoByID(tx, input.ID)
+		if err != nil {
+			return fmt.Errorf("reload patched todo: %w", err)
+		}
+		if len(columns) == 0 {
+			return nil
+		}
+		after := domain.TodoFieldValuesOf(todo)
+		if err := insertTodoRevision(tx, newTodoRevisionEntity(todo.ID, input.UserID, domain.TodoRevisionUpdated, after, domain.ChangedTodoFields(before, after))); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo))
+	})
 	if err != nil {
-		return nil, fmt.Errorf("to todo: %w", err)
+		return nil, fmt.Errorf("patch todo: %w", err)
 	}
 
 	return todo, nil
 }
 
-// UpdateTodo updates a todo owned by the user. Returns ErrTodoNotFound if not found.
-func (r *TodoRepository) UpdateTodo(ctx context.Context, input *domain.UpdateTodoInput) (*domain.Todo, error) {
+// DeleteTodo moves a todo the user may edit to the trash and returns the ID of its owner, whose todo list the delete
+// is reported to. Returns ErrTodoNotFound if not found or already in the trash, ErrForbidden if the user may only view
+// the todo and a *TodoVersionMismatchError if input.ExpectedVersion is set and not current.
+func (r *TodoRepository) DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) (int, error) {
+	var ownerID int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		ownerID, err = authorizeTodo(tx, input.ID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := r.nextChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		// Lock the todo by ID and the ID of its owner, which the change sequence number was taken for
+		values, err := lockOwnedTodoAtVersion(tx, input.ID, ownerID, input.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		// Soft delete the todo
+		if result := tx.Model(&TodoEntity{}).Where("id = ?", input.ID).Updates(map[string]any{ //nolint:exhaustruct
+			"deleted_at": gorm.Expr("CURRENT_TIMESTAMP(6)"),
+			"version":    incrementTodoVersion,
+			"change_seq": seq,
+		}); result.Error != nil {
+			return fmt.Errorf("delete todo: %w", result.Error)
+		}
+
+		if err := insertTodoRevision(tx, newTodoRevisionEntity(input.ID, input.UserID, domain.TodoRevisionDeleted, values, nil)); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, domain.NewTodoDeletedEvent(ownerID, input.ID))
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete todo: %w", err)
+	}
+
+	return ownerID, nil
+}
+
+// FindTrashedTodos returns the todos in the user's trash with their checklists and comment counts, most recently deleted first.
+func (r *TodoRepository) FindTrashedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	var entities TodoEntities
+	query := r.db.WithContext(ctx).Unscoped().Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	if result := query.Where("user_id = ? AND deleted_at IS NOT NULL", userID).Order("deleted_at DESC, id DESC").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find trashed todos: %w", result.Error)
+	}
+	todos, err := entities.toTodos()
+	if err != nil {
+		return nil, fmt.Errorf("to todos: %w", err)
+	}
+	return todos, nil
+}
+
+// RestoreTodo moves a todo the user may edit out of the trash. Returns ErrTodoNotFound if it is not in the trash
+// and ErrForbidden if the user may only view it.
+func (r *TodoRepository) RestoreTodo(ctx context.Context, input *domain.RestoreTodoInput) (*domain.Todo, error) {
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx.Unscoped(), input.ID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		todo, err = restoreTrashedTodo(tx, seq, input.ID, input.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restore todo: %w", err)
+	}
+
+	return todo, nil
+}
+
+// FindTodoHistory returns the revisions of a todo the user can see, oldest first.
+// Returns ErrTodoNotFound if not found or in the trash.
+func (r *TodoRepository) FindTodoHistory(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error) {
+	db := r.db.WithContext(ctx)
+	if _, err := authorizeTodo(db, input.TodoID, input.UserID, domain.TodoListViewer); err != nil {
+		return nil, err
+	}
+
+	var entities TodoRevisionEntities
+	if result := db.Where("todo_id = ?", input.TodoID).Order("revision").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find todo revisions: %w", result.Error)
+	}
+	revisions, err := entities.toTodoRevisions()
+	if err != nil {
+		return nil, fmt.Errorf("to todo revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// RevertTodo sets the text and completion of a todo the user may edit back to their values at input.Revision
+// and records the revert as a new revision. Returns ErrTodoNotFound if not found or in the trash, ErrForbidden if the
+// user may only view the todo, ErrTodoRevisionNotFound if the todo has no such revision, and a *TodoVersionMismatchError
+// if input.ExpectedVersion is set and not current. CompletedAt follows the completion as in UpdateTodo.
+func (r *TodoRepository) RevertTodo(ctx context.Context, input *domain.RevertTodoInput) (*domain.Todo, error) {
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.TodoID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		before, err := lockOwnedTodoAtVersion(tx, input.TodoID, ownerID, input.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		todo, err = revertLockedTodo(tx, seq, input.TodoID, input.UserID, input.Revision, before)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("revert todo: %w", err)
+	}
+
+	return todo, nil
+}
+
+// PurgeTodos permanently deletes up to input.BatchSize todos of any user that were moved to the trash before input.DeletedBefore.
+// Checklist items, comments and attachment metadata are removed by the database cascade; the storage keys of the
+// attachments are returned so that the caller can delete the blobs. Rows locked by another purge are skipped.
+func (r *TodoRepository) PurgeTodos(ctx context.Context, input *domain.PurgeTodosInput) (*domain.PurgedTodos, error) {
+	purged := &domain.PurgedTodos{
+		TodoIDs:               make([]int, 0),
+		AttachmentStorageKeys: make([]string, 0),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Unscoped().Model(&TodoEntity{}).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}) //nolint:exhaustruct
+		if result := query.Where("deleted_at < ?", input.DeletedBefore).Order("id").Limit(input.BatchSize).Pluck("id", &purged.TodoIDs); result.Error != nil {
+			return fmt.Errorf("find purgeable todos: %w", result.Error)
+		}
+		if len(purged.TodoIDs) == 0 {
+			return nil
+		}
+
+		if result := tx.Model(&TodoAttachmentEntity{}).Where("todo_id IN ?", purged.TodoIDs).Pluck("storage_key", &purged.AttachmentStorageKeys); result.Error != nil { //nolint:exhaustruct
+			return fmt.Errorf("find attachment storage keys: %w", result.Error)
+		}
+
+		if result := tx.Unscoped().Where("id IN ?", purged.TodoIDs).Delete(&TodoEntity{}); result.Error != nil { //nolint:exhaustruct
+			return fmt.Errorf("purge todos: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("purge todos: %w", err)
+	}
+
+	return purged, nil
+}
+
+// FindArchivedTodos returns the archived todos for the given user with their checklists and comment counts, most recently archived first.
+func (r *TodoRepository) FindArchivedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	var entities TodoEntities
+	query := r.db.WithContext(ctx).Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	if result := query.Where("user_id = ? AND archived_at IS NOT NULL", userID).Order("archived_at DESC, id DESC").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find archived todos: %w", result.Error)
+	}
+	todos, err := entities.toTodos()
+	if err != nil {
+		return nil, fmt.Errorf("to todos: %w", err)
+	}
+	return todos, nil
+}
+
+// ArchiveTodo archives or unarchives a todo the user may edit. Returns ErrTodoNotFound if not found
+// and ErrForbidden if the user may only view it. Archiving an archived todo keeps its original ArchivedAt.
+func (r *TodoRepository) ArchiveTodo(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.Todo, error) {
+	var archivedAt any
+	if input.Archived {
+		archivedAt = gorm.Expr("COALESCE(archived_at, CURRENT_TIMESTAMP(6))")
+	}
+
+	var todo *domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ownerID, err := authorizeTodo(tx, input.ID, input.UserID, domain.TodoListEditor)
+		if err != nil {
+			return err
+		}
+		seq, err := nextTodoChangeSeq(tx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		if err := lockOwnedTodo(tx, input.ID, ownerID); err != nil {
+			return err
+		}
+
+		columns := map[string]any{"archived_at": archivedAt, "version": incrementTodoVersion, "change_seq": seq}
+		if result := tx.Model(&TodoEntity{}).Where("id = ?", input.ID).Updates(columns); result.Error != nil { //nolint:exhaustruct
+			return fmt.Errorf("archive todo: %w", result.Error)
+		}
+
+		todo, err = findTodoByID(tx, input.ID)
+		if err != nil {
+			return fmt.Errorf("reload archived todo: %w", err)
+		}
+		action := domain.TodoRevisionUnarchived
+		if input.Archived {
+			action = domain.TodoRevisionArchived
+		}
+		if err := insertTodoRevision(tx, newTodoRevisionEntity(todo.ID, input.UserID, action, domain.TodoFieldValuesOf(todo), nil)); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archive todo: %w", err)
+	}
+
+	return todo, nil
+}
+
+// ArchiveCompletedTodos archives every completed, unarchived todo of the user and returns the archived todos ordered by ID.
+func (r *TodoRepository) ArchiveCompletedTodos(ctx context.Context, input *domain.ArchiveCompletedTodosInput) ([]domain.Todo, error) {
+	var archived []domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		seq, err := nextTodoChangeSeq(tx, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		query := tx.Model(&TodoEntity{}).Where("user_id = ? AND is_complete = ? AND archived_at IS NULL", input.UserID, true) //nolint:exhaustruct
+		archived, err = archiveTodos(tx, query, seq, input.UserID)
+		if err != nil {
+			return fmt.Errorf("archive completed todos: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archive completed todos: %w", err)
+	}
+	return archived, nil
+}
+
+// AutoArchiveTodos archives up to input.BatchSize unarchived todos of any user that were completed before input.CompletedBefore
+// and returns the archived todos. Their revisions record the owner of each todo as the user who archived it.
+// The todos of each user are archived in a transaction of their own because each takes a change sequence number of that user.
+func (r *TodoRepository) AutoArchiveTodos(ctx context.Context, input *domain.AutoArchiveTodosInput) ([]domain.Todo, error) {
+	const condition = "archived_at IS NULL AND is_complete = ? AND completed_at < ?"
+
+	var candidates TodoEntities
+	query := r.db.WithContext(ctx).Select("id", "user_id").Where(condition, true, input.CompletedBefore)
+	if result := query.Order("id").Limit(input.BatchSize).Find(&candidates); result.Error != nil {
+		return nil, fmt.Errorf("find todos to auto archive: %w", result.Error)
+	}
+
+	userIDs := make([]int, 0)
+	todoIDsByUser := make(map[int][]int)
+	for _, candidate := range candidates {
+		if _, ok := todoIDsByUser[candidate.UserID]; !ok {
+			userIDs = append(userIDs, candidate.UserID)
+		}
+		todoIDsByUser[candidate.UserID] = append(todoIDsByUser[candidate.UserID], candidate.ID)
+	}
+
+	archived := make([]domain.Todo, 0)
+	for _, userID := range userIDs {
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			seq, err := nextTodoChangeSeq(tx, userID)
+			if err != nil {
+				return err
+			}
+
+			// Check the condition again in case the todo was changed since it was found
+			query := tx.Model(&TodoEntity{}).Where("id IN ? AND user_id = ?", todoIDsByUser[userID], userID).Where(condition, true, input.CompletedBefore) //nolint:exhaustruct
+			todos, err := archiveTodos(tx, query, seq, userID)
+			if err != nil {
+				return err
+			}
+			archived = append(archived, todos...)
+			return nil
+		})
+		if err != nil {
+			return archived, fmt.Errorf("auto archive todos: %w", err)
+		}
+	}
+	return archived, nil
+}
+
+// archiveTodos archives the todos the query selects in a write by the user with the change sequence number seq,
+// records a revision and writes an updated event for each to the outbox and returns the archived todos ordered by ID.
+// The todos are locked before they are archived so that the events report exactly the todos that were.
+func archiveTodos(tx *gorm.DB, query *gorm.DB, seq int64, userID int) ([]domain.Todo, error) {
+	var ids []int
+	if result := query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Order("id").Pluck("id", &ids); result.Error != nil { //nolint:exhaustruct
+		return nil, fmt.Errorf("lock todos to archive: %w", result.Error)
+	}
+	if len(ids) == 0 {
+		return []domain.Todo{}, nil
+	}
+
+	columns := map[string]any{"archived_at": gorm.Expr("CURRENT_TIMESTAMP(6)"), "version": incrementTodoVersion, "change_seq": seq}
+	if result := tx.Model(&TodoEntity{}).Where("id IN ?", ids).Updates(columns); result.Error != nil { //nolint:exhaustruct
+		return nil, fmt.Errorf("archive todos: %w", result.Error)
+	}
+
+	var entities TodoEntities
+	query = tx.Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	if result := query.Where("id IN ?", ids).Order("id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("reload archived todos: %w", result.Error)
+	}
+	todos, err := entities.toTodos()
+	if err != nil {
+		return nil, fmt.Errorf("to todos: %w", err)
+	}
+	events := make([]domain.TodoEvent, len(todos))
+	for i := range todos {
+		if err := insertTodoRevision(tx, newTodoRevisionEntity(todos[i].ID, userID, domain.TodoRevisionArchived, domain.TodoFieldValuesOf(&todos[i]), nil)); err != nil {
+			return nil, err
+		}
+		events[i] = domain.NewTodoChangedEvent(domain.TodoEventUpdated, &todos[i])
+	}
+	if err := insertOutboxEvents(tx, events...); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// incrementTodoVersion is the update expression for the version column of a changed todo.
+var incrementTodoVersion = gorm.Expr("version + 1")
+
+// lockOwnedTodoAtVersion takes a row lock on the todo owned by the user like lockOwnedTodo and, if expectedVersion is set,
+// checks that it is the current version. Returns a *TodoVersionMismatchError holding the current todo if it is not.
+// Returns the values of the fields of the todo before the write, from which the write's revision takes its changes.
+func lockOwnedTodoAtVersion(tx *gorm.DB, todoID int, userID int, expectedVersion *int) (domain.TodoFieldValues, error) {
 	var entity TodoEntity
+	query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Select("id", "text", "is_complete", "version") //nolint:exhaustruct
+	if result := query.Where("id = ? AND user_id = ?", todoID, userID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return domain.TodoFieldValues{}, domain.ErrTodoNotFound
+		}
+		return domain.TodoFieldValues{}, fmt.Errorf("lock todo: %w", result.Error)
+	}
+	values := domain.TodoFieldValues{
+		Text:       entity.Text,
+		IsComplete: entity.IsComplete,
+	}
+	if expectedVersion == nil || *expectedVersion == entity.Version {
+		return values, nil
+	}
 
-	// Find the todo by ID and UserID to ensure the user owns this todo
-	if result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", input.ID, input.UserID).First(&entity); result.Error != nil {
+	current, err := findTodoByID(tx, todoID)
+	if err != nil {
+		return domain.TodoFieldValues{}, fmt.Errorf("find current todo: %w", err)
+	}
+	return domain.TodoFieldValues{}, &domain.TodoVersionMismatchError{Current: current}
+}
+
+// revertLockedTodo sets the text and completion of a todo locked by the transaction back to their values at the revision
+// and records the revert by the user. before holds the values of the fields before the revert.
+// Returns ErrTodoRevisionNotFound if the todo has no such revision.
+func revertLockedTodo(tx *gorm.DB, seq int64, todoID int, userID int, revision int, before domain.TodoFieldValues) (*domain.Todo, error) {
+	var target TodoRevisionEntity
+	if result := tx.Where("todo_id = ? AND revision = ?", todoID, revision).First(&target); result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, domain.ErrTodoNotFound
+			return nil, domain.ErrTodoRevisionNotFound
 		}
-		return nil, fmt.Errorf("find todo: %w", result.Error)
+		return nil, fmt.Errorf("find todo revision: %w", result.Error)
 	}
 
-	// Update only the changed fields (preserves CreatedAt)
-	if result := r.db.WithContext(ctx).Model(&entity).Updates(map[string]any{
-		"text":        input.Text,
-		"is_complete": input.IsComplete,
+	if result := tx.Model(&TodoEntity{}).Where("id = ?", todoID).Updates(map[string]any{ //nolint:exhaustruct
+		"text":         target.Text,
+		"is_complete":  target.IsComplete,
+		"completed_at": gorm.Expr("IF(?, COALESCE(completed_at, CURRENT_TIMESTAMP(6)), NULL)", target.IsComplete),
+		"version":      incrementTodoVersion,
+		"change_seq":   seq,
 	}); result.Error != nil {
-		return nil, fmt.Errorf("update todo: %w", result.Error)
+		return nil, fmt.Errorf("revert todo: %w", result.Error)
 	}
 
-	todo, err := entity.toTodo()
+	todo, err := findTodoByID(tx, todoID)
 	if err != nil {
-		return nil, fmt.Errorf("to todo: %w", err)
+		return nil, fmt.Errorf("reload reverted todo: %w", err)
+	}
+	after := domain.TodoFieldValuesOf(todo)
+	revisionEntity := newTodoRevisionEntity(todo.ID, userID, domain.TodoRevisionReverted, after, domain.ChangedTodoFields(before, after))
+	revisionEntity.RevertedFrom = &revision
+	if err := insertTodoRevision(tx, revisionEntity); err != nil {
+		return nil, err
+	}
+	if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+		return nil, err
 	}
-
 	return todo, nil
 }
 
-// DeleteTodo deletes a todo owned by the user. Returns ErrTodoNotFound if not found.
-func (r *TodoRepository) DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) error {
-	// Delete the todo by ID and UserID to ensure the user owns this todo
-	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", input.ID, input.UserID).Delete(&TodoEntity{}) //nolint:exhaustruct
+// restoreTrashedTodo moves a todo the user may edit out of the trash and records the restore by the user.
+// The caller has checked that the user may. Returns ErrTodoNotFound if it is not in the trash.
+func restoreTrashedTodo(tx *gorm.DB, seq int64, todoID int, userID int) (*domain.Todo, error) {
+	query := tx.Unscoped().Model(&TodoEntity{}).Where("id = ? AND deleted_at IS NOT NULL", todoID) //nolint:exhaustruct
+	result := query.Updates(map[string]any{"deleted_at": nil, "version": incrementTodoVersion, "change_seq": seq})
 	if result.Error != nil {
-		return fmt.Errorf("delete todo: %w", result.Error)
+		return nil, fmt.Errorf("restore todo: %w", result.Error)
 	}
-
-	// Check if any rows were affected
 	if result.RowsAffected == 0 {
-		return domain.ErrTodoNotFound
+		return nil, domain.ErrTodoNotFound
 	}
 
+	todo, err := findTodoByID(tx, todoID)
+	if err != nil {
+		return nil, fmt.Errorf("reload restored todo: %w", err)
+	}
+	if err := insertTodoRevision(tx, newTodoRevisionEntity(todo.ID, userID, domain.TodoRevisionRestored, domain.TodoFieldValuesOf(todo), nil)); err != nil {
+		return nil, err
+	}
+	if err := insertOutboxEvents(tx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo)); err != nil {
+		return nil, err
+	}
+	return todo, nil
+}
+
+// bumpTodoVersion increments the version of a todo whose checklist changed and records the change sequence number seq.
+func bumpTodoVersion(tx *gorm.DB, todoID int, seq int64) error {
+	query := tx.Model(&TodoEntity{}).Where("id = ?", todoID) //nolint:exhaustruct
+	if result := query.Updates(map[string]any{"version": incrementTodoVersion, "change_seq": seq}); result.Error != nil {
+		return fmt.Errorf("bump todo version: %w", result.Error)
+	}
 	return nil
 }
 
+func findTodoByID(db *gorm.DB, todoID int) (*domain.Todo, error) {
+	var entity TodoEntity
+	query := db.Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	if result := query.Where("id = ?", todoID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTodoNotFound
+		}
+		return nil, fmt.Errorf("find todo: %w", result.Error)
+	}
+
+	todo, err := entity.toTodo()
+	if err != nil {
+		return nil, fmt.Errorf("to todo: %w", err)
+	}
+
+	return todo, nil
+}
+
+func preloadChecklistItems(db *gorm.DB) *gorm.DB {
+	return db.Order("position, id")
+}
+
+func selectTodoWithCommentCount(db *gorm.DB) *gorm.DB {
+	return db.Select("todo.*, (SELECT COUNT(*) FROM todo_comment WHERE todo_comment.todo_id = todo.id) AS comment_count")
+}
+
 // TodoCreateBulkCommandTxManager manages GORM transactions for bulk todo creation.
 type TodoCreateBulkCommandTxManager struct {
 	dbc *DBConnection
@@ -177,3 +843,69 @@ func (tm *TodoCreateBulkCommandTxManager) WithTransaction(ctx context.Context, f
 	}
 	return todos, nil
 }
+
+// TodoBulkCommandTxManager manages GORM transactions for all-or-nothing bulk todo updates and deletes.
+type TodoBulkCommandTxManager struct {
+	dbc *DBConnection
+}
+
+// NewTodoBulkCommandTxManager returns a new transaction manager.
+func NewTodoBulkCommandTxManager(dbc *DBConnection) *TodoBulkCommandTxManager {
+	return &TodoBulkCommandTxManager{
+		dbc: dbc,
+	}
+}
+
+// WithTransaction executes fn, which writes the todos with the given IDs, within a database transaction, rolling back on error.
+// The change sequence numbers of the owners of the todos are all taken before fn runs, so that a write to the todo of
+// one owner never waits for the sequence of another while holding a todo lock.
+func (tm *TodoBulkCommandTxManager) WithTransaction(ctx context.Context, todoIDs []int, fn func(patchTodo domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) error) error {
+	err := tm.dbc.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		seqs, err := nextTodoChangeSeqsForTodos(tx, nil, todoIDs)
+		if err != nil {
+			return err
+		}
+		todoRepo := newTodoRepositoryWithChangeSeqs(tx, seqs)
+		if err := fn(todoRepo.PatchTodo, todoRepo.DeleteTodo); err != nil {
+			return fmt.Errorf("execute function in transaction: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+	return nil
+}
+
+// TodoBatchCommandTxManager manages GORM transactions for atomic batches of todo creates, updates and deletes.
+type TodoBatchCommandTxManager struct {
+	dbc *DBConnection
+}
+
+// NewTodoBatchCommandTxManager returns a new transaction manager.
+func NewTodoBatchCommandTxManager(dbc *DBConnection) *TodoBatchCommandTxManager {
+	return &TodoBatchCommandTxManager{
+		dbc: dbc,
+	}
+}
+
+// WithTransaction executes fn, which creates todos for the user and writes the todos with the given IDs, within
+// a database transaction, rolling back on error. As in TodoBulkCommandTxManager, the change sequence numbers of the user
+// and of the owners of the todos are all taken before fn runs.
+func (tm *TodoBatchCommandTxManager) WithTransaction(ctx context.Context, userID int, todoIDs []int, fn func(createTodo domain.CreateTodoFunc, patchTodo domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) error) error {
+	err := tm.dbc.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		seqs, err := nextTodoChangeSeqsForTodos(tx, []int{userID}, todoIDs)
+		if err != nil {
+			return err
+		}
+		todoRepo := newTodoRepositoryWithChangeSeqs(tx, seqs)
+		if err := fn(todoRepo.CreateTodo, todoRepo.PatchTodo, todoRepo.DeleteTodo); err != nil {
+			return fmt.Errorf("execute function in transaction: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+	return nil
+}
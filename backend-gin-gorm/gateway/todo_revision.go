@@ -0,0 +1,99 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoRevisionEntity is the GORM model for the "todo_revision" table.
+// UserID is the user who made the write the revision records. ChangedFields holds the changed fields separated by commas.
+type TodoRevisionEntity struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	TodoID        int       `gorm:"not null"`
+	Revision      int       `gorm:"not null"`
+	Action        string    `gorm:"type:varchar(20);not null"`
+	UserID        int       `gorm:"not null"`
+	Text          string    `gorm:"type:varchar(255);not null"`
+	IsComplete    bool      `gorm:"not null"`
+	ChangedFields string    `gorm:"type:varchar(100);not null"`
+	RevertedFrom  *int      `gorm:"default:null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (e *TodoRevisionEntity) TableName() string {
+	return "todo_revision"
+}
+
+func (e *TodoRevisionEntity) toTodoRevision() (*domain.TodoRevision, error) {
+	changedFields := make([]domain.TodoField, 0)
+	if e.ChangedFields != "" {
+		for _, name := range strings.Split(e.ChangedFields, ",") {
+			changedFields = append(changedFields, domain.TodoField(name))
+		}
+	}
+	values := domain.TodoFieldValues{
+		Text:       e.Text,
+		IsComplete: e.IsComplete,
+	}
+
+	revision, err := domain.NewTodoRevision(e.TodoID, e.Revision, domain.TodoRevisionAction(e.Action), e.UserID, values, changedFields, e.RevertedFrom, e.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to todo revision model: %w", err)
+	}
+
+	return revision, nil
+}
+
+// TodoRevisionEntities is a slice of TodoRevisionEntity with batch conversion support.
+type TodoRevisionEntities []TodoRevisionEntity
+
+func (e TodoRevisionEntities) toTodoRevisions() ([]domain.TodoRevision, error) {
+	revisions := make([]domain.TodoRevision, len(e))
+	for i, revisionE := range e {
+		revision, err := revisionE.toTodoRevision()
+		if err != nil {
+			return nil, fmt.Errorf("to todo revision: %w", err)
+		}
+		revisions[i] = *revision
+	}
+
+	return revisions, nil
+}
+
+// newTodoRevisionEntity returns the revision recording a write by the user that left the fields of the todo at values.
+func newTodoRevisionEntity(todoID int, userID int, action domain.TodoRevisionAction, values domain.TodoFieldValues, changedFields []domain.TodoField) *TodoRevisionEntity {
+	names := make([]string, len(changedFields))
+	for i, field := range changedFields {
+		names[i] = string(field)
+	}
+
+	return &TodoRevisionEntity{ //nolint:exhaustruct
+		TodoID:        todoID,
+		UserID:        userID,
+		Action:        string(action),
+		Text:          values.Text,
+		IsComplete:    values.IsComplete,
+		ChangedFields: strings.Join(names, ","),
+	}
+}
+
+// insertTodoRevision writes the revision in the transaction of the write it records, numbered after the latest
+// revision of the todo. The write has locked the todo, so no other transaction numbers a revision of it meanwhile.
+func insertTodoRevision(tx *gorm.DB, entity *TodoRevisionEntity) error {
+	var latest int
+	query := tx.Model(&TodoRevisionEntity{}).Select("COALESCE(MAX(revision), 0)").Where("todo_id = ?", entity.TodoID) //nolint:exhaustruct
+	if result := query.Scan(&latest); result.Error != nil {
+		return fmt.Errorf("find latest todo revision: %w", result.Error)
+	}
+	entity.Revision = latest + 1
+
+	if result := tx.Create(entity); result.Error != nil {
+		return fmt.Errorf("create todo revision: %w", result.Error)
+	}
+	return nil
+}
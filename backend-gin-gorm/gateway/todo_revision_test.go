@@ -0,0 +1,265 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func findTodoHistory(t *testing.T, ctx context.Context, repo *gateway.TodoRepository, todoID int, userID int) []domain.TodoRevision {
+	t.Helper()
+	input, err := domain.NewFindTodoHistoryInput(todoID, userID)
+	require.NoError(t, err)
+	revisions, err := repo.FindTodoHistory(ctx, input)
+	require.NoError(t, err)
+	return revisions
+}
+
+// FindTodoHistory Tests
+
+func TestTodoRepository_FindTodoHistory_shouldReturnRevisionOfEachWrite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	isComplete := true
+	patchInput, err := domain.NewPatchTodoInput(createdTodo.ID, userID, nil, &isComplete, nil)
+	require.NoError(t, err)
+	_, err = repo.PatchTodo(ctx, patchInput)
+	require.NoError(t, err)
+
+	// when
+	revisions := findTodoHistory(t, ctx, repo, createdTodo.ID, userID)
+
+	// then
+	require.Len(t, revisions, 3)
+	assert.Equal(t, 1, revisions[0].Revision)
+	assert.Equal(t, domain.TodoRevisionCreated, revisions[0].Action)
+	assert.Equal(t, domain.TodoFieldValues{Text: "Original Text", IsComplete: false}, revisions[0].Values)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldText, domain.TodoFieldIsComplete}, revisions[0].ChangedFields)
+	assert.Equal(t, 2, revisions[1].Revision)
+	assert.Equal(t, domain.TodoRevisionUpdated, revisions[1].Action)
+	assert.Equal(t, domain.TodoFieldValues{Text: "Updated Text", IsComplete: false}, revisions[1].Values)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldText}, revisions[1].ChangedFields)
+	assert.Equal(t, 3, revisions[2].Revision)
+	assert.Equal(t, domain.TodoFieldValues{Text: "Updated Text", IsComplete: true}, revisions[2].Values)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldIsComplete}, revisions[2].ChangedFields)
+	for _, revision := range revisions {
+		assert.Equal(t, userID, revision.UserID)
+		assert.Nil(t, revision.RevertedFrom)
+	}
+}
+
+func TestTodoRepository_FindTodoHistory_shouldRecordDeleteAndRestore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Trashed Todo")
+	deleteInput, err := domain.NewDeleteTodoInput(createdTodo.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = repo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+	restoreInput, err := domain.NewRestoreTodoInput(createdTodo.ID, userID)
+	require.NoError(t, err)
+	_, err = repo.RestoreTodo(ctx, restoreInput)
+	require.NoError(t, err)
+
+	// when
+	revisions := findTodoHistory(t, ctx, repo, createdTodo.ID, userID)
+
+	// then
+	require.Len(t, revisions, 3)
+	assert.Equal(t, domain.TodoRevisionDeleted, revisions[1].Action)
+	assert.Equal(t, domain.TodoFieldValues{Text: "Trashed Todo", IsComplete: false}, revisions[1].Values)
+	assert.Empty(t, revisions[1].ChangedFields)
+	assert.Equal(t, domain.TodoRevisionRestored, revisions[2].Action)
+	assert.Empty(t, revisions[2].ChangedFields)
+}
+
+func TestTodoRepository_FindTodoHistory_shouldRecordArchiveAndUnarchive(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Archived Todo")
+	for _, archived := range []bool{true, false} {
+		archiveInput, err := domain.NewArchiveTodoInput(createdTodo.ID, userID, archived)
+		require.NoError(t, err)
+		_, err = repo.ArchiveTodo(ctx, archiveInput)
+		require.NoError(t, err)
+	}
+
+	// when
+	revisions := findTodoHistory(t, ctx, repo, createdTodo.ID, userID)
+
+	// then
+	require.Len(t, revisions, 3)
+	assert.Equal(t, domain.TodoRevisionArchived, revisions[1].Action)
+	assert.Equal(t, domain.TodoFieldValues{Text: "Archived Todo", IsComplete: false}, revisions[1].Values)
+	assert.Empty(t, revisions[1].ChangedFields)
+	assert.Equal(t, domain.TodoRevisionUnarchived, revisions[2].Action)
+	assert.Empty(t, revisions[2].ChangedFields)
+}
+
+func TestTodoRepository_FindTodoHistory_shouldRecordBulkAndAutomaticArchive(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	bulkTodo := createTestTodo(t, ctx, userID, "Archived With The Completed")
+	completeTestTodo(t, ctx, bulkTodo)
+	archiveInput, err := domain.NewArchiveCompletedTodosInput(userID)
+	require.NoError(t, err)
+	_, err = repo.ArchiveCompletedTodos(ctx, archiveInput)
+	require.NoError(t, err)
+	autoTodo := createTestTodo(t, ctx, userID, "Archived Automatically")
+	completeTestTodo(t, ctx, autoTodo)
+	backdateCompletedTodo(t, autoTodo.ID)
+	autoArchiveInput, err := domain.NewAutoArchiveTodosInput(time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC), 1000)
+	require.NoError(t, err)
+	_, err = repo.AutoArchiveTodos(ctx, autoArchiveInput)
+	require.NoError(t, err)
+
+	// when
+	bulkRevisions := findTodoHistory(t, ctx, repo, bulkTodo.ID, userID)
+	autoRevisions := findTodoHistory(t, ctx, repo, autoTodo.ID, userID)
+
+	// then
+	// - the automatic archive is recorded as a write by the owner of the todo
+	for _, revisions := range [][]domain.TodoRevision{bulkRevisions, autoRevisions} {
+		require.Len(t, revisions, 3)
+		assert.Equal(t, domain.TodoRevisionArchived, revisions[2].Action)
+		assert.True(t, revisions[2].Values.IsComplete)
+		assert.Equal(t, userID, revisions[2].UserID)
+	}
+}
+
+func TestTodoRepository_FindTodoHistory_shouldReturnError_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1000000
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Private Todo")
+
+	// when
+	input, err := domain.NewFindTodoHistoryInput(createdTodo.ID, otherUserID)
+	require.NoError(t, err)
+	revisions, err := repo.FindTodoHistory(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, revisions)
+}
+
+// RevertTodo Tests
+
+func TestTodoRepository_RevertTodo_shouldRestoreValuesAsNewRevision(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", true, nil)
+	require.NoError(t, err)
+	updatedTodo, err := repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	// when
+	input, err := domain.NewRevertTodoInput(createdTodo.ID, userID, 1, &updatedTodo.Version)
+	require.NoError(t, err)
+	revertedTodo, err := repo.RevertTodo(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Original Text", revertedTodo.Text)
+	assert.False(t, revertedTodo.IsComplete)
+	assert.Nil(t, revertedTodo.CompletedAt, "reopening the todo should clear CompletedAt")
+	assert.Equal(t, updatedTodo.Version+1, revertedTodo.Version)
+
+	revisions := findTodoHistory(t, ctx, repo, createdTodo.ID, userID)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, domain.TodoRevisionReverted, revisions[2].Action)
+	assert.Equal(t, domain.TodoFieldValues{Text: "Original Text", IsComplete: false}, revisions[2].Values)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldText, domain.TodoFieldIsComplete}, revisions[2].ChangedFields)
+	require.NotNil(t, revisions[2].RevertedFrom)
+	assert.Equal(t, 1, *revisions[2].RevertedFrom)
+}
+
+func TestTodoRepository_RevertTodo_shouldReturnError_whenRevisionDoesNotExist(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+
+	// when
+	input, err := domain.NewRevertTodoInput(createdTodo.ID, userID, 2, nil)
+	require.NoError(t, err)
+	revertedTodo, err := repo.RevertTodo(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoRevisionNotFound)
+	assert.Nil(t, revertedTodo)
+	assert.Len(t, findTodoHistory(t, ctx, repo, createdTodo.ID, userID), 1, "a failed revert should not be recorded")
+}
+
+func TestTodoRepository_RevertTodo_shouldReturnVersionMismatch_whenExpectedVersionIsStale(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+	staleVersion := createdTodo.Version
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	// when
+	input, err := domain.NewRevertTodoInput(createdTodo.ID, userID, 1, &staleVersion)
+	require.NoError(t, err)
+	_, err = repo.RevertTodo(ctx, input)
+
+	// then
+	var mismatch *domain.TodoVersionMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, "Updated Text", mismatch.Current.Text)
+}
@@ -0,0 +1,235 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// todoLikeSearchCandidateLimit bounds the number of matching todos TodoLikeSearchRepository ranks in memory.
+const todoLikeSearchCandidateLimit = 500
+
+// fullTextOperatorRemover strips the characters that have a meaning in MySQL boolean mode full-text queries.
+var fullTextOperatorRemover = strings.NewReplacer(`+`, ``, `-`, ``, `<`, ``, `>`, ``, `(`, ``, `)`, ``, `~`, ``, `*`, ``, `"`, ``, `@`, ``)
+
+// TodoFullTextSearchRepository searches todos using MySQL FULLTEXT indexes built with the ngram parser,
+// so that text without spaces between words, such as Japanese, is searchable.
+type TodoFullTextSearchRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoFullTextSearchRepository returns a new TodoFullTextSearchRepository backed by the given GORM DB.
+func NewTodoFullTextSearchRepository(db *gorm.DB) *TodoFullTextSearchRepository {
+	return &TodoFullTextSearchRepository{
+		db: db,
+	}
+}
+
+// SearchTodos returns the user's todos whose text, or one of whose comments, contains every term of input,
+// ordered by full-text relevance. Trashed todos are not searched.
+func (r *TodoFullTextSearchRepository) SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error) {
+	against := fullTextBooleanQuery(input.Terms)
+	if against == "" {
+		return []domain.TodoSearchHit{}, nil
+	}
+
+	db := r.db.WithContext(ctx)
+	var scored []struct {
+		ID    int
+		Score float64
+	}
+	commentHits := db.Model(&TodoCommentEntity{}) //nolint:exhaustruct
+	commentHits = commentHits.Select("todo_id, MAX(MATCH(text) AGAINST(? IN BOOLEAN MODE)) AS score", against).Where("MATCH(text) AGAINST(? IN BOOLEAN MODE)", against).Group("todo_id")
+	query := db.Model(&TodoEntity{}) //nolint:exhaustruct
+	query = query.Select("todo.id, MATCH(todo.text) AGAINST(? IN BOOLEAN MODE) + COALESCE(comment_hit.score, 0) AS score", against)
+	query = query.Joins("LEFT JOIN (?) AS comment_hit ON comment_hit.todo_id = todo.id", commentHits).Where("todo.user_id = ?", input.UserID)
+	query = query.Where("MATCH(todo.text) AGAINST(? IN BOOLEAN MODE) OR comment_hit.todo_id IS NOT NULL", against)
+	if result := query.Order("score DESC, todo.id DESC").Limit(input.Limit).Scan(&scored); result.Error != nil {
+		return nil, fmt.Errorf("search todos: %w", result.Error)
+	}
+
+	ids := make([]int, len(scored))
+	scores := make(map[int]float64, len(scored))
+	for i, s := range scored {
+		ids[i] = s.ID
+		scores[s.ID] = s.Score
+	}
+	commentScope := func(db *gorm.DB) *gorm.DB {
+		return db.Where("MATCH(text) AGAINST(? IN BOOLEAN MODE)", against)
+	}
+	score := func(todo *TodoEntity, _ TodoCommentEntities) float64 {
+		return scores[todo.ID]
+	}
+
+	hits, err := findTodoSearchHits(db, ids, input.Terms, commentScope, score)
+	if err != nil {
+		return nil, fmt.Errorf("find todo search hits: %w", err)
+	}
+	return hits, nil
+}
+
+// fullTextBooleanQuery builds a boolean mode query requiring every term.
+// Terms shorter than the ngram token size can only be searched as prefixes.
+func fullTextBooleanQuery(terms []string) string {
+	required := make([]string, 0, len(terms))
+	for _, term := range terms {
+		term = fullTextOperatorRemover.Replace(term)
+		switch {
+		case term == "":
+			continue
+		case utf8.RuneCountInString(term) < 2:
+			required = append(required, "+"+term+"*")
+		default:
+			required = append(required, `+"`+term+`"`)
+		}
+	}
+	return strings.Join(required, " ")
+}
+
+// TodoLikeSearchRepository searches todos with LIKE conditions for databases without full-text search.
+// Matching todos are ranked in memory by how often the terms occur, so only the most recent
+// todoLikeSearchCandidateLimit matches are considered.
+type TodoLikeSearchRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoLikeSearchRepository returns a new TodoLikeSearchRepository backed by the given GORM DB.
+func NewTodoLikeSearchRepository(db *gorm.DB) *TodoLikeSearchRepository {
+	return &TodoLikeSearchRepository{
+		db: db,
+	}
+}
+
+// SearchTodos returns the user's todos whose text, or one of whose comments, contains every term of input,
+// ordered by the number of occurrences of the terms. Trashed todos are not searched.
+func (r *TodoLikeSearchRepository) SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error) {
+	db := r.db.WithContext(ctx)
+	textCondition, textArgs := likeAllTermsCondition("todo.text", input.Terms)
+	commentCondition, commentArgs := likeAllTermsCondition("todo_comment.text", input.Terms)
+	matchingComments := db.Model(&TodoCommentEntity{}).Select("todo_comment.todo_id").Where(commentCondition, commentArgs...) //nolint:exhaustruct
+
+	var ids []int
+	query := db.Model(&TodoEntity{}).Where("todo.user_id = ?", input.UserID) //nolint:exhaustruct
+	query = query.Where("("+textCondition+") OR todo.id IN (?)", append(textArgs, matchingComments)...)
+	if result := query.Order("todo.id DESC").Limit(todoLikeSearchCandidateLimit).Pluck("todo.id", &ids); result.Error != nil {
+		return nil, fmt.Errorf("search todos: %w", result.Error)
+	}
+
+	commentScope := func(db *gorm.DB) *gorm.DB {
+		return db.Where(commentCondition, commentArgs...)
+	}
+	score := func(todo *TodoEntity, comments TodoCommentEntities) float64 {
+		// Occurrences in the todo itself weigh more than those in its comments
+		occurrences := 2 * countTermOccurrences(todo.Text, input.Terms)
+		for _, comment := range comments {
+			occurrences += countTermOccurrences(comment.Text, input.Terms)
+		}
+		return float64(occurrences)
+	}
+
+	hits, err := findTodoSearchHits(db, ids, input.Terms, commentScope, score)
+	if err != nil {
+		return nil, fmt.Errorf("find todo search hits: %w", err)
+	}
+	// ids are in descending order, so ties stay newest first
+	slices.SortStableFunc(hits, func(a, b domain.TodoSearchHit) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if len(hits) > input.Limit {
+		hits = hits[:input.Limit]
+	}
+	return hits, nil
+}
+
+// likeAllTermsCondition builds a condition requiring column to contain every term literally.
+func likeAllTermsCondition(column string, terms []string) (string, []any) {
+	conditions := make([]string, len(terms))
+	args := make([]any, len(terms))
+	for i, term := range terms {
+		conditions[i] = column + " LIKE ?"
+		args[i] = "%" + likeEscaper.Replace(term) + "%"
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+func countTermOccurrences(text string, terms []string) int {
+	text = strings.ToLower(text)
+	count := 0
+	for _, term := range terms {
+		count += strings.Count(text, strings.ToLower(term))
+	}
+	return count
+}
+
+// findTodoSearchHits loads the todos with the given IDs, in that order, together with their scores
+// and snippets of their text and of the comments selected by commentScope.
+func findTodoSearchHits(db *gorm.DB, ids []int, terms []string, commentScope func(db *gorm.DB) *gorm.DB, score func(todo *TodoEntity, comments TodoCommentEntities) float64) ([]domain.TodoSearchHit, error) {
+	hits := make([]domain.TodoSearchHit, 0, len(ids))
+	if len(ids) == 0 {
+		return hits, nil
+	}
+
+	var todoEntities TodoEntities
+	query := db.Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	if result := query.Where("id IN ?", ids).Find(&todoEntities); result.Error != nil {
+		return nil, fmt.Errorf("find todos: %w", result.Error)
+	}
+	todoEntitiesByID := make(map[int]*TodoEntity, len(todoEntities))
+	for i := range todoEntities {
+		todoEntitiesByID[todoEntities[i].ID] = &todoEntities[i]
+	}
+
+	var commentEntities TodoCommentEntities
+	if result := db.Scopes(commentScope).Where("todo_comment.todo_id IN ?", ids).Order("todo_comment.id").Find(&commentEntities); result.Error != nil {
+		return nil, fmt.Errorf("find comments: %w", result.Error)
+	}
+	commentEntitiesByTodoID := make(map[int]TodoCommentEntities)
+	for _, comment := range commentEntities {
+		commentEntitiesByTodoID[comment.TodoID] = append(commentEntitiesByTodoID[comment.TodoID], comment)
+	}
+
+	for _, id := range ids {
+		todoEntity, ok := todoEntitiesByID[id]
+		if !ok {
+			// Deleted after it was matched
+			continue
+		}
+		todo, err := todoEntity.toTodo()
+		if err != nil {
+			return nil, fmt.Errorf("to todo: %w", err)
+		}
+
+		comments := commentEntitiesByTodoID[id]
+		snippets := make([]domain.TodoSearchSnippet, 0)
+		if snippet := domain.NewTodoSearchSnippet(domain.TodoSearchFieldText, todoEntity.Text, terms); snippet != nil {
+			snippets = append(snippets, *snippet)
+		}
+		for _, comment := range comments {
+			if snippet := domain.NewTodoSearchSnippet(domain.TodoSearchFieldComment, comment.Text, terms); snippet != nil {
+				snippets = append(snippets, *snippet)
+				break
+			}
+		}
+
+		hits = append(hits, domain.TodoSearchHit{
+			Todo:     *todo,
+			Score:    score(todoEntity, comments),
+			Snippets: snippets,
+		})
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,150 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+type todoSearcher interface {
+	SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error)
+}
+
+// todoSearchers returns every search implementation so that each test checks they behave alike.
+func todoSearchers() map[string]todoSearcher {
+	return map[string]todoSearcher{
+		"full-text": gateway.NewTodoFullTextSearchRepository(db),
+		"like":      gateway.NewTodoLikeSearchRepository(db),
+	}
+}
+
+func searchTodos(t *testing.T, ctx context.Context, searcher todoSearcher, userID int, query string) []domain.TodoSearchHit {
+	t.Helper()
+	input, err := domain.NewSearchTodosInput(userID, query, domain.DefaultTodoSearchLimit)
+	require.NoError(t, err)
+	hits, err := searcher.SearchTodos(ctx, input)
+	require.NoError(t, err)
+	return hits
+}
+
+func hitTexts(hits []domain.TodoSearchHit) []string {
+	texts := make([]string, len(hits))
+	for i, hit := range hits {
+		texts[i] = hit.Todo.Text
+	}
+	return texts
+}
+
+func TestTodoSearchRepository_SearchTodos_shouldRankTodoTextAboveComments_whenTermOccursInBoth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	commentRepo := gateway.NewTodoCommentRepository(db)
+	onlyComment := createTestTodo(t, ctx, userID, "Pay the bills")
+	createTestComment(t, ctx, commentRepo, onlyComment.ID, userID, "The invoice arrived today")
+	both := createTestTodo(t, ctx, userID, "Send the invoice")
+	createTestComment(t, ctx, commentRepo, both.ID, userID, "invoice sent by mail")
+	createTestTodo(t, ctx, userID, "Buy milk")
+
+	for name, searcher := range todoSearchers() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			hits := searchTodos(t, ctx, searcher, userID, "invoice")
+
+			// then
+			assert.Equal(t, []string{"Send the invoice", "Pay the bills"}, hitTexts(hits))
+			assert.Greater(t, hits[0].Score, hits[1].Score)
+
+			// - snippets of the todo text and of the comment
+			require.Len(t, hits[0].Snippets, 2)
+			assert.Equal(t, domain.TodoSearchSnippet{
+				Field: domain.TodoSearchFieldText,
+				Fragments: []domain.SnippetFragment{
+					{Text: "Send the ", Highlighted: false},
+					{Text: "invoice", Highlighted: true},
+				},
+			}, hits[0].Snippets[0])
+			assert.Equal(t, domain.TodoSearchSnippet{
+				Field: domain.TodoSearchFieldComment,
+				Fragments: []domain.SnippetFragment{
+					{Text: "invoice", Highlighted: true},
+					{Text: " sent by mail", Highlighted: false},
+				},
+			}, hits[0].Snippets[1])
+			require.Len(t, hits[1].Snippets, 1)
+			assert.Equal(t, domain.TodoSearchFieldComment, hits[1].Snippets[0].Field)
+		})
+	}
+}
+
+func TestTodoSearchRepository_SearchTodos_shouldFindJapaneseText_whenWordsAreNotSeparated(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	createTestTodo(t, ctx, userID, "取引先に請求書を送る")
+	createTestTodo(t, ctx, userID, "牛乳を買う")
+
+	for name, searcher := range todoSearchers() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			hits := searchTodos(t, ctx, searcher, userID, "請求書")
+
+			// then
+			assert.Equal(t, []string{"取引先に請求書を送る"}, hitTexts(hits))
+			require.Len(t, hits[0].Snippets, 1)
+			assert.Equal(t, []domain.SnippetFragment{
+				{Text: "取引先に", Highlighted: false},
+				{Text: "請求書", Highlighted: true},
+				{Text: "を送る", Highlighted: false},
+			}, hits[0].Snippets[0].Fragments)
+		})
+	}
+}
+
+func TestTodoSearchRepository_SearchTodos_shouldReturnOnlyVisibleTodosContainingAllTerms(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupTodoTable(t, otherUserID)
+	todoRepo := gateway.NewTodoRepository(db)
+	createTestTodo(t, ctx, userID, "Quarterly report draft")
+	createTestTodo(t, ctx, userID, "Quarterly planning")
+	trashed := createTestTodo(t, ctx, userID, "Quarterly report review")
+	deleteInput, err := domain.NewDeleteTodoInput(trashed.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = todoRepo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+	createTestTodo(t, ctx, otherUserID, "Quarterly report of other user")
+
+	for name, searcher := range todoSearchers() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			hits := searchTodos(t, ctx, searcher, userID, "Quarterly report")
+
+			// then
+			assert.Equal(t, []string{"Quarterly report draft"}, hitTexts(hits))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoSyncRepository reads the changes to todos for delta sync using GORM.
+type TodoSyncRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoSyncRepository returns a new TodoSyncRepository backed by the given GORM DB.
+func NewTodoSyncRepository(db *gorm.DB) *TodoSyncRepository {
+	return &TodoSyncRepository{
+		db: db,
+	}
+}
+
+// FindTodoChanges returns up to input.Limit todos of the user, with their checklists and comment counts, that were changed
+// after the position of input.Since, ordered by change sequence number and ID. Todos in the trash are included as they carry
+// the deletions; without a token, only todos outside the trash are returned.
+func (r *TodoSyncRepository) FindTodoChanges(ctx context.Context, input *domain.SyncTodosInput) (*domain.TodoChangePage, error) {
+	var entities TodoEntities
+	query := r.db.WithContext(ctx).Unscoped().Scopes(selectTodoWithCommentCount).Preload("ChecklistItems", preloadChecklistItems)
+	query = query.Where("user_id = ?", input.UserID)
+	if input.Since == nil {
+		query = query.Where("deleted_at IS NULL")
+	} else {
+		query = query.Where("(change_seq > ? OR (change_seq = ? AND id > ?))", input.Since.Seq, input.Since.Seq, input.Since.AfterID)
+	}
+	// Fetch one extra row to find out whether more changes follow
+	if result := query.Order("change_seq, id").Limit(input.Limit + 1).Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find todo changes: %w", result.Error)
+	}
+
+	hasMore := len(entities) > input.Limit
+	if hasMore {
+		entities = entities[:input.Limit]
+	}
+
+	changes := make([]domain.TodoChange, len(entities))
+	for i, entity := range entities {
+		todo, err := entity.toTodo()
+		if err != nil {
+			return nil, fmt.Errorf("to todo: %w", err)
+		}
+		changes[i] = domain.TodoChange{
+			Todo:       *todo,
+			Seq:        entity.ChangeSeq,
+			CreatedSeq: entity.CreatedChangeSeq,
+		}
+	}
+
+	return &domain.TodoChangePage{
+		Changes: changes,
+		HasMore: hasMore,
+	}, nil
+}
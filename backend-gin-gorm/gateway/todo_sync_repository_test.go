@@ -0,0 +1,121 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func findTodoChanges(t *testing.T, ctx context.Context, repo *gateway.TodoSyncRepository, userID int, since *domain.SyncToken, limit int) *domain.TodoChangePage {
+	t.Helper()
+	input, err := domain.NewSyncTodosInput(userID, since, limit)
+	require.NoError(t, err)
+	page, err := repo.FindTodoChanges(ctx, input)
+	require.NoError(t, err)
+	return page
+}
+
+func lastSyncToken(t *testing.T, page *domain.TodoChangePage) *domain.SyncToken {
+	t.Helper()
+	require.NotEmpty(t, page.Changes)
+	last := page.Changes[len(page.Changes)-1]
+	token, err := domain.NewSyncToken(last.Seq, last.Todo.ID, time.Now())
+	require.NoError(t, err)
+	return token
+}
+
+func TestTodoSyncRepository_FindTodoChanges_shouldReturnTodosOutsideTrash_whenNoTokenIsGiven(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(db)
+	repo := gateway.NewTodoSyncRepository(db)
+	kept := createTestTodo(t, ctx, userID, "kept")
+	trashed := createTestTodo(t, ctx, userID, "trashed")
+	deleteInput, err := domain.NewDeleteTodoInput(trashed.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = todoRepo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+
+	// when
+	page := findTodoChanges(t, ctx, repo, userID, nil, domain.DefaultSyncLimit)
+
+	// then
+	require.Len(t, page.Changes, 1)
+	assert.Equal(t, kept.ID, page.Changes[0].Todo.ID)
+	assert.Positive(t, page.Changes[0].Seq)
+	assert.Equal(t, page.Changes[0].Seq, page.Changes[0].CreatedSeq)
+	assert.False(t, page.HasMore)
+}
+
+func TestTodoSyncRepository_FindTodoChanges_shouldReturnTodosChangedSinceToken_inChangeOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(db)
+	repo := gateway.NewTodoSyncRepository(db)
+	first := createTestTodo(t, ctx, userID, "first")
+	second := createTestTodo(t, ctx, userID, "second")
+	untouched := createTestTodo(t, ctx, userID, "untouched")
+	since := lastSyncToken(t, findTodoChanges(t, ctx, repo, userID, nil, domain.DefaultSyncLimit))
+
+	// 同期後に second を削除し、first を更新する
+	deleteInput, err := domain.NewDeleteTodoInput(second.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = todoRepo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+	text := "first updated"
+	patchInput, err := domain.NewPatchTodoInput(first.ID, userID, &text, nil, nil)
+	require.NoError(t, err)
+	_, err = todoRepo.PatchTodo(ctx, patchInput)
+	require.NoError(t, err)
+
+	// when
+	page := findTodoChanges(t, ctx, repo, userID, since, domain.DefaultSyncLimit)
+
+	// then
+	require.Len(t, page.Changes, 2)
+	assert.Equal(t, second.ID, page.Changes[0].Todo.ID)
+	assert.NotNil(t, page.Changes[0].Todo.DeletedAt, "the trashed todo should be returned as a deletion")
+	assert.Equal(t, first.ID, page.Changes[1].Todo.ID)
+	assert.Equal(t, "first updated", page.Changes[1].Todo.Text)
+	assert.Less(t, page.Changes[0].Seq, page.Changes[1].Seq)
+	assert.NotEqual(t, untouched.ID, page.Changes[1].Todo.ID)
+}
+
+func TestTodoSyncRepository_FindTodoChanges_shouldPageThroughChanges_whenLimitIsReached(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoSyncRepository(db)
+	for _, text := range []string{"a", "b", "c"} {
+		createTestTodo(t, ctx, userID, text)
+	}
+
+	// when
+	firstPage := findTodoChanges(t, ctx, repo, userID, nil, 2)
+	secondPage := findTodoChanges(t, ctx, repo, userID, lastSyncToken(t, firstPage), 2)
+
+	// then
+	require.Len(t, firstPage.Changes, 2)
+	assert.True(t, firstPage.HasMore)
+	require.Len(t, secondPage.Changes, 1)
+	assert.Equal(t, "c", secondPage.Changes[0].Todo.Text)
+	assert.False(t, secondPage.HasMore)
+}
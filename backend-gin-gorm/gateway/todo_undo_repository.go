@@ -0,0 +1,212 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoUndoEntity is the GORM model for the "todo_undo" table, one row per todo an undo token reverses.
+// Version is the version the write left the todo at and Revision the latest revision of the todo before the write.
+type TodoUndoEntity struct {
+	Token     string    `gorm:"primaryKey;type:char(32)"`
+	TodoID    int       `gorm:"primaryKey"`
+	UserID    int       `gorm:"not null"`
+	Action    string    `gorm:"type:varchar(20);not null"`
+	Version   int       `gorm:"not null"`
+	Revision  int       `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	ExpiresAt time.Time `gorm:"not null"`
+}
+
+func (e *TodoUndoEntity) TableName() string {
+	return "todo_undo"
+}
+
+// TodoUndoRepository records and applies undo tokens for writes to todos using GORM.
+type TodoUndoRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoUndoRepository returns a new TodoUndoRepository backed by the given GORM DB.
+func NewTodoUndoRepository(db *gorm.DB) *TodoUndoRepository {
+	return &TodoUndoRepository{
+		db: db,
+	}
+}
+
+// RecordTodoUndo records the undo of the write to input.Targets under input.Token and returns how many todos it covers.
+// A todo is covered if the user may still edit it and it is still as the write left it: its latest revision is the
+// write's, made by the user, it is in the trash after a delete and out of it after an update, and it is at the version
+// of the target, if set. Todos changed or purged since the write are skipped.
+func (r *TodoUndoRepository) RecordTodoUndo(ctx context.Context, input *domain.RecordTodoUndoInput) (int, error) {
+	revisionAction := domain.TodoRevisionUpdated
+	if input.Action == domain.TodoUndoDelete {
+		revisionAction = domain.TodoRevisionDeleted
+	}
+
+	recorded := 0
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, target := range input.Targets {
+			ownerID, err := authorizeTodo(tx.Unscoped(), target.TodoID, input.UserID, domain.TodoListEditor)
+			if errors.Is(err, domain.ErrTodoNotFound) || errors.Is(err, domain.ErrForbidden) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			todo, err := lockUndoTarget(tx, target.TodoID, ownerID)
+			if errors.Is(err, domain.ErrTodoNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if todo.DeletedAt.Valid != (input.Action == domain.TodoUndoDelete) {
+				continue
+			}
+			if target.Version != nil && *target.Version != todo.Version {
+				continue
+			}
+
+			var latest TodoRevisionEntity
+			if result := tx.Where("todo_id = ?", target.TodoID).Order("revision DESC").Limit(1).Find(&latest); result.Error != nil {
+				return fmt.Errorf("find latest todo revision: %w", result.Error)
+			}
+			if latest.Revision <= 1 || latest.Action != string(revisionAction) || latest.UserID != input.UserID {
+				continue
+			}
+
+			entity := TodoUndoEntity{ //nolint:exhaustruct
+				Token:     input.Token,
+				TodoID:    target.TodoID,
+				UserID:    input.UserID,
+				Action:    string(input.Action),
+				Version:   todo.Version,
+				Revision:  latest.Revision - 1,
+				ExpiresAt: input.ExpiresAt,
+			}
+			if result := tx.Create(&entity); result.Error != nil {
+				return fmt.Errorf("create todo undo: %w", result.Error)
+			}
+			recorded++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("record todo undo: %w", err)
+	}
+
+	return recorded, nil
+}
+
+// ApplyTodoUndo reverses the write input.Token was issued for in one transaction and returns the todos as it left them.
+// An update is reversed by reverting each todo to the revision before the write and a delete by restoring each todo
+// from the trash. The token can be used once. Returns ErrTodoUndoNotFound if the token is unknown, expired, used or
+// issued to another user, and an error wrapping ErrTodoUndoConflict, with nothing changed, if a todo changed since the write
+// or the user may no longer edit it. The changes are numbered in the change sequence of the owner of each todo.
+func (r *TodoUndoRepository) ApplyTodoUndo(ctx context.Context, input *domain.ApplyTodoUndoInput) ([]domain.Todo, error) {
+	var todos []domain.Todo
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entities []TodoUndoEntity
+		query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where("token = ? AND user_id = ? AND expires_at > ?", input.Token, input.UserID, input.Now)
+		if result := query.Order("todo_id").Find(&entities); result.Error != nil {
+			return fmt.Errorf("find todo undo: %w", result.Error)
+		}
+		if len(entities) == 0 {
+			return domain.ErrTodoUndoNotFound
+		}
+
+		ownerIDs := make([]int, len(entities))
+		for i, entity := range entities {
+			ownerID, err := authorizeTodo(tx.Unscoped(), entity.TodoID, entity.UserID, domain.TodoListEditor)
+			if errors.Is(err, domain.ErrTodoNotFound) || errors.Is(err, domain.ErrForbidden) {
+				return fmt.Errorf("todo %d: %w", entity.TodoID, domain.ErrTodoUndoConflict)
+			}
+			if err != nil {
+				return err
+			}
+			ownerIDs[i] = ownerID
+		}
+		seqs, err := nextTodoChangeSeqs(tx, ownerIDs)
+		if err != nil {
+			return err
+		}
+
+		todos = make([]domain.Todo, 0, len(entities))
+		for i, entity := range entities {
+			todo, err := applyTodoUndo(tx, seqs[ownerIDs[i]], ownerIDs[i], &entity)
+			if err != nil {
+				return err
+			}
+			todos = append(todos, *todo)
+		}
+
+		if result := tx.Where("token = ?", input.Token).Delete(&TodoUndoEntity{}); result.Error != nil { //nolint:exhaustruct
+			return fmt.Errorf("delete todo undo: %w", result.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("apply todo undo: %w", err)
+	}
+
+	return todos, nil
+}
+
+// PurgeTodoUndos deletes up to input.BatchSize undo records of any user that expired before input.ExpiredBefore
+// and returns how many were deleted.
+func (r *TodoUndoRepository) PurgeTodoUndos(ctx context.Context, input *domain.PurgeTodoUndosInput) (int, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", input.ExpiredBefore).
+		Limit(input.BatchSize).
+		Delete(&TodoUndoEntity{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return 0, fmt.Errorf("purge todo undos: %w", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// applyTodoUndo reverses the write recorded by entity for one todo of ownerID, which must still be at the version the write left it at.
+func applyTodoUndo(tx *gorm.DB, seq int64, ownerID int, entity *TodoUndoEntity) (*domain.Todo, error) {
+	todo, err := lockUndoTarget(tx, entity.TodoID, ownerID)
+	if errors.Is(err, domain.ErrTodoNotFound) {
+		return nil, fmt.Errorf("todo %d: %w", entity.TodoID, domain.ErrTodoUndoConflict)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if todo.Version != entity.Version {
+		return nil, fmt.Errorf("todo %d: %w", entity.TodoID, domain.ErrTodoUndoConflict)
+	}
+
+	if domain.TodoUndoAction(entity.Action) == domain.TodoUndoDelete {
+		return restoreTrashedTodo(tx, seq, entity.TodoID, entity.UserID)
+	}
+	before := domain.TodoFieldValues{
+		Text:       todo.Text,
+		IsComplete: todo.IsComplete,
+	}
+	return revertLockedTodo(tx, seq, entity.TodoID, entity.UserID, entity.Revision, before)
+}
+
+// lockUndoTarget takes a row lock on the todo of ownerID, in the trash or not, and returns its fields and version.
+// Returns ErrTodoNotFound if the owner has no such todo.
+func lockUndoTarget(tx *gorm.DB, todoID int, ownerID int) (*TodoEntity, error) {
+	var entity TodoEntity
+	query := tx.Unscoped().Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Select("id", "text", "is_complete", "deleted_at", "version") //nolint:exhaustruct
+	if result := query.Where("id = ? AND user_id = ?", todoID, ownerID).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTodoNotFound
+		}
+		return nil, fmt.Errorf("lock todo: %w", result.Error)
+	}
+	return &entity, nil
+}
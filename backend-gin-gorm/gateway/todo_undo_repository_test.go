@@ -0,0 +1,301 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func recordTodoUndo(t *testing.T, ctx context.Context, userID int, action domain.TodoUndoAction, expiresAt time.Time, targets ...domain.TodoUndoTarget) (string, int) {
+	t.Helper()
+	token, err := domain.GenerateTodoUndoToken()
+	require.NoError(t, err)
+	request, err := domain.NewCreateTodoUndoInput(userID, action, targets)
+	require.NoError(t, err)
+	input, err := domain.NewRecordTodoUndoInput(request, token, expiresAt)
+	require.NoError(t, err)
+	recorded, err := gateway.NewTodoUndoRepository(db).RecordTodoUndo(ctx, input)
+	require.NoError(t, err)
+	return token, recorded
+}
+
+func applyTodoUndo(ctx context.Context, userID int, token string) ([]domain.Todo, error) {
+	request, err := domain.NewUndoTodosInput(userID, token)
+	if err != nil {
+		return nil, err
+	}
+	input, err := domain.NewApplyTodoUndoInput(request, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return gateway.NewTodoUndoRepository(db).ApplyTodoUndo(ctx, input)
+}
+
+// ApplyTodoUndo Tests
+
+func TestTodoUndoRepository_ApplyTodoUndo_shouldRevertUpdate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", true, nil)
+	require.NoError(t, err)
+	updatedTodo, err := repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	token, recorded := recordTodoUndo(t, ctx, userID, domain.TodoUndoUpdate, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: updatedTodo.ID, Version: &updatedTodo.Version})
+	require.Equal(t, 1, recorded)
+
+	// when
+	todos, err := applyTodoUndo(ctx, userID, token)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, "Original Text", todos[0].Text)
+	assert.False(t, todos[0].IsComplete)
+	assert.Nil(t, todos[0].CompletedAt)
+
+	revisions := findTodoHistory(t, ctx, repo, createdTodo.ID, userID)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, domain.TodoRevisionReverted, revisions[2].Action)
+	require.NotNil(t, revisions[2].RevertedFrom)
+	assert.Equal(t, 1, *revisions[2].RevertedFrom)
+}
+
+func TestTodoUndoRepository_ApplyTodoUndo_shouldRestoreDeletedTodos(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	first := createTestTodo(t, ctx, userID, "First")
+	second := createTestTodo(t, ctx, userID, "Second")
+	for _, todo := range []*domain.Todo{first, second} {
+		deleteInput, err := domain.NewDeleteTodoInput(todo.ID, userID, nil)
+		require.NoError(t, err)
+		_, err = repo.DeleteTodo(ctx, deleteInput)
+		require.NoError(t, err)
+	}
+	token, recorded := recordTodoUndo(t, ctx, userID, domain.TodoUndoDelete, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: first.ID}, domain.TodoUndoTarget{TodoID: second.ID})
+	require.Equal(t, 2, recorded)
+
+	// when
+	todos, err := applyTodoUndo(ctx, userID, token)
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, todos, 2)
+	remaining, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	assert.Len(t, remaining, 2, "both todos should be out of the trash")
+}
+
+func TestTodoUndoRepository_ApplyTodoUndo_shouldReturnConflict_whenTodoChangedSince(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, userID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	updatedTodo, err := repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	token, _ := recordTodoUndo(t, ctx, userID, domain.TodoUndoUpdate, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: updatedTodo.ID, Version: &updatedTodo.Version})
+	laterInput, err := domain.NewUpdateTodoInput(createdTodo.ID, userID, "Edited Elsewhere", false, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, laterInput)
+	require.NoError(t, err)
+
+	// when
+	todos, err := applyTodoUndo(ctx, userID, token)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoUndoConflict)
+	assert.Nil(t, todos)
+	findInput, err := domain.NewFindTodoInput(createdTodo.ID, userID)
+	require.NoError(t, err)
+	current, err := repo.FindTodo(ctx, findInput)
+	require.NoError(t, err)
+	assert.Equal(t, "Edited Elsewhere", current.Text, "a refused undo should change nothing")
+}
+
+func TestTodoUndoRepository_ApplyTodoUndo_shouldRevertUpdate_whenEditorUpdatedSharedTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, gateway.NewTodoListMemberRepository(db), ownerID, editorID, domain.TodoListEditor)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, ownerID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, editorID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	updatedTodo, err := repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	token, recorded := recordTodoUndo(t, ctx, editorID, domain.TodoUndoUpdate, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: updatedTodo.ID, Version: &updatedTodo.Version})
+	require.Equal(t, 1, recorded, "the editor should be able to undo a write to a shared todo")
+
+	// when
+	todos, err := applyTodoUndo(ctx, editorID, token)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, "Original Text", todos[0].Text)
+	assert.Equal(t, ownerID, todos[0].UserID)
+
+	// 同期が owner の変更として拾えるように、owner の変更シーケンス番号が使われていることを確認
+	var entity gateway.TodoEntity
+	require.NoError(t, db.Where("id = ?", createdTodo.ID).First(&entity).Error)
+	var sequence gateway.TodoChangeSequenceEntity
+	require.NoError(t, db.Where("user_id = ?", ownerID).First(&sequence).Error)
+	assert.Equal(t, sequence.LastSeq, entity.ChangeSeq)
+}
+
+func TestTodoUndoRepository_ApplyTodoUndo_shouldReturnConflict_whenEditorLostAccess(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, gateway.NewTodoListMemberRepository(db), ownerID, editorID, domain.TodoListEditor)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, ownerID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, editorID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	token, recorded := recordTodoUndo(t, ctx, editorID, domain.TodoUndoUpdate, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: createdTodo.ID})
+	require.Equal(t, 1, recorded)
+	cleanupTodoListMemberTable(t, ownerID)
+
+	// when
+	todos, err := applyTodoUndo(ctx, editorID, token)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoUndoConflict)
+	assert.Nil(t, todos)
+}
+
+func TestTodoUndoRepository_ApplyTodoUndo_shouldReturnNotFound_whenTokenUsedExpiredOrOtherUsers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1000000
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	used := createTestTodo(t, ctx, userID, "Used")
+	expired := createTestTodo(t, ctx, userID, "Expired")
+	for _, todo := range []*domain.Todo{used, expired} {
+		deleteInput, err := domain.NewDeleteTodoInput(todo.ID, userID, nil)
+		require.NoError(t, err)
+		_, err = repo.DeleteTodo(ctx, deleteInput)
+		require.NoError(t, err)
+	}
+	usedToken, _ := recordTodoUndo(t, ctx, userID, domain.TodoUndoDelete, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: used.ID})
+	_, err := applyTodoUndo(ctx, userID, usedToken)
+	require.NoError(t, err)
+	expiredToken, _ := recordTodoUndo(t, ctx, userID, domain.TodoUndoDelete, time.Now().Add(-time.Second), domain.TodoUndoTarget{TodoID: expired.ID})
+
+	tests := []struct {
+		name   string
+		userID int
+		token  string
+	}{
+		{name: "used", userID: userID, token: usedToken},
+		{name: "expired", userID: userID, token: expiredToken},
+		{name: "other user", userID: otherUserID, token: expiredToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			_, err := applyTodoUndo(ctx, tt.userID, tt.token)
+
+			// then
+			require.ErrorIs(t, err, domain.ErrTodoUndoNotFound)
+		})
+	}
+}
+
+// RecordTodoUndo Tests
+
+func TestTodoUndoRepository_RecordTodoUndo_shouldSkipTodos_whenNotAsTheWriteLeftThem(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(db)
+	createdOnly := createTestTodo(t, ctx, userID, "Never Updated")
+	updated := createTestTodo(t, ctx, userID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(updated.ID, userID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	updatedTodo, err := repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	staleVersion := updatedTodo.Version - 1
+
+	// when
+	_, recorded := recordTodoUndo(t, ctx, userID, domain.TodoUndoUpdate, time.Now().Add(time.Minute),
+		domain.TodoUndoTarget{TodoID: createdOnly.ID},
+		domain.TodoUndoTarget{TodoID: updated.ID, Version: &staleVersion},
+	)
+	_, recordedDelete := recordTodoUndo(t, ctx, userID, domain.TodoUndoDelete, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: updated.ID})
+
+	// then
+	assert.Equal(t, 0, recorded, "a todo without an update or at another version should not be undoable")
+	assert.Equal(t, 0, recordedDelete, "a todo outside the trash should not have its delete undone")
+}
+
+func TestTodoUndoRepository_RecordTodoUndo_shouldSkipTodos_whenUserMayNotEditThem(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+	strangerID := ownerID + 2
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, gateway.NewTodoListMemberRepository(db), ownerID, viewerID, domain.TodoListViewer)
+	repo := gateway.NewTodoRepository(db)
+	createdTodo := createTestTodo(t, ctx, ownerID, "Original Text")
+	updateInput, err := domain.NewUpdateTodoInput(createdTodo.ID, ownerID, "Updated Text", false, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	// when
+	_, recordedByViewer := recordTodoUndo(t, ctx, viewerID, domain.TodoUndoUpdate, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: createdTodo.ID})
+	_, recordedByStranger := recordTodoUndo(t, ctx, strangerID, domain.TodoUndoUpdate, time.Now().Add(time.Minute), domain.TodoUndoTarget{TodoID: createdTodo.ID})
+
+	// then
+	assert.Equal(t, 0, recordedByViewer, "a viewer should not be able to undo a write")
+	assert.Equal(t, 0, recordedByStranger, "a user the list is not shared with should not be able to undo a write")
+}
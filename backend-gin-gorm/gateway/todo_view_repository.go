@@ -0,0 +1,214 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoViewEntity is the GORM model for the "todo_view" table.
+type TodoViewEntity struct {
+	ID            int       `gorm:"primaryKey;autoIncrement"`
+	UserID        int       `gorm:"not null"`
+	Name          string    `gorm:"type:varchar(100);not null"`
+	Filter        string    `gorm:"type:varchar(500);not null"`
+	SortField     string    `gorm:"type:varchar(20);not null"`
+	SortDirection string    `gorm:"type:varchar(4);not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+func (e *TodoViewEntity) TableName() string {
+	return "todo_view"
+}
+
+func (e *TodoViewEntity) toView() (*domain.View, error) {
+	sort, err := domain.NewTodoSort(domain.TodoSortField(e.SortField), domain.SortDirection(e.SortDirection))
+	if err != nil {
+		return nil, fmt.Errorf("to todo sort: %w", err)
+	}
+
+	view, err := domain.NewView(e.ID, e.UserID, e.Name, e.Filter, *sort, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to view model: %w", err)
+	}
+
+	return view, nil
+}
+
+// TodoViewEntities is a slice of TodoViewEntity with batch conversion support.
+type TodoViewEntities []TodoViewEntity
+
+func (e TodoViewEntities) toViews() ([]domain.View, error) {
+	views := make([]domain.View, len(e))
+	for i, viewE := range e {
+		view, err := viewE.toView()
+		if err != nil {
+			return nil, fmt.Errorf("to view: %w", err)
+		}
+		views[i] = *view
+	}
+
+	return views, nil
+}
+
+// TodoViewRepository implements saved view persistence operations using GORM.
+type TodoViewRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoViewRepository returns a new TodoViewRepository backed by the given GORM DB.
+func NewTodoViewRepository(db *gorm.DB) *TodoViewRepository {
+	return &TodoViewRepository{
+		db: db,
+	}
+}
+
+// CreateView inserts a view for the user.
+// Returns ErrViewLimitExceeded if the user already has MaxViewsPerUser views and ErrDuplicateViewName if the name is taken.
+func (r *TodoViewRepository) CreateView(ctx context.Context, input *domain.CreateViewInput) (*domain.View, error) {
+	entity := &TodoViewEntity{ //nolint:exhaustruct
+		UserID:        input.UserID,
+		Name:          input.Name,
+		Filter:        input.Filter,
+		SortField:     string(input.Sort.Field),
+		SortDirection: string(input.Sort.Direction),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the user's views so that concurrent creates cannot both pass the limit check
+		var count int64
+		query := tx.Model(&TodoViewEntity{}).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}) //nolint:exhaustruct
+		if result := query.Where("user_id = ?", input.UserID).Count(&count); result.Error != nil {
+			return fmt.Errorf("count views: %w", result.Error)
+		}
+		if count >= domain.MaxViewsPerUser {
+			return domain.ErrViewLimitExceeded
+		}
+
+		if result := tx.Create(entity); result.Error != nil {
+			if isDuplicateKeyError(result.Error) {
+				return domain.ErrDuplicateViewName
+			}
+			return fmt.Errorf("create view: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload created view: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create view: %w", err)
+	}
+
+	view, err := entity.toView()
+	if err != nil {
+		return nil, fmt.Errorf("to view: %w", err)
+	}
+
+	return view, nil
+}
+
+// FindViews returns the user's views in creation order.
+func (r *TodoViewRepository) FindViews(ctx context.Context, userID int) ([]domain.View, error) {
+	var entities TodoViewEntities
+	if result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find views: %w", result.Error)
+	}
+
+	views, err := entities.toViews()
+	if err != nil {
+		return nil, fmt.Errorf("to views: %w", err)
+	}
+
+	return views, nil
+}
+
+// FindView returns a view of the user. Returns ErrViewNotFound if not found.
+func (r *TodoViewRepository) FindView(ctx context.Context, id int, userID int) (*domain.View, error) {
+	var entity TodoViewEntity
+	if err := findOwnedView(r.db.WithContext(ctx), &entity, id, userID); err != nil {
+		return nil, err
+	}
+
+	view, err := entity.toView()
+	if err != nil {
+		return nil, fmt.Errorf("to view: %w", err)
+	}
+
+	return view, nil
+}
+
+// UpdateView replaces the name, filter and sort of a view of the user.
+// Returns ErrViewNotFound if not found and ErrDuplicateViewName if the name is taken by another view.
+func (r *TodoViewRepository) UpdateView(ctx context.Context, input *domain.UpdateViewInput) (*domain.View, error) {
+	var entity TodoViewEntity
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := findOwnedView(tx, &entity, input.ID, input.UserID); err != nil {
+			return err
+		}
+
+		if result := tx.Model(&entity).Updates(map[string]any{
+			"name":           input.Name,
+			"filter":         input.Filter,
+			"sort_field":     string(input.Sort.Field),
+			"sort_direction": string(input.Sort.Direction),
+		}); result.Error != nil {
+			if isDuplicateKeyError(result.Error) {
+				return domain.ErrDuplicateViewName
+			}
+			return fmt.Errorf("update view: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(&entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload updated view: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update view: %w", err)
+	}
+
+	view, err := entity.toView()
+	if err != nil {
+		return nil, fmt.Errorf("to view: %w", err)
+	}
+
+	return view, nil
+}
+
+// DeleteView removes a view of the user. Returns ErrViewNotFound if not found.
+func (r *TodoViewRepository) DeleteView(ctx context.Context, input *domain.DeleteViewInput) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", input.ID, input.UserID).Delete(&TodoViewEntity{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return fmt.Errorf("delete view: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrViewNotFound
+	}
+
+	return nil
+}
+
+func findOwnedView(db *gorm.DB, entity *TodoViewEntity, id int, userID int) error {
+	if result := db.Where("id = ? AND user_id = ?", id, userID).First(entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return domain.ErrViewNotFound
+		}
+		return fmt.Errorf("find view: %w", result.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package gateway_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func cleanupTodoViewTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := db.Exec("DELETE FROM todo_view WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table todo_view: %v", err)
+	}
+}
+
+func createTestView(t *testing.T, ctx context.Context, repo *gateway.TodoViewRepository, userID int, name string, filter string) *domain.View {
+	t.Helper()
+	input, err := domain.NewCreateViewInput(userID, name, filter, domain.DefaultTodoSort)
+	require.NoError(t, err)
+	view, err := repo.CreateView(ctx, input)
+	require.NoError(t, err, "Failed to insert test data")
+	return view
+}
+
+// CreateView Tests
+
+func TestTodoViewRepository_CreateView_shouldReturnView_whenNameIsUnique(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	sort := domain.TodoSort{Field: domain.TodoSortFieldUpdatedAt, Direction: domain.SortDirectionDesc}
+	input, err := domain.NewCreateViewInput(userID, "Recent", "updated<7d", sort)
+	require.NoError(t, err)
+
+	// when
+	view, err := repo.CreateView(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Positive(t, view.ID)
+	assert.Equal(t, "Recent", view.Name)
+	assert.Equal(t, "updated<7d", view.Filter)
+	assert.Equal(t, sort, view.Sort)
+}
+
+func TestTodoViewRepository_CreateView_shouldReturnError_whenNameIsTaken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	createTestView(t, ctx, repo, userID, "Open", "is:open")
+	input, err := domain.NewCreateViewInput(userID, "Open", "is:open text:a", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	view, err := repo.CreateView(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrDuplicateViewName)
+	assert.Nil(t, view)
+}
+
+func TestTodoViewRepository_CreateView_shouldReturnError_whenUserHasMaxViews(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	for i := range domain.MaxViewsPerUser {
+		createTestView(t, ctx, repo, userID, fmt.Sprintf("View %d", i), "")
+	}
+	input, err := domain.NewCreateViewInput(userID, "One more", "", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	view, err := repo.CreateView(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewLimitExceeded)
+	assert.Nil(t, view)
+}
+
+// FindViews Tests
+
+func TestTodoViewRepository_FindViews_shouldReturnOnlyUsersViewsInCreationOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	cleanupTodoViewTable(t, otherUserID)
+	repo := gateway.NewTodoViewRepository(db)
+	createTestView(t, ctx, repo, userID, "B", "is:open")
+	createTestView(t, ctx, repo, userID, "A", "is:done")
+	createTestView(t, ctx, repo, otherUserID, "C", "")
+
+	// when
+	views, err := repo.FindViews(ctx, userID)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+	assert.Equal(t, "B", views[0].Name)
+	assert.Equal(t, "A", views[1].Name)
+}
+
+// UpdateView Tests
+
+func TestTodoViewRepository_UpdateView_shouldReplaceDefinition_whenViewExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	created := createTestView(t, ctx, repo, userID, "Open", "is:open")
+	sort := domain.TodoSort{Field: domain.TodoSortFieldText, Direction: domain.SortDirectionAsc}
+	input, err := domain.NewUpdateViewInput(created.ID, userID, "Done", "is:done", sort)
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateView(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Done", updated.Name)
+	assert.Equal(t, "is:done", updated.Filter)
+	assert.Equal(t, sort, updated.Sort)
+}
+
+func TestTodoViewRepository_UpdateView_shouldReturnError_whenViewOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	created := createTestView(t, ctx, repo, userID, "Open", "is:open")
+	input, err := domain.NewUpdateViewInput(created.ID, otherUserID, "Mine", "", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateView(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewNotFound)
+	assert.Nil(t, updated)
+}
+
+func TestTodoViewRepository_UpdateView_shouldReturnError_whenNameIsTakenByAnotherView(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	createTestView(t, ctx, repo, userID, "Open", "is:open")
+	done := createTestView(t, ctx, repo, userID, "Done", "is:done")
+	input, err := domain.NewUpdateViewInput(done.ID, userID, "Open", "is:done", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	updated, err := repo.UpdateView(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrDuplicateViewName)
+	assert.Nil(t, updated)
+}
+
+// DeleteView Tests
+
+func TestTodoViewRepository_DeleteView_shouldRemoveView_whenViewExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	repo := gateway.NewTodoViewRepository(db)
+	created := createTestView(t, ctx, repo, userID, "Open", "is:open")
+	input, err := domain.NewDeleteViewInput(created.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	err = repo.DeleteView(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	_, err = repo.FindView(ctx, created.ID, userID)
+	require.ErrorIs(t, err, domain.ErrViewNotFound)
+}
+
+func TestTodoViewRepository_DeleteView_shouldReturnError_whenViewNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	repo := gateway.NewTodoViewRepository(db)
+	input, err := domain.NewDeleteViewInput(999999999, userID)
+	require.NoError(t, err)
+
+	// when
+	err = repo.DeleteView(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewNotFound)
+}
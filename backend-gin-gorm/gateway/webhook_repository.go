@@ -0,0 +1,373 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookEntity is the GORM model for the "webhook" table.
+// Events holds the subscribed todo event types separated by commas.
+type WebhookEntity struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	UserID    int       `gorm:"not null"`
+	URL       string    `gorm:"column:url;type:varchar(2048);not null"`
+	Events    string    `gorm:"type:varchar(100);not null"`
+	Secret    string    `gorm:"type:varchar(100);not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (e *WebhookEntity) TableName() string {
+	return "webhook"
+}
+
+func (e *WebhookEntity) toWebhook() (*domain.Webhook, error) {
+	names := strings.Split(e.Events, ",")
+	events := make([]domain.TodoEventType, len(names))
+	for i, name := range names {
+		events[i] = domain.TodoEventType(name)
+	}
+
+	webhook, err := domain.NewWebhook(e.ID, e.UserID, e.URL, events, e.Secret, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to webhook model: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// WebhookEntities is a slice of WebhookEntity with batch conversion support.
+type WebhookEntities []WebhookEntity
+
+func (e WebhookEntities) toWebhooks() ([]domain.Webhook, error) {
+	webhooks := make([]domain.Webhook, len(e))
+	for i, webhookE := range e {
+		webhook, err := webhookE.toWebhook()
+		if err != nil {
+			return nil, fmt.Errorf("to webhook: %w", err)
+		}
+		webhooks[i] = *webhook
+	}
+
+	return webhooks, nil
+}
+
+// WebhookDeliveryEntity is the GORM model for the "webhook_delivery" table.
+type WebhookDeliveryEntity struct {
+	ID             int       `gorm:"primaryKey;autoIncrement"`
+	WebhookID      int       `gorm:"not null"`
+	EventID        string    `gorm:"type:char(32);not null"`
+	EventType      string    `gorm:"type:varchar(20);not null"`
+	Payload        string    `gorm:"type:mediumtext;not null"`
+	Status         string    `gorm:"type:varchar(20);not null"`
+	Attempts       int       `gorm:"not null"`
+	NextAttemptAt  time.Time `gorm:"not null"`
+	LastAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      string    `gorm:"type:varchar(1000);not null"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+func (e *WebhookDeliveryEntity) TableName() string {
+	return "webhook_delivery"
+}
+
+func (e *WebhookDeliveryEntity) toWebhookDelivery() (*domain.WebhookDelivery, error) {
+	delivery, err := domain.NewWebhookDelivery(e.ID, e.WebhookID, e.EventID, domain.TodoEventType(e.EventType), []byte(e.Payload),
+		domain.WebhookDeliveryStatus(e.Status), e.Attempts, e.NextAttemptAt, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("to webhook delivery model: %w", err)
+	}
+	delivery.LastAttemptAt = e.LastAttemptAt
+	delivery.LastStatusCode = e.LastStatusCode
+	delivery.LastError = e.LastError
+
+	return delivery, nil
+}
+
+// WebhookDeliveryEntities is a slice of WebhookDeliveryEntity with batch conversion support.
+type WebhookDeliveryEntities []WebhookDeliveryEntity
+
+func (e WebhookDeliveryEntities) toWebhookDeliveries() ([]domain.WebhookDelivery, error) {
+	deliveries := make([]domain.WebhookDelivery, len(e))
+	for i, deliveryE := range e {
+		delivery, err := deliveryE.toWebhookDelivery()
+		if err != nil {
+			return nil, fmt.Errorf("to webhook delivery: %w", err)
+		}
+		deliveries[i] = *delivery
+	}
+
+	return deliveries, nil
+}
+
+// WebhookRepository implements webhook and webhook delivery persistence operations using GORM.
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository returns a new WebhookRepository backed by the given GORM DB.
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+// CreateWebhook inserts a webhook for the user that signs its payloads with the secret.
+// Returns ErrWebhookLimitExceeded if the user already has MaxWebhooksPerUser webhooks.
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, input *domain.CreateWebhookInput, secret string) (*domain.Webhook, error) {
+	events := make([]string, len(input.Events))
+	for i, event := range input.Events {
+		events[i] = string(event)
+	}
+	entity := &WebhookEntity{ //nolint:exhaustruct
+		UserID: input.UserID,
+		URL:    input.URL,
+		Events: strings.Join(events, ","),
+		Secret: secret,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the user's webhooks so that concurrent creates cannot both pass the limit check
+		var count int64
+		query := tx.Model(&WebhookEntity{}).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}) //nolint:exhaustruct
+		if result := query.Where("user_id = ?", input.UserID).Count(&count); result.Error != nil {
+			return fmt.Errorf("count webhooks: %w", result.Error)
+		}
+		if count >= domain.MaxWebhooksPerUser {
+			return domain.ErrWebhookLimitExceeded
+		}
+
+		if result := tx.Create(entity); result.Error != nil {
+			return fmt.Errorf("create webhook: %w", result.Error)
+		}
+
+		// Re-read to get DB-precision timestamps
+		if result := tx.First(entity, entity.ID); result.Error != nil {
+			return fmt.Errorf("reload created webhook: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create webhook: %w", err)
+	}
+
+	webhook, err := entity.toWebhook()
+	if err != nil {
+		return nil, fmt.Errorf("to webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// FindWebhooks returns the user's webhooks in creation order.
+func (r *WebhookRepository) FindWebhooks(ctx context.Context, userID int) ([]domain.Webhook, error) {
+	var entities WebhookEntities
+	if result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("id").Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find webhooks: %w", result.Error)
+	}
+
+	webhooks, err := entities.toWebhooks()
+	if err != nil {
+		return nil, fmt.Errorf("to webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook of the user. Its deliveries are removed by the database cascade.
+// Returns ErrWebhookNotFound if not found.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, input *domain.DeleteWebhookInput) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", input.ID, input.UserID).Delete(&WebhookEntity{}) //nolint:exhaustruct
+	if result.Error != nil {
+		return fmt.Errorf("delete webhook: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// FindWebhookDeliveries returns up to input.Limit deliveries of a webhook of the user, most recent first.
+// Returns ErrWebhookNotFound if the webhook is not found.
+func (r *WebhookRepository) FindWebhookDeliveries(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error) {
+	db := r.db.WithContext(ctx)
+
+	var count int64
+	if result := db.Model(&WebhookEntity{}).Where("id = ? AND user_id = ?", input.WebhookID, input.UserID).Count(&count); result.Error != nil { //nolint:exhaustruct
+		return nil, fmt.Errorf("find webhook: %w", result.Error)
+	}
+	if count == 0 {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	var entities WebhookDeliveryEntities
+	if result := db.Where("webhook_id = ?", input.WebhookID).Order("id DESC").Limit(input.Limit).Find(&entities); result.Error != nil {
+		return nil, fmt.Errorf("find webhook deliveries: %w", result.Error)
+	}
+
+	deliveries, err := entities.toWebhookDeliveries()
+	if err != nil {
+		return nil, fmt.Errorf("to webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// EnqueueWebhookDeliveries adds a pending delivery, due now, of each event to each webhook of its user
+// that subscribes to its type, and returns how many were added.
+// An event that was already queued for a webhook is not queued again, so an event published twice is delivered once.
+func (r *WebhookRepository) EnqueueWebhookDeliveries(ctx context.Context, events []domain.WebhookEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+	userIDs := make([]int, 0, len(events))
+	for _, event := range events {
+		userIDs = append(userIDs, event.UserID)
+	}
+
+	db := r.db.WithContext(ctx)
+	var webhookEntities WebhookEntities
+	if result := db.Where("user_id IN ?", userIDs).Order("id").Find(&webhookEntities); result.Error != nil {
+		return 0, fmt.Errorf("find webhooks: %w", result.Error)
+	}
+	if len(webhookEntities) == 0 {
+		return 0, nil
+	}
+	webhooks, err := webhookEntities.toWebhooks()
+	if err != nil {
+		return 0, fmt.Errorf("to webhooks: %w", err)
+	}
+
+	now := time.Now()
+	entities := make([]WebhookDeliveryEntity, 0)
+	for _, event := range events {
+		for _, webhook := range webhooks {
+			if webhook.UserID != event.UserID || !webhook.Subscribes(event.Type) {
+				continue
+			}
+			entities = append(entities, WebhookDeliveryEntity{ //nolint:exhaustruct
+				WebhookID:     webhook.ID,
+				EventID:       event.ID,
+				EventType:     string(event.Type),
+				Payload:       string(event.Payload),
+				Status:        string(domain.WebhookDeliveryPending),
+				NextAttemptAt: now,
+			})
+		}
+	}
+	if len(entities) == 0 {
+		return 0, nil
+	}
+
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&entities) //nolint:exhaustruct
+	if result.Error != nil {
+		return 0, fmt.Errorf("create webhook deliveries: %w", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// ClaimWebhookDeliveries claims up to input.BatchSize pending deliveries of any user that are due as of input.Now,
+// earliest first, and returns them with their webhooks. Each claimed delivery counts an attempt and is not due again
+// before input.LeaseUntil. Rows locked by another worker are skipped.
+func (r *WebhookRepository) ClaimWebhookDeliveries(ctx context.Context, input *domain.ClaimWebhookDeliveriesInput) ([]domain.ClaimedWebhookDelivery, error) {
+	var deliveryEntities WebhookDeliveryEntities
+	var webhookEntities WebhookEntities
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}) //nolint:exhaustruct
+		query = query.Where("status = ? AND next_attempt_at <= ?", string(domain.WebhookDeliveryPending), input.Now)
+		if result := query.Order("next_attempt_at, id").Limit(input.BatchSize).Find(&deliveryEntities); result.Error != nil {
+			return fmt.Errorf("find due webhook deliveries: %w", result.Error)
+		}
+		if len(deliveryEntities) == 0 {
+			return nil
+		}
+
+		ids := make([]int, len(deliveryEntities))
+		webhookIDs := make([]int, len(deliveryEntities))
+		for i := range deliveryEntities {
+			ids[i] = deliveryEntities[i].ID
+			webhookIDs[i] = deliveryEntities[i].WebhookID
+		}
+		if result := tx.Model(&WebhookDeliveryEntity{}).Where("id IN ?", ids).Updates(map[string]any{ //nolint:exhaustruct
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_attempt_at": input.Now,
+			"next_attempt_at": input.LeaseUntil,
+		}); result.Error != nil {
+			return fmt.Errorf("claim webhook deliveries: %w", result.Error)
+		}
+		for i := range deliveryEntities {
+			deliveryEntities[i].Attempts++
+			deliveryEntities[i].LastAttemptAt = &input.Now
+			deliveryEntities[i].NextAttemptAt = input.LeaseUntil
+		}
+
+		if result := tx.Where("id IN ?", webhookIDs).Find(&webhookEntities); result.Error != nil {
+			return fmt.Errorf("find webhooks: %w", result.Error)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	deliveries, err := deliveryEntities.toWebhookDeliveries()
+	if err != nil {
+		return nil, fmt.Errorf("to webhook deliveries: %w", err)
+	}
+	webhooks, err := webhookEntities.toWebhooks()
+	if err != nil {
+		return nil, fmt.Errorf("to webhooks: %w", err)
+	}
+	webhooksByID := make(map[int]domain.Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		webhooksByID[webhook.ID] = webhook
+	}
+
+	claimed := make([]domain.ClaimedWebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		webhook, ok := webhooksByID[delivery.WebhookID]
+		if !ok {
+			return nil, errors.New("claimed webhook delivery has no webhook")
+		}
+		claimed = append(claimed, domain.ClaimedWebhookDelivery{
+			Delivery: delivery,
+			Webhook:  webhook,
+		})
+	}
+
+	return claimed, nil
+}
+
+// RecordWebhookAttempt stores the outcome of the latest attempt of a delivery.
+// A delivery whose webhook was deleted in the meantime is gone and left alone.
+func (r *WebhookRepository) RecordWebhookAttempt(ctx context.Context, input *domain.RecordWebhookAttemptInput) error {
+	values := map[string]any{
+		"status":           string(input.Status),
+		"last_status_code": input.StatusCode,
+		"last_error":       input.Error,
+	}
+	if input.Status == domain.WebhookDeliveryPending {
+		values["next_attempt_at"] = input.NextAttemptAt
+	}
+
+	if result := r.db.WithContext(ctx).Model(&WebhookDeliveryEntity{}).Where("id = ?", input.DeliveryID).Updates(values); result.Error != nil { //nolint:exhaustruct
+		return fmt.Errorf("record webhook attempt: %w", result.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package gateway_test
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+func cleanupWebhookTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := db.Exec("DELETE FROM webhook WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table webhook: %v", err)
+	}
+}
+
+func createTestWebhook(t *testing.T, ctx context.Context, repo *gateway.WebhookRepository, userID int, events ...domain.TodoEventType) *domain.Webhook {
+	t.Helper()
+	input, err := domain.NewCreateWebhookInput(userID, "https://example.com/hooks", events)
+	require.NoError(t, err)
+	webhook, err := repo.CreateWebhook(ctx, input, "whsec_test")
+	require.NoError(t, err, "Failed to insert test data")
+	return webhook
+}
+
+func newTestWebhookEvent(t *testing.T, eventType domain.TodoEventType, userID int) domain.WebhookEvent {
+	t.Helper()
+	event := domain.NewTodoDeletedEvent(userID, 1)
+	event.Type = eventType
+	outboxEvent, err := domain.NewOutboxEvent(&event)
+	require.NoError(t, err)
+	webhookEvent, err := domain.NewWebhookEvent(outboxEvent)
+	require.NoError(t, err)
+	return *webhookEvent
+}
+
+// CreateWebhook Tests
+
+func TestWebhookRepository_CreateWebhook_shouldReturnWebhook(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	input, err := domain.NewCreateWebhookInput(userID, "https://example.com/hooks", []domain.TodoEventType{domain.TodoEventCreated, domain.TodoEventDeleted})
+	require.NoError(t, err)
+
+	// when
+	webhook, err := repo.CreateWebhook(ctx, input, "whsec_test")
+
+	// then
+	require.NoError(t, err)
+	assert.Positive(t, webhook.ID)
+	assert.Equal(t, "https://example.com/hooks", webhook.URL)
+	assert.Equal(t, []domain.TodoEventType{domain.TodoEventCreated, domain.TodoEventDeleted}, webhook.Events)
+	assert.Equal(t, "whsec_test", webhook.Secret)
+}
+
+func TestWebhookRepository_CreateWebhook_shouldReturnError_whenLimitIsReached(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	for range domain.MaxWebhooksPerUser {
+		createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	}
+	input, err := domain.NewCreateWebhookInput(userID, "https://example.com/hooks", []domain.TodoEventType{domain.TodoEventCreated})
+	require.NoError(t, err)
+
+	// when
+	_, err = repo.CreateWebhook(ctx, input, "whsec_test")
+
+	// then
+	require.ErrorIs(t, err, domain.ErrWebhookLimitExceeded)
+}
+
+// DeleteWebhook Tests
+
+func TestWebhookRepository_DeleteWebhook_shouldReturnError_whenWebhookBelongsToAnotherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	input, err := domain.NewDeleteWebhookInput(webhook.ID, userID+1)
+	require.NoError(t, err)
+
+	// when
+	err = repo.DeleteWebhook(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+	webhooks, err := repo.FindWebhooks(ctx, userID)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+}
+
+// EnqueueWebhookDeliveries Tests
+
+func TestWebhookRepository_EnqueueWebhookDeliveries_shouldEnqueueForSubscribedWebhooks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	createdHook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	deletedHook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventDeleted)
+	events := []domain.WebhookEvent{newTestWebhookEvent(t, domain.TodoEventCreated, userID)}
+
+	// when
+	count, err := repo.EnqueueWebhookDeliveries(ctx, events)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	input, err := domain.NewFindWebhookDeliveriesInput(createdHook.ID, userID, 10)
+	require.NoError(t, err)
+	deliveries, err := repo.FindWebhookDeliveries(ctx, input)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, events[0].ID, deliveries[0].EventID)
+	assert.Equal(t, domain.WebhookDeliveryPending, deliveries[0].Status)
+	assert.JSONEq(t, string(events[0].Payload), string(deliveries[0].Payload))
+
+	input, err = domain.NewFindWebhookDeliveriesInput(deletedHook.ID, userID, 10)
+	require.NoError(t, err)
+	deliveries, err = repo.FindWebhookDeliveries(ctx, input)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestWebhookRepository_EnqueueWebhookDeliveries_shouldNotEnqueueAgain_whenEventIsPublishedTwice(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	events := []domain.WebhookEvent{newTestWebhookEvent(t, domain.TodoEventCreated, userID)}
+	_, err := repo.EnqueueWebhookDeliveries(ctx, events)
+	require.NoError(t, err)
+
+	// when
+	count, err := repo.EnqueueWebhookDeliveries(ctx, events)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	input, err := domain.NewFindWebhookDeliveriesInput(webhook.ID, userID, 10)
+	require.NoError(t, err)
+	deliveries, err := repo.FindWebhookDeliveries(ctx, input)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+}
+
+// ClaimWebhookDeliveries and RecordWebhookAttempt Tests
+
+func TestWebhookRepository_ClaimWebhookDeliveries_shouldLeaseClaimedDeliveries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	_, err := repo.EnqueueWebhookDeliveries(ctx, []domain.WebhookEvent{newTestWebhookEvent(t, domain.TodoEventCreated, userID)})
+	require.NoError(t, err)
+	now := time.Now().Add(time.Second)
+	input, err := domain.NewClaimWebhookDeliveriesInput(now, now.Add(time.Minute), 1000)
+	require.NoError(t, err)
+
+	// when
+	claimed, err := repo.ClaimWebhookDeliveries(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	var mine []domain.ClaimedWebhookDelivery
+	for _, c := range claimed {
+		if c.Webhook.ID == webhook.ID {
+			mine = append(mine, c)
+		}
+	}
+	require.Len(t, mine, 1)
+	assert.Equal(t, 1, mine[0].Delivery.Attempts)
+	assert.Equal(t, "whsec_test", mine[0].Webhook.Secret)
+
+	// - a leased delivery is not claimed again
+	claimedAgain, err := repo.ClaimWebhookDeliveries(ctx, input)
+	require.NoError(t, err)
+	for _, c := range claimedAgain {
+		assert.NotEqual(t, mine[0].Delivery.ID, c.Delivery.ID)
+	}
+}
+
+func TestWebhookRepository_RecordWebhookAttempt_shouldStoreOutcome(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(db)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	_, err := repo.EnqueueWebhookDeliveries(ctx, []domain.WebhookEvent{newTestWebhookEvent(t, domain.TodoEventCreated, userID)})
+	require.NoError(t, err)
+	findInput, err := domain.NewFindWebhookDeliveriesInput(webhook.ID, userID, 10)
+	require.NoError(t, err)
+	deliveries, err := repo.FindWebhookDeliveries(ctx, findInput)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	statusCode := http.StatusInternalServerError
+	input := &domain.RecordWebhookAttemptInput{
+		DeliveryID:    deliveries[0].ID,
+		Status:        domain.WebhookDeliveryDead,
+		NextAttemptAt: time.Time{},
+		StatusCode:    &statusCode,
+		Error:         "unexpected status code 500",
+	}
+
+	// when
+	err = repo.RecordWebhookAttempt(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	deliveries, err = repo.FindWebhookDeliveries(ctx, findInput)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, domain.WebhookDeliveryDead, deliveries[0].Status)
+	assert.Equal(t, &statusCode, deliveries[0].LastStatusCode)
+	assert.Equal(t, "unexpected status code 500", deliveries[0].LastError)
+}
@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// maxWebhookResponseBytes is how much of a response body is read so that the connection can be reused.
+const maxWebhookResponseBytes = 64 << 10
+
+// WebhookSender sends webhook requests over HTTP.
+type WebhookSender struct {
+	client     *http.Client
+	resolver   *net.Resolver
+	allowsAddr func(netip.Addr) bool
+}
+
+// NewWebhookSender returns a new WebhookSender that gives up on a request after timeout.
+// Requests are only sent to addresses allowsAddr accepts, which in production is domain.IsPublicWebhookAddr.
+// The address is checked when each connection is made, after the host name was resolved, so that a host that
+// was public when the webhook was created cannot be rebound to an internal address later.
+// Redirects are not followed, so that a webhook cannot send its payloads on to another URL.
+func NewWebhookSender(timeout time.Duration, allowsAddr func(netip.Addr) bool) *WebhookSender {
+	dialer := &net.Dialer{ //nolint:exhaustruct
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkWebhookDialAddress(address, allowsAddr)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	// Through a proxy the dialed address would be the proxy's, so webhooks are always sent directly.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &WebhookSender{
+		client: &http.Client{ //nolint:exhaustruct
+			Transport: transport,
+			Timeout:   timeout,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		resolver:   net.DefaultResolver,
+		allowsAddr: allowsAddr,
+	}
+}
+
+// checkWebhookDialAddress returns an error wrapping domain.ErrInvalidWebhookURL if the resolved address about to be
+// dialed is not allowed.
+func checkWebhookDialAddress(address string, allowsAddr func(netip.Addr) bool) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse webhook address %q: %w", address, domain.ErrInvalidWebhookURL)
+	}
+	if !allowsAddr(addrPort.Addr()) {
+		return fmt.Errorf("webhook address %s is not allowed: %w", addrPort.Addr(), domain.ErrInvalidWebhookURL)
+	}
+	return nil
+}
+
+// CheckWebhookURL resolves the host of a webhook URL and returns an error wrapping domain.ErrInvalidWebhookURL
+// if the host cannot be resolved or any of its addresses is not allowed.
+func (s *WebhookSender) CheckWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("parse webhook URL: %w", domain.ErrInvalidWebhookURL)
+	}
+	addrs, err := s.resolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("resolve webhook host %q: %w: %w", u.Hostname(), domain.ErrInvalidWebhookURL, err)
+	}
+	for _, addr := range addrs {
+		if !s.allowsAddr(addr) {
+			return fmt.Errorf("webhook host %q resolves to %s: %w", u.Hostname(), addr, domain.ErrInvalidWebhookURL)
+		}
+	}
+	return nil
+}
+
+// SendWebhook posts the payload of the request to its URL and returns the status code of the response.
+// The payload is signed in the X-Webhook-Signature header. Returns an error if no response was received.
+func (s *WebhookSender) SendWebhook(ctx context.Context, request *domain.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("new webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", domain.AppName+"-webhook")
+	req.Header.Set("X-Webhook-ID", strconv.Itoa(request.WebhookID))
+	req.Header.Set("X-Webhook-Delivery", strconv.Itoa(request.DeliveryID))
+	req.Header.Set("X-Webhook-Event-ID", request.EventID)
+	req.Header.Set("X-Webhook-Event", string(request.EventType))
+	req.Header.Set("X-Webhook-Signature", request.Signature)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponseBytes))
+
+	return resp.StatusCode, nil
+}
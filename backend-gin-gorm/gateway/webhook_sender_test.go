@@ -0,0 +1,178 @@
+package gateway_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+)
+
+// allowsLoopbackWebhookAddr lets tests receive webhooks on httptest servers, which listen on the loopback address.
+func allowsLoopbackWebhookAddr(addr netip.Addr) bool {
+	return addr.IsLoopback() || domain.IsPublicWebhookAddr(addr)
+}
+
+func newTestWebhookRequest(url string) *domain.WebhookRequest {
+	return &domain.WebhookRequest{
+		URL:        url,
+		WebhookID:  3,
+		DeliveryID: 11,
+		EventID:    "0123456789abcdef0123456789abcdef",
+		EventType:  domain.TodoEventCreated,
+		Signature:  "t=1735689600,v1=abc",
+		Payload:    []byte(`{"type":"created"}`),
+	}
+}
+
+func TestWebhookSender_SendWebhook_shouldPostSignedPayload(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+	sender := gateway.NewWebhookSender(time.Second, allowsLoopbackWebhookAddr)
+
+	// when
+	statusCode, err := sender.SendWebhook(ctx, newTestWebhookRequest(receiver.URL))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, statusCode)
+	require.NotNil(t, received)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "3", received.Header.Get("X-Webhook-ID"))
+	assert.Equal(t, "11", received.Header.Get("X-Webhook-Delivery"))
+	assert.Equal(t, "0123456789abcdef0123456789abcdef", received.Header.Get("X-Webhook-Event-ID"))
+	assert.Equal(t, "created", received.Header.Get("X-Webhook-Event"))
+	assert.Equal(t, "t=1735689600,v1=abc", received.Header.Get("X-Webhook-Signature"))
+	assert.JSONEq(t, `{"type":"created"}`, string(body))
+}
+
+func TestWebhookSender_SendWebhook_shouldNotFollowRedirect(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		redirected = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+	receiver := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer receiver.Close()
+	sender := gateway.NewWebhookSender(time.Second, allowsLoopbackWebhookAddr)
+
+	// when
+	statusCode, err := sender.SendWebhook(ctx, newTestWebhookRequest(receiver.URL))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, statusCode)
+	assert.False(t, redirected)
+}
+
+func TestWebhookSender_SendWebhook_shouldReturnError_whenReceiverTimesOut(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	release := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	defer close(release)
+	sender := gateway.NewWebhookSender(50*time.Millisecond, allowsLoopbackWebhookAddr)
+
+	// when
+	_, err := sender.SendWebhook(ctx, newTestWebhookRequest(receiver.URL))
+
+	// then
+	require.Error(t, err)
+}
+
+func TestWebhookSender_SendWebhook_shouldNotConnect_whenHostResolvesToInternalAddress(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	// localhost が public なアドレスから loopback に切り替わった (DNS rebinding) 場合を想定
+	received := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	sender := gateway.NewWebhookSender(time.Second, domain.IsPublicWebhookAddr)
+	url := strings.Replace(receiver.URL, "127.0.0.1", "localhost", 1)
+
+	// when
+	_, err := sender.SendWebhook(ctx, newTestWebhookRequest(url))
+
+	// then
+	require.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+	assert.False(t, received)
+}
+
+func TestWebhookSender_CheckWebhookURL_shouldReturnError_whenHostIsNotPublic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "host resolves to loopback", url: "http://localhost:8080/hooks"},
+		{name: "private address", url: "http://10.0.0.5/hooks"},
+		{name: "link-local address", url: "http://169.254.169.254/latest/meta-data"},
+		{name: "unspecified address", url: "http://[::]/hooks"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			// given
+			sender := gateway.NewWebhookSender(time.Second, domain.IsPublicWebhookAddr)
+
+			// when
+			err := sender.CheckWebhookURL(ctx, tt.url)
+
+			// then
+			require.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+		})
+	}
+}
+
+func TestWebhookSender_CheckWebhookURL_shouldAcceptURL_whenHostIsPublic(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	sender := gateway.NewWebhookSender(time.Second, domain.IsPublicWebhookAddr)
+
+	// when
+	err := sender.CheckWebhookURL(ctx, "https://93.184.216.34/hooks")
+
+	// then
+	require.NoError(t, err)
+}
@@ -60,12 +60,106 @@ func run() (int, error) {
 	// v1
 	v1 := api.Group("v1")
 
+	blobStore, err := gateway.InitBlobStore(ctx, cfg.Attachment.Storage)
+	if err != nil {
+		return 1, fmt.Errorf("init blob store: %w", err)
+	}
+	attachmentPolicy, err := domain.NewAttachmentPolicy(cfg.Attachment.MaxSizeBytes, cfg.Attachment.AllowedContentTypes)
+	if err != nil {
+		return 1, fmt.Errorf("new attachment policy: %w", err)
+	}
+	attachmentRepo := gateway.NewTodoAttachmentRepository(dbc.DB)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	todoCreateBulkCommandTxManager := gateway.NewTodoCreateBulkCommandTxManager(dbc)
+	todoBulkCommandTxManager := gateway.NewTodoBulkCommandTxManager(dbc)
+	todoBatchCommandTxManager := gateway.NewTodoBatchCommandTxManager(dbc)
+	var todoSearcher usecase.TodoSearcher = gateway.NewTodoLikeSearchRepository(dbc.DB)
+	if dbc.Dialect.SupportsFullTextSearch() {
+		todoSearcher = gateway.NewTodoFullTextSearchRepository(dbc.DB)
+	}
+	todoListMemberRepo := gateway.NewTodoListMemberRepository(dbc.DB)
+	todoEventBroker := gateway.NewTodoEventBroker(cfg.Event.ReplayBufferSize, todoListMemberRepo)
+	webhookRetryPolicy, err := domain.NewWebhookRetryPolicy(
+		cfg.Webhook.MaxAttempts,
+		time.Duration(cfg.Webhook.RetryBaseDelaySec)*time.Second,
+		time.Duration(cfg.Webhook.RetryMaxDelaySec)*time.Second,
+	)
+	if err != nil {
+		return 1, fmt.Errorf("new webhook retry policy: %w", err)
+	}
+	// A claimed delivery is leased for twice the request timeout, so that it is not sent again while still in flight
+	webhookTimeout := time.Duration(cfg.Webhook.TimeoutSec) * time.Second
+	webhookSender := gateway.NewWebhookSender(webhookTimeout, domain.IsPublicWebhookAddr)
+	webhookUsecase := usecase.NewWebhookUsecase(gateway.NewWebhookRepository(dbc.DB), webhookSender, webhookSender, webhookRetryPolicy, 2*webhookTimeout)
+	undoTokenTTL := time.Duration(cfg.Undo.TokenTTLSec) * time.Second
+	todoUsecase := usecase.NewTodoUsecase(todoRepo, todoCreateBulkCommandTxManager, todoBulkCommandTxManager, todoBatchCommandTxManager, blobStore, todoSearcher, todoEventBroker, gateway.NewTodoUndoRepository(dbc.DB), undoTokenTTL)
+
+	// Todo events are written to the outbox together with the change, and relayed from there to the publishers
+	eventPublisher, closeEventPublisher, err := newEventPublisher(cfg.EventPublisher, webhookUsecase)
+	if err != nil {
+		return 1, fmt.Errorf("new event publisher: %w", err)
+	}
+	defer closeEventPublisher()
+	outboxLease := time.Duration(cfg.Outbox.LeaseSec) * time.Second
+	outboxUsecase := usecase.NewOutboxUsecase(gateway.NewOutboxRepository(dbc.DB), eventPublisher, outboxLease)
+
+	trashRetention := time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour
+	idempotencyKeyTTL := time.Duration(cfg.Idempotency.KeyTTLHours) * time.Hour
+	idempotencyUsecase := usecase.NewIdempotencyUsecase(gateway.NewIdempotencyKeyRepository(dbc.DB), idempotencyKeyTTL)
+
 	authMiddleware := middleware.NewAuthMiddleware(authUsecase, cfg.Auth.Cookie, cfg.Auth.AccessTokenTTLMin)
+	idempotencyMiddleware := middleware.NewIdempotencyMiddleware(idempotencyUsecase)
+	{
+		funcs := handler.NewInitTodoRouterFunc(todoUsecase, idempotencyMiddleware)
+		funcs(v1, authMiddleware)
+	}
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	{
+		todoEventUsecase := usecase.NewTodoEventUsecase(todoEventBroker, viewRepo, todoListMemberRepo)
+		heartbeatInterval := time.Duration(cfg.Event.HeartbeatIntervalSec) * time.Second
+		funcs := handler.NewInitTodoEventRouterFunc(todoEventUsecase, heartbeatInterval)
+		funcs(v1, authMiddleware)
+
+		// Browsers do not apply CORS to WebSockets, so the live endpoint checks the Origin against the same origins
+		originPatterns := handler.SplitCommaSeparated(cfg.Server.Gin.CORS.AllowOrigins)
+		liveFuncs := handler.NewInitTodoLiveRouterFunc(todoUsecase, todoEventUsecase, cfg.Live, originPatterns)
+		liveFuncs(v1, authMiddleware)
+	}
+	{
+		checklistRepo := gateway.NewTodoChecklistRepository(dbc.DB)
+		checklistUsecase := usecase.NewChecklistUsecase(checklistRepo, todoEventBroker)
+		funcs := handler.NewInitChecklistRouterFunc(checklistUsecase)
+		funcs(v1, authMiddleware)
+	}
 	{
-		todoRepo := gateway.NewTodoRepository(dbc.DB)
-		todoCreateBulkCommandTxManager := gateway.NewTodoCreateBulkCommandTxManager(dbc)
-		todoUsecase := usecase.NewTodoUsecase(todoRepo, todoCreateBulkCommandTxManager)
-		funcs := handler.NewInitTodoRouterFunc(todoUsecase)
+		attachmentUsecase := usecase.NewAttachmentUsecase(attachmentRepo, blobStore, attachmentPolicy)
+		funcs := handler.NewInitAttachmentRouterFunc(attachmentUsecase, attachmentPolicy.MaxSizeBytes)
+		funcs(v1, authMiddleware)
+	}
+	{
+		commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+		commentUsecase := usecase.NewCommentUsecase(commentRepo, todoEventBroker)
+		funcs := handler.NewInitCommentRouterFunc(commentUsecase)
+		funcs(v1, authMiddleware)
+	}
+	{
+		viewUsecase := usecase.NewViewUsecase(viewRepo, todoRepo)
+		funcs := handler.NewInitViewRouterFunc(viewUsecase)
+		funcs(v1, authMiddleware)
+	}
+	{
+		todoListShareUsecase := usecase.NewTodoListShareUsecase(todoListMemberRepo, todoRepo)
+		funcs := handler.NewInitTodoListShareRouterFunc(todoListShareUsecase)
+		funcs(v1, authMiddleware)
+	}
+	{
+		// Sync tokens live as long as the trash, so that no deletion since a token has been purged yet
+		syncUsecase := usecase.NewSyncUsecase(gateway.NewTodoSyncRepository(dbc.DB), todoRepo, todoEventBroker, trashRetention)
+		funcs := handler.NewInitSyncRouterFunc(syncUsecase, idempotencyMiddleware)
+		funcs(v1, authMiddleware)
+	}
+	{
+		funcs := handler.NewInitWebhookRouterFunc(webhookUsecase)
 		funcs(v1, authMiddleware)
 	}
 	{
@@ -76,14 +170,66 @@ func run() (int, error) {
 	// run
 	readHeaderTimeout := time.Duration(cfg.Server.ReadHeaderTimeoutSec) * time.Second
 	shutdownTime := time.Duration(cfg.Server.Shutdown.TimeSec1) * time.Second
-	result := process.Run(ctx,
-		controller.WithWebServerProcess(router, cfg.Server.HTTPPort, readHeaderTimeout, shutdownTime),
+	trashPurgeInterval := time.Duration(cfg.Trash.PurgeIntervalMin) * time.Minute
+	idempotencyPurgeInterval := time.Duration(cfg.Idempotency.PurgeIntervalMin) * time.Minute
+	undoPurgeInterval := time.Duration(cfg.Undo.PurgeIntervalMin) * time.Minute
+	webhookDeliveryInterval := time.Duration(cfg.Webhook.DeliveryIntervalSec) * time.Second
+	outboxRelayInterval := time.Duration(cfg.Outbox.RelayIntervalSec) * time.Second
+	outboxRetention := time.Duration(cfg.Outbox.RetentionHours) * time.Hour
+	outboxPurgeInterval := time.Duration(cfg.Outbox.PurgeIntervalMin) * time.Minute
+	processFuncs := []process.RunProcessFunc{
+		// Closing the broker ends the open event streams, which would otherwise hold up the shutdown
+		controller.WithWebServerProcess(router, cfg.Server.HTTPPort, readHeaderTimeout, shutdownTime, todoEventBroker.Close),
 		controller.WithMetricsServerProcess(cfg.Server.MetricsPort, readHeaderTimeout, shutdownTime),
+		controller.WithTodoPurgeProcess(todoUsecase, trashRetention, trashPurgeInterval, cfg.Trash.PurgeBatchSize),
+		controller.WithIdempotencyKeyPurgeProcess(idempotencyUsecase, idempotencyPurgeInterval, cfg.Idempotency.PurgeBatchSize),
+		controller.WithTodoUndoPurgeProcess(todoUsecase, undoPurgeInterval, cfg.Undo.PurgeBatchSize),
+		controller.WithWebhookDeliveryProcess(webhookUsecase, webhookDeliveryInterval, cfg.Webhook.DeliveryBatchSize),
+		controller.WithOutboxRelayProcess(outboxUsecase, outboxRelayInterval, cfg.Outbox.RelayBatchSize),
+		controller.WithOutboxPurgeProcess(outboxUsecase, outboxRetention, outboxPurgeInterval, cfg.Outbox.PurgeBatchSize),
 		gateway.WithSignalWatchProcess(),
-	)
+	}
+	if cfg.Archive.AutoArchiveEnabled {
+		autoArchiveAfter := time.Duration(cfg.Archive.AutoArchiveAfterDays) * 24 * time.Hour
+		autoArchiveInterval := time.Duration(cfg.Archive.AutoArchiveIntervalMin) * time.Minute
+		processFuncs = append(processFuncs, controller.WithTodoAutoArchiveProcess(todoUsecase, autoArchiveAfter, autoArchiveInterval, cfg.Archive.AutoArchiveBatchSize))
+	}
+	result := process.Run(ctx, processFuncs...)
 
 	gracefulShutdownTime2 := time.Duration(cfg.Server.Shutdown.TimeSec2) * time.Second
 	time.Sleep(gracefulShutdownTime2)
 	logger.InfoContext(ctx, "exited")
 	return result, nil
 }
+
+// newEventPublisher returns a publisher that publishes outbox events to each of the configured publisher types,
+// and a function that releases what the publishers hold.
+func newEventPublisher(cfg *config.EventPublisherConfig, webhookUsecase *usecase.WebhookUsecase) (usecase.EventPublishers, func(), error) {
+	publishers := make(usecase.EventPublishers, 0)
+	closers := make([]func(), 0)
+	closeAll := func() {
+		for _, closer := range closers {
+			closer()
+		}
+	}
+	for _, publisherType := range handler.SplitCommaSeparated(cfg.Types) {
+		switch publisherType {
+		case "log":
+			publishers = append(publishers, gateway.NewLogEventPublisher())
+		case "webhook":
+			publishers = append(publishers, webhookUsecase)
+		case "nats":
+			natsPublisher, err := gateway.NewNATSEventPublisher(cfg.NATS)
+			if err != nil {
+				closeAll()
+				return nil, nil, fmt.Errorf("new nats event publisher: %w", err)
+			}
+			publishers = append(publishers, natsPublisher)
+			closers = append(closers, natsPublisher.Close)
+		default:
+			closeAll()
+			return nil, nil, fmt.Errorf("unsupported event publisher type %q", publisherType)
+		}
+	}
+	return publishers, closeAll, nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// BlobPutter defines the interface for writing blob content.
+type BlobPutter interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+}
+
+// BlobGetter defines the interface for reading blob content.
+type BlobGetter interface {
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// BlobDeleter defines the interface for removing blob content.
+type BlobDeleter interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// BlobStore composes all blob store interfaces.
+type BlobStore interface {
+	BlobPutter
+	BlobGetter
+	BlobDeleter
+}
+
+// AttachmentRepository composes all attachment metadata interfaces.
+type AttachmentRepository interface {
+	AttachmentUploader
+	AttachmentsFinder
+	AttachmentFinder
+	AttachmentDeleter
+}
+
+// AttachmentUsecase orchestrates todo attachment operations via command/query objects.
+type AttachmentUsecase struct {
+	uploadAttachmentCommand *UploadAttachmentCommand
+	findAttachmentsQuery    *FindAttachmentsQuery
+	downloadAttachmentQuery *DownloadAttachmentQuery
+	deleteAttachmentCommand *DeleteAttachmentCommand
+	logger                  *slog.Logger
+}
+
+// NewAttachmentUsecase returns a new AttachmentUsecase wired with the given repository, blob store and upload policy.
+func NewAttachmentUsecase(repo AttachmentRepository, blobStore BlobStore, policy *domain.AttachmentPolicy) *AttachmentUsecase {
+	return &AttachmentUsecase{
+		uploadAttachmentCommand: NewUploadAttachmentCommand(repo, blobStore, policy),
+		findAttachmentsQuery:    NewFindAttachmentsQuery(repo),
+		downloadAttachmentQuery: NewDownloadAttachmentQuery(repo, blobStore),
+		deleteAttachmentCommand: NewDeleteAttachmentCommand(repo, blobStore),
+		logger:                  slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-AttachmentUsecase")),
+	}
+}
+
+// UploadAttachment stores a file and attaches it to a todo.
+func (u *AttachmentUsecase) UploadAttachment(ctx context.Context, input *domain.UploadAttachmentInput) (*domain.UploadAttachmentOutput, error) {
+	ctx, span := tracer.Start(ctx, "UploadAttachment")
+	defer span.End()
+
+	output, err := u.uploadAttachmentCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute upload attachment command: %w", err)
+	}
+	return output, nil
+}
+
+// FindAttachments returns the attachments of a todo.
+func (u *AttachmentUsecase) FindAttachments(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error) {
+	attachments, err := u.findAttachmentsQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find attachments query: %w", err)
+	}
+	return attachments, nil
+}
+
+// DownloadAttachment returns an attachment's metadata with a reader over its content.
+func (u *AttachmentUsecase) DownloadAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.DownloadAttachmentOutput, error) {
+	output, err := u.downloadAttachmentQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute download attachment query: %w", err)
+	}
+	return output, nil
+}
+
+// DeleteAttachment removes an attachment and its content.
+func (u *AttachmentUsecase) DeleteAttachment(ctx context.Context, input *domain.AttachmentInput) error {
+	if err := u.deleteAttachmentCommand.Execute(ctx, input); err != nil {
+		return fmt.Errorf("execute delete attachment command: %w", err)
+	}
+	return nil
+}
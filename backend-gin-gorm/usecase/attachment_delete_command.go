@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// AttachmentDeleter defines the interface for removing attachment metadata.
+type AttachmentDeleter interface {
+	DeleteAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error)
+}
+
+// DeleteAttachmentCommand removes an attachment's metadata and content.
+type DeleteAttachmentCommand struct {
+	repo      AttachmentDeleter
+	blobStore BlobDeleter
+	logger    *slog.Logger
+}
+
+// NewDeleteAttachmentCommand returns a new DeleteAttachmentCommand.
+func NewDeleteAttachmentCommand(repo AttachmentDeleter, blobStore BlobDeleter) *DeleteAttachmentCommand {
+	return &DeleteAttachmentCommand{
+		repo:      repo,
+		blobStore: blobStore,
+		logger:    slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-DeleteAttachmentCommand")),
+	}
+}
+
+// Execute deletes the metadata first, then the content.
+// A failure to delete the content is only logged because the attachment is already gone from the user's point of view.
+func (u *DeleteAttachmentCommand) Execute(ctx context.Context, input *domain.AttachmentInput) error {
+	attachment, err := u.repo.DeleteAttachment(ctx, input)
+	if err != nil {
+		return fmt.Errorf("delete attachment: %w", err)
+	}
+
+	if err := u.blobStore.Delete(ctx, attachment.StorageKey); err != nil && !errors.Is(err, domain.ErrBlobNotFound) {
+		u.logger.WarnContext(ctx, "failed to delete blob", slog.String("storageKey", attachment.StorageKey), slog.Any("error", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func newTestAttachment(t *testing.T) *domain.Attachment {
+	t.Helper()
+	attachment, err := domain.NewAttachment(3, 1, "receipt.txt", "text/plain", 7, "todo/1/key", time.Now())
+	require.NoError(t, err)
+	return attachment
+}
+
+func Test_DeleteAttachmentCommand_Execute_shouldDeleteMetadataAndContent_whenAttachmentExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAttachmentInput(3, 1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockAttachmentDeleter(t)
+	mockRepo.EXPECT().DeleteAttachment(mock.Anything, input).Return(newTestAttachment(t), nil).Once()
+	mockBlobStore := NewMockBlobDeleter(t)
+	mockBlobStore.EXPECT().Delete(mock.Anything, "todo/1/key").Return(nil).Once()
+	cmd := usecase.NewDeleteAttachmentCommand(mockRepo, mockBlobStore)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+}
+
+func Test_DeleteAttachmentCommand_Execute_shouldSucceed_whenContentCannotBeDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		blobErr error
+	}{
+		{
+			name:    "content already gone",
+			blobErr: domain.ErrBlobNotFound,
+		},
+		{
+			name:    "blob store failure",
+			blobErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			input, err := domain.NewAttachmentInput(3, 1, 2)
+			require.NoError(t, err)
+			mockRepo := NewMockAttachmentDeleter(t)
+			mockRepo.EXPECT().DeleteAttachment(mock.Anything, input).Return(newTestAttachment(t), nil).Once()
+			mockBlobStore := NewMockBlobDeleter(t)
+			mockBlobStore.EXPECT().Delete(mock.Anything, "todo/1/key").Return(tt.blobErr).Once()
+			cmd := usecase.NewDeleteAttachmentCommand(mockRepo, mockBlobStore)
+
+			// when
+			err = cmd.Execute(ctx, input)
+
+			// then
+			require.NoError(t, err, "the attachment is already gone once its metadata is deleted")
+		})
+	}
+}
+
+func Test_DeleteAttachmentCommand_Execute_shouldNotDeleteContent_whenMetadataCannotBeDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		repoErr error
+	}{
+		{
+			name:    "attachment not found",
+			repoErr: domain.ErrAttachmentNotFound,
+		},
+		{
+			name:    "todo not visible",
+			repoErr: domain.ErrTodoNotFound,
+		},
+		{
+			name:    "viewer of a shared list",
+			repoErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			input, err := domain.NewAttachmentInput(3, 1, 2)
+			require.NoError(t, err)
+			mockRepo := NewMockAttachmentDeleter(t)
+			mockRepo.EXPECT().DeleteAttachment(mock.Anything, input).Return(nil, tt.repoErr).Once()
+			// Delete が呼ばれた場合は mock が失敗させる
+			mockBlobStore := NewMockBlobDeleter(t)
+			cmd := usecase.NewDeleteAttachmentCommand(mockRepo, mockBlobStore)
+
+			// when
+			err = cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.repoErr)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// AttachmentFinder defines the interface for retrieving a single attachment's metadata.
+type AttachmentFinder interface {
+	FindAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error)
+}
+
+// DownloadAttachmentQuery opens an attachment's content for reading.
+type DownloadAttachmentQuery struct {
+	repo      AttachmentFinder
+	blobStore BlobGetter
+}
+
+// NewDownloadAttachmentQuery returns a new DownloadAttachmentQuery.
+func NewDownloadAttachmentQuery(repo AttachmentFinder, blobStore BlobGetter) *DownloadAttachmentQuery {
+	return &DownloadAttachmentQuery{
+		repo:      repo,
+		blobStore: blobStore,
+	}
+}
+
+// Execute returns the attachment metadata with an open content reader. Missing content is reported as ErrAttachmentNotFound.
+func (u *DownloadAttachmentQuery) Execute(ctx context.Context, input *domain.AttachmentInput) (*domain.DownloadAttachmentOutput, error) {
+	attachment, err := u.repo.FindAttachment(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find attachment: %w", err)
+	}
+
+	content, err := u.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrBlobNotFound) {
+			return nil, fmt.Errorf("get blob %s: %w", attachment.StorageKey, domain.ErrAttachmentNotFound)
+		}
+		return nil, fmt.Errorf("get blob: %w", err)
+	}
+
+	output, err := domain.NewDownloadAttachmentOutput(attachment, content)
+	if err != nil {
+		content.Close()
+		return nil, fmt.Errorf("create download attachment output: %w", err)
+	}
+
+	return output, nil
+}
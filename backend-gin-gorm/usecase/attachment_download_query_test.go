@@ -0,0 +1,82 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_DownloadAttachmentQuery_Execute_shouldReturnContent_whenAttachmentExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAttachmentInput(3, 1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockAttachmentFinder(t)
+	mockRepo.EXPECT().FindAttachment(mock.Anything, input).Return(newTestAttachment(t), nil).Once()
+	mockBlobStore := NewMockBlobGetter(t)
+	mockBlobStore.EXPECT().Get(mock.Anything, "todo/1/key").Return(io.NopCloser(strings.NewReader("receipt")), nil).Once()
+	query := usecase.NewDownloadAttachmentQuery(mockRepo, mockBlobStore)
+
+	// when
+	output, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	defer output.Content.Close()
+	assert.Equal(t, "receipt.txt", output.Attachment.FileName)
+	content, err := io.ReadAll(output.Content)
+	require.NoError(t, err)
+	assert.Equal(t, "receipt", string(content))
+}
+
+func Test_DownloadAttachmentQuery_Execute_shouldReturnAttachmentNotFound_whenContentIsMissing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAttachmentInput(3, 1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockAttachmentFinder(t)
+	mockRepo.EXPECT().FindAttachment(mock.Anything, input).Return(newTestAttachment(t), nil).Once()
+	mockBlobStore := NewMockBlobGetter(t)
+	mockBlobStore.EXPECT().Get(mock.Anything, "todo/1/key").Return(nil, domain.ErrBlobNotFound).Once()
+	query := usecase.NewDownloadAttachmentQuery(mockRepo, mockBlobStore)
+
+	// when
+	output, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrAttachmentNotFound)
+	assert.Nil(t, output)
+}
+
+func Test_DownloadAttachmentQuery_Execute_shouldNotReadContent_whenAttachmentIsNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAttachmentInput(3, 1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockAttachmentFinder(t)
+	mockRepo.EXPECT().FindAttachment(mock.Anything, input).Return(nil, domain.ErrAttachmentNotFound).Once()
+	// Get が呼ばれた場合は mock が失敗させる
+	mockBlobStore := NewMockBlobGetter(t)
+	query := usecase.NewDownloadAttachmentQuery(mockRepo, mockBlobStore)
+
+	// when
+	output, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrAttachmentNotFound)
+	assert.Nil(t, output)
+}
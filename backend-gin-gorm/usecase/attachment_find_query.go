@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// AttachmentsFinder defines the interface for listing the attachments of a todo.
+type AttachmentsFinder interface {
+	FindAttachments(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error)
+}
+
+// FindAttachmentsQuery retrieves the attachments of a todo.
+type FindAttachmentsQuery struct {
+	repo AttachmentsFinder
+}
+
+// NewFindAttachmentsQuery returns a new FindAttachmentsQuery.
+func NewFindAttachmentsQuery(repo AttachmentsFinder) *FindAttachmentsQuery {
+	return &FindAttachmentsQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the attachments of the todo.
+func (u *FindAttachmentsQuery) Execute(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error) {
+	attachments, err := u.repo.FindAttachments(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find attachments: %w", err)
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,52 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindAttachmentsQuery_Execute_shouldReturnAttachments_whenTodoIsVisible(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewFindAttachmentsInput(1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockAttachmentsFinder(t)
+	mockRepo.EXPECT().FindAttachments(mock.Anything, input).Return([]domain.Attachment{*newTestAttachment(t)}, nil).Once()
+	query := usecase.NewFindAttachmentsQuery(mockRepo)
+
+	// when
+	attachments, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, 3, attachments[0].ID)
+}
+
+func Test_FindAttachmentsQuery_Execute_shouldReturnError_whenTodoIsNotVisible(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewFindAttachmentsInput(1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockAttachmentsFinder(t)
+	mockRepo.EXPECT().FindAttachments(mock.Anything, input).Return(nil, domain.ErrTodoNotFound).Once()
+	query := usecase.NewFindAttachmentsQuery(mockRepo)
+
+	// when
+	attachments, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, attachments)
+}
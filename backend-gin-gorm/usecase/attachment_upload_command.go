@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// AttachableTodoChecker defines the interface for checking that a user may attach files to a todo.
+// Implementations must return domain.ErrTodoNotFound when the todo is not visible to the user
+// and domain.ErrForbidden when the user may not edit it.
+type AttachableTodoChecker interface {
+	CheckAttachableTodo(ctx context.Context, todoID int, userID int) error
+}
+
+// AttachmentCreator defines the interface for persisting attachment metadata.
+type AttachmentCreator interface {
+	CreateAttachment(ctx context.Context, input *domain.CreateAttachmentInput) (*domain.Attachment, error)
+}
+
+// AttachmentUploader composes the interfaces an upload needs.
+type AttachmentUploader interface {
+	AttachableTodoChecker
+	AttachmentCreator
+}
+
+// UploadAttachmentCommand stores an uploaded file in the blob store and records its metadata.
+type UploadAttachmentCommand struct {
+	repo      AttachmentUploader
+	blobStore BlobStore
+	policy    *domain.AttachmentPolicy
+	logger    *slog.Logger
+}
+
+// NewUploadAttachmentCommand returns a new UploadAttachmentCommand.
+func NewUploadAttachmentCommand(repo AttachmentUploader, blobStore BlobStore, policy *domain.AttachmentPolicy) *UploadAttachmentCommand {
+	return &UploadAttachmentCommand{
+		repo:      repo,
+		blobStore: blobStore,
+		policy:    policy,
+		logger:    slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-UploadAttachmentCommand")),
+	}
+}
+
+// Execute checks the upload against the policy and the access of the user to the todo, writes the content and then the metadata.
+// No content is written for a todo the user may not edit. If the metadata cannot be written (e.g. the todo was deleted
+// in the meantime) the stored content is removed again.
+func (u *UploadAttachmentCommand) Execute(ctx context.Context, input *domain.UploadAttachmentInput) (*domain.UploadAttachmentOutput, error) {
+	if err := u.policy.Check(input.ContentType, input.Size); err != nil {
+		return nil, fmt.Errorf("check attachment policy: %w", err)
+	}
+
+	storageKey := "todo/" + strconv.Itoa(input.TodoID) + "/" + uuid.NewString()
+	createInput, err := domain.NewCreateAttachmentInput(input.TodoID, input.UserID, input.FileName, input.ContentType, input.Size, storageKey)
+	if err != nil {
+		return nil, fmt.Errorf("create attachment input: %w", err)
+	}
+
+	if err := u.repo.CheckAttachableTodo(ctx, input.TodoID, input.UserID); err != nil {
+		return nil, fmt.Errorf("check attachable todo: %w", err)
+	}
+
+	if err := u.blobStore.Put(ctx, storageKey, input.Content, input.Size, input.ContentType); err != nil {
+		return nil, fmt.Errorf("put blob: %w", err)
+	}
+
+	attachment, err := u.repo.CreateAttachment(ctx, createInput)
+	if err != nil {
+		u.deleteBlob(ctx, storageKey)
+		return nil, fmt.Errorf("create attachment: %w", err)
+	}
+
+	output, err := domain.NewUploadAttachmentOutput(attachment)
+	if err != nil {
+		return nil, fmt.Errorf("create upload attachment output: %w", err)
+	}
+
+	return output, nil
+}
+
+func (u *UploadAttachmentCommand) deleteBlob(ctx context.Context, storageKey string) {
+	if err := u.blobStore.Delete(ctx, storageKey); err != nil {
+		u.logger.WarnContext(ctx, "failed to delete orphaned blob", slog.String("storageKey", storageKey), slog.Any("error", err))
+	}
+}
@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func newTestAttachmentPolicy(t *testing.T) *domain.AttachmentPolicy {
+	t.Helper()
+	policy, err := domain.NewAttachmentPolicy(1024, "text/plain,image/png")
+	require.NoError(t, err)
+	return policy
+}
+
+func Test_UploadAttachmentCommand_Execute_shouldStoreContentAndMetadata_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	blobStore := newTestBlobStore(t)
+	cmd := usecase.NewUploadAttachmentCommand(gateway.NewTodoAttachmentRepository(dbc.DB), blobStore, newTestAttachmentPolicy(t))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo with attachment")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	content := []byte("receipt")
+	input, err := domain.NewUploadAttachmentInput(created.ID, userID, "receipt.txt", "text/plain; charset=utf-8", int64(len(content)), bytes.NewReader(content))
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "receipt.txt", output.Attachment.FileName)
+	assert.Equal(t, int64(len(content)), output.Attachment.Size)
+
+	// BlobStore にも保存されていることを確認
+	rc, err := blobStore.Get(ctx, output.Attachment.StorageKey)
+	require.NoError(t, err)
+	defer rc.Close()
+	stored, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, content, stored)
+}
+
+func Test_UploadAttachmentCommand_Execute_shouldReturnError_whenPolicyRejectsUpload(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cmd := usecase.NewUploadAttachmentCommand(gateway.NewTodoAttachmentRepository(dbc.DB), newTestBlobStore(t), newTestAttachmentPolicy(t))
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		expected    error
+	}{
+		{
+			name:        "too large",
+			contentType: "text/plain",
+			size:        1025,
+			expected:    domain.ErrAttachmentTooLarge,
+		},
+		{
+			name:        "type not allowed",
+			contentType: "application/zip",
+			size:        10,
+			expected:    domain.ErrAttachmentTypeNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			input, err := domain.NewUploadAttachmentInput(1, userID, "file", tt.contentType, tt.size, bytes.NewReader(nil))
+			require.NoError(t, err)
+
+			// when
+			_, err = cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_UploadAttachmentCommand_Execute_shouldRemoveBlob_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	dir := t.TempDir()
+	blobStore, err := gateway.NewLocalBlobStore(dir)
+	require.NoError(t, err)
+	cmd := usecase.NewUploadAttachmentCommand(gateway.NewTodoAttachmentRepository(dbc.DB), blobStore, newTestAttachmentPolicy(t))
+
+	input, err := domain.NewUploadAttachmentInput(999999999, userID, "receipt.txt", "text/plain", 1, bytes.NewReader([]byte("x")))
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+
+	// 孤立した blob が残っていないことを確認
+	entries, err := filepath.Glob(filepath.Join(dir, "todo", "999999999", "*"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func Test_UploadAttachmentCommand_Execute_shouldNotStoreContent_whenUserMayNotEditTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		checkErr error
+	}{
+		{
+			name:     "todo not visible",
+			checkErr: domain.ErrTodoNotFound,
+		},
+		{
+			name:     "viewer of a shared list",
+			checkErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			mockRepo := NewMockAttachmentUploader(t)
+			mockRepo.EXPECT().CheckAttachableTodo(mock.Anything, 1, 2).Return(tt.checkErr).Once()
+			// Put や CreateAttachment が呼ばれた場合は mock が失敗させる
+			mockBlobStore := NewMockBlobStore(t)
+			cmd := usecase.NewUploadAttachmentCommand(mockRepo, mockBlobStore, newTestAttachmentPolicy(t))
+
+			content := []byte("receipt")
+			input, err := domain.NewUploadAttachmentInput(1, 2, "receipt.txt", "text/plain", int64(len(content)), bytes.NewReader(content))
+			require.NoError(t, err)
+
+			// when
+			output, err := cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.checkErr)
+			assert.Nil(t, output)
+		})
+	}
+}
+
+func Test_UploadAttachmentCommand_Execute_shouldDeleteContent_whenMetadataCannotBeStored(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	var storedKey string
+	mockRepo := NewMockAttachmentUploader(t)
+	mockRepo.EXPECT().CheckAttachableTodo(mock.Anything, 1, 2).Return(nil).Once()
+	mockRepo.EXPECT().CreateAttachment(mock.Anything, mock.Anything).Return(nil, domain.ErrTodoNotFound).Once()
+	mockBlobStore := NewMockBlobStore(t)
+	mockBlobStore.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(7), "text/plain").RunAndReturn(func(_ context.Context, key string, _ io.Reader, _ int64, _ string) error {
+		storedKey = key
+		return nil
+	}).Once()
+	mockBlobStore.EXPECT().Delete(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string) error {
+		assert.Equal(t, storedKey, key, "the stored content should be removed again")
+		return nil
+	}).Once()
+	cmd := usecase.NewUploadAttachmentCommand(mockRepo, mockBlobStore, newTestAttachmentPolicy(t))
+
+	input, err := domain.NewUploadAttachmentInput(1, 2, "receipt.txt", "text/plain", 7, bytes.NewReader([]byte("receipt")))
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+}
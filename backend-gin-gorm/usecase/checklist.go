@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChecklistRepository composes all checklist mutation interfaces.
+type ChecklistRepository interface {
+	ChecklistItemAdder
+	ChecklistItemUpdater
+	ChecklistReorderer
+	ChecklistItemDeleter
+}
+
+// ChecklistUsecase orchestrates checklist operations on a todo via command objects.
+type ChecklistUsecase struct {
+	addChecklistItemCommand    *AddChecklistItemCommand
+	updateChecklistItemCommand *UpdateChecklistItemCommand
+	reorderChecklistCommand    *ReorderChecklistCommand
+	deleteChecklistItemCommand *DeleteChecklistItemCommand
+	publisher                  TodoEventPublisher
+	logger                     *slog.Logger
+}
+
+// NewChecklistUsecase returns a new ChecklistUsecase wired with the given repository.
+// Every checklist change also changes its todo, which is reported to the clients through the publisher.
+func NewChecklistUsecase(repo ChecklistRepository, publisher TodoEventPublisher) *ChecklistUsecase {
+	return &ChecklistUsecase{
+		addChecklistItemCommand:    NewAddChecklistItemCommand(repo),
+		updateChecklistItemCommand: NewUpdateChecklistItemCommand(repo),
+		reorderChecklistCommand:    NewReorderChecklistCommand(repo),
+		deleteChecklistItemCommand: NewDeleteChecklistItemCommand(repo),
+		publisher:                  publisher,
+		logger:                     slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-ChecklistUsecase")),
+	}
+}
+
+// AddChecklistItem appends an item to a todo's checklist.
+func (u *ChecklistUsecase) AddChecklistItem(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.AddChecklistItemOutput, error) {
+	output, err := u.addChecklistItemCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute add checklist item command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// UpdateChecklistItem edits the text or toggles the checked state of a checklist item.
+func (u *ChecklistUsecase) UpdateChecklistItem(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.UpdateChecklistItemOutput, error) {
+	output, err := u.updateChecklistItemCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute update checklist item command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// ReorderChecklist changes the order of a todo's checklist items.
+func (u *ChecklistUsecase) ReorderChecklist(ctx context.Context, input *domain.ReorderChecklistInput) (*domain.ReorderChecklistOutput, error) {
+	output, err := u.reorderChecklistCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute reorder checklist command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// DeleteChecklistItem removes an item from a todo's checklist.
+func (u *ChecklistUsecase) DeleteChecklistItem(ctx context.Context, input *domain.DeleteChecklistItemInput) error {
+	todo, err := u.deleteChecklistItemCommand.Execute(ctx, input)
+	if err != nil {
+		return fmt.Errorf("execute delete checklist item command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo))
+	return nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChecklistItemAdder defines the interface for appending checklist items in the repository.
+type ChecklistItemAdder interface {
+	AddChecklistItem(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.ChecklistItem, *domain.Todo, error)
+}
+
+// AddChecklistItemCommand appends a new item to a todo's checklist.
+type AddChecklistItemCommand struct {
+	repo ChecklistItemAdder
+}
+
+// NewAddChecklistItemCommand returns a new AddChecklistItemCommand.
+func NewAddChecklistItemCommand(repo ChecklistItemAdder) *AddChecklistItemCommand {
+	return &AddChecklistItemCommand{
+		repo: repo,
+	}
+}
+
+// Execute adds the checklist item and returns the result.
+func (u *AddChecklistItemCommand) Execute(ctx context.Context, input *domain.AddChecklistItemInput) (*domain.AddChecklistItemOutput, error) {
+	item, todo, err := u.repo.AddChecklistItem(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("add checklist item: %w", err)
+	}
+
+	output, err := domain.NewAddChecklistItemOutput(item, todo)
+	if err != nil {
+		return nil, fmt.Errorf("create add checklist item output: %w", err)
+	}
+
+	return output, nil
+}
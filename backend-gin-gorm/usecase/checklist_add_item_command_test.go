@@ -0,0 +1,68 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_AddChecklistItemCommand_Execute_shouldAddItem_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewAddChecklistItemCommand(gateway.NewTodoChecklistRepository(dbc.DB))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo with checklist")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewAddChecklistItemInput(created.ID, userID, "step 1")
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "step 1", output.Item.Text)
+	assert.Equal(t, created.ID, output.Item.TodoID)
+
+	// FindTodos の結果にもチェックリストが含まれることを確認
+	todos, err := findTodos(ctx, todoRepo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Len(t, todos[0].Checklist, 1)
+	assert.Equal(t, output.Item.ID, todos[0].Checklist[0].ID)
+}
+
+func Test_AddChecklistItemCommand_Execute_shouldReturnError_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cmd := usecase.NewAddChecklistItemCommand(gateway.NewTodoChecklistRepository(dbc.DB))
+
+	input, err := domain.NewAddChecklistItemInput(999999999, userID, "step 1")
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChecklistItemDeleter defines the interface for deleting checklist items from the repository.
+type ChecklistItemDeleter interface {
+	DeleteChecklistItem(ctx context.Context, input *domain.DeleteChecklistItemInput) (*domain.Todo, error)
+}
+
+// DeleteChecklistItemCommand removes an item from a todo's checklist.
+type DeleteChecklistItemCommand struct {
+	repo ChecklistItemDeleter
+}
+
+// NewDeleteChecklistItemCommand returns a new DeleteChecklistItemCommand.
+func NewDeleteChecklistItemCommand(repo ChecklistItemDeleter) *DeleteChecklistItemCommand {
+	return &DeleteChecklistItemCommand{
+		repo: repo,
+	}
+}
+
+// Execute deletes the specified checklist item and returns the todo it belonged to.
+func (u *DeleteChecklistItemCommand) Execute(ctx context.Context, input *domain.DeleteChecklistItemInput) (*domain.Todo, error) {
+	todo, err := u.repo.DeleteChecklistItem(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("delete checklist item: %w", err)
+	}
+
+	return todo, nil
+}
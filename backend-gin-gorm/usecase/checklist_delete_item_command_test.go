@@ -0,0 +1,50 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_DeleteChecklistItemCommand_Execute_shouldDeleteItem_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	checklistRepo := gateway.NewTodoChecklistRepository(dbc.DB)
+	cmd := usecase.NewDeleteChecklistItemCommand(checklistRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	addInput, err := domain.NewAddChecklistItemInput(created.ID, userID, "step")
+	require.NoError(t, err)
+	item, _, err := checklistRepo.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteChecklistItemInput(item.ID, created.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+
+	// DB からも削除されていることを確認
+	todos, err := findTodos(ctx, todoRepo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Empty(t, todos[0].Checklist)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChecklistReorderer defines the interface for reordering checklist items in the repository.
+type ChecklistReorderer interface {
+	ReorderChecklist(ctx context.Context, input *domain.ReorderChecklistInput) ([]domain.ChecklistItem, *domain.Todo, error)
+}
+
+// ReorderChecklistCommand rewrites the order of a todo's checklist items.
+type ReorderChecklistCommand struct {
+	repo ChecklistReorderer
+}
+
+// NewReorderChecklistCommand returns a new ReorderChecklistCommand.
+func NewReorderChecklistCommand(repo ChecklistReorderer) *ReorderChecklistCommand {
+	return &ReorderChecklistCommand{
+		repo: repo,
+	}
+}
+
+// Execute reorders the checklist and returns the items in their new order.
+func (u *ReorderChecklistCommand) Execute(ctx context.Context, input *domain.ReorderChecklistInput) (*domain.ReorderChecklistOutput, error) {
+	items, todo, err := u.repo.ReorderChecklist(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("reorder checklist: %w", err)
+	}
+
+	output, err := domain.NewReorderChecklistOutput(items, todo)
+	if err != nil {
+		return nil, fmt.Errorf("create reorder checklist output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,57 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_ReorderChecklistCommand_Execute_shouldReorderItems_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	checklistRepo := gateway.NewTodoChecklistRepository(dbc.DB)
+	cmd := usecase.NewReorderChecklistCommand(checklistRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	ids := make([]int, 0, 2)
+	for _, text := range []string{"first", "second"} {
+		addInput, err := domain.NewAddChecklistItemInput(created.ID, userID, text)
+		require.NoError(t, err)
+		item, _, err := checklistRepo.AddChecklistItem(ctx, addInput)
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+
+	input, err := domain.NewReorderChecklistInput(created.ID, userID, []int{ids[1], ids[0]})
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, "second", output.Items[0].Text)
+	assert.Equal(t, "first", output.Items[1].Text)
+
+	// FindTodos でも新しい順序で返ることを確認
+	todos, err := findTodos(ctx, todoRepo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, ids[1], todos[0].Checklist[0].ID)
+}
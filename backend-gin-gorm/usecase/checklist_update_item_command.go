@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ChecklistItemUpdater defines the interface for updating checklist items in the repository.
+type ChecklistItemUpdater interface {
+	UpdateChecklistItem(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.ChecklistItem, *domain.Todo, error)
+}
+
+// UpdateChecklistItemCommand edits or toggles an existing checklist item.
+type UpdateChecklistItemCommand struct {
+	repo ChecklistItemUpdater
+}
+
+// NewUpdateChecklistItemCommand returns a new UpdateChecklistItemCommand.
+func NewUpdateChecklistItemCommand(repo ChecklistItemUpdater) *UpdateChecklistItemCommand {
+	return &UpdateChecklistItemCommand{
+		repo: repo,
+	}
+}
+
+// Execute updates the checklist item and returns the updated result.
+func (u *UpdateChecklistItemCommand) Execute(ctx context.Context, input *domain.UpdateChecklistItemInput) (*domain.UpdateChecklistItemOutput, error) {
+	item, todo, err := u.repo.UpdateChecklistItem(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("update checklist item: %w", err)
+	}
+
+	output, err := domain.NewUpdateChecklistItemOutput(item, todo)
+	if err != nil {
+		return nil, fmt.Errorf("create update checklist item output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,77 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_UpdateChecklistItemCommand_Execute_shouldUpdateItem_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	checklistRepo := gateway.NewTodoChecklistRepository(dbc.DB)
+	cmd := usecase.NewUpdateChecklistItemCommand(checklistRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	addInput, err := domain.NewAddChecklistItemInput(created.ID, userID, "step")
+	require.NoError(t, err)
+	item, _, err := checklistRepo.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewUpdateChecklistItemInput(item.ID, created.ID, userID, "step done", true)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "step done", output.Item.Text)
+	assert.True(t, output.Item.IsChecked)
+}
+
+func Test_UpdateChecklistItemCommand_Execute_shouldReturnError_whenUserIDDoesNotMatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	checklistRepo := gateway.NewTodoChecklistRepository(dbc.DB)
+	cmd := usecase.NewUpdateChecklistItemCommand(checklistRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	addInput, err := domain.NewAddChecklistItemInput(created.ID, userID, "step")
+	require.NoError(t, err)
+	item, _, err := checklistRepo.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewUpdateChecklistItemInput(item.ID, created.ID, otherUserID, "hijacked", true)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CommentRepository composes all comment persistence interfaces.
+type CommentRepository interface {
+	CommentCreator
+	CommentsFinder
+	CommentUpdater
+	CommentDeleter
+}
+
+// CommentUsecase orchestrates the comment thread of a todo via command/query objects.
+type CommentUsecase struct {
+	createCommentCommand *CreateCommentCommand
+	findCommentsQuery    *FindCommentsQuery
+	updateCommentCommand *UpdateCommentCommand
+	deleteCommentCommand *DeleteCommentCommand
+	publisher            TodoEventPublisher
+	logger               *slog.Logger
+}
+
+// NewCommentUsecase returns a new CommentUsecase wired with the given repository.
+// Posting and deleting comments change the comment count of the todo, which is reported to the clients through the publisher.
+func NewCommentUsecase(repo CommentRepository, publisher TodoEventPublisher) *CommentUsecase {
+	return &CommentUsecase{
+		createCommentCommand: NewCreateCommentCommand(repo),
+		findCommentsQuery:    NewFindCommentsQuery(repo),
+		updateCommentCommand: NewUpdateCommentCommand(repo),
+		deleteCommentCommand: NewDeleteCommentCommand(repo),
+		publisher:            publisher,
+		logger:               slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-CommentUsecase")),
+	}
+}
+
+// CreateComment posts a comment on a todo.
+func (u *CommentUsecase) CreateComment(ctx context.Context, input *domain.CreateCommentInput) (*domain.CreateCommentOutput, error) {
+	output, err := u.createCommentCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute create comment command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// FindComments returns the comments of a todo in posting order.
+func (u *CommentUsecase) FindComments(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error) {
+	comments, err := u.findCommentsQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find comments query: %w", err)
+	}
+	return comments, nil
+}
+
+// UpdateComment edits the text of a comment written by the user.
+func (u *CommentUsecase) UpdateComment(ctx context.Context, input *domain.UpdateCommentInput) (*domain.UpdateCommentOutput, error) {
+	output, err := u.updateCommentCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute update comment command: %w", err)
+	}
+	return output, nil
+}
+
+// DeleteComment removes a comment written by the user or posted on a todo the user owns.
+func (u *CommentUsecase) DeleteComment(ctx context.Context, input *domain.DeleteCommentInput) error {
+	todo, err := u.deleteCommentCommand.Execute(ctx, input)
+	if err != nil {
+		return fmt.Errorf("execute delete comment command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo))
+	return nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CommentCreator defines the interface for persisting new comments.
+type CommentCreator interface {
+	CreateComment(ctx context.Context, input *domain.CreateCommentInput) (*domain.Comment, *domain.Todo, error)
+}
+
+// CreateCommentCommand posts a comment on a todo.
+type CreateCommentCommand struct {
+	repo CommentCreator
+}
+
+// NewCreateCommentCommand returns a new CreateCommentCommand.
+func NewCreateCommentCommand(repo CommentCreator) *CreateCommentCommand {
+	return &CreateCommentCommand{
+		repo: repo,
+	}
+}
+
+// Execute creates the comment and returns the created result.
+func (u *CreateCommentCommand) Execute(ctx context.Context, input *domain.CreateCommentInput) (*domain.CreateCommentOutput, error) {
+	comment, todo, err := u.repo.CreateComment(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("create comment: %w", err)
+	}
+
+	output, err := domain.NewCreateCommentOutput(comment, todo)
+	if err != nil {
+		return nil, fmt.Errorf("create create comment output: %w", err)
+	}
+
+	return output, nil
+}
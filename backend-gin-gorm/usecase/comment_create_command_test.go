@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_CreateCommentCommand_Execute_shouldCreateComment_whenTodoIsVisible(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewCreateCommentCommand(gateway.NewTodoCommentRepository(dbc.DB))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewCreateCommentInput(created.ID, userID, "user1", "looks good")
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, output.Comment.TodoID)
+	assert.Equal(t, userID, output.Comment.Author.UserID)
+	assert.Equal(t, "user1", output.Comment.Author.LoginID)
+	assert.Equal(t, "looks good", output.Comment.Text)
+	assert.Nil(t, output.Comment.EditedAt)
+}
+
+func Test_CreateCommentCommand_Execute_shouldReturnError_whenTodoIsNotVisible(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewCreateCommentCommand(gateway.NewTodoCommentRepository(dbc.DB))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewCreateCommentInput(created.ID, otherUserID, "user2", "not my todo")
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+}
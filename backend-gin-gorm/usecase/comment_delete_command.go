@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CommentDeleter defines the interface for deleting comments from the repository.
+// Implementations must return domain.ErrForbidden when the user is not allowed to delete the comment.
+type CommentDeleter interface {
+	DeleteComment(ctx context.Context, input *domain.DeleteCommentInput) (*domain.Todo, error)
+}
+
+// DeleteCommentCommand removes a comment from a todo.
+type DeleteCommentCommand struct {
+	repo CommentDeleter
+}
+
+// NewDeleteCommentCommand returns a new DeleteCommentCommand.
+func NewDeleteCommentCommand(repo CommentDeleter) *DeleteCommentCommand {
+	return &DeleteCommentCommand{
+		repo: repo,
+	}
+}
+
+// Execute deletes the specified comment and returns the todo it was posted on.
+func (u *DeleteCommentCommand) Execute(ctx context.Context, input *domain.DeleteCommentInput) (*domain.Todo, error) {
+	todo, err := u.repo.DeleteComment(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("delete comment: %w", err)
+	}
+
+	return todo, nil
+}
@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_DeleteCommentCommand_Execute_shouldDeleteComment_whenAuthorDeletes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+	cmd := usecase.NewDeleteCommentCommand(commentRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	commentInput, err := domain.NewCreateCommentInput(created.ID, userID, "user1", "obsolete")
+	require.NoError(t, err)
+	comment, _, err := commentRepo.CreateComment(ctx, commentInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteCommentInput(comment.ID, created.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	findInput, err := domain.NewFindCommentsInput(created.ID, userID)
+	require.NoError(t, err)
+	comments, err := commentRepo.FindComments(ctx, findInput)
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+}
+
+func Test_DeleteCommentCommand_Execute_shouldDeleteComment_whenTodoOwnerDeletesMemberComment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	memberID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, memberID, domain.TodoListViewer)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+	cmd := usecase.NewDeleteCommentCommand(commentRepo)
+
+	createInput, err := domain.NewCreateTodoInput(ownerID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	commentInput, err := domain.NewCreateCommentInput(created.ID, memberID, "user"+strconv.Itoa(memberID), "off topic")
+	require.NoError(t, err)
+	comment, _, err := commentRepo.CreateComment(ctx, commentInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteCommentInput(comment.ID, created.ID, ownerID)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+}
+
+func Test_DeleteCommentCommand_Execute_shouldReturnForbidden_whenNonAuthorDeletes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	memberID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, memberID, domain.TodoListEditor)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+	cmd := usecase.NewDeleteCommentCommand(commentRepo)
+
+	createInput, err := domain.NewCreateTodoInput(ownerID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	commentInput, err := domain.NewCreateCommentInput(created.ID, ownerID, "user"+strconv.Itoa(ownerID), "owner's note")
+	require.NoError(t, err)
+	comment, _, err := commentRepo.CreateComment(ctx, commentInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteCommentInput(comment.ID, created.ID, memberID)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	findInput, err := domain.NewFindCommentsInput(created.ID, ownerID)
+	require.NoError(t, err)
+	comments, err := commentRepo.FindComments(ctx, findInput)
+	require.NoError(t, err)
+	assert.Len(t, comments, 1, "the comment should be kept")
+}
+
+func Test_DeleteCommentCommand_Execute_shouldReturnError_whenCommentDoesNotExist(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewDeleteCommentCommand(gateway.NewTodoCommentRepository(dbc.DB))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteCommentInput(999999999, created.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrCommentNotFound)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CommentsFinder defines the interface for listing the comments of a todo.
+type CommentsFinder interface {
+	FindComments(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error)
+}
+
+// FindCommentsQuery retrieves the comments of a todo.
+type FindCommentsQuery struct {
+	repo CommentsFinder
+}
+
+// NewFindCommentsQuery returns a new FindCommentsQuery.
+func NewFindCommentsQuery(repo CommentsFinder) *FindCommentsQuery {
+	return &FindCommentsQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the comments of the todo.
+func (u *FindCommentsQuery) Execute(ctx context.Context, input *domain.FindCommentsInput) ([]domain.Comment, error) {
+	comments, err := u.repo.FindComments(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find comments: %w", err)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindCommentsQuery_Execute_shouldReturnCommentsInPostingOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+	query := usecase.NewFindCommentsQuery(commentRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	for _, text := range []string{"first", "second", "third"} {
+		commentInput, err := domain.NewCreateCommentInput(created.ID, userID, "user1", text)
+		require.NoError(t, err)
+		_, _, err = commentRepo.CreateComment(ctx, commentInput)
+		require.NoError(t, err)
+	}
+
+	input, err := domain.NewFindCommentsInput(created.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	comments, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	texts := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		texts = append(texts, comment.Text)
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, texts)
+}
+
+func Test_FindCommentsQuery_Execute_shouldReturnError_whenTodoIsNotVisible(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewFindCommentsQuery(gateway.NewTodoCommentRepository(dbc.DB))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindCommentsInput(created.ID, otherUserID)
+	require.NoError(t, err)
+
+	// when
+	comments, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, comments)
+}
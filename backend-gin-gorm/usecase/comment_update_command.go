@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CommentUpdater defines the interface for editing comments in the repository.
+// Implementations must return domain.ErrForbidden when the user is not allowed to edit the comment.
+type CommentUpdater interface {
+	UpdateComment(ctx context.Context, input *domain.UpdateCommentInput) (*domain.Comment, error)
+}
+
+// UpdateCommentCommand edits the text of an existing comment.
+type UpdateCommentCommand struct {
+	repo CommentUpdater
+}
+
+// NewUpdateCommentCommand returns a new UpdateCommentCommand.
+func NewUpdateCommentCommand(repo CommentUpdater) *UpdateCommentCommand {
+	return &UpdateCommentCommand{
+		repo: repo,
+	}
+}
+
+// Execute updates the comment and returns the updated result.
+func (u *UpdateCommentCommand) Execute(ctx context.Context, input *domain.UpdateCommentInput) (*domain.UpdateCommentOutput, error) {
+	comment, err := u.repo.UpdateComment(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("update comment: %w", err)
+	}
+
+	output, err := domain.NewUpdateCommentOutput(comment)
+	if err != nil {
+		return nil, fmt.Errorf("create update comment output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_UpdateCommentCommand_Execute_shouldUpdateComment_whenAuthorEdits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+	cmd := usecase.NewUpdateCommentCommand(commentRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	commentInput, err := domain.NewCreateCommentInput(created.ID, userID, "user1", "first draft")
+	require.NoError(t, err)
+	comment, _, err := commentRepo.CreateComment(ctx, commentInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewUpdateCommentInput(comment.ID, created.ID, userID, "final")
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "final", output.Comment.Text)
+	assert.NotNil(t, output.Comment.EditedAt)
+}
+
+func Test_UpdateCommentCommand_Execute_shouldReturnError_whenUserIDDoesNotMatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	commentRepo := gateway.NewTodoCommentRepository(dbc.DB)
+	cmd := usecase.NewUpdateCommentCommand(commentRepo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "todo")
+	require.NoError(t, err)
+	created, err := todoRepo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	commentInput, err := domain.NewCreateCommentInput(created.ID, userID, "user1", "mine")
+	require.NoError(t, err)
+	comment, _, err := commentRepo.CreateComment(ctx, commentInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewUpdateCommentInput(comment.ID, created.ID, otherUserID, "hijacked")
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// IdempotencyKeyRepository composes all idempotency key persistence interfaces.
+type IdempotencyKeyRepository interface {
+	IdempotencyKeyClaimer
+	IdempotentRequestCompleter
+	IdempotentRequestReleaser
+	IdempotencyKeyPurger
+}
+
+// IdempotencyUsecase orchestrates idempotency keys via command objects.
+type IdempotencyUsecase struct {
+	beginIdempotentRequestCommand    *BeginIdempotentRequestCommand
+	completeIdempotentRequestCommand *CompleteIdempotentRequestCommand
+	releaseIdempotentRequestCommand  *ReleaseIdempotentRequestCommand
+	purgeIdempotencyKeysCommand      *PurgeIdempotencyKeysCommand
+	logger                           *slog.Logger
+}
+
+// NewIdempotencyUsecase returns a new IdempotencyUsecase wired with the given repository.
+// A key is kept for keyTTL after its first use.
+func NewIdempotencyUsecase(repo IdempotencyKeyRepository, keyTTL time.Duration) *IdempotencyUsecase {
+	return &IdempotencyUsecase{
+		beginIdempotentRequestCommand:    NewBeginIdempotentRequestCommand(repo, keyTTL),
+		completeIdempotentRequestCommand: NewCompleteIdempotentRequestCommand(repo),
+		releaseIdempotentRequestCommand:  NewReleaseIdempotentRequestCommand(repo),
+		purgeIdempotencyKeysCommand:      NewPurgeIdempotencyKeysCommand(repo),
+		logger:                           slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-IdempotencyUsecase")),
+	}
+}
+
+// BeginIdempotentRequest claims the idempotency key of a request and returns nil if the request should run,
+// or the stored response to replay if the request already ran.
+func (u *IdempotencyUsecase) BeginIdempotentRequest(ctx context.Context, input *domain.BeginIdempotentRequestInput) (*domain.IdempotentResponse, error) {
+	response, err := u.beginIdempotentRequestCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute begin idempotent request command: %w", err)
+	}
+	return response, nil
+}
+
+// CompleteIdempotentRequest stores the response of a request for its idempotency key.
+func (u *IdempotencyUsecase) CompleteIdempotentRequest(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error {
+	if err := u.completeIdempotentRequestCommand.Execute(ctx, input); err != nil {
+		return fmt.Errorf("execute complete idempotent request command: %w", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotentRequest frees the idempotency key of a request that failed so that it can be sent again.
+func (u *IdempotencyUsecase) ReleaseIdempotentRequest(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error {
+	if err := u.releaseIdempotentRequestCommand.Execute(ctx, input); err != nil {
+		return fmt.Errorf("execute release idempotent request command: %w", err)
+	}
+	return nil
+}
+
+// PurgeIdempotencyKeys deletes idempotency keys that expired before the given time.
+func (u *IdempotencyUsecase) PurgeIdempotencyKeys(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (*domain.PurgeIdempotencyKeysOutput, error) {
+	ctx, span := tracer.Start(ctx, "PurgeIdempotencyKeys")
+	defer span.End()
+
+	output, err := u.purgeIdempotencyKeysCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute purge idempotency keys command: %w", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// IdempotencyKeyClaimer defines the interface for claiming idempotency keys in the repository.
+type IdempotencyKeyClaimer interface {
+	ClaimIdempotencyKey(ctx context.Context, input *domain.ClaimIdempotencyKeyInput) (*domain.IdempotentResponse, error)
+}
+
+// BeginIdempotentRequestCommand claims the idempotency key of a request for the key TTL.
+type BeginIdempotentRequestCommand struct {
+	repo   IdempotencyKeyClaimer
+	keyTTL time.Duration
+}
+
+// NewBeginIdempotentRequestCommand returns a new BeginIdempotentRequestCommand.
+func NewBeginIdempotentRequestCommand(repo IdempotencyKeyClaimer, keyTTL time.Duration) *BeginIdempotentRequestCommand {
+	return &BeginIdempotentRequestCommand{
+		repo:   repo,
+		keyTTL: keyTTL,
+	}
+}
+
+// Execute claims the key of the request and returns nil, or returns the response stored for an earlier identical request.
+// Returns ErrIdempotencyKeyReused if the key was used for a different request and ErrIdempotencyKeyInFlight
+// if the earlier request has not completed.
+func (u *BeginIdempotentRequestCommand) Execute(ctx context.Context, input *domain.BeginIdempotentRequestInput) (*domain.IdempotentResponse, error) {
+	now := time.Now()
+	claimInput, err := domain.NewClaimIdempotencyKeyInput(input, now, now.Add(u.keyTTL))
+	if err != nil {
+		return nil, fmt.Errorf("new claim idempotency key input: %w", err)
+	}
+
+	response, err := u.repo.ClaimIdempotencyKey(ctx, claimInput)
+	if err != nil {
+		return nil, fmt.Errorf("claim idempotency key: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func cleanupIdempotencyKeyTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := dbc.DB.Exec("DELETE FROM idempotency_key WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table idempotency_key: %v", err)
+	}
+}
+
+func Test_BeginIdempotentRequestCommand_Execute_shouldReplayResponse_whenRequestIsRetried(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupIdempotencyKeyTable(t, userID)
+	repo := gateway.NewIdempotencyKeyRepository(dbc.DB)
+	cmd := usecase.NewBeginIdempotentRequestCommand(repo, time.Hour)
+	complete := usecase.NewCompleteIdempotentRequestCommand(repo)
+	input, err := domain.NewBeginIdempotentRequestInput(userID, "key-1", strings.Repeat("a", 64))
+	require.NoError(t, err)
+
+	first, err := cmd.Execute(ctx, input)
+	require.NoError(t, err)
+	require.Nil(t, first)
+
+	// 完了前の再送は処理中として拒否される
+	_, err = cmd.Execute(ctx, input)
+	require.ErrorIs(t, err, domain.ErrIdempotencyKeyInFlight)
+
+	completeInput, err := domain.NewCompleteIdempotentRequestInput(userID, "key-1", domain.IdempotentResponse{
+		StatusCode: 201,
+		Header:     map[string]string{"Content-Type": "application/json"},
+		Body:       []byte(`{"id":1}`),
+	})
+	require.NoError(t, err)
+	require.NoError(t, complete.Execute(ctx, completeInput))
+
+	// when
+	replayed, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, replayed)
+	assert.Equal(t, 201, replayed.StatusCode)
+	assert.JSONEq(t, `{"id":1}`, string(replayed.Body))
+}
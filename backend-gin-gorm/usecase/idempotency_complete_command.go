@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// IdempotentRequestCompleter defines the interface for storing the responses of idempotent requests in the repository.
+type IdempotentRequestCompleter interface {
+	CompleteIdempotentRequest(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error
+}
+
+// CompleteIdempotentRequestCommand stores the response of a request for its idempotency key.
+type CompleteIdempotentRequestCommand struct {
+	repo IdempotentRequestCompleter
+}
+
+// NewCompleteIdempotentRequestCommand returns a new CompleteIdempotentRequestCommand.
+func NewCompleteIdempotentRequestCommand(repo IdempotentRequestCompleter) *CompleteIdempotentRequestCommand {
+	return &CompleteIdempotentRequestCommand{
+		repo: repo,
+	}
+}
+
+// Execute stores the response for the key so that retries of the request replay it.
+func (u *CompleteIdempotentRequestCommand) Execute(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error {
+	if err := u.repo.CompleteIdempotentRequest(ctx, input); err != nil {
+		return fmt.Errorf("complete idempotent request: %w", err)
+	}
+
+	return nil
+}
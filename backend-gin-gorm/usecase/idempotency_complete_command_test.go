@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_CompleteIdempotentRequestCommand_Execute_shouldStoreResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewCompleteIdempotentRequestInput(1, "key-1", domain.IdempotentResponse{
+		StatusCode: 201,
+		Header:     map[string]string{"Content-Type": "application/json"},
+		Body:       []byte(`{"id":1}`),
+	})
+	require.NoError(t, err)
+	mockRepo := NewMockIdempotentRequestCompleter(t)
+	mockRepo.EXPECT().CompleteIdempotentRequest(mock.Anything, input).Return(nil).Once()
+	cmd := usecase.NewCompleteIdempotentRequestCommand(mockRepo)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+}
+
+func Test_CompleteIdempotentRequestCommand_Execute_shouldReturnError_whenRepositoryFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewCompleteIdempotentRequestInput(1, "key-1", domain.IdempotentResponse{
+		StatusCode: 204,
+		Header:     map[string]string{},
+		Body:       nil,
+	})
+	require.NoError(t, err)
+	repoErr := errors.New("connection refused")
+	mockRepo := NewMockIdempotentRequestCompleter(t)
+	mockRepo.EXPECT().CompleteIdempotentRequest(mock.Anything, input).Return(repoErr).Once()
+	cmd := usecase.NewCompleteIdempotentRequestCommand(mockRepo)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, repoErr)
+}
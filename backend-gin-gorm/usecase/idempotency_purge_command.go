@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// IdempotencyKeyPurger defines the interface for deleting expired idempotency keys from the repository.
+type IdempotencyKeyPurger interface {
+	PurgeIdempotencyKeys(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (int, error)
+}
+
+// PurgeIdempotencyKeysCommand deletes expired idempotency keys.
+type PurgeIdempotencyKeysCommand struct {
+	repo IdempotencyKeyPurger
+}
+
+// NewPurgeIdempotencyKeysCommand returns a new PurgeIdempotencyKeysCommand.
+func NewPurgeIdempotencyKeysCommand(repo IdempotencyKeyPurger) *PurgeIdempotencyKeysCommand {
+	return &PurgeIdempotencyKeysCommand{
+		repo: repo,
+	}
+}
+
+// Execute purges batches of keys until none that expired before input.ExpiredBefore remain or the context is canceled.
+func (u *PurgeIdempotencyKeysCommand) Execute(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (*domain.PurgeIdempotencyKeysOutput, error) {
+	purgedCount := 0
+	for ctx.Err() == nil {
+		count, err := u.repo.PurgeIdempotencyKeys(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("purge idempotency keys: %w", err)
+		}
+		purgedCount += count
+
+		if count < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewPurgeIdempotencyKeysOutput(purgedCount)
+	if err != nil {
+		return nil, fmt.Errorf("create purge idempotency keys output: %w", err)
+	}
+
+	return output, nil
+}
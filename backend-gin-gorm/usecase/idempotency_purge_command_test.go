@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_PurgeIdempotencyKeysCommand_Execute_shouldPurgeBatchesUntilLastPartialBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewPurgeIdempotencyKeysInput(time.Now(), 100)
+	require.NoError(t, err)
+	mockRepo := NewMockIdempotencyKeyPurger(t)
+	mockRepo.EXPECT().PurgeIdempotencyKeys(mock.Anything, input).Return(100, nil).Once()
+	mockRepo.EXPECT().PurgeIdempotencyKeys(mock.Anything, input).Return(42, nil).Once()
+	cmd := usecase.NewPurgeIdempotencyKeysCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 142, output.PurgedCount)
+}
+
+func Test_PurgeIdempotencyKeysCommand_Execute_shouldNotPurge_whenContextIsCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// given
+	input, err := domain.NewPurgeIdempotencyKeysInput(time.Now(), 100)
+	require.NoError(t, err)
+	// PurgeIdempotencyKeys が呼ばれた場合は mock が失敗させる
+	mockRepo := NewMockIdempotencyKeyPurger(t)
+	cmd := usecase.NewPurgeIdempotencyKeysCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.PurgedCount)
+}
+
+func Test_PurgeIdempotencyKeysCommand_Execute_shouldReturnError_whenRepositoryFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewPurgeIdempotencyKeysInput(time.Now(), 100)
+	require.NoError(t, err)
+	repoErr := errors.New("connection refused")
+	mockRepo := NewMockIdempotencyKeyPurger(t)
+	mockRepo.EXPECT().PurgeIdempotencyKeys(mock.Anything, input).Return(0, repoErr).Once()
+	cmd := usecase.NewPurgeIdempotencyKeysCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, repoErr)
+	assert.Nil(t, output)
+}
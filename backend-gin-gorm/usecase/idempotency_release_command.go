@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// IdempotentRequestReleaser defines the interface for freeing the idempotency keys of failed requests in the repository.
+type IdempotentRequestReleaser interface {
+	ReleaseIdempotentRequest(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error
+}
+
+// ReleaseIdempotentRequestCommand frees the idempotency key of a failed request.
+type ReleaseIdempotentRequestCommand struct {
+	repo IdempotentRequestReleaser
+}
+
+// NewReleaseIdempotentRequestCommand returns a new ReleaseIdempotentRequestCommand.
+func NewReleaseIdempotentRequestCommand(repo IdempotentRequestReleaser) *ReleaseIdempotentRequestCommand {
+	return &ReleaseIdempotentRequestCommand{
+		repo: repo,
+	}
+}
+
+// Execute frees the key so that a retry of the request runs it again.
+func (u *ReleaseIdempotentRequestCommand) Execute(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error {
+	if err := u.repo.ReleaseIdempotentRequest(ctx, input); err != nil {
+		return fmt.Errorf("release idempotent request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_ReleaseIdempotentRequestCommand_Execute_shouldFreeKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewReleaseIdempotentRequestInput(1, "key-1")
+	require.NoError(t, err)
+	mockRepo := NewMockIdempotentRequestReleaser(t)
+	mockRepo.EXPECT().ReleaseIdempotentRequest(mock.Anything, input).Return(nil).Once()
+	cmd := usecase.NewReleaseIdempotentRequestCommand(mockRepo)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+}
+
+func Test_ReleaseIdempotentRequestCommand_Execute_shouldReturnError_whenRepositoryFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewReleaseIdempotentRequestInput(1, "key-1")
+	require.NoError(t, err)
+	repoErr := errors.New("connection refused")
+	mockRepo := NewMockIdempotentRequestReleaser(t)
+	mockRepo.EXPECT().ReleaseIdempotentRequest(mock.Anything, input).Return(repoErr).Once()
+	cmd := usecase.NewReleaseIdempotentRequestCommand(mockRepo)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, repoErr)
+}
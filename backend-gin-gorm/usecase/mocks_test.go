@@ -5,6 +5,8 @@
 package usecase_test
 
 import (
+	"context"
+	"io"
 	"time"
 
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
@@ -279,3 +281,2648 @@ func (_c *MockAuthTokenParser_ParseToken_Call) RunAndReturn(run func(tokenString
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockEventPublisher creates a new instance of MockEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventPublisher {
+	mock := &MockEventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventPublisher is an autogenerated mock type for the EventPublisher type
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+type MockEventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventPublisher) EXPECT() *MockEventPublisher_Expecter {
+	return &MockEventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishEvents provides a mock function for the type MockEventPublisher
+func (_mock *MockEventPublisher) PublishEvents(ctx context.Context, events []domain.OutboxEvent) error {
+	ret := _mock.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishEvents")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.OutboxEvent) error); ok {
+		r0 = returnFunc(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventPublisher_PublishEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishEvents'
+type MockEventPublisher_PublishEvents_Call struct {
+	*mock.Call
+}
+
+// PublishEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []domain.OutboxEvent
+func (_e *MockEventPublisher_Expecter) PublishEvents(ctx interface{}, events interface{}) *MockEventPublisher_PublishEvents_Call {
+	return &MockEventPublisher_PublishEvents_Call{Call: _e.mock.On("PublishEvents", ctx, events)}
+}
+
+func (_c *MockEventPublisher_PublishEvents_Call) Run(run func(ctx context.Context, events []domain.OutboxEvent)) *MockEventPublisher_PublishEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.OutboxEvent
+		if args[1] != nil {
+			arg1 = args[1].([]domain.OutboxEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventPublisher_PublishEvents_Call) Return(err error) *MockEventPublisher_PublishEvents_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventPublisher_PublishEvents_Call) RunAndReturn(run func(ctx context.Context, events []domain.OutboxEvent) error) *MockEventPublisher_PublishEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOutboxRelayRepository creates a new instance of MockOutboxRelayRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRelayRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRelayRepository {
+	mock := &MockOutboxRelayRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRelayRepository is an autogenerated mock type for the OutboxRelayRepository type
+type MockOutboxRelayRepository struct {
+	mock.Mock
+}
+
+type MockOutboxRelayRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRelayRepository) EXPECT() *MockOutboxRelayRepository_Expecter {
+	return &MockOutboxRelayRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimOutboxEvents provides a mock function for the type MockOutboxRelayRepository
+func (_mock *MockOutboxRelayRepository) ClaimOutboxEvents(ctx context.Context, input *domain.ClaimOutboxEventsInput) ([]domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutboxEvents")
+	}
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ClaimOutboxEventsInput) ([]domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ClaimOutboxEventsInput) []domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ClaimOutboxEventsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRelayRepository_ClaimOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimOutboxEvents'
+type MockOutboxRelayRepository_ClaimOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ClaimOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ClaimOutboxEventsInput
+func (_e *MockOutboxRelayRepository_Expecter) ClaimOutboxEvents(ctx interface{}, input interface{}) *MockOutboxRelayRepository_ClaimOutboxEvents_Call {
+	return &MockOutboxRelayRepository_ClaimOutboxEvents_Call{Call: _e.mock.On("ClaimOutboxEvents", ctx, input)}
+}
+
+func (_c *MockOutboxRelayRepository_ClaimOutboxEvents_Call) Run(run func(ctx context.Context, input *domain.ClaimOutboxEventsInput)) *MockOutboxRelayRepository_ClaimOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ClaimOutboxEventsInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ClaimOutboxEventsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayRepository_ClaimOutboxEvents_Call) Return(outboxEvents []domain.OutboxEvent, err error) *MockOutboxRelayRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(outboxEvents, err)
+	return _c
+}
+
+func (_c *MockOutboxRelayRepository_ClaimOutboxEvents_Call) RunAndReturn(run func(ctx context.Context, input *domain.ClaimOutboxEventsInput) ([]domain.OutboxEvent, error)) *MockOutboxRelayRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventsDispatched provides a mock function for the type MockOutboxRelayRepository
+func (_mock *MockOutboxRelayRepository) MarkOutboxEventsDispatched(ctx context.Context, ids []int64, dispatchedAt time.Time) error {
+	ret := _mock.Called(ctx, ids, dispatchedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventsDispatched")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64, time.Time) error); ok {
+		r0 = returnFunc(ctx, ids, dispatchedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventsDispatched'
+type MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventsDispatched is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+//   - dispatchedAt time.Time
+func (_e *MockOutboxRelayRepository_Expecter) MarkOutboxEventsDispatched(ctx interface{}, ids interface{}, dispatchedAt interface{}) *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call {
+	return &MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call{Call: _e.mock.On("MarkOutboxEventsDispatched", ctx, ids, dispatchedAt)}
+}
+
+func (_c *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call) Run(run func(ctx context.Context, ids []int64, dispatchedAt time.Time)) *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call) Return(err error) *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call) RunAndReturn(run func(ctx context.Context, ids []int64, dispatchedAt time.Time) error) *MockOutboxRelayRepository_MarkOutboxEventsDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordOutboxPublishFailure provides a mock function for the type MockOutboxRelayRepository
+func (_mock *MockOutboxRelayRepository) RecordOutboxPublishFailure(ctx context.Context, ids []int64, publishErr error) error {
+	ret := _mock.Called(ctx, ids, publishErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordOutboxPublishFailure")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64, error) error); ok {
+		r0 = returnFunc(ctx, ids, publishErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRelayRepository_RecordOutboxPublishFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordOutboxPublishFailure'
+type MockOutboxRelayRepository_RecordOutboxPublishFailure_Call struct {
+	*mock.Call
+}
+
+// RecordOutboxPublishFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+//   - publishErr error
+func (_e *MockOutboxRelayRepository_Expecter) RecordOutboxPublishFailure(ctx interface{}, ids interface{}, publishErr interface{}) *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call {
+	return &MockOutboxRelayRepository_RecordOutboxPublishFailure_Call{Call: _e.mock.On("RecordOutboxPublishFailure", ctx, ids, publishErr)}
+}
+
+func (_c *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call) Run(run func(ctx context.Context, ids []int64, publishErr error)) *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		var arg2 error
+		if args[2] != nil {
+			arg2 = args[2].(error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call) Return(err error) *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call) RunAndReturn(run func(ctx context.Context, ids []int64, publishErr error) error) *MockOutboxRelayRepository_RecordOutboxPublishFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoUndoRecorder creates a new instance of MockTodoUndoRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoUndoRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoUndoRecorder {
+	mock := &MockTodoUndoRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoUndoRecorder is an autogenerated mock type for the TodoUndoRecorder type
+type MockTodoUndoRecorder struct {
+	mock.Mock
+}
+
+type MockTodoUndoRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoUndoRecorder) EXPECT() *MockTodoUndoRecorder_Expecter {
+	return &MockTodoUndoRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordTodoUndo provides a mock function for the type MockTodoUndoRecorder
+func (_mock *MockTodoUndoRecorder) RecordTodoUndo(ctx context.Context, input *domain.RecordTodoUndoInput) (int, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTodoUndo")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RecordTodoUndoInput) (int, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RecordTodoUndoInput) int); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.RecordTodoUndoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUndoRecorder_RecordTodoUndo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTodoUndo'
+type MockTodoUndoRecorder_RecordTodoUndo_Call struct {
+	*mock.Call
+}
+
+// RecordTodoUndo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.RecordTodoUndoInput
+func (_e *MockTodoUndoRecorder_Expecter) RecordTodoUndo(ctx interface{}, input interface{}) *MockTodoUndoRecorder_RecordTodoUndo_Call {
+	return &MockTodoUndoRecorder_RecordTodoUndo_Call{Call: _e.mock.On("RecordTodoUndo", ctx, input)}
+}
+
+func (_c *MockTodoUndoRecorder_RecordTodoUndo_Call) Run(run func(ctx context.Context, input *domain.RecordTodoUndoInput)) *MockTodoUndoRecorder_RecordTodoUndo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.RecordTodoUndoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RecordTodoUndoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUndoRecorder_RecordTodoUndo_Call) Return(n int, err error) *MockTodoUndoRecorder_RecordTodoUndo_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTodoUndoRecorder_RecordTodoUndo_Call) RunAndReturn(run func(ctx context.Context, input *domain.RecordTodoUndoInput) (int, error)) *MockTodoUndoRecorder_RecordTodoUndo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobGetter creates a new instance of MockBlobGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobGetter {
+	mock := &MockBlobGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBlobGetter is an autogenerated mock type for the BlobGetter type
+type MockBlobGetter struct {
+	mock.Mock
+}
+
+type MockBlobGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobGetter) EXPECT() *MockBlobGetter_Expecter {
+	return &MockBlobGetter_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockBlobGetter
+func (_mock *MockBlobGetter) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBlobGetter_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockBlobGetter_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobGetter_Expecter) Get(ctx interface{}, key interface{}) *MockBlobGetter_Get_Call {
+	return &MockBlobGetter_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockBlobGetter_Get_Call) Run(run func(ctx context.Context, key string)) *MockBlobGetter_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobGetter_Get_Call) Return(readCloser io.ReadCloser, err error) *MockBlobGetter_Get_Call {
+	_c.Call.Return(readCloser, err)
+	return _c
+}
+
+func (_c *MockBlobGetter_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (io.ReadCloser, error)) *MockBlobGetter_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobDeleter creates a new instance of MockBlobDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobDeleter {
+	mock := &MockBlobDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBlobDeleter is an autogenerated mock type for the BlobDeleter type
+type MockBlobDeleter struct {
+	mock.Mock
+}
+
+type MockBlobDeleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobDeleter) EXPECT() *MockBlobDeleter_Expecter {
+	return &MockBlobDeleter_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockBlobDeleter
+func (_mock *MockBlobDeleter) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobDeleter_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBlobDeleter_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobDeleter_Expecter) Delete(ctx interface{}, key interface{}) *MockBlobDeleter_Delete_Call {
+	return &MockBlobDeleter_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockBlobDeleter_Delete_Call) Run(run func(ctx context.Context, key string)) *MockBlobDeleter_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobDeleter_Delete_Call) Return(err error) *MockBlobDeleter_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobDeleter_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockBlobDeleter_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobStore creates a new instance of MockBlobStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobStore {
+	mock := &MockBlobStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBlobStore is an autogenerated mock type for the BlobStore type
+type MockBlobStore struct {
+	mock.Mock
+}
+
+type MockBlobStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobStore) EXPECT() *MockBlobStore_Expecter {
+	return &MockBlobStore_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBlobStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStore_Expecter) Delete(ctx interface{}, key interface{}) *MockBlobStore_Delete_Call {
+	return &MockBlobStore_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockBlobStore_Delete_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) Return(err error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBlobStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockBlobStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStore_Expecter) Get(ctx interface{}, key interface{}) *MockBlobStore_Get_Call {
+	return &MockBlobStore_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockBlobStore_Get_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Get_Call) Return(readCloser io.ReadCloser, err error) *MockBlobStore_Get_Call {
+	_c.Call.Return(readCloser, err)
+	return _c
+}
+
+func (_c *MockBlobStore_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (io.ReadCloser, error)) *MockBlobStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	ret := _mock.Called(ctx, key, body, size, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, io.Reader, int64, string) error); ok {
+		r0 = returnFunc(ctx, key, body, size, contentType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobStore_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockBlobStore_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - body io.Reader
+//   - size int64
+//   - contentType string
+func (_e *MockBlobStore_Expecter) Put(ctx interface{}, key interface{}, body interface{}, size interface{}, contentType interface{}) *MockBlobStore_Put_Call {
+	return &MockBlobStore_Put_Call{Call: _e.mock.On("Put", ctx, key, body, size, contentType)}
+}
+
+func (_c *MockBlobStore_Put_Call) Run(run func(ctx context.Context, key string, body io.Reader, size int64, contentType string)) *MockBlobStore_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Put_Call) Return(err error) *MockBlobStore_Put_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobStore_Put_Call) RunAndReturn(run func(ctx context.Context, key string, body io.Reader, size int64, contentType string) error) *MockBlobStore_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentDeleter creates a new instance of MockAttachmentDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentDeleter {
+	mock := &MockAttachmentDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentDeleter is an autogenerated mock type for the AttachmentDeleter type
+type MockAttachmentDeleter struct {
+	mock.Mock
+}
+
+type MockAttachmentDeleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentDeleter) EXPECT() *MockAttachmentDeleter_Expecter {
+	return &MockAttachmentDeleter_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAttachment provides a mock function for the type MockAttachmentDeleter
+func (_mock *MockAttachmentDeleter) DeleteAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 *domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) (*domain.Attachment, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) *domain.Attachment); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AttachmentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentDeleter_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockAttachmentDeleter_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AttachmentInput
+func (_e *MockAttachmentDeleter_Expecter) DeleteAttachment(ctx interface{}, input interface{}) *MockAttachmentDeleter_DeleteAttachment_Call {
+	return &MockAttachmentDeleter_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, input)}
+}
+
+func (_c *MockAttachmentDeleter_DeleteAttachment_Call) Run(run func(ctx context.Context, input *domain.AttachmentInput)) *MockAttachmentDeleter_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AttachmentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AttachmentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentDeleter_DeleteAttachment_Call) Return(attachment *domain.Attachment, err error) *MockAttachmentDeleter_DeleteAttachment_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *MockAttachmentDeleter_DeleteAttachment_Call) RunAndReturn(run func(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error)) *MockAttachmentDeleter_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentFinder creates a new instance of MockAttachmentFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentFinder {
+	mock := &MockAttachmentFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentFinder is an autogenerated mock type for the AttachmentFinder type
+type MockAttachmentFinder struct {
+	mock.Mock
+}
+
+type MockAttachmentFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentFinder) EXPECT() *MockAttachmentFinder_Expecter {
+	return &MockAttachmentFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindAttachment provides a mock function for the type MockAttachmentFinder
+func (_mock *MockAttachmentFinder) FindAttachment(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttachment")
+	}
+
+	var r0 *domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) (*domain.Attachment, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AttachmentInput) *domain.Attachment); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AttachmentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentFinder_FindAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachment'
+type MockAttachmentFinder_FindAttachment_Call struct {
+	*mock.Call
+}
+
+// FindAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AttachmentInput
+func (_e *MockAttachmentFinder_Expecter) FindAttachment(ctx interface{}, input interface{}) *MockAttachmentFinder_FindAttachment_Call {
+	return &MockAttachmentFinder_FindAttachment_Call{Call: _e.mock.On("FindAttachment", ctx, input)}
+}
+
+func (_c *MockAttachmentFinder_FindAttachment_Call) Run(run func(ctx context.Context, input *domain.AttachmentInput)) *MockAttachmentFinder_FindAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AttachmentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AttachmentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentFinder_FindAttachment_Call) Return(attachment *domain.Attachment, err error) *MockAttachmentFinder_FindAttachment_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *MockAttachmentFinder_FindAttachment_Call) RunAndReturn(run func(ctx context.Context, input *domain.AttachmentInput) (*domain.Attachment, error)) *MockAttachmentFinder_FindAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentsFinder creates a new instance of MockAttachmentsFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsFinder {
+	mock := &MockAttachmentsFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentsFinder is an autogenerated mock type for the AttachmentsFinder type
+type MockAttachmentsFinder struct {
+	mock.Mock
+}
+
+type MockAttachmentsFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsFinder) EXPECT() *MockAttachmentsFinder_Expecter {
+	return &MockAttachmentsFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindAttachments provides a mock function for the type MockAttachmentsFinder
+func (_mock *MockAttachmentsFinder) FindAttachments(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttachments")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindAttachmentsInput) ([]domain.Attachment, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.FindAttachmentsInput) []domain.Attachment); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.FindAttachmentsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentsFinder_FindAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachments'
+type MockAttachmentsFinder_FindAttachments_Call struct {
+	*mock.Call
+}
+
+// FindAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.FindAttachmentsInput
+func (_e *MockAttachmentsFinder_Expecter) FindAttachments(ctx interface{}, input interface{}) *MockAttachmentsFinder_FindAttachments_Call {
+	return &MockAttachmentsFinder_FindAttachments_Call{Call: _e.mock.On("FindAttachments", ctx, input)}
+}
+
+func (_c *MockAttachmentsFinder_FindAttachments_Call) Run(run func(ctx context.Context, input *domain.FindAttachmentsInput)) *MockAttachmentsFinder_FindAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.FindAttachmentsInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.FindAttachmentsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsFinder_FindAttachments_Call) Return(attachments []domain.Attachment, err error) *MockAttachmentsFinder_FindAttachments_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *MockAttachmentsFinder_FindAttachments_Call) RunAndReturn(run func(ctx context.Context, input *domain.FindAttachmentsInput) ([]domain.Attachment, error)) *MockAttachmentsFinder_FindAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentUploader creates a new instance of MockAttachmentUploader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentUploader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentUploader {
+	mock := &MockAttachmentUploader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentUploader is an autogenerated mock type for the AttachmentUploader type
+type MockAttachmentUploader struct {
+	mock.Mock
+}
+
+type MockAttachmentUploader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentUploader) EXPECT() *MockAttachmentUploader_Expecter {
+	return &MockAttachmentUploader_Expecter{mock: &_m.Mock}
+}
+
+// CheckAttachableTodo provides a mock function for the type MockAttachmentUploader
+func (_mock *MockAttachmentUploader) CheckAttachableTodo(ctx context.Context, todoID int, userID int) error {
+	ret := _mock.Called(ctx, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAttachableTodo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = returnFunc(ctx, todoID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentUploader_CheckAttachableTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAttachableTodo'
+type MockAttachmentUploader_CheckAttachableTodo_Call struct {
+	*mock.Call
+}
+
+// CheckAttachableTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID int
+//   - userID int
+func (_e *MockAttachmentUploader_Expecter) CheckAttachableTodo(ctx interface{}, todoID interface{}, userID interface{}) *MockAttachmentUploader_CheckAttachableTodo_Call {
+	return &MockAttachmentUploader_CheckAttachableTodo_Call{Call: _e.mock.On("CheckAttachableTodo", ctx, todoID, userID)}
+}
+
+func (_c *MockAttachmentUploader_CheckAttachableTodo_Call) Run(run func(ctx context.Context, todoID int, userID int)) *MockAttachmentUploader_CheckAttachableTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUploader_CheckAttachableTodo_Call) Return(err error) *MockAttachmentUploader_CheckAttachableTodo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentUploader_CheckAttachableTodo_Call) RunAndReturn(run func(ctx context.Context, todoID int, userID int) error) *MockAttachmentUploader_CheckAttachableTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAttachment provides a mock function for the type MockAttachmentUploader
+func (_mock *MockAttachmentUploader) CreateAttachment(ctx context.Context, input *domain.CreateAttachmentInput) (*domain.Attachment, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAttachment")
+	}
+
+	var r0 *domain.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateAttachmentInput) (*domain.Attachment, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CreateAttachmentInput) *domain.Attachment); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.CreateAttachmentInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentUploader_CreateAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAttachment'
+type MockAttachmentUploader_CreateAttachment_Call struct {
+	*mock.Call
+}
+
+// CreateAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CreateAttachmentInput
+func (_e *MockAttachmentUploader_Expecter) CreateAttachment(ctx interface{}, input interface{}) *MockAttachmentUploader_CreateAttachment_Call {
+	return &MockAttachmentUploader_CreateAttachment_Call{Call: _e.mock.On("CreateAttachment", ctx, input)}
+}
+
+func (_c *MockAttachmentUploader_CreateAttachment_Call) Run(run func(ctx context.Context, input *domain.CreateAttachmentInput)) *MockAttachmentUploader_CreateAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CreateAttachmentInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CreateAttachmentInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUploader_CreateAttachment_Call) Return(attachment *domain.Attachment, err error) *MockAttachmentUploader_CreateAttachment_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *MockAttachmentUploader_CreateAttachment_Call) RunAndReturn(run func(ctx context.Context, input *domain.CreateAttachmentInput) (*domain.Attachment, error)) *MockAttachmentUploader_CreateAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTrashedTodosFinder creates a new instance of MockTrashedTodosFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTrashedTodosFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTrashedTodosFinder {
+	mock := &MockTrashedTodosFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTrashedTodosFinder is an autogenerated mock type for the TrashedTodosFinder type
+type MockTrashedTodosFinder struct {
+	mock.Mock
+}
+
+type MockTrashedTodosFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTrashedTodosFinder) EXPECT() *MockTrashedTodosFinder_Expecter {
+	return &MockTrashedTodosFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindTrashedTodos provides a mock function for the type MockTrashedTodosFinder
+func (_mock *MockTrashedTodosFinder) FindTrashedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashedTodos")
+	}
+
+	var r0 []domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Todo, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Todo); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTrashedTodosFinder_FindTrashedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedTodos'
+type MockTrashedTodosFinder_FindTrashedTodos_Call struct {
+	*mock.Call
+}
+
+// FindTrashedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockTrashedTodosFinder_Expecter) FindTrashedTodos(ctx interface{}, userID interface{}) *MockTrashedTodosFinder_FindTrashedTodos_Call {
+	return &MockTrashedTodosFinder_FindTrashedTodos_Call{Call: _e.mock.On("FindTrashedTodos", ctx, userID)}
+}
+
+func (_c *MockTrashedTodosFinder_FindTrashedTodos_Call) Run(run func(ctx context.Context, userID int)) *MockTrashedTodosFinder_FindTrashedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTrashedTodosFinder_FindTrashedTodos_Call) Return(todos []domain.Todo, err error) *MockTrashedTodosFinder_FindTrashedTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTrashedTodosFinder_FindTrashedTodos_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Todo, error)) *MockTrashedTodosFinder_FindTrashedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoRestorer creates a new instance of MockTodoRestorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoRestorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoRestorer {
+	mock := &MockTodoRestorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoRestorer is an autogenerated mock type for the TodoRestorer type
+type MockTodoRestorer struct {
+	mock.Mock
+}
+
+type MockTodoRestorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoRestorer) EXPECT() *MockTodoRestorer_Expecter {
+	return &MockTodoRestorer_Expecter{mock: &_m.Mock}
+}
+
+// RestoreTodo provides a mock function for the type MockTodoRestorer
+func (_mock *MockTodoRestorer) RestoreTodo(ctx context.Context, input *domain.RestoreTodoInput) (*domain.Todo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTodo")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RestoreTodoInput) (*domain.Todo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RestoreTodoInput) *domain.Todo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.RestoreTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoRestorer_RestoreTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTodo'
+type MockTodoRestorer_RestoreTodo_Call struct {
+	*mock.Call
+}
+
+// RestoreTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.RestoreTodoInput
+func (_e *MockTodoRestorer_Expecter) RestoreTodo(ctx interface{}, input interface{}) *MockTodoRestorer_RestoreTodo_Call {
+	return &MockTodoRestorer_RestoreTodo_Call{Call: _e.mock.On("RestoreTodo", ctx, input)}
+}
+
+func (_c *MockTodoRestorer_RestoreTodo_Call) Run(run func(ctx context.Context, input *domain.RestoreTodoInput)) *MockTodoRestorer_RestoreTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.RestoreTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RestoreTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoRestorer_RestoreTodo_Call) Return(todo *domain.Todo, err error) *MockTodoRestorer_RestoreTodo_Call {
+	_c.Call.Return(todo, err)
+	return _c
+}
+
+func (_c *MockTodoRestorer_RestoreTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.RestoreTodoInput) (*domain.Todo, error)) *MockTodoRestorer_RestoreTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoArchiver creates a new instance of MockTodoArchiver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoArchiver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoArchiver {
+	mock := &MockTodoArchiver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoArchiver is an autogenerated mock type for the TodoArchiver type
+type MockTodoArchiver struct {
+	mock.Mock
+}
+
+type MockTodoArchiver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoArchiver) EXPECT() *MockTodoArchiver_Expecter {
+	return &MockTodoArchiver_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveTodo provides a mock function for the type MockTodoArchiver
+func (_mock *MockTodoArchiver) ArchiveTodo(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.Todo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveTodo")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ArchiveTodoInput) (*domain.Todo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ArchiveTodoInput) *domain.Todo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ArchiveTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoArchiver_ArchiveTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveTodo'
+type MockTodoArchiver_ArchiveTodo_Call struct {
+	*mock.Call
+}
+
+// ArchiveTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ArchiveTodoInput
+func (_e *MockTodoArchiver_Expecter) ArchiveTodo(ctx interface{}, input interface{}) *MockTodoArchiver_ArchiveTodo_Call {
+	return &MockTodoArchiver_ArchiveTodo_Call{Call: _e.mock.On("ArchiveTodo", ctx, input)}
+}
+
+func (_c *MockTodoArchiver_ArchiveTodo_Call) Run(run func(ctx context.Context, input *domain.ArchiveTodoInput)) *MockTodoArchiver_ArchiveTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ArchiveTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ArchiveTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoArchiver_ArchiveTodo_Call) Return(todo *domain.Todo, err error) *MockTodoArchiver_ArchiveTodo_Call {
+	_c.Call.Return(todo, err)
+	return _c
+}
+
+func (_c *MockTodoArchiver_ArchiveTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.Todo, error)) *MockTodoArchiver_ArchiveTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoAutoArchiver creates a new instance of MockTodoAutoArchiver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoAutoArchiver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoAutoArchiver {
+	mock := &MockTodoAutoArchiver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoAutoArchiver is an autogenerated mock type for the TodoAutoArchiver type
+type MockTodoAutoArchiver struct {
+	mock.Mock
+}
+
+type MockTodoAutoArchiver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoAutoArchiver) EXPECT() *MockTodoAutoArchiver_Expecter {
+	return &MockTodoAutoArchiver_Expecter{mock: &_m.Mock}
+}
+
+// AutoArchiveTodos provides a mock function for the type MockTodoAutoArchiver
+func (_mock *MockTodoAutoArchiver) AutoArchiveTodos(ctx context.Context, input *domain.AutoArchiveTodosInput) ([]domain.Todo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoArchiveTodos")
+	}
+
+	var r0 []domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AutoArchiveTodosInput) ([]domain.Todo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AutoArchiveTodosInput) []domain.Todo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AutoArchiveTodosInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoAutoArchiver_AutoArchiveTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoArchiveTodos'
+type MockTodoAutoArchiver_AutoArchiveTodos_Call struct {
+	*mock.Call
+}
+
+// AutoArchiveTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.AutoArchiveTodosInput
+func (_e *MockTodoAutoArchiver_Expecter) AutoArchiveTodos(ctx interface{}, input interface{}) *MockTodoAutoArchiver_AutoArchiveTodos_Call {
+	return &MockTodoAutoArchiver_AutoArchiveTodos_Call{Call: _e.mock.On("AutoArchiveTodos", ctx, input)}
+}
+
+func (_c *MockTodoAutoArchiver_AutoArchiveTodos_Call) Run(run func(ctx context.Context, input *domain.AutoArchiveTodosInput)) *MockTodoAutoArchiver_AutoArchiveTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AutoArchiveTodosInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AutoArchiveTodosInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoAutoArchiver_AutoArchiveTodos_Call) Return(todos []domain.Todo, err error) *MockTodoAutoArchiver_AutoArchiveTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTodoAutoArchiver_AutoArchiveTodos_Call) RunAndReturn(run func(ctx context.Context, input *domain.AutoArchiveTodosInput) ([]domain.Todo, error)) *MockTodoAutoArchiver_AutoArchiveTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockArchivedTodosFinder creates a new instance of MockArchivedTodosFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArchivedTodosFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArchivedTodosFinder {
+	mock := &MockArchivedTodosFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArchivedTodosFinder is an autogenerated mock type for the ArchivedTodosFinder type
+type MockArchivedTodosFinder struct {
+	mock.Mock
+}
+
+type MockArchivedTodosFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArchivedTodosFinder) EXPECT() *MockArchivedTodosFinder_Expecter {
+	return &MockArchivedTodosFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindArchivedTodos provides a mock function for the type MockArchivedTodosFinder
+func (_mock *MockArchivedTodosFinder) FindArchivedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindArchivedTodos")
+	}
+
+	var r0 []domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Todo, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Todo); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArchivedTodosFinder_FindArchivedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindArchivedTodos'
+type MockArchivedTodosFinder_FindArchivedTodos_Call struct {
+	*mock.Call
+}
+
+// FindArchivedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockArchivedTodosFinder_Expecter) FindArchivedTodos(ctx interface{}, userID interface{}) *MockArchivedTodosFinder_FindArchivedTodos_Call {
+	return &MockArchivedTodosFinder_FindArchivedTodos_Call{Call: _e.mock.On("FindArchivedTodos", ctx, userID)}
+}
+
+func (_c *MockArchivedTodosFinder_FindArchivedTodos_Call) Run(run func(ctx context.Context, userID int)) *MockArchivedTodosFinder_FindArchivedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArchivedTodosFinder_FindArchivedTodos_Call) Return(todos []domain.Todo, err error) *MockArchivedTodosFinder_FindArchivedTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockArchivedTodosFinder_FindArchivedTodos_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Todo, error)) *MockArchivedTodosFinder_FindArchivedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoPatcher creates a new instance of MockTodoPatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoPatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoPatcher {
+	mock := &MockTodoPatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoPatcher is an autogenerated mock type for the TodoPatcher type
+type MockTodoPatcher struct {
+	mock.Mock
+}
+
+type MockTodoPatcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoPatcher) EXPECT() *MockTodoPatcher_Expecter {
+	return &MockTodoPatcher_Expecter{mock: &_m.Mock}
+}
+
+// PatchTodo provides a mock function for the type MockTodoPatcher
+func (_mock *MockTodoPatcher) PatchTodo(ctx context.Context, input *domain.PatchTodoInput) (*domain.Todo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTodo")
+	}
+
+	var r0 *domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PatchTodoInput) (*domain.Todo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PatchTodoInput) *domain.Todo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PatchTodoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoPatcher_PatchTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTodo'
+type MockTodoPatcher_PatchTodo_Call struct {
+	*mock.Call
+}
+
+// PatchTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.PatchTodoInput
+func (_e *MockTodoPatcher_Expecter) PatchTodo(ctx interface{}, input interface{}) *MockTodoPatcher_PatchTodo_Call {
+	return &MockTodoPatcher_PatchTodo_Call{Call: _e.mock.On("PatchTodo", ctx, input)}
+}
+
+func (_c *MockTodoPatcher_PatchTodo_Call) Run(run func(ctx context.Context, input *domain.PatchTodoInput)) *MockTodoPatcher_PatchTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PatchTodoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PatchTodoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoPatcher_PatchTodo_Call) Return(todo *domain.Todo, err error) *MockTodoPatcher_PatchTodo_Call {
+	_c.Call.Return(todo, err)
+	return _c
+}
+
+func (_c *MockTodoPatcher_PatchTodo_Call) RunAndReturn(run func(ctx context.Context, input *domain.PatchTodoInput) (*domain.Todo, error)) *MockTodoPatcher_PatchTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdempotentRequestCompleter creates a new instance of MockIdempotentRequestCompleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotentRequestCompleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotentRequestCompleter {
+	mock := &MockIdempotentRequestCompleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotentRequestCompleter is an autogenerated mock type for the IdempotentRequestCompleter type
+type MockIdempotentRequestCompleter struct {
+	mock.Mock
+}
+
+type MockIdempotentRequestCompleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotentRequestCompleter) EXPECT() *MockIdempotentRequestCompleter_Expecter {
+	return &MockIdempotentRequestCompleter_Expecter{mock: &_m.Mock}
+}
+
+// CompleteIdempotentRequest provides a mock function for the type MockIdempotentRequestCompleter
+func (_mock *MockIdempotentRequestCompleter) CompleteIdempotentRequest(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.CompleteIdempotentRequestInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteIdempotentRequest'
+type MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// CompleteIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.CompleteIdempotentRequestInput
+func (_e *MockIdempotentRequestCompleter_Expecter) CompleteIdempotentRequest(ctx interface{}, input interface{}) *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call {
+	return &MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call{Call: _e.mock.On("CompleteIdempotentRequest", ctx, input)}
+}
+
+func (_c *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call) Run(run func(ctx context.Context, input *domain.CompleteIdempotentRequestInput)) *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.CompleteIdempotentRequestInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.CompleteIdempotentRequestInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call) Return(err error) *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, input *domain.CompleteIdempotentRequestInput) error) *MockIdempotentRequestCompleter_CompleteIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdempotencyKeyPurger creates a new instance of MockIdempotencyKeyPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyKeyPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyKeyPurger {
+	mock := &MockIdempotencyKeyPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyKeyPurger is an autogenerated mock type for the IdempotencyKeyPurger type
+type MockIdempotencyKeyPurger struct {
+	mock.Mock
+}
+
+type MockIdempotencyKeyPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyKeyPurger) EXPECT() *MockIdempotencyKeyPurger_Expecter {
+	return &MockIdempotencyKeyPurger_Expecter{mock: &_m.Mock}
+}
+
+// PurgeIdempotencyKeys provides a mock function for the type MockIdempotencyKeyPurger
+func (_mock *MockIdempotencyKeyPurger) PurgeIdempotencyKeys(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (int, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeIdempotencyKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PurgeIdempotencyKeysInput) (int, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PurgeIdempotencyKeysInput) int); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PurgeIdempotencyKeysInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeIdempotencyKeys'
+type MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call struct {
+	*mock.Call
+}
+
+// PurgeIdempotencyKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.PurgeIdempotencyKeysInput
+func (_e *MockIdempotencyKeyPurger_Expecter) PurgeIdempotencyKeys(ctx interface{}, input interface{}) *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call {
+	return &MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call{Call: _e.mock.On("PurgeIdempotencyKeys", ctx, input)}
+}
+
+func (_c *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call) Run(run func(ctx context.Context, input *domain.PurgeIdempotencyKeysInput)) *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PurgeIdempotencyKeysInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PurgeIdempotencyKeysInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call) Return(n int, err error) *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call) RunAndReturn(run func(ctx context.Context, input *domain.PurgeIdempotencyKeysInput) (int, error)) *MockIdempotencyKeyPurger_PurgeIdempotencyKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdempotentRequestReleaser creates a new instance of MockIdempotentRequestReleaser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotentRequestReleaser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotentRequestReleaser {
+	mock := &MockIdempotentRequestReleaser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotentRequestReleaser is an autogenerated mock type for the IdempotentRequestReleaser type
+type MockIdempotentRequestReleaser struct {
+	mock.Mock
+}
+
+type MockIdempotentRequestReleaser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotentRequestReleaser) EXPECT() *MockIdempotentRequestReleaser_Expecter {
+	return &MockIdempotentRequestReleaser_Expecter{mock: &_m.Mock}
+}
+
+// ReleaseIdempotentRequest provides a mock function for the type MockIdempotentRequestReleaser
+func (_mock *MockIdempotentRequestReleaser) ReleaseIdempotentRequest(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReleaseIdempotentRequestInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseIdempotentRequest'
+type MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// ReleaseIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ReleaseIdempotentRequestInput
+func (_e *MockIdempotentRequestReleaser_Expecter) ReleaseIdempotentRequest(ctx interface{}, input interface{}) *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call {
+	return &MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call{Call: _e.mock.On("ReleaseIdempotentRequest", ctx, input)}
+}
+
+func (_c *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call) Run(run func(ctx context.Context, input *domain.ReleaseIdempotentRequestInput)) *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReleaseIdempotentRequestInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReleaseIdempotentRequestInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call) Return(err error) *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, input *domain.ReleaseIdempotentRequestInput) error) *MockIdempotentRequestReleaser_ReleaseIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoEventSubscriber creates a new instance of MockTodoEventSubscriber. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoEventSubscriber(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoEventSubscriber {
+	mock := &MockTodoEventSubscriber{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoEventSubscriber is an autogenerated mock type for the TodoEventSubscriber type
+type MockTodoEventSubscriber struct {
+	mock.Mock
+}
+
+type MockTodoEventSubscriber_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoEventSubscriber) EXPECT() *MockTodoEventSubscriber_Expecter {
+	return &MockTodoEventSubscriber_Expecter{mock: &_m.Mock}
+}
+
+// SubscribeTodoEvents provides a mock function for the type MockTodoEventSubscriber
+func (_mock *MockTodoEventSubscriber) SubscribeTodoEvents(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeTodoEvents")
+	}
+
+	var r0 *domain.TodoEventSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SubscribeTodoEventsInput) *domain.TodoEventSubscription); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TodoEventSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.SubscribeTodoEventsInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoEventSubscriber_SubscribeTodoEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeTodoEvents'
+type MockTodoEventSubscriber_SubscribeTodoEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeTodoEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.SubscribeTodoEventsInput
+func (_e *MockTodoEventSubscriber_Expecter) SubscribeTodoEvents(ctx interface{}, input interface{}) *MockTodoEventSubscriber_SubscribeTodoEvents_Call {
+	return &MockTodoEventSubscriber_SubscribeTodoEvents_Call{Call: _e.mock.On("SubscribeTodoEvents", ctx, input)}
+}
+
+func (_c *MockTodoEventSubscriber_SubscribeTodoEvents_Call) Run(run func(ctx context.Context, input *domain.SubscribeTodoEventsInput)) *MockTodoEventSubscriber_SubscribeTodoEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SubscribeTodoEventsInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SubscribeTodoEventsInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoEventSubscriber_SubscribeTodoEvents_Call) Return(todoEventSubscription *domain.TodoEventSubscription, err error) *MockTodoEventSubscriber_SubscribeTodoEvents_Call {
+	_c.Call.Return(todoEventSubscription, err)
+	return _c
+}
+
+func (_c *MockTodoEventSubscriber_SubscribeTodoEvents_Call) RunAndReturn(run func(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error)) *MockTodoEventSubscriber_SubscribeTodoEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookDeliveryEnqueuer creates a new instance of MockWebhookDeliveryEnqueuer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookDeliveryEnqueuer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookDeliveryEnqueuer {
+	mock := &MockWebhookDeliveryEnqueuer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookDeliveryEnqueuer is an autogenerated mock type for the WebhookDeliveryEnqueuer type
+type MockWebhookDeliveryEnqueuer struct {
+	mock.Mock
+}
+
+type MockWebhookDeliveryEnqueuer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookDeliveryEnqueuer) EXPECT() *MockWebhookDeliveryEnqueuer_Expecter {
+	return &MockWebhookDeliveryEnqueuer_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueWebhookDeliveries provides a mock function for the type MockWebhookDeliveryEnqueuer
+func (_mock *MockWebhookDeliveryEnqueuer) EnqueueWebhookDeliveries(ctx context.Context, events []domain.WebhookEvent) (int, error) {
+	ret := _mock.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueWebhookDeliveries")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.WebhookEvent) (int, error)); ok {
+		return returnFunc(ctx, events)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.WebhookEvent) int); ok {
+		r0 = returnFunc(ctx, events)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.WebhookEvent) error); ok {
+		r1 = returnFunc(ctx, events)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueWebhookDeliveries'
+type MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// EnqueueWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []domain.WebhookEvent
+func (_e *MockWebhookDeliveryEnqueuer_Expecter) EnqueueWebhookDeliveries(ctx interface{}, events interface{}) *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call {
+	return &MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call{Call: _e.mock.On("EnqueueWebhookDeliveries", ctx, events)}
+}
+
+func (_c *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call) Run(run func(ctx context.Context, events []domain.WebhookEvent)) *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.WebhookEvent
+		if args[1] != nil {
+			arg1 = args[1].([]domain.WebhookEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call) Return(n int, err error) *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, events []domain.WebhookEvent) (int, error)) *MockWebhookDeliveryEnqueuer_EnqueueWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoUndoApplier creates a new instance of MockTodoUndoApplier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoUndoApplier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoUndoApplier {
+	mock := &MockTodoUndoApplier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoUndoApplier is an autogenerated mock type for the TodoUndoApplier type
+type MockTodoUndoApplier struct {
+	mock.Mock
+}
+
+type MockTodoUndoApplier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoUndoApplier) EXPECT() *MockTodoUndoApplier_Expecter {
+	return &MockTodoUndoApplier_Expecter{mock: &_m.Mock}
+}
+
+// ApplyTodoUndo provides a mock function for the type MockTodoUndoApplier
+func (_mock *MockTodoUndoApplier) ApplyTodoUndo(ctx context.Context, input *domain.ApplyTodoUndoInput) ([]domain.Todo, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyTodoUndo")
+	}
+
+	var r0 []domain.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ApplyTodoUndoInput) ([]domain.Todo, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ApplyTodoUndoInput) []domain.Todo); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ApplyTodoUndoInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoUndoApplier_ApplyTodoUndo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyTodoUndo'
+type MockTodoUndoApplier_ApplyTodoUndo_Call struct {
+	*mock.Call
+}
+
+// ApplyTodoUndo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *domain.ApplyTodoUndoInput
+func (_e *MockTodoUndoApplier_Expecter) ApplyTodoUndo(ctx interface{}, input interface{}) *MockTodoUndoApplier_ApplyTodoUndo_Call {
+	return &MockTodoUndoApplier_ApplyTodoUndo_Call{Call: _e.mock.On("ApplyTodoUndo", ctx, input)}
+}
+
+func (_c *MockTodoUndoApplier_ApplyTodoUndo_Call) Run(run func(ctx context.Context, input *domain.ApplyTodoUndoInput)) *MockTodoUndoApplier_ApplyTodoUndo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ApplyTodoUndoInput
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ApplyTodoUndoInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoUndoApplier_ApplyTodoUndo_Call) Return(todos []domain.Todo, err error) *MockTodoUndoApplier_ApplyTodoUndo_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTodoUndoApplier_ApplyTodoUndo_Call) RunAndReturn(run func(ctx context.Context, input *domain.ApplyTodoUndoInput) ([]domain.Todo, error)) *MockTodoUndoApplier_ApplyTodoUndo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookURLChecker creates a new instance of MockWebhookURLChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookURLChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookURLChecker {
+	mock := &MockWebhookURLChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookURLChecker is an autogenerated mock type for the WebhookURLChecker type
+type MockWebhookURLChecker struct {
+	mock.Mock
+}
+
+type MockWebhookURLChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookURLChecker) EXPECT() *MockWebhookURLChecker_Expecter {
+	return &MockWebhookURLChecker_Expecter{mock: &_m.Mock}
+}
+
+// CheckWebhookURL provides a mock function for the type MockWebhookURLChecker
+func (_mock *MockWebhookURLChecker) CheckWebhookURL(ctx context.Context, rawURL string) error {
+	ret := _mock.Called(ctx, rawURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckWebhookURL")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, rawURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookURLChecker_CheckWebhookURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckWebhookURL'
+type MockWebhookURLChecker_CheckWebhookURL_Call struct {
+	*mock.Call
+}
+
+// CheckWebhookURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawURL string
+func (_e *MockWebhookURLChecker_Expecter) CheckWebhookURL(ctx interface{}, rawURL interface{}) *MockWebhookURLChecker_CheckWebhookURL_Call {
+	return &MockWebhookURLChecker_CheckWebhookURL_Call{Call: _e.mock.On("CheckWebhookURL", ctx, rawURL)}
+}
+
+func (_c *MockWebhookURLChecker_CheckWebhookURL_Call) Run(run func(ctx context.Context, rawURL string)) *MockWebhookURLChecker_CheckWebhookURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookURLChecker_CheckWebhookURL_Call) Return(err error) *MockWebhookURLChecker_CheckWebhookURL_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookURLChecker_CheckWebhookURL_Call) RunAndReturn(run func(ctx context.Context, rawURL string) error) *MockWebhookURLChecker_CheckWebhookURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTodoListRoleFinder creates a new instance of MockTodoListRoleFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoListRoleFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoListRoleFinder {
+	mock := &MockTodoListRoleFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoListRoleFinder is an autogenerated mock type for the TodoListRoleFinder type
+type MockTodoListRoleFinder struct {
+	mock.Mock
+}
+
+type MockTodoListRoleFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoListRoleFinder) EXPECT() *MockTodoListRoleFinder_Expecter {
+	return &MockTodoListRoleFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindTodoListRole provides a mock function for the type MockTodoListRoleFinder
+func (_mock *MockTodoListRoleFinder) FindTodoListRole(ctx context.Context, listOwnerID int, userID int) (domain.TodoListRole, error) {
+	ret := _mock.Called(ctx, listOwnerID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTodoListRole")
+	}
+
+	var r0 domain.TodoListRole
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) (domain.TodoListRole, error)); ok {
+		return returnFunc(ctx, listOwnerID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) domain.TodoListRole); ok {
+		r0 = returnFunc(ctx, listOwnerID, userID)
+	} else {
+		r0 = ret.Get(0).(domain.TodoListRole)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, listOwnerID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoListRoleFinder_FindTodoListRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTodoListRole'
+type MockTodoListRoleFinder_FindTodoListRole_Call struct {
+	*mock.Call
+}
+
+// FindTodoListRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listOwnerID int
+//   - userID int
+func (_e *MockTodoListRoleFinder_Expecter) FindTodoListRole(ctx interface{}, listOwnerID interface{}, userID interface{}) *MockTodoListRoleFinder_FindTodoListRole_Call {
+	return &MockTodoListRoleFinder_FindTodoListRole_Call{Call: _e.mock.On("FindTodoListRole", ctx, listOwnerID, userID)}
+}
+
+func (_c *MockTodoListRoleFinder_FindTodoListRole_Call) Run(run func(ctx context.Context, listOwnerID int, userID int)) *MockTodoListRoleFinder_FindTodoListRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoListRoleFinder_FindTodoListRole_Call) Return(todoListRole domain.TodoListRole, err error) *MockTodoListRoleFinder_FindTodoListRole_Call {
+	_c.Call.Return(todoListRole, err)
+	return _c
+}
+
+func (_c *MockTodoListRoleFinder_FindTodoListRole_Call) RunAndReturn(run func(ctx context.Context, listOwnerID int, userID int) (domain.TodoListRole, error)) *MockTodoListRoleFinder_FindTodoListRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockViewFinder creates a new instance of MockViewFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockViewFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockViewFinder {
+	mock := &MockViewFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockViewFinder is an autogenerated mock type for the ViewFinder type
+type MockViewFinder struct {
+	mock.Mock
+}
+
+type MockViewFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockViewFinder) EXPECT() *MockViewFinder_Expecter {
+	return &MockViewFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindView provides a mock function for the type MockViewFinder
+func (_mock *MockViewFinder) FindView(ctx context.Context, id int, userID int) (*domain.View, error) {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindView")
+	}
+
+	var r0 *domain.View
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) (*domain.View, error)); ok {
+		return returnFunc(ctx, id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) *domain.View); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.View)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViewFinder_FindView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindView'
+type MockViewFinder_FindView_Call struct {
+	*mock.Call
+}
+
+// FindView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - userID int
+func (_e *MockViewFinder_Expecter) FindView(ctx interface{}, id interface{}, userID interface{}) *MockViewFinder_FindView_Call {
+	return &MockViewFinder_FindView_Call{Call: _e.mock.On("FindView", ctx, id, userID)}
+}
+
+func (_c *MockViewFinder_FindView_Call) Run(run func(ctx context.Context, id int, userID int)) *MockViewFinder_FindView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViewFinder_FindView_Call) Return(view *domain.View, err error) *MockViewFinder_FindView_Call {
+	_c.Call.Return(view, err)
+	return _c
+}
+
+func (_c *MockViewFinder_FindView_Call) RunAndReturn(run func(ctx context.Context, id int, userID int) (*domain.View, error)) *MockViewFinder_FindView_Call {
+	_c.Call.Return(run)
+	return _c
+}
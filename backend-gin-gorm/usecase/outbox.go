@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// EventPublisher defines the interface for publishing outbox events to where they are consumed.
+// Implementations return an error unless every event was published. The events are then published again later,
+// so consumers see each event at least once and have to drop duplicates by its event ID.
+type EventPublisher interface {
+	PublishEvents(ctx context.Context, events []domain.OutboxEvent) error
+}
+
+// EventPublishers publishes outbox events to each of its publishers.
+type EventPublishers []EventPublisher
+
+// PublishEvents publishes the events to every publisher, even if one fails, and returns the errors of those that failed.
+func (p EventPublishers) PublishEvents(ctx context.Context, events []domain.OutboxEvent) error {
+	errs := make([]error, 0)
+	for _, publisher := range p {
+		if err := publisher.PublishEvents(ctx, events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OutboxRepository composes all outbox persistence interfaces.
+type OutboxRepository interface {
+	OutboxRelayRepository
+	OutboxEventPurger
+}
+
+// OutboxUsecase orchestrates the relay of outbox events to their publisher via command objects.
+type OutboxUsecase struct {
+	relayOutboxEventsCommand *RelayOutboxEventsCommand
+	purgeOutboxEventsCommand *PurgeOutboxEventsCommand
+	logger                   *slog.Logger
+}
+
+// NewOutboxUsecase returns a new OutboxUsecase wired with the given repository and publisher.
+// A claimed event is not claimed again for lease, which must outlast a publication.
+func NewOutboxUsecase(repo OutboxRepository, publisher EventPublisher, lease time.Duration) *OutboxUsecase {
+	return &OutboxUsecase{
+		relayOutboxEventsCommand: NewRelayOutboxEventsCommand(repo, publisher, lease),
+		purgeOutboxEventsCommand: NewPurgeOutboxEventsCommand(repo),
+		logger:                   slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-OutboxUsecase")),
+	}
+}
+
+// RelayOutboxEvents publishes the outbox events that have not been dispatched yet.
+func (u *OutboxUsecase) RelayOutboxEvents(ctx context.Context, input *domain.RelayOutboxEventsInput) (*domain.RelayOutboxEventsOutput, error) {
+	ctx, span := tracer.Start(ctx, "RelayOutboxEvents")
+	defer span.End()
+
+	output, err := u.relayOutboxEventsCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute relay outbox events command: %w", err)
+	}
+	return output, nil
+}
+
+// PurgeOutboxEvents permanently deletes outbox events that were dispatched before the given time.
+func (u *OutboxUsecase) PurgeOutboxEvents(ctx context.Context, input *domain.PurgeOutboxEventsInput) (*domain.PurgeOutboxEventsOutput, error) {
+	ctx, span := tracer.Start(ctx, "PurgeOutboxEvents")
+	defer span.End()
+
+	output, err := u.purgeOutboxEventsCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute purge outbox events command: %w", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// OutboxEventPurger defines the interface for deleting dispatched outbox events from the repository.
+type OutboxEventPurger interface {
+	PurgeOutboxEvents(ctx context.Context, input *domain.PurgeOutboxEventsInput) (int, error)
+}
+
+// PurgeOutboxEventsCommand deletes outbox events that were dispatched long enough ago.
+type PurgeOutboxEventsCommand struct {
+	repo OutboxEventPurger
+}
+
+// NewPurgeOutboxEventsCommand returns a new PurgeOutboxEventsCommand.
+func NewPurgeOutboxEventsCommand(repo OutboxEventPurger) *PurgeOutboxEventsCommand {
+	return &PurgeOutboxEventsCommand{
+		repo: repo,
+	}
+}
+
+// Execute purges batches of events until none that were dispatched before input.DispatchedBefore remain or the context is canceled.
+func (u *PurgeOutboxEventsCommand) Execute(ctx context.Context, input *domain.PurgeOutboxEventsInput) (*domain.PurgeOutboxEventsOutput, error) {
+	purgedCount := 0
+	for ctx.Err() == nil {
+		count, err := u.repo.PurgeOutboxEvents(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("purge outbox events: %w", err)
+		}
+		purgedCount += count
+
+		if count < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewPurgeOutboxEventsOutput(purgedCount)
+	if err != nil {
+		return nil, fmt.Errorf("create purge outbox events output: %w", err)
+	}
+
+	return output, nil
+}
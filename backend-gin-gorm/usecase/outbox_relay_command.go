@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// OutboxEventClaimer defines the interface for claiming undispatched outbox events for publication.
+type OutboxEventClaimer interface {
+	ClaimOutboxEvents(ctx context.Context, input *domain.ClaimOutboxEventsInput) ([]domain.OutboxEvent, error)
+}
+
+// OutboxEventDispatchMarker defines the interface for recording that outbox events were published.
+type OutboxEventDispatchMarker interface {
+	MarkOutboxEventsDispatched(ctx context.Context, ids []int64, dispatchedAt time.Time) error
+}
+
+// OutboxPublishFailureRecorder defines the interface for recording why outbox events could not be published.
+type OutboxPublishFailureRecorder interface {
+	RecordOutboxPublishFailure(ctx context.Context, ids []int64, publishErr error) error
+}
+
+// OutboxRelayRepository composes the outbox interfaces used by the relay.
+type OutboxRelayRepository interface {
+	OutboxEventClaimer
+	OutboxEventDispatchMarker
+	OutboxPublishFailureRecorder
+}
+
+// RelayOutboxEventsCommand publishes the outbox events that have not been dispatched yet.
+type RelayOutboxEventsCommand struct {
+	repo      OutboxRelayRepository
+	publisher EventPublisher
+	lease     time.Duration
+}
+
+// NewRelayOutboxEventsCommand returns a new RelayOutboxEventsCommand.
+// A claimed event is not claimed again for lease, which must outlast a publication.
+func NewRelayOutboxEventsCommand(repo OutboxRelayRepository, publisher EventPublisher, lease time.Duration) *RelayOutboxEventsCommand {
+	return &RelayOutboxEventsCommand{
+		repo:      repo,
+		publisher: publisher,
+		lease:     lease,
+	}
+}
+
+// Execute claims batches of undispatched events in the order they were written, publishes each batch and marks it
+// dispatched, until none are left or the context is canceled. A batch that fails to publish stops the run and is
+// published again when its lease ends. Publication is at least once: a batch that was published but could not be
+// marked dispatched is published again as well.
+func (u *RelayOutboxEventsCommand) Execute(ctx context.Context, input *domain.RelayOutboxEventsInput) (*domain.RelayOutboxEventsOutput, error) {
+	dispatchedCount := 0
+	for ctx.Err() == nil {
+		now := time.Now()
+		claimInput, err := domain.NewClaimOutboxEventsInput(now, now.Add(u.lease), input.BatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("new claim outbox events input: %w", err)
+		}
+		events, err := u.repo.ClaimOutboxEvents(ctx, claimInput)
+		if err != nil {
+			return nil, fmt.Errorf("claim outbox events: %w", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		ids := make([]int64, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+		if err := u.publisher.PublishEvents(ctx, events); err != nil {
+			if ctx.Err() != nil {
+				// The relay is stopping; the events are published again when their lease ends
+				return nil, fmt.Errorf("publish outbox events: %w", ctx.Err())
+			}
+			if recordErr := u.repo.RecordOutboxPublishFailure(ctx, ids, err); recordErr != nil {
+				err = errors.Join(err, fmt.Errorf("record outbox publish failure: %w", recordErr))
+			}
+			return nil, fmt.Errorf("publish outbox events: %w", err)
+		}
+		// Mark the events even if the relay is stopping meanwhile, so that they are not published again
+		if err := u.repo.MarkOutboxEventsDispatched(context.WithoutCancel(ctx), ids, time.Now()); err != nil {
+			return nil, fmt.Errorf("mark outbox events dispatched: %w", err)
+		}
+		dispatchedCount += len(events)
+
+		if len(events) < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewRelayOutboxEventsOutput(dispatchedCount)
+	if err != nil {
+		return nil, fmt.Errorf("create relay outbox events output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func newTestOutboxEvents(t *testing.T, ids ...int64) []domain.OutboxEvent {
+	t.Helper()
+	events := make([]domain.OutboxEvent, len(ids))
+	for i, id := range ids {
+		event := domain.NewTodoDeletedEvent(1, int(id))
+		outboxEvent, err := domain.NewOutboxEvent(&event)
+		require.NoError(t, err)
+		outboxEvent.ID = id
+		events[i] = *outboxEvent
+	}
+	return events
+}
+
+func Test_RelayOutboxEventsCommand_Execute_shouldPublishAndMarkEvents_untilNoneAreLeft(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	firstBatch := newTestOutboxEvents(t, 1, 2)
+	secondBatch := newTestOutboxEvents(t, 3)
+	mockRepo := NewMockOutboxRelayRepository(t)
+	mockRepo.EXPECT().ClaimOutboxEvents(mock.Anything, mock.Anything).Return(firstBatch, nil).Once()
+	mockRepo.EXPECT().ClaimOutboxEvents(mock.Anything, mock.Anything).Return(secondBatch, nil).Once()
+	mockRepo.EXPECT().MarkOutboxEventsDispatched(mock.Anything, []int64{1, 2}, mock.Anything).Return(nil).Once()
+	mockRepo.EXPECT().MarkOutboxEventsDispatched(mock.Anything, []int64{3}, mock.Anything).Return(nil).Once()
+	mockPublisher := NewMockEventPublisher(t)
+	mockPublisher.EXPECT().PublishEvents(mock.Anything, firstBatch).Return(nil).Once()
+	mockPublisher.EXPECT().PublishEvents(mock.Anything, secondBatch).Return(nil).Once()
+	cmd := usecase.NewRelayOutboxEventsCommand(mockRepo, mockPublisher, time.Minute)
+	input, err := domain.NewRelayOutboxEventsInput(2)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 3, output.DispatchedCount)
+}
+
+func Test_RelayOutboxEventsCommand_Execute_shouldLeaseEvents_whenClaiming(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	mockRepo := NewMockOutboxRelayRepository(t)
+	mockRepo.EXPECT().ClaimOutboxEvents(mock.Anything, mock.MatchedBy(func(input *domain.ClaimOutboxEventsInput) bool {
+		return input.LeaseUntil.Sub(input.Now) == time.Minute && input.BatchSize == 10
+	})).Return([]domain.OutboxEvent{}, nil).Once()
+	mockPublisher := NewMockEventPublisher(t)
+	cmd := usecase.NewRelayOutboxEventsCommand(mockRepo, mockPublisher, time.Minute)
+	input, err := domain.NewRelayOutboxEventsInput(10)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.DispatchedCount)
+}
+
+func Test_RelayOutboxEventsCommand_Execute_shouldRecordFailure_whenPublishFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	events := newTestOutboxEvents(t, 1, 2)
+	publishErr := errors.New("connection refused")
+	mockRepo := NewMockOutboxRelayRepository(t)
+	mockRepo.EXPECT().ClaimOutboxEvents(mock.Anything, mock.Anything).Return(events, nil).Once()
+	mockRepo.EXPECT().RecordOutboxPublishFailure(mock.Anything, []int64{1, 2}, publishErr).Return(nil).Once()
+	mockPublisher := NewMockEventPublisher(t)
+	mockPublisher.EXPECT().PublishEvents(mock.Anything, events).Return(publishErr).Once()
+	cmd := usecase.NewRelayOutboxEventsCommand(mockRepo, mockPublisher, time.Minute)
+	input, err := domain.NewRelayOutboxEventsInput(2)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, publishErr)
+	assert.Nil(t, output)
+	mockRepo.AssertNotCalled(t, "MarkOutboxEventsDispatched", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// SyncUsecase orchestrates the sync of todos with offline-first clients via command/query objects.
+type SyncUsecase struct {
+	syncTodosQuery         *SyncTodosQuery
+	pushTodoChangesCommand *PushTodoChangesCommand
+	publisher              TodoEventPublisher
+	logger                 *slog.Logger
+}
+
+// NewSyncUsecase returns a new SyncUsecase wired with the given repositories.
+// Sync tokens older than tokenTTL are rejected; see NewSyncTodosQuery. Pushed changes are reported through the publisher.
+func NewSyncUsecase(repo TodoChangesFinder, todoRepo TodoPushRepository, publisher TodoEventPublisher, tokenTTL time.Duration) *SyncUsecase {
+	return &SyncUsecase{
+		syncTodosQuery:         NewSyncTodosQuery(repo, tokenTTL),
+		pushTodoChangesCommand: NewPushTodoChangesCommand(todoRepo),
+		publisher:              publisher,
+		logger:                 slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-SyncUsecase")),
+	}
+}
+
+// SyncTodos returns the changes to the todos of the user since a sync token.
+func (u *SyncUsecase) SyncTodos(ctx context.Context, input *domain.SyncTodosInput) (*domain.SyncTodosOutput, error) {
+	ctx, span := tracer.Start(ctx, "SyncTodos")
+	defer span.End()
+	u.logger.InfoContext(ctx, "SyncTodos called")
+
+	output, err := u.syncTodosQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute sync todos query: %w", err)
+	}
+	return output, nil
+}
+
+// PushTodoChanges applies the changes a client made to the todos of the user while offline.
+func (u *SyncUsecase) PushTodoChanges(ctx context.Context, input *domain.PushTodoChangesInput) (*domain.PushTodoChangesOutput, error) {
+	ctx, span := tracer.Start(ctx, "PushTodoChanges")
+	defer span.End()
+	u.logger.InfoContext(ctx, "PushTodoChanges called")
+
+	output, err := u.pushTodoChangesCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute push todo changes command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, newPushTodoEvents(output)...)
+	return output, nil
+}
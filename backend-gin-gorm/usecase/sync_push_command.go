@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// maxPushMergeAttempts is how many times an update is merged again when the todo changes between reading and patching it.
+const maxPushMergeAttempts = 3
+
+// TodoPushRepository defines the repository operations a push applies client changes with.
+type TodoPushRepository interface {
+	TodoCreator
+	TodoByIDFinder
+	TodoPatcher
+	TodoDeleter
+}
+
+// PushTodoChangesCommand applies the changes a client made offline, merging them with the changes made on the server since.
+type PushTodoChangesCommand struct {
+	repo TodoPushRepository
+}
+
+// NewPushTodoChangesCommand returns a new PushTodoChangesCommand.
+func NewPushTodoChangesCommand(repo TodoPushRepository) *PushTodoChangesCommand {
+	return &PushTodoChangesCommand{
+		repo: repo,
+	}
+}
+
+// Execute applies the changes of input in order, each on its own, and reports a result for each.
+// An update made to the current version of the todo is applied as made. Otherwise it is merged field by field
+// with the server state: fields only the client changed take the client values and fields both sides changed
+// to different values keep the server values and are reported as conflicts.
+// A delete only applies to the version it was made to; a todo changed since is kept and reported as a conflict.
+// A change that fails for another reason is reported as PushChangeFailed and does not stop the changes after it.
+func (u *PushTodoChangesCommand) Execute(ctx context.Context, input *domain.PushTodoChangesInput) (*domain.PushTodoChangesOutput, error) {
+	results := make([]domain.PushChangeResult, 0, len(input.Changes))
+	for i := range input.Changes {
+		change := &input.Changes[i]
+		result := domain.PushChangeResult{Type: change.Type, ID: change.ID, TempID: change.TempID, Status: domain.PushChangeApplied, Todo: nil, OwnerID: 0, Local: nil, Conflicts: nil, Err: nil}
+		switch change.Type {
+		case domain.PushChangeCreate:
+			u.pushCreate(ctx, input.UserID, change, &result)
+		case domain.PushChangeUpdate:
+			u.pushUpdate(ctx, input.UserID, change, &result)
+		case domain.PushChangeDelete:
+			u.pushDelete(ctx, input.UserID, change, &result)
+		}
+		results = append(results, result)
+	}
+
+	output, err := domain.NewPushTodoChangesOutput(results)
+	if err != nil {
+		return nil, fmt.Errorf("create push todo changes output: %w", err)
+	}
+	return output, nil
+}
+
+func (u *PushTodoChangesCommand) pushCreate(ctx context.Context, userID int, change *domain.PushChange, result *domain.PushChangeResult) {
+	createInput, err := domain.NewCreateTodoInput(userID, change.Local.Text)
+	if err != nil {
+		failPushChange(result, err)
+		return
+	}
+	todo, err := u.repo.CreateTodo(ctx, createInput)
+	if err != nil {
+		failPushChange(result, fmt.Errorf("create todo: %w", err))
+		return
+	}
+	result.ID = todo.ID
+	result.Todo = todo
+
+	if !change.Local.IsComplete {
+		return
+	}
+	isComplete := true
+	patchInput, err := domain.NewPatchTodoInput(todo.ID, userID, nil, &isComplete, &todo.Version)
+	if err != nil {
+		failPushChange(result, err)
+		return
+	}
+	todo, err = u.repo.PatchTodo(ctx, patchInput)
+	if err != nil {
+		failPushChange(result, fmt.Errorf("complete created todo: %w", err))
+		return
+	}
+	result.Todo = todo
+}
+
+func (u *PushTodoChangesCommand) pushUpdate(ctx context.Context, userID int, change *domain.PushChange, result *domain.PushChangeResult) {
+	findInput, err := domain.NewFindTodoInput(change.ID, userID)
+	if err != nil {
+		failPushChange(result, err)
+		return
+	}
+
+	for range maxPushMergeAttempts {
+		current, err := u.repo.FindTodo(ctx, findInput)
+		if errors.Is(err, domain.ErrTodoNotFound) {
+			result.Status = domain.PushChangeNotFound
+			return
+		}
+		if err != nil {
+			failPushChange(result, fmt.Errorf("find todo: %w", err))
+			return
+		}
+
+		server := domain.TodoFieldValuesOf(current)
+		merged, conflicts := *change.Local, []domain.TodoField{}
+		result.Status = domain.PushChangeApplied
+		if current.Version != change.BaseVersion {
+			merged, conflicts = domain.MergeTodoFields(*change.Base, *change.Local, server)
+			result.Status = domain.PushChangeMerged
+		}
+		if len(conflicts) > 0 {
+			result.Status = domain.PushChangeConflict
+			result.Local = change.Local
+		}
+		result.Conflicts = conflicts
+
+		if merged == server {
+			result.Todo = current
+			return
+		}
+
+		var text *string
+		var isComplete *bool
+		if merged.Text != server.Text {
+			text = &merged.Text
+		}
+		if merged.IsComplete != server.IsComplete {
+			isComplete = &merged.IsComplete
+		}
+		patchInput, err := domain.NewPatchTodoInput(change.ID, userID, text, isComplete, &current.Version)
+		if err != nil {
+			failPushChange(result, err)
+			return
+		}
+
+		todo, err := u.repo.PatchTodo(ctx, patchInput)
+		var mismatchErr *domain.TodoVersionMismatchError
+		switch {
+		case errors.As(err, &mismatchErr):
+			// The todo changed after it was read; merge again with the new server state
+			continue
+		case errors.Is(err, domain.ErrTodoNotFound):
+			result.Status = domain.PushChangeNotFound
+			return
+		case err != nil:
+			failPushChange(result, fmt.Errorf("patch todo: %w", err))
+			return
+		}
+		result.Todo = todo
+		return
+	}
+
+	failPushChange(result, errors.New("todo kept changing while the update was merged"))
+}
+
+func (u *PushTodoChangesCommand) pushDelete(ctx context.Context, userID int, change *domain.PushChange, result *domain.PushChangeResult) {
+	deleteInput, err := domain.NewDeleteTodoInput(change.ID, userID, &change.BaseVersion)
+	if err != nil {
+		failPushChange(result, err)
+		return
+	}
+
+	ownerID, err := u.repo.DeleteTodo(ctx, deleteInput)
+	var mismatchErr *domain.TodoVersionMismatchError
+	switch {
+	case errors.As(err, &mismatchErr):
+		result.Status = domain.PushChangeConflict
+		result.Todo = mismatchErr.Current
+		result.Conflicts = []domain.TodoField{}
+	case errors.Is(err, domain.ErrTodoNotFound):
+		result.Status = domain.PushChangeNotFound
+	case err != nil:
+		failPushChange(result, fmt.Errorf("delete todo: %w", err))
+	default:
+		result.OwnerID = ownerID
+	}
+}
+
+// failPushChange reports the change as failed for the reason err.
+// A todo the change already created is still reported so that the client can pick it up.
+func failPushChange(result *domain.PushChangeResult, err error) {
+	result.Status = domain.PushChangeFailed
+	result.Local = nil
+	result.Conflicts = nil
+	result.Err = err
+}
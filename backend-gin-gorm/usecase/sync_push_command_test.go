@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_PushTodoChangesCommand_Execute_shouldMergeFields_whenTodoChangedOnServer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewPushTodoChangesCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "buy milk")
+	require.NoError(t, err)
+	merged, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	conflicting, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	deleted, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	// サーバー側でテキストを変更する
+	serverText := "buy oat milk"
+	for _, todo := range []*domain.Todo{merged, conflicting, deleted} {
+		patchInput, err := domain.NewPatchTodoInput(todo.ID, userID, &serverText, nil, nil)
+		require.NoError(t, err)
+		_, err = repo.PatchTodo(ctx, patchInput)
+		require.NoError(t, err)
+	}
+
+	base := &domain.TodoFieldValues{Text: "buy milk", IsComplete: false}
+	input, err := domain.NewPushTodoChangesInput(userID, []domain.PushChange{
+		{Type: domain.PushChangeCreate, TempID: "a", Local: &domain.TodoFieldValues{Text: "buy bread", IsComplete: true}},
+		{Type: domain.PushChangeUpdate, ID: merged.ID, BaseVersion: 1, Base: base, Local: &domain.TodoFieldValues{Text: "buy milk", IsComplete: true}},
+		{Type: domain.PushChangeUpdate, ID: conflicting.ID, BaseVersion: 1, Base: base, Local: &domain.TodoFieldValues{Text: "buy soy milk", IsComplete: true}},
+		{Type: domain.PushChangeDelete, ID: deleted.ID, BaseVersion: 1},
+	})
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Results, 4)
+	for _, result := range output.Results {
+		require.NoError(t, result.Err)
+	}
+
+	// - create
+	assert.Equal(t, domain.PushChangeApplied, output.Results[0].Status)
+	assert.Equal(t, "a", output.Results[0].TempID)
+	assert.Equal(t, "buy bread", output.Results[0].Todo.Text)
+	assert.True(t, output.Results[0].Todo.IsComplete)
+
+	// - update merged without conflict
+	assert.Equal(t, domain.PushChangeMerged, output.Results[1].Status)
+	assert.Equal(t, "buy oat milk", output.Results[1].Todo.Text)
+	assert.True(t, output.Results[1].Todo.IsComplete)
+	assert.Empty(t, output.Results[1].Conflicts)
+
+	// - update with a conflict keeps the server text
+	assert.Equal(t, domain.PushChangeConflict, output.Results[2].Status)
+	assert.Equal(t, "buy oat milk", output.Results[2].Todo.Text)
+	assert.True(t, output.Results[2].Todo.IsComplete)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldText}, output.Results[2].Conflicts)
+	assert.Equal(t, "buy soy milk", output.Results[2].Local.Text)
+
+	// - delete of a changed todo is not applied
+	assert.Equal(t, domain.PushChangeConflict, output.Results[3].Status)
+	require.NotNil(t, output.Results[3].Todo)
+	assert.Equal(t, 2, output.Results[3].Todo.Version)
+
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	assert.Len(t, todos, 4)
+}
+
+func Test_PushTodoChangesCommand_Execute_shouldApplyAsMade_whenTodoUnchangedSinceBaseVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewPushTodoChangesCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "buy milk")
+	require.NoError(t, err)
+	updated, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	deleted, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewPushTodoChangesInput(userID, []domain.PushChange{
+		{Type: domain.PushChangeUpdate, ID: updated.ID, BaseVersion: 1, Base: &domain.TodoFieldValues{Text: "buy milk", IsComplete: false}, Local: &domain.TodoFieldValues{Text: "buy bread", IsComplete: false}},
+		{Type: domain.PushChangeDelete, ID: deleted.ID, BaseVersion: 1},
+		{Type: domain.PushChangeDelete, ID: deleted.ID, BaseVersion: 1},
+	})
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Results, 3)
+	assert.Equal(t, domain.PushChangeApplied, output.Results[0].Status)
+	assert.Equal(t, "buy bread", output.Results[0].Todo.Text)
+	assert.Equal(t, 2, output.Results[0].Todo.Version)
+	assert.Equal(t, domain.PushChangeApplied, output.Results[1].Status)
+	assert.Nil(t, output.Results[1].Todo)
+	assert.Equal(t, domain.PushChangeNotFound, output.Results[2].Status, "a todo already in the trash should not be found")
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoChangesFinder defines the interface for listing the todos of a user changed since a sync token.
+type TodoChangesFinder interface {
+	FindTodoChanges(ctx context.Context, input *domain.SyncTodosInput) (*domain.TodoChangePage, error)
+}
+
+// SyncTodosQuery retrieves the changes to the todos of a user since a sync token.
+type SyncTodosQuery struct {
+	repo     TodoChangesFinder
+	tokenTTL time.Duration
+}
+
+// NewSyncTodosQuery returns a new SyncTodosQuery.
+// tokenTTL must not exceed the trash retention: a todo deleted after a token was issued is purged, and leaves
+// no tombstone, once the retention has passed.
+func NewSyncTodosQuery(repo TodoChangesFinder, tokenTTL time.Duration) *SyncTodosQuery {
+	return &SyncTodosQuery{
+		repo:     repo,
+		tokenTTL: tokenTTL,
+	}
+}
+
+// Execute returns the todos changed since input.Since, sorted into created, updated and deleted ones, with the token for the next sync.
+// Returns ErrSyncTokenExpired if input.Since is older than the token TTL.
+func (q *SyncTodosQuery) Execute(ctx context.Context, input *domain.SyncTodosInput) (*domain.SyncTodosOutput, error) {
+	now := time.Now()
+	if input.Since != nil && now.Sub(input.Since.IssuedAt) > q.tokenTTL {
+		return nil, domain.ErrSyncTokenExpired
+	}
+
+	page, err := q.repo.FindTodoChanges(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find todo changes: %w", err)
+	}
+
+	output := &domain.SyncTodosOutput{
+		Created: make([]domain.Todo, 0),
+		Updated: make([]domain.Todo, 0),
+		Deleted: make([]domain.TodoTombstone, 0),
+		Token:   nil,
+		HasMore: page.HasMore,
+	}
+	var seq int64
+	var afterID int
+	if input.Since != nil {
+		seq, afterID = input.Since.Seq, input.Since.AfterID
+	}
+	for _, change := range page.Changes {
+		switch {
+		case change.Todo.DeletedAt != nil:
+			output.Deleted = append(output.Deleted, domain.TodoTombstone{ID: change.Todo.ID, DeletedAt: *change.Todo.DeletedAt})
+		case input.Since == nil || input.Since.Precedes(change.CreatedSeq, change.Todo.ID):
+			output.Created = append(output.Created, change.Todo)
+		default:
+			output.Updated = append(output.Updated, change.Todo)
+		}
+		seq, afterID = change.Seq, change.Todo.ID
+	}
+
+	token, err := domain.NewSyncToken(seq, afterID, now)
+	if err != nil {
+		return nil, fmt.Errorf("new sync token: %w", err)
+	}
+	output.Token = token
+
+	return output, nil
+}
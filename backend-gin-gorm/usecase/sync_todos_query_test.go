@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_SyncTodosQuery_Execute_shouldSortChangesIntoCreatedUpdatedAndDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewSyncTodosQuery(gateway.NewTodoSyncRepository(dbc.DB), time.Hour)
+	createTodo := func(text string) *domain.Todo {
+		input, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		todo, err := todoRepo.CreateTodo(ctx, input)
+		require.NoError(t, err)
+		return todo
+	}
+	updated := createTodo("updated")
+	deleted := createTodo("deleted")
+
+	initialInput, err := domain.NewSyncTodosInput(userID, nil, domain.DefaultSyncLimit)
+	require.NoError(t, err)
+	initial, err := query.Execute(ctx, initialInput)
+	require.NoError(t, err)
+	require.Len(t, initial.Created, 2, "the first sync should return every todo as created")
+
+	created := createTodo("created")
+	isComplete := true
+	patchInput, err := domain.NewPatchTodoInput(updated.ID, userID, nil, &isComplete, nil)
+	require.NoError(t, err)
+	_, err = todoRepo.PatchTodo(ctx, patchInput)
+	require.NoError(t, err)
+	deleteInput, err := domain.NewDeleteTodoInput(deleted.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = todoRepo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewSyncTodosInput(userID, initial.Token, domain.DefaultSyncLimit)
+	require.NoError(t, err)
+
+	// when
+	output, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Created, 1)
+	assert.Equal(t, created.ID, output.Created[0].ID)
+	require.Len(t, output.Updated, 1)
+	assert.Equal(t, updated.ID, output.Updated[0].ID)
+	assert.True(t, output.Updated[0].IsComplete)
+	require.Len(t, output.Deleted, 1)
+	assert.Equal(t, deleted.ID, output.Deleted[0].ID)
+	assert.False(t, output.HasMore)
+
+	// 変更がなければ次の同期は空になる
+	again, err := domain.NewSyncTodosInput(userID, output.Token, domain.DefaultSyncLimit)
+	require.NoError(t, err)
+	empty, err := query.Execute(ctx, again)
+	require.NoError(t, err)
+	assert.Empty(t, empty.Created)
+	assert.Empty(t, empty.Updated)
+	assert.Empty(t, empty.Deleted)
+	assert.Equal(t, output.Token.Seq, empty.Token.Seq)
+}
+
+func Test_SyncTodosQuery_Execute_shouldReturnErrSyncTokenExpired_whenTokenIsOlderThanTTL(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	query := usecase.NewSyncTodosQuery(gateway.NewTodoSyncRepository(dbc.DB), time.Hour)
+	since, err := domain.NewSyncToken(1, 1, time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+	input, err := domain.NewSyncTodosInput(userID, since, domain.DefaultSyncLimit)
+	require.NoError(t, err)
+
+	// when
+	output, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrSyncTokenExpired)
+	assert.Nil(t, output)
+}
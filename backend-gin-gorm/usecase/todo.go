@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 )
@@ -12,48 +13,135 @@ import (
 type TodoRepository interface {
 	TodoCreator
 	TodoFinder
+	TodoByIDFinder
 	TodoUpdater
+	TodoPatcher
 	TodoDeleter
+	TrashedTodosFinder
+	TodoRestorer
+	TodoHistoryFinder
+	TodoReverter
+	TodoPurger
+	ArchivedTodosFinder
+	TodoArchiver
+	CompletedTodosArchiver
+	TodoAutoArchiver
+}
+
+// TodoUndoRepository composes all undo token persistence interfaces.
+type TodoUndoRepository interface {
+	TodoUndoRecorder
+	TodoUndoApplier
+	TodoUndoPurger
 }
 
 // TodoUsecase orchestrates todo CRUD operations via command/query objects.
 type TodoUsecase struct {
-	findTodosQuery         *FindTodosQuery
-	createTodoCommand      *CreateTodoCommand
-	createBulkTodosCommand *CreateBulkTodosCommand
-	updateTodoCommand      *UpdateTodoCommand
-	deleteTodoCommand      *DeleteTodoCommand
-	logger                 *slog.Logger
+	findTodosQuery               *FindTodosQuery
+	findTodoQuery                *FindTodoQuery
+	createTodoCommand            *CreateTodoCommand
+	createBulkTodosCommand       *CreateBulkTodosCommand
+	patchBulkTodosCommand        *PatchBulkTodosCommand
+	deleteBulkTodosCommand       *DeleteBulkTodosCommand
+	batchTodosCommand            *BatchTodosCommand
+	updateTodoCommand            *UpdateTodoCommand
+	patchTodoCommand             *PatchTodoCommand
+	deleteTodoCommand            *DeleteTodoCommand
+	findTrashedTodosQuery        *FindTrashedTodosQuery
+	restoreTodoCommand           *RestoreTodoCommand
+	findTodoHistoryQuery         *FindTodoHistoryQuery
+	revertTodoCommand            *RevertTodoCommand
+	purgeTodosCommand            *PurgeTodosCommand
+	findArchivedTodosQuery       *FindArchivedTodosQuery
+	archiveTodoCommand           *ArchiveTodoCommand
+	archiveCompletedTodosCommand *ArchiveCompletedTodosCommand
+	autoArchiveTodosCommand      *AutoArchiveTodosCommand
+	searchTodosQuery             *SearchTodosQuery
+	createTodoUndoCommand        *CreateTodoUndoCommand
+	undoTodosCommand             *UndoTodosCommand
+	purgeTodoUndosCommand        *PurgeTodoUndosCommand
+	publisher                    TodoEventPublisher
+	logger                       *slog.Logger
 }
 
-// NewTodoUsecase returns a new TodoUsecase wired with the given repository and transaction manager.
-func NewTodoUsecase(repo TodoRepository, createBulkCommandTxManager TodoCreateBulkCommandTxManager) *TodoUsecase {
+// NewTodoUsecase returns a new TodoUsecase wired with the given repository and transaction managers.
+// The blob store is used to remove attachment content when trashed todos are purged.
+// The searcher is separate from the repository so that the search implementation can be chosen per database.
+// Successful changes are reported to the clients of the user through the publisher.
+// Undo tokens are kept in undoRepo and can be used for undoTTL after they are issued.
+func NewTodoUsecase(repo TodoRepository, createBulkCommandTxManager TodoCreateBulkCommandTxManager, bulkCommandTxManager TodoBulkCommandTxManager, batchCommandTxManager TodoBatchCommandTxManager, blobStore BlobDeleter, searcher TodoSearcher, publisher TodoEventPublisher, undoRepo TodoUndoRepository, undoTTL time.Duration) *TodoUsecase {
 	findTodosQuery := NewFindTodosQuery(repo)
+	findTodoQuery := NewFindTodoQuery(repo)
 	createTodoCommand := NewCreateTodoCommand(repo)
 	createBulkTodosCommand := NewCreateBulkTodosCommand(createBulkCommandTxManager)
+	patchBulkTodosCommand := NewPatchBulkTodosCommand(repo, bulkCommandTxManager)
+	deleteBulkTodosCommand := NewDeleteBulkTodosCommand(repo, bulkCommandTxManager)
+	batchTodosCommand := NewBatchTodosCommand(repo, batchCommandTxManager)
 	updateTodoCommand := NewUpdateTodoCommand(repo)
+	patchTodoCommand := NewPatchTodoCommand(repo)
 	deleteTodoCommand := NewDeleteTodoCommand(repo)
+	findTrashedTodosQuery := NewFindTrashedTodosQuery(repo)
+	restoreTodoCommand := NewRestoreTodoCommand(repo)
+	findTodoHistoryQuery := NewFindTodoHistoryQuery(repo)
+	revertTodoCommand := NewRevertTodoCommand(repo)
+	purgeTodosCommand := NewPurgeTodosCommand(repo, blobStore)
+	findArchivedTodosQuery := NewFindArchivedTodosQuery(repo)
+	archiveTodoCommand := NewArchiveTodoCommand(repo)
+	archiveCompletedTodosCommand := NewArchiveCompletedTodosCommand(repo)
+	autoArchiveTodosCommand := NewAutoArchiveTodosCommand(repo)
+	searchTodosQuery := NewSearchTodosQuery(searcher)
+	createTodoUndoCommand := NewCreateTodoUndoCommand(undoRepo, undoTTL)
+	undoTodosCommand := NewUndoTodosCommand(undoRepo)
+	purgeTodoUndosCommand := NewPurgeTodoUndosCommand(undoRepo)
 	return &TodoUsecase{
-		findTodosQuery:         findTodosQuery,
-		createTodoCommand:      createTodoCommand,
-		createBulkTodosCommand: createBulkTodosCommand,
-		updateTodoCommand:      updateTodoCommand,
-		deleteTodoCommand:      deleteTodoCommand,
-		logger:                 slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-TodoUsecase")),
+		findTodosQuery:               findTodosQuery,
+		findTodoQuery:                findTodoQuery,
+		createTodoCommand:            createTodoCommand,
+		createBulkTodosCommand:       createBulkTodosCommand,
+		patchBulkTodosCommand:        patchBulkTodosCommand,
+		deleteBulkTodosCommand:       deleteBulkTodosCommand,
+		batchTodosCommand:            batchTodosCommand,
+		updateTodoCommand:            updateTodoCommand,
+		patchTodoCommand:             patchTodoCommand,
+		deleteTodoCommand:            deleteTodoCommand,
+		findTrashedTodosQuery:        findTrashedTodosQuery,
+		restoreTodoCommand:           restoreTodoCommand,
+		findTodoHistoryQuery:         findTodoHistoryQuery,
+		revertTodoCommand:            revertTodoCommand,
+		purgeTodosCommand:            purgeTodosCommand,
+		findArchivedTodosQuery:       findArchivedTodosQuery,
+		archiveTodoCommand:           archiveTodoCommand,
+		archiveCompletedTodosCommand: archiveCompletedTodosCommand,
+		autoArchiveTodosCommand:      autoArchiveTodosCommand,
+		searchTodosQuery:             searchTodosQuery,
+		createTodoUndoCommand:        createTodoUndoCommand,
+		undoTodosCommand:             undoTodosCommand,
+		purgeTodoUndosCommand:        purgeTodoUndosCommand,
+		publisher:                    publisher,
+		logger:                       slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-TodoUsecase")),
 	}
 }
 
-// FindTodos returns all todos belonging to the given user.
-func (u *TodoUsecase) FindTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+// FindTodos returns one page of the todos belonging to the given user.
+func (u *TodoUsecase) FindTodos(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error) {
 	ctx, span := tracer.Start(ctx, "FindTodos")
 	defer span.End()
 	u.logger.InfoContext(ctx, "FindTodos called")
 
-	todos, err := u.findTodosQuery.Execute(ctx, userID)
+	page, err := u.findTodosQuery.Execute(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("execute find todos query: %w", err)
 	}
-	return todos, nil
+	return page, nil
+}
+
+// FindTodo returns a single todo belonging to the given user.
+func (u *TodoUsecase) FindTodo(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error) {
+	todo, err := u.findTodoQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find todo query: %w", err)
+	}
+	return todo, nil
 }
 
 // CreateTodo creates a single todo item.
@@ -62,6 +150,7 @@ func (u *TodoUsecase) CreateTodo(ctx context.Context, input *domain.CreateTodoIn
 	if err != nil {
 		return nil, fmt.Errorf("execute create todo command: %w", err)
 	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventCreated, output.Todo))
 	return output, nil
 }
 
@@ -71,6 +160,41 @@ func (u *TodoUsecase) CreateBulkTodos(ctx context.Context, input *domain.CreateB
 	if err != nil {
 		return nil, fmt.Errorf("execute create bulk todos command: %w", err)
 	}
+	events := make([]domain.TodoEvent, 0, len(output.Todos))
+	for i := range output.Todos {
+		events = append(events, domain.NewTodoChangedEvent(domain.TodoEventCreated, &output.Todos[i]))
+	}
+	u.publisher.PublishTodoEvents(ctx, events...)
+	return output, nil
+}
+
+// PatchBulkTodos applies the same partial update to several todo items.
+func (u *TodoUsecase) PatchBulkTodos(ctx context.Context, input *domain.PatchBulkTodosInput) (*domain.BulkTodosOutput, error) {
+	output, err := u.patchBulkTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute patch bulk todos command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, newBulkTodoEvents(domain.TodoEventUpdated, output)...)
+	return output, nil
+}
+
+// DeleteBulkTodos moves several todo items to the trash.
+func (u *TodoUsecase) DeleteBulkTodos(ctx context.Context, input *domain.DeleteBulkTodosInput) (*domain.BulkTodosOutput, error) {
+	output, err := u.deleteBulkTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute delete bulk todos command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, newBulkTodoEvents(domain.TodoEventDeleted, output)...)
+	return output, nil
+}
+
+// BatchTodos runs an ordered list of todo creates, updates and deletes.
+func (u *TodoUsecase) BatchTodos(ctx context.Context, input *domain.BatchTodosInput) (*domain.BatchTodosOutput, error) {
+	output, err := u.batchTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute batch todos command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, newBatchTodoEvents(output)...)
 	return output, nil
 }
 
@@ -80,13 +204,174 @@ func (u *TodoUsecase) UpdateTodo(ctx context.Context, input *domain.UpdateTodoIn
 	if err != nil {
 		return nil, fmt.Errorf("execute update todo command: %w", err)
 	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
 	return output, nil
 }
 
-// DeleteTodo removes a todo item.
+// PatchTodo updates the provided fields of an existing todo item.
+func (u *TodoUsecase) PatchTodo(ctx context.Context, input *domain.PatchTodoInput) (*domain.UpdateTodoOutput, error) {
+	output, err := u.patchTodoCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute patch todo command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// DeleteTodo moves a todo item to the trash. The delete is reported to the owner of the todo, who may not be the user.
 func (u *TodoUsecase) DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) error {
-	if err := u.deleteTodoCommand.Execute(ctx, input); err != nil {
+	ownerID, err := u.deleteTodoCommand.Execute(ctx, input)
+	if err != nil {
 		return fmt.Errorf("execute delete todo command: %w", err)
 	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoDeletedEvent(ownerID, input.ID))
 	return nil
 }
+
+// FindTrashedTodos returns all todos in the given user's trash.
+func (u *TodoUsecase) FindTrashedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	todos, err := u.findTrashedTodosQuery.Execute(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute find trashed todos query: %w", err)
+	}
+	return todos, nil
+}
+
+// RestoreTodo moves a todo item out of the trash.
+func (u *TodoUsecase) RestoreTodo(ctx context.Context, input *domain.RestoreTodoInput) (*domain.RestoreTodoOutput, error) {
+	output, err := u.restoreTodoCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute restore todo command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// FindTodoHistory returns the revisions of a todo item, oldest first.
+func (u *TodoUsecase) FindTodoHistory(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error) {
+	revisions, err := u.findTodoHistoryQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find todo history query: %w", err)
+	}
+	return revisions, nil
+}
+
+// RevertTodo sets the fields of a todo item back to their values at an earlier revision.
+func (u *TodoUsecase) RevertTodo(ctx context.Context, input *domain.RevertTodoInput) (*domain.RevertTodoOutput, error) {
+	output, err := u.revertTodoCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute revert todo command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// PurgeTodos permanently deletes todos that have been in the trash since before the given time.
+func (u *TodoUsecase) PurgeTodos(ctx context.Context, input *domain.PurgeTodosInput) (*domain.PurgeTodosOutput, error) {
+	ctx, span := tracer.Start(ctx, "PurgeTodos")
+	defer span.End()
+
+	output, err := u.purgeTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute purge todos command: %w", err)
+	}
+	return output, nil
+}
+
+// FindArchivedTodos returns all archived todos belonging to the given user.
+func (u *TodoUsecase) FindArchivedTodos(ctx context.Context, userID int) ([]domain.Todo, error) {
+	todos, err := u.findArchivedTodosQuery.Execute(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute find archived todos query: %w", err)
+	}
+	return todos, nil
+}
+
+// ArchiveTodo archives or unarchives a todo item.
+func (u *TodoUsecase) ArchiveTodo(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.ArchiveTodoOutput, error) {
+	output, err := u.archiveTodoCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute archive todo command: %w", err)
+	}
+	u.publisher.PublishTodoEvents(ctx, domain.NewTodoChangedEvent(domain.TodoEventUpdated, output.Todo))
+	return output, nil
+}
+
+// ArchiveCompletedTodos archives all completed todos of a user.
+func (u *TodoUsecase) ArchiveCompletedTodos(ctx context.Context, input *domain.ArchiveCompletedTodosInput) (*domain.ArchiveTodosOutput, error) {
+	output, err := u.archiveCompletedTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute archive completed todos command: %w", err)
+	}
+	events := make([]domain.TodoEvent, len(output.Todos))
+	for i := range output.Todos {
+		events[i] = domain.NewTodoChangedEvent(domain.TodoEventUpdated, &output.Todos[i])
+	}
+	u.publisher.PublishTodoEvents(ctx, events...)
+	return output, nil
+}
+
+// AutoArchiveTodos archives the todos of all users that were completed before the given time.
+func (u *TodoUsecase) AutoArchiveTodos(ctx context.Context, input *domain.AutoArchiveTodosInput) (*domain.AutoArchiveTodosOutput, error) {
+	ctx, span := tracer.Start(ctx, "AutoArchiveTodos")
+	defer span.End()
+
+	output, err := u.autoArchiveTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute auto archive todos command: %w", err)
+	}
+	// The archived todos are not kept one by one, so the clients of their owners fetch their todos again
+	events := make([]domain.TodoEvent, len(output.UserIDs))
+	for i, userID := range output.UserIDs {
+		events[i] = domain.NewTodoResetEvent(userID)
+	}
+	u.publisher.PublishTodoEvents(ctx, events...)
+	return output, nil
+}
+
+// SearchTodos returns the todos of a user matching a search query, most relevant first.
+func (u *TodoUsecase) SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error) {
+	ctx, span := tracer.Start(ctx, "SearchTodos")
+	defer span.End()
+
+	hits, err := u.searchTodosQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute search todos query: %w", err)
+	}
+	return hits, nil
+}
+
+// CreateTodoUndo issues a token for undoing a write to todo items. Returns nil if there is nothing to undo.
+func (u *TodoUsecase) CreateTodoUndo(ctx context.Context, input *domain.CreateTodoUndoInput) (*domain.TodoUndo, error) {
+	undo, err := u.createTodoUndoCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute create todo undo command: %w", err)
+	}
+	return undo, nil
+}
+
+// UndoTodos reverses the write an undo token was issued for.
+func (u *TodoUsecase) UndoTodos(ctx context.Context, input *domain.UndoTodosInput) (*domain.UndoTodosOutput, error) {
+	output, err := u.undoTodosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute undo todos command: %w", err)
+	}
+	events := make([]domain.TodoEvent, 0, len(output.Todos))
+	for i := range output.Todos {
+		events = append(events, domain.NewTodoChangedEvent(domain.TodoEventUpdated, &output.Todos[i]))
+	}
+	u.publisher.PublishTodoEvents(ctx, events...)
+	return output, nil
+}
+
+// PurgeTodoUndos deletes undo tokens that expired before the given time.
+func (u *TodoUsecase) PurgeTodoUndos(ctx context.Context, input *domain.PurgeTodoUndosInput) (*domain.PurgeTodoUndosOutput, error) {
+	ctx, span := tracer.Start(ctx, "PurgeTodoUndos")
+	defer span.End()
+
+	output, err := u.purgeTodoUndosCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute purge todo undos command: %w", err)
+	}
+	return output, nil
+}
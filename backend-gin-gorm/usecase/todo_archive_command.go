@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoArchiver defines the interface for archiving and unarchiving todos.
+type TodoArchiver interface {
+	ArchiveTodo(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.Todo, error)
+}
+
+// ArchiveTodoCommand archives or unarchives a todo item.
+type ArchiveTodoCommand struct {
+	repo TodoArchiver
+}
+
+// NewArchiveTodoCommand returns a new ArchiveTodoCommand.
+func NewArchiveTodoCommand(repo TodoArchiver) *ArchiveTodoCommand {
+	return &ArchiveTodoCommand{
+		repo: repo,
+	}
+}
+
+// Execute sets the archived state of the specified todo item and returns it.
+func (u *ArchiveTodoCommand) Execute(ctx context.Context, input *domain.ArchiveTodoInput) (*domain.ArchiveTodoOutput, error) {
+	todo, err := u.repo.ArchiveTodo(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("archive todo: %w", err)
+	}
+
+	output, err := domain.NewArchiveTodoOutput(todo)
+	if err != nil {
+		return nil, fmt.Errorf("create archive todo output: %w", err)
+	}
+
+	return output, nil
+}
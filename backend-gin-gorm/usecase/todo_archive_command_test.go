@@ -0,0 +1,56 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_ArchiveTodoCommand_Execute_shouldReturnArchivedTodo_whenArchiving(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewArchiveTodoInput(1, 2, true)
+	require.NoError(t, err)
+	archivedAt := time.Now()
+	archived := newTestTodo(t, 1, 2, "done long ago")
+	archived.ArchivedAt = &archivedAt
+	mockRepo := NewMockTodoArchiver(t)
+	mockRepo.EXPECT().ArchiveTodo(mock.Anything, input).Return(archived, nil).Once()
+	cmd := usecase.NewArchiveTodoCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Todo.ID)
+	assert.NotNil(t, output.Todo.ArchivedAt)
+}
+
+func Test_ArchiveTodoCommand_Execute_shouldReturnError_whenTodoNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewArchiveTodoInput(1, 2, false)
+	require.NoError(t, err)
+	mockRepo := NewMockTodoArchiver(t)
+	mockRepo.EXPECT().ArchiveTodo(mock.Anything, input).Return(nil, domain.ErrTodoNotFound).Once()
+	cmd := usecase.NewArchiveTodoCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+}
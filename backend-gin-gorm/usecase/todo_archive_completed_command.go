@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// CompletedTodosArchiver defines the interface for archiving all completed todos of a user.
+type CompletedTodosArchiver interface {
+	ArchiveCompletedTodos(ctx context.Context, input *domain.ArchiveCompletedTodosInput) ([]domain.Todo, error)
+}
+
+// ArchiveCompletedTodosCommand archives every completed todo of a user at once.
+type ArchiveCompletedTodosCommand struct {
+	repo CompletedTodosArchiver
+}
+
+// NewArchiveCompletedTodosCommand returns a new ArchiveCompletedTodosCommand.
+func NewArchiveCompletedTodosCommand(repo CompletedTodosArchiver) *ArchiveCompletedTodosCommand {
+	return &ArchiveCompletedTodosCommand{
+		repo: repo,
+	}
+}
+
+// Execute archives the completed todos of the user and returns the archived todos.
+func (u *ArchiveCompletedTodosCommand) Execute(ctx context.Context, input *domain.ArchiveCompletedTodosInput) (*domain.ArchiveTodosOutput, error) {
+	todos, err := u.repo.ArchiveCompletedTodos(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("archive completed todos: %w", err)
+	}
+
+	output, err := domain.NewArchiveTodosOutput(todos)
+	if err != nil {
+		return nil, fmt.Errorf("create archive todos output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_ArchiveCompletedTodosCommand_Execute_shouldArchiveCompletedTodos_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewArchiveCompletedTodosCommand(repo)
+
+	for _, text := range []string{"done", "open"} {
+		createInput, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		created, err := repo.CreateTodo(ctx, createInput)
+		require.NoError(t, err)
+		updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, text, text == "done", nil)
+		require.NoError(t, err)
+		_, err = repo.UpdateTodo(ctx, updateInput)
+		require.NoError(t, err)
+	}
+
+	input, err := domain.NewArchiveCompletedTodosInput(userID)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.ArchivedCount)
+
+	// 未完了の todo だけが一覧に残っていることを確認
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, "open", todos[0].Text)
+
+	// 完了済みの todo がアーカイブに移動していることを確認
+	archived, err := repo.FindArchivedTodos(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.Equal(t, "done", archived[0].Text)
+	assert.True(t, archived[0].IsComplete)
+}
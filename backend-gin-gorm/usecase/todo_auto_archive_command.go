@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoAutoArchiver defines the interface for archiving todos that have been complete for a long time.
+type TodoAutoArchiver interface {
+	AutoArchiveTodos(ctx context.Context, input *domain.AutoArchiveTodosInput) ([]domain.Todo, error)
+}
+
+// AutoArchiveTodosCommand archives the todos of all users that were completed before a cutoff.
+type AutoArchiveTodosCommand struct {
+	repo TodoAutoArchiver
+}
+
+// NewAutoArchiveTodosCommand returns a new AutoArchiveTodosCommand.
+func NewAutoArchiveTodosCommand(repo TodoAutoArchiver) *AutoArchiveTodosCommand {
+	return &AutoArchiveTodosCommand{
+		repo: repo,
+	}
+}
+
+// Execute archives batches of todos until none completed before input.CompletedBefore remain unarchived or the context is canceled.
+// Only the owners of the archived todos are kept rather than the todos, since an automatic archive may archive many.
+func (u *AutoArchiveTodosCommand) Execute(ctx context.Context, input *domain.AutoArchiveTodosInput) (*domain.AutoArchiveTodosOutput, error) {
+	archivedCount := 0
+	userIDs := make([]int, 0)
+	seen := make(map[int]bool)
+	for ctx.Err() == nil {
+		todos, err := u.repo.AutoArchiveTodos(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("auto archive todos: %w", err)
+		}
+		archivedCount += len(todos)
+		for _, todo := range todos {
+			if !seen[todo.UserID] {
+				seen[todo.UserID] = true
+				userIDs = append(userIDs, todo.UserID)
+			}
+		}
+
+		if len(todos) < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewAutoArchiveTodosOutput(archivedCount, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("create auto archive todos output: %w", err)
+	}
+
+	return output, nil
+}
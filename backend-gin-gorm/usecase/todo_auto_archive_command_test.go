@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+// newTestArchivedTodos returns count todos of the user as a stubbed repository archives them.
+func newTestArchivedTodos(t *testing.T, count int, userID int) []domain.Todo {
+	t.Helper()
+	todos := make([]domain.Todo, count)
+	for i := range todos {
+		todos[i] = *newTestTodo(t, i+1, userID, "archived")
+	}
+	return todos
+}
+
+func Test_AutoArchiveTodosCommand_Execute_shouldArchiveBatchesUntilLastPartialBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAutoArchiveTodosInput(time.Now().Add(-30*24*time.Hour), 10)
+	require.NoError(t, err)
+	mockRepo := NewMockTodoAutoArchiver(t)
+	mockRepo.EXPECT().AutoArchiveTodos(mock.Anything, input).Return(append(newTestArchivedTodos(t, 6, 1), newTestArchivedTodos(t, 4, 2)...), nil).Once()
+	mockRepo.EXPECT().AutoArchiveTodos(mock.Anything, input).Return(newTestArchivedTodos(t, 10, 2), nil).Once()
+	mockRepo.EXPECT().AutoArchiveTodos(mock.Anything, input).Return(newTestArchivedTodos(t, 3, 3), nil).Once()
+	cmd := usecase.NewAutoArchiveTodosCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 23, output.ArchivedCount)
+	assert.Equal(t, []int{1, 2, 3}, output.UserIDs, "each owner should be listed once")
+}
+
+func Test_AutoArchiveTodosCommand_Execute_shouldStop_whenNothingToArchive(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAutoArchiveTodosInput(time.Now().Add(-30*24*time.Hour), 10)
+	require.NoError(t, err)
+	mockRepo := NewMockTodoAutoArchiver(t)
+	mockRepo.EXPECT().AutoArchiveTodos(mock.Anything, input).Return([]domain.Todo{}, nil).Once()
+	cmd := usecase.NewAutoArchiveTodosCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.ArchivedCount)
+}
+
+func Test_AutoArchiveTodosCommand_Execute_shouldNotArchive_whenContextIsCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// given
+	input, err := domain.NewAutoArchiveTodosInput(time.Now().Add(-30*24*time.Hour), 10)
+	require.NoError(t, err)
+	// AutoArchiveTodos が呼ばれた場合は mock が失敗させる
+	mockRepo := NewMockTodoAutoArchiver(t)
+	cmd := usecase.NewAutoArchiveTodosCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.ArchivedCount)
+}
+
+func Test_AutoArchiveTodosCommand_Execute_shouldReturnError_whenRepositoryFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewAutoArchiveTodosInput(time.Now().Add(-30*24*time.Hour), 10)
+	require.NoError(t, err)
+	repoErr := errors.New("connection refused")
+	mockRepo := NewMockTodoAutoArchiver(t)
+	mockRepo.EXPECT().AutoArchiveTodos(mock.Anything, input).Return(newTestArchivedTodos(t, 10, 1), nil).Once()
+	mockRepo.EXPECT().AutoArchiveTodos(mock.Anything, input).Return(nil, repoErr).Once()
+	cmd := usecase.NewAutoArchiveTodosCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, repoErr)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoBatchRepository defines the repository operations a batch runs outside a transaction.
+type TodoBatchRepository interface {
+	TodoCreator
+	TodoPatcher
+	TodoDeleter
+}
+
+// TodoBatchCommandTxManager manages database transactions for atomic batches.
+// fn creates todos for userID and writes the existing todos listed in todoIDs.
+type TodoBatchCommandTxManager interface {
+	WithTransaction(ctx context.Context, userID int, todoIDs []int, fn func(createTodo domain.CreateTodoFunc, patchTodo domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) error) error
+}
+
+// BatchTodosCommand runs an ordered list of create, update and delete operations.
+type BatchTodosCommand struct {
+	repo      TodoBatchRepository
+	txManager TodoBatchCommandTxManager
+}
+
+// NewBatchTodosCommand returns a new BatchTodosCommand.
+func NewBatchTodosCommand(repo TodoBatchRepository, txManager TodoBatchCommandTxManager) *BatchTodosCommand {
+	return &BatchTodosCommand{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+// Execute runs the operations of input in order and reports a result for each.
+// An atomic batch runs in a single transaction. When an operation fails, the transaction is rolled back,
+// the operations before it are reported as ErrBatchRolledBack and those after it as ErrBatchNotExecuted.
+// Otherwise each operation is applied on its own and a failure only affects the operations that refer to
+// the todo a failed create should have created, which are reported as ErrBatchDependencyFailed.
+func (u *BatchTodosCommand) Execute(ctx context.Context, input *domain.BatchTodosInput) (*domain.BatchTodosOutput, error) {
+	if !input.Atomic {
+		results := newTodoBatchRunner(input.UserID, u.repo.CreateTodo, u.repo.PatchTodo, u.repo.DeleteTodo).run(ctx, input)
+		return newBatchTodosOutput(results, true)
+	}
+
+	var results []domain.BatchOperationResult
+	err := u.txManager.WithTransaction(ctx, input.UserID, input.TodoIDs(), func(createTodo domain.CreateTodoFunc, patchTodo domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) error {
+		results = newTodoBatchRunner(input.UserID, createTodo, patchTodo, deleteTodo).run(ctx, input)
+		if last := results[len(results)-1]; last.Err != nil {
+			return &domain.BatchOperationError{Index: len(results) - 1, Err: last.Err}
+		}
+		return nil
+	})
+	var opErr *domain.BatchOperationError
+	if errors.As(err, &opErr) {
+		return newBatchTodosOutput(rollBackBatchResults(input, results), false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("do in transaction: %w", err)
+	}
+
+	return newBatchTodosOutput(results, true)
+}
+
+func newBatchTodosOutput(results []domain.BatchOperationResult, committed bool) (*domain.BatchTodosOutput, error) {
+	output, err := domain.NewBatchTodosOutput(results, committed)
+	if err != nil {
+		return nil, fmt.Errorf("create batch todos output: %w", err)
+	}
+	return output, nil
+}
+
+// rollBackBatchResults turns the results of an atomic batch that stopped at its last result into the results
+// of the rolled back batch, in which only the failed operation keeps its own outcome.
+func rollBackBatchResults(input *domain.BatchTodosInput, results []domain.BatchOperationResult) []domain.BatchOperationResult {
+	failed := len(results) - 1
+	rolledBack := make([]domain.BatchOperationResult, len(input.Operations))
+	for i, op := range input.Operations {
+		result := domain.BatchOperationResult{Type: op.Type, ID: op.ID, TempID: op.TempID, Todo: nil, OwnerID: 0, Err: domain.ErrBatchNotExecuted}
+		switch {
+		case i == failed:
+			result = results[i]
+		case i < failed:
+			if op.Type != domain.BatchOperationCreate {
+				result.ID = results[i].ID
+			}
+			result.Err = domain.ErrBatchRolledBack
+		}
+		rolledBack[i] = result
+	}
+	return rolledBack
+}
+
+// todoBatchRunner runs the operations of a batch with the given repository functions,
+// resolving temporary IDs to the todos created earlier in the batch.
+type todoBatchRunner struct {
+	userID     int
+	createTodo domain.CreateTodoFunc
+	patchTodo  domain.PatchTodoFunc
+	deleteTodo domain.DeleteTodoFunc
+	tempIDs    map[string]int
+}
+
+func newTodoBatchRunner(userID int, createTodo domain.CreateTodoFunc, patchTodo domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) *todoBatchRunner {
+	return &todoBatchRunner{
+		userID:     userID,
+		createTodo: createTodo,
+		patchTodo:  patchTodo,
+		deleteTodo: deleteTodo,
+		tempIDs:    make(map[string]int),
+	}
+}
+
+// run runs the operations in order, stopping at the first failure of an atomic batch.
+func (r *todoBatchRunner) run(ctx context.Context, input *domain.BatchTodosInput) []domain.BatchOperationResult {
+	results := make([]domain.BatchOperationResult, 0, len(input.Operations))
+	for i := range input.Operations {
+		result := r.runOperation(ctx, &input.Operations[i])
+		results = append(results, result)
+		if result.Err != nil && input.Atomic {
+			break
+		}
+	}
+	return results
+}
+
+func (r *todoBatchRunner) runOperation(ctx context.Context, op *domain.BatchOperation) domain.BatchOperationResult {
+	result := domain.BatchOperationResult{Type: op.Type, ID: op.ID, TempID: op.TempID, Todo: nil, OwnerID: 0, Err: nil}
+	if op.TempID != "" && op.Type != domain.BatchOperationCreate {
+		id, ok := r.tempIDs[op.TempID]
+		if !ok {
+			result.Err = domain.ErrBatchDependencyFailed
+			return result
+		}
+		result.ID = id
+	}
+
+	switch op.Type {
+	case domain.BatchOperationCreate:
+		result.Todo, result.Err = r.createTodo(ctx, &domain.CreateTodoInput{UserID: r.userID, Text: *op.Text})
+		if result.Err == nil {
+			result.ID = result.Todo.ID
+			if op.TempID != "" {
+				r.tempIDs[op.TempID] = result.ID
+			}
+		}
+	case domain.BatchOperationUpdate:
+		result.Todo, result.Err = r.patchTodo(ctx, &domain.PatchTodoInput{
+			ID:              result.ID,
+			UserID:          r.userID,
+			Text:            op.Text,
+			IsComplete:      op.IsComplete,
+			ExpectedVersion: op.ExpectedVersion,
+		})
+	case domain.BatchOperationDelete:
+		result.OwnerID, result.Err = r.deleteTodo(ctx, &domain.DeleteTodoInput{
+			ID:              result.ID,
+			UserID:          r.userID,
+			ExpectedVersion: op.ExpectedVersion,
+		})
+	}
+
+	var mismatch *domain.TodoVersionMismatchError
+	if errors.As(result.Err, &mismatch) {
+		result.Todo = mismatch.Current
+	}
+	return result
+}
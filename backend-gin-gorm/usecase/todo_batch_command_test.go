@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_BatchTodosCommand_Execute_shouldResolveTempIDs_whenOperationsSucceed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewBatchTodosCommand(repo, gateway.NewTodoBatchCommandTxManager(dbc))
+
+	text1 := "task1"
+	text2 := "task2"
+	isComplete := true
+	input, err := domain.NewBatchTodosInput(userID, []domain.BatchOperation{
+		{Type: domain.BatchOperationCreate, TempID: "a", Text: &text1},
+		{Type: domain.BatchOperationCreate, TempID: "b", Text: &text2},
+		{Type: domain.BatchOperationUpdate, TempID: "a", IsComplete: &isComplete},
+		{Type: domain.BatchOperationDelete, TempID: "b"},
+	}, true)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, output.Committed)
+	require.Len(t, output.Results, 4)
+	for _, result := range output.Results {
+		require.NoError(t, result.Err)
+	}
+	assert.Equal(t, output.Results[0].ID, output.Results[2].ID)
+	assert.Equal(t, output.Results[1].ID, output.Results[3].ID)
+
+	// 一時IDで参照した更新と削除が作成済みのTODOに適用されていることを確認
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, output.Results[0].ID, todos[0].ID)
+	assert.True(t, todos[0].IsComplete)
+}
+
+func Test_BatchTodosCommand_Execute_shouldRollbackAll_whenAtomicOperationFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewBatchTodosCommand(repo, gateway.NewTodoBatchCommandTxManager(dbc))
+
+	text := "task1"
+	input, err := domain.NewBatchTodosInput(userID, []domain.BatchOperation{
+		{Type: domain.BatchOperationCreate, Text: &text},
+		{Type: domain.BatchOperationDelete, ID: 999999999},
+		{Type: domain.BatchOperationCreate, Text: &text},
+	}, true)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, output.Committed)
+	require.Len(t, output.Results, 3)
+	require.ErrorIs(t, output.Results[0].Err, domain.ErrBatchRolledBack)
+	assert.Zero(t, output.Results[0].ID)
+	require.ErrorIs(t, output.Results[1].Err, domain.ErrTodoNotFound)
+	require.ErrorIs(t, output.Results[2].Err, domain.ErrBatchNotExecuted)
+
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	assert.Empty(t, todos, "the created todo should be rolled back")
+}
+
+func Test_BatchTodosCommand_Execute_shouldRunLaterOperations_whenNonAtomicOperationFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewBatchTodosCommand(repo, gateway.NewTodoBatchCommandTxManager(dbc))
+
+	text := "task1"
+	input, err := domain.NewBatchTodosInput(userID, []domain.BatchOperation{
+		{Type: domain.BatchOperationCreate, TempID: "a", Text: &text},
+		{Type: domain.BatchOperationDelete, ID: 999999999},
+		{Type: domain.BatchOperationDelete, TempID: "a"},
+	}, false)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, output.Committed)
+	require.Len(t, output.Results, 3)
+	require.NoError(t, output.Results[0].Err)
+	require.ErrorIs(t, output.Results[1].Err, domain.ErrTodoNotFound)
+	require.NoError(t, output.Results[2].Err)
+
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	assert.Empty(t, todos)
+}
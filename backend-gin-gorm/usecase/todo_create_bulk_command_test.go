@@ -44,7 +44,7 @@ func Test_CreateBulkTodosCommand_Execute_shouldCreateAllTodos_whenAllSucceed(t *
 
 	// DB にも永続化されていることを確認
 	repo := gateway.NewTodoRepository(dbc.DB)
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	assert.Len(t, todos, 3)
 }
@@ -78,7 +78,7 @@ func Test_CreateBulkTodosCommand_Execute_shouldRollbackAll_whenMiddleTodoFails(t
 
 	// 1件目もロールバックされ、DB にレコードが残っていないことを確認
 	repo := gateway.NewTodoRepository(dbc.DB)
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	assert.Empty(t, todos)
 }
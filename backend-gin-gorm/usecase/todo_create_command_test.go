@@ -39,7 +39,7 @@ func Test_CreateTodoCommand_Execute_shouldReturnCreatedTodo_whenValidInput(t *te
 	assert.Positive(t, output.Todo.ID)
 
 	// DB にも永続化されていることを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	assert.Len(t, todos, 1)
 	assert.Equal(t, "buy milk", todos[0].Text)
@@ -70,7 +70,7 @@ func Test_CreateTodoCommand_Execute_shouldReturnError_whenRepoFails(t *testing.T
 	assert.Contains(t, err.Error(), "create todo")
 
 	// DB にレコードが残っていないことを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	assert.Empty(t, todos)
 }
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// DeleteBulkTodosCommand moves several todos to the trash.
+type DeleteBulkTodosCommand struct {
+	repo      TodoDeleter
+	txManager TodoBulkCommandTxManager
+}
+
+// NewDeleteBulkTodosCommand returns a new DeleteBulkTodosCommand.
+func NewDeleteBulkTodosCommand(repo TodoDeleter, txManager TodoBulkCommandTxManager) *DeleteBulkTodosCommand {
+	return &DeleteBulkTodosCommand{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+// Execute moves the todos in input.IDs to the trash.
+// In BulkModeAllOrNothing the todos are deleted in a single transaction that is rolled back when a todo fails,
+// and a *BulkTodoError naming that todo is returned. In BulkModeBestEffort each todo is deleted on its own
+// and failures are reported in the per-todo results.
+func (u *DeleteBulkTodosCommand) Execute(ctx context.Context, input *domain.DeleteBulkTodosInput) (*domain.BulkTodosOutput, error) {
+	var results []domain.BulkTodoResult
+	if input.Mode == domain.BulkModeAllOrNothing {
+		err := u.txManager.WithTransaction(ctx, input.IDs, func(_ domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) error {
+			results = deleteTodos(ctx, input, deleteTodo)
+			for _, result := range results {
+				if result.Err != nil {
+					return &domain.BulkTodoError{ID: result.ID, Err: result.Err}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("do in transaction: %w", err)
+		}
+	} else {
+		results = deleteTodos(ctx, input, u.repo.DeleteTodo)
+	}
+
+	output, err := domain.NewBulkTodosOutput(results)
+	if err != nil {
+		return nil, fmt.Errorf("create bulk todos output: %w", err)
+	}
+	return output, nil
+}
+
+// deleteTodos deletes each todo in input.IDs, stopping at the first failure in BulkModeAllOrNothing.
+func deleteTodos(ctx context.Context, input *domain.DeleteBulkTodosInput, deleteTodo domain.DeleteTodoFunc) []domain.BulkTodoResult {
+	results := make([]domain.BulkTodoResult, 0, len(input.IDs))
+	for _, id := range input.IDs {
+		ownerID, err := deleteTodo(ctx, input.DeleteTodoInput(id))
+		results = append(results, domain.BulkTodoResult{ID: id, Todo: nil, OwnerID: ownerID, Err: err})
+		if err != nil && input.Mode == domain.BulkModeAllOrNothing {
+			break
+		}
+	}
+	return results
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_DeleteBulkTodosCommand_Execute_shouldDeleteAllTodos_whenAllOrNothingSucceeds(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewDeleteBulkTodosCommand(repo, gateway.NewTodoBulkCommandTxManager(dbc))
+
+	ids := make([]int, 0, 3)
+	for _, text := range []string{"task1", "task2", "task3"} {
+		createInput, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		created, err := repo.CreateTodo(ctx, createInput)
+		require.NoError(t, err)
+		ids = append(ids, created.ID)
+	}
+	input, err := domain.NewDeleteBulkTodosInput(userID, ids[:2], domain.BulkModeAllOrNothing)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Results, 2)
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, ids[2], todos[0].ID)
+}
+
+func Test_DeleteBulkTodosCommand_Execute_shouldRollbackAll_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupTodoTable(t, otherUserID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewDeleteBulkTodosCommand(repo, gateway.NewTodoBulkCommandTxManager(dbc))
+
+	ownInput, err := domain.NewCreateTodoInput(userID, "mine")
+	require.NoError(t, err)
+	own, err := repo.CreateTodo(ctx, ownInput)
+	require.NoError(t, err)
+	otherInput, err := domain.NewCreateTodoInput(otherUserID, "theirs")
+	require.NoError(t, err)
+	other, err := repo.CreateTodo(ctx, otherInput)
+	require.NoError(t, err)
+	input, err := domain.NewDeleteBulkTodosInput(userID, []int{own.ID, other.ID}, domain.BulkModeAllOrNothing)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	assert.Len(t, todos, 1, "the own todo should not be moved to the trash")
+}
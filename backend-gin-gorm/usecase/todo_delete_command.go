@@ -7,12 +7,12 @@ import (
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 )
 
-// TodoDeleter defines the interface for deleting todos from the repository.
+// TodoDeleter defines the interface for moving todos to the trash.
 type TodoDeleter interface {
-	DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) error
+	DeleteTodo(ctx context.Context, input *domain.DeleteTodoInput) (int, error)
 }
 
-// DeleteTodoCommand removes a todo item from the repository.
+// DeleteTodoCommand moves a todo item to the trash. It stays restorable until the purge removes it.
 type DeleteTodoCommand struct {
 	repo TodoDeleter
 }
@@ -24,12 +24,12 @@ func NewDeleteTodoCommand(repo TodoDeleter) *DeleteTodoCommand {
 	}
 }
 
-// Execute deletes the specified todo item.
-func (u *DeleteTodoCommand) Execute(ctx context.Context, input *domain.DeleteTodoInput) error {
-	err := u.repo.DeleteTodo(ctx, input)
+// Execute moves the specified todo item to the trash and returns the ID of its owner.
+func (u *DeleteTodoCommand) Execute(ctx context.Context, input *domain.DeleteTodoInput) (int, error) {
+	ownerID, err := u.repo.DeleteTodo(ctx, input)
 	if err != nil {
-		return fmt.Errorf("delete todo: %w", err)
+		return 0, fmt.Errorf("delete todo: %w", err)
 	}
 
-	return nil
+	return ownerID, nil
 }
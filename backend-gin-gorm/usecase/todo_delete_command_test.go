@@ -28,17 +28,18 @@ func Test_DeleteTodoCommand_Execute_shouldDeleteTodo_whenValidInput(t *testing.T
 	created, err := repo.CreateTodo(ctx, createInput)
 	require.NoError(t, err)
 
-	deleteInput, err := domain.NewDeleteTodoInput(created.ID, userID)
+	deleteInput, err := domain.NewDeleteTodoInput(created.ID, userID, nil)
 	require.NoError(t, err)
 
 	// when
-	err = cmd.Execute(ctx, deleteInput)
+	ownerID, err := cmd.Execute(ctx, deleteInput)
 
 	// then
 	require.NoError(t, err)
+	assert.Equal(t, userID, ownerID)
 
 	// DB からも削除されていることを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	assert.Empty(t, todos)
 }
@@ -53,11 +54,11 @@ func Test_DeleteTodoCommand_Execute_shouldReturnError_whenTodoNotFound(t *testin
 	repo := gateway.NewTodoRepository(dbc.DB)
 	cmd := usecase.NewDeleteTodoCommand(repo)
 
-	deleteInput, err := domain.NewDeleteTodoInput(999999999, userID)
+	deleteInput, err := domain.NewDeleteTodoInput(999999999, userID, nil)
 	require.NoError(t, err)
 
 	// when
-	err = cmd.Execute(ctx, deleteInput)
+	_, err = cmd.Execute(ctx, deleteInput)
 
 	// then
 	require.Error(t, err)
@@ -82,18 +83,18 @@ func Test_DeleteTodoCommand_Execute_shouldReturnError_whenUserIDDoesNotMatch(t *
 	require.NoError(t, err)
 
 	// 別ユーザーの ID で削除を試みる
-	deleteInput, err := domain.NewDeleteTodoInput(created.ID, otherUserID)
+	deleteInput, err := domain.NewDeleteTodoInput(created.ID, otherUserID, nil)
 	require.NoError(t, err)
 
 	// when
-	err = cmd.Execute(ctx, deleteInput)
+	_, err = cmd.Execute(ctx, deleteInput)
 
 	// then
 	require.Error(t, err)
 	require.ErrorIs(t, err, domain.ErrTodoNotFound)
 
 	// 元の todo が削除されていないことを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	assert.Len(t, todos, 1)
 	assert.Equal(t, "protected", todos[0].Text)
@@ -119,18 +120,83 @@ func Test_DeleteTodoCommand_Execute_shouldNotAffectOtherTodos_whenOneTodoDeleted
 	_, err = repo.CreateTodo(ctx, input2)
 	require.NoError(t, err)
 
-	deleteInput, err := domain.NewDeleteTodoInput(todo1.ID, userID)
+	deleteInput, err := domain.NewDeleteTodoInput(todo1.ID, userID, nil)
 	require.NoError(t, err)
 
 	// when
-	err = cmd.Execute(ctx, deleteInput)
+	_, err = cmd.Execute(ctx, deleteInput)
 
 	// then
 	require.NoError(t, err)
 
 	// task2 だけが残っていることを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	require.Len(t, todos, 1)
 	assert.Equal(t, "task2", todos[0].Text)
 }
+
+func Test_DeleteTodoCommand_Execute_shouldMoveTodoToTrash_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewDeleteTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "to be trashed")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	deleteInput, err := domain.NewDeleteTodoInput(created.ID, userID, nil)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, deleteInput)
+
+	// then
+	require.NoError(t, err)
+
+	// ゴミ箱に移動していることを確認
+	trashed, err := repo.FindTrashedTodos(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, created.ID, trashed[0].ID)
+	assert.NotNil(t, trashed[0].DeletedAt)
+}
+
+func Test_DeleteTodoCommand_Execute_shouldReturnOwnerID_whenEditorDeletesSharedTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewDeleteTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(ownerID, "shared")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	deleteInput, err := domain.NewDeleteTodoInput(created.ID, editorID, nil)
+	require.NoError(t, err)
+
+	// when
+	deletedOwnerID, err := cmd.Execute(ctx, deleteInput)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ownerID, deletedOwnerID, "the owner of the todo, not the editor, should be returned")
+	trashed, err := repo.FindTrashedTodos(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, created.ID, trashed[0].ID)
+}
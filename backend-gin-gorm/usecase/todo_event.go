@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoEventPublisher defines the interface for publishing todo events to the clients of their users.
+// Events are published after the change they report has been committed.
+type TodoEventPublisher interface {
+	PublishTodoEvents(ctx context.Context, events ...domain.TodoEvent)
+}
+
+// TodoEventUsecase orchestrates the real-time delivery of todo events via query objects.
+type TodoEventUsecase struct {
+	subscribeTodoEventsQuery *SubscribeTodoEventsQuery
+	findTodoListQuery        *FindTodoListQuery
+	logger                   *slog.Logger
+}
+
+// NewTodoEventUsecase returns a new TodoEventUsecase wired with the given subscriber, view repository and todo list member repository.
+func NewTodoEventUsecase(subscriber TodoEventSubscriber, viewRepo ViewFinder, listRepo TodoListRoleFinder) *TodoEventUsecase {
+	return &TodoEventUsecase{
+		subscribeTodoEventsQuery: NewSubscribeTodoEventsQuery(subscriber),
+		findTodoListQuery:        NewFindTodoListQuery(viewRepo, listRepo),
+		logger:                   slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-TodoEventUsecase")),
+	}
+}
+
+// SubscribeTodoEvents subscribes to the todo events of the user and of the todo lists shared with them.
+func (u *TodoEventUsecase) SubscribeTodoEvents(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error) {
+	ctx, span := tracer.Start(ctx, "SubscribeTodoEvents")
+	defer span.End()
+	u.logger.InfoContext(ctx, "SubscribeTodoEvents called")
+
+	subscription, err := u.subscribeTodoEventsQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute subscribe todo events query: %w", err)
+	}
+	return subscription, nil
+}
+
+// FindTodoList returns the todo list, of the user or shared with them, a client wants to follow.
+func (u *TodoEventUsecase) FindTodoList(ctx context.Context, input *domain.FindTodoListInput) (*domain.TodoList, error) {
+	ctx, span := tracer.Start(ctx, "FindTodoList")
+	defer span.End()
+	u.logger.InfoContext(ctx, "FindTodoList called")
+
+	list, err := u.findTodoListQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find todo list query: %w", err)
+	}
+	return list, nil
+}
+
+// newBulkTodoEvents returns an event of the type for each todo a bulk operation changed, for the owner of the todo.
+func newBulkTodoEvents(eventType domain.TodoEventType, output *domain.BulkTodosOutput) []domain.TodoEvent {
+	events := make([]domain.TodoEvent, 0, len(output.Results))
+	for _, result := range output.Results {
+		switch {
+		case result.Err != nil:
+		case eventType == domain.TodoEventDeleted:
+			events = append(events, domain.NewTodoDeletedEvent(result.OwnerID, result.ID))
+		case result.Todo != nil:
+			events = append(events, domain.NewTodoChangedEvent(eventType, result.Todo))
+		}
+	}
+	return events
+}
+
+// newBatchTodoEvents returns an event for each successful operation of a committed batch, for the owner of the todo.
+func newBatchTodoEvents(output *domain.BatchTodosOutput) []domain.TodoEvent {
+	events := make([]domain.TodoEvent, 0, len(output.Results))
+	if !output.Committed {
+		return events
+	}
+	for _, result := range output.Results {
+		switch {
+		case result.Err != nil:
+		case result.Type == domain.BatchOperationCreate:
+			events = append(events, domain.NewTodoChangedEvent(domain.TodoEventCreated, result.Todo))
+		case result.Type == domain.BatchOperationUpdate:
+			events = append(events, domain.NewTodoChangedEvent(domain.TodoEventUpdated, result.Todo))
+		case result.Type == domain.BatchOperationDelete:
+			events = append(events, domain.NewTodoDeletedEvent(result.OwnerID, result.ID))
+		}
+	}
+	return events
+}
+
+// newPushTodoEvents returns an event for each todo a push changed, for the owner of the todo.
+// An update that ended in a conflict may still have applied the fields that merged, so it is reported as well.
+func newPushTodoEvents(output *domain.PushTodoChangesOutput) []domain.TodoEvent {
+	events := make([]domain.TodoEvent, 0, len(output.Results))
+	for _, result := range output.Results {
+		switch {
+		case result.Type == domain.PushChangeCreate && result.Todo != nil:
+			// Reported even if completing the created todo failed, since the todo exists
+			events = append(events, domain.NewTodoChangedEvent(domain.TodoEventCreated, result.Todo))
+		case result.Err != nil || result.Status == domain.PushChangeNotFound:
+		case result.Type == domain.PushChangeUpdate:
+			events = append(events, domain.NewTodoChangedEvent(domain.TodoEventUpdated, result.Todo))
+		case result.Type == domain.PushChangeDelete && result.Status == domain.PushChangeApplied:
+			events = append(events, domain.NewTodoDeletedEvent(result.OwnerID, result.ID))
+		}
+	}
+	return events
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoEventSubscriber defines the interface for subscribing to the todo events of a user.
+type TodoEventSubscriber interface {
+	SubscribeTodoEvents(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error)
+}
+
+// SubscribeTodoEventsQuery subscribes to the todo events of a user.
+type SubscribeTodoEventsQuery struct {
+	subscriber TodoEventSubscriber
+}
+
+// NewSubscribeTodoEventsQuery returns a new SubscribeTodoEventsQuery.
+func NewSubscribeTodoEventsQuery(subscriber TodoEventSubscriber) *SubscribeTodoEventsQuery {
+	return &SubscribeTodoEventsQuery{
+		subscriber: subscriber,
+	}
+}
+
+// Execute returns a subscription to the todo events of the user, replaying the events missed since input.LastEventID.
+// Returns ErrTodoEventStreamClosed while the server is shutting down.
+func (q *SubscribeTodoEventsQuery) Execute(ctx context.Context, input *domain.SubscribeTodoEventsInput) (*domain.TodoEventSubscription, error) {
+	subscription, err := q.subscriber.SubscribeTodoEvents(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe todo events: %w", err)
+	}
+	return subscription, nil
+}
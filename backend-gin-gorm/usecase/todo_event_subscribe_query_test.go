@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_SubscribeTodoEventsQuery_Execute_shouldReturnSubscriptionWithReplay(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	lastEventID := domain.TodoEventID{Epoch: 1, Seq: 3}
+	input, err := domain.NewSubscribeTodoEventsInput(1, &lastEventID)
+	require.NoError(t, err)
+	subscription := &domain.TodoEventSubscription{
+		Replay: []domain.TodoEvent{domain.NewTodoDeletedEvent(1, 100)},
+		Events: make(chan domain.TodoEvent),
+		Close:  func() {},
+	}
+	mockSubscriber := NewMockTodoEventSubscriber(t)
+	mockSubscriber.EXPECT().SubscribeTodoEvents(mock.Anything, input).Return(subscription, nil).Once()
+	query := usecase.NewSubscribeTodoEventsQuery(mockSubscriber)
+
+	// when
+	got, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Same(t, subscription, got)
+	require.Len(t, got.Replay, 1)
+	assert.Equal(t, 100, got.Replay[0].TodoID)
+}
+
+func Test_SubscribeTodoEventsQuery_Execute_shouldReturnError_whenStreamIsClosed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewSubscribeTodoEventsInput(1, nil)
+	require.NoError(t, err)
+	mockSubscriber := NewMockTodoEventSubscriber(t)
+	mockSubscriber.EXPECT().SubscribeTodoEvents(mock.Anything, input).Return(nil, domain.ErrTodoEventStreamClosed).Once()
+	query := usecase.NewSubscribeTodoEventsQuery(mockSubscriber)
+
+	// when
+	subscription, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoEventStreamClosed)
+	assert.Nil(t, subscription)
+}
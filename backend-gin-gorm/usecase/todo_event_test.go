@@ -0,0 +1,355 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+// newTestTodoUsecase returns a TodoUsecase that publishes its todo events to the broker.
+func newTestTodoUsecase(t *testing.T, broker *gateway.TodoEventBroker) *usecase.TodoUsecase {
+	t.Helper()
+	return usecase.NewTodoUsecase(
+		gateway.NewTodoRepository(dbc.DB),
+		gateway.NewTodoCreateBulkCommandTxManager(dbc),
+		gateway.NewTodoBulkCommandTxManager(dbc),
+		gateway.NewTodoBatchCommandTxManager(dbc),
+		newTestBlobStore(t),
+		gateway.NewTodoLikeSearchRepository(dbc.DB),
+		broker,
+		gateway.NewTodoUndoRepository(dbc.DB),
+		time.Minute,
+	)
+}
+
+// subscribeTestTodoEvents subscribes to the todo events the broker publishes for the user.
+func subscribeTestTodoEvents(t *testing.T, broker *gateway.TodoEventBroker, userID int) *domain.TodoEventSubscription {
+	t.Helper()
+	input, err := domain.NewSubscribeTodoEventsInput(userID, nil)
+	require.NoError(t, err)
+	subscription, err := broker.SubscribeTodoEvents(context.Background(), input)
+	require.NoError(t, err)
+	t.Cleanup(subscription.Close)
+	return subscription
+}
+
+func Test_TodoUsecase_DeleteBulkTodos_shouldPublishDeletedEvent_whenTodoIsDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	createInput, err := domain.NewCreateTodoInput(userID, "to be deleted")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	subscription := subscribeTestTodoEvents(t, broker, userID)
+	input, err := domain.NewDeleteBulkTodosInput(userID, []int{created.ID, 999999999}, domain.BulkModeBestEffort)
+	require.NoError(t, err)
+
+	// when
+	_, err = uc.DeleteBulkTodos(ctx, input)
+
+	// then
+	// 削除できなかった todo のイベントは配信されない
+	require.NoError(t, err)
+	require.Len(t, subscription.Events, 1)
+	event := <-subscription.Events
+	assert.Equal(t, domain.TodoEventDeleted, event.Type)
+	assert.Equal(t, userID, event.UserID)
+	assert.Equal(t, created.ID, event.TodoID)
+}
+
+func Test_TodoUsecase_PatchBulkTodos_shouldPublishUpdatedEventWithTodo_whenTodoIsPatched(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	createInput, err := domain.NewCreateTodoInput(userID, "to be completed")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	subscription := subscribeTestTodoEvents(t, broker, userID)
+	isComplete := true
+	input, err := domain.NewPatchBulkTodosInput(userID, []int{created.ID}, nil, &isComplete, domain.BulkModeAllOrNothing)
+	require.NoError(t, err)
+
+	// when
+	_, err = uc.PatchBulkTodos(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, subscription.Events, 1)
+	event := <-subscription.Events
+	assert.Equal(t, domain.TodoEventUpdated, event.Type)
+	require.NotNil(t, event.Todo)
+	assert.Equal(t, created.ID, event.Todo.ID)
+	assert.True(t, event.Todo.IsComplete)
+}
+
+func Test_TodoUsecase_BatchTodos_shouldNotPublishEvents_whenBatchIsRolledBack(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	subscription := subscribeTestTodoEvents(t, broker, userID)
+	text := "task1"
+	input, err := domain.NewBatchTodosInput(userID, []domain.BatchOperation{
+		{Type: domain.BatchOperationCreate, Text: &text},
+		{Type: domain.BatchOperationDelete, ID: 999999999},
+	}, true)
+	require.NoError(t, err)
+
+	// when
+	output, err := uc.BatchTodos(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.False(t, output.Committed)
+	assert.Empty(t, subscription.Events, "changes that were rolled back should not be reported")
+}
+
+func Test_TodoUsecase_DeleteTodo_shouldPublishDeletedEventToOwnerAndMembers_whenEditorDeletesSharedTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	createInput, err := domain.NewCreateTodoInput(ownerID, "shared")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	ownerSubscription := subscribeTestTodoEvents(t, broker, ownerID)
+	editorSubscription := subscribeTestTodoEvents(t, broker, editorID)
+	input, err := domain.NewDeleteTodoInput(created.ID, editorID, nil)
+	require.NoError(t, err)
+
+	// when
+	err = uc.DeleteTodo(ctx, input)
+
+	// then
+	// 共有された todo の削除は、owner のリストのイベントとして owner とメンバーの購読者に届く
+	require.NoError(t, err)
+	for _, subscription := range []*domain.TodoEventSubscription{ownerSubscription, editorSubscription} {
+		require.Len(t, subscription.Events, 1)
+		event := <-subscription.Events
+		assert.Equal(t, domain.TodoEventDeleted, event.Type)
+		assert.Equal(t, ownerID, event.UserID)
+		assert.Equal(t, created.ID, event.TodoID)
+	}
+}
+
+func Test_TodoUsecase_DeleteBulkTodos_shouldPublishDeletedEventToOwnerAndMembers_whenEditorDeletesSharedTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	createInput, err := domain.NewCreateTodoInput(ownerID, "shared")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	ownerSubscription := subscribeTestTodoEvents(t, broker, ownerID)
+	editorSubscription := subscribeTestTodoEvents(t, broker, editorID)
+	input, err := domain.NewDeleteBulkTodosInput(editorID, []int{created.ID}, domain.BulkModeAllOrNothing)
+	require.NoError(t, err)
+
+	// when
+	_, err = uc.DeleteBulkTodos(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	for _, subscription := range []*domain.TodoEventSubscription{ownerSubscription, editorSubscription} {
+		require.Len(t, subscription.Events, 1)
+		event := <-subscription.Events
+		assert.Equal(t, domain.TodoEventDeleted, event.Type)
+		assert.Equal(t, ownerID, event.UserID)
+	}
+}
+
+func Test_TodoUsecase_ArchiveCompletedTodos_shouldPublishUpdatedEventForEachArchivedTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	for _, text := range []string{"done 1", "open", "done 2"} {
+		createInput, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		created, err := repo.CreateTodo(ctx, createInput)
+		require.NoError(t, err)
+		updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, text, text != "open", nil)
+		require.NoError(t, err)
+		_, err = repo.UpdateTodo(ctx, updateInput)
+		require.NoError(t, err)
+	}
+	subscription := subscribeTestTodoEvents(t, broker, userID)
+	input, err := domain.NewArchiveCompletedTodosInput(userID)
+	require.NoError(t, err)
+
+	// when
+	output, err := uc.ArchiveCompletedTodos(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.ArchivedCount)
+	require.Len(t, subscription.Events, 2)
+	for _, text := range []string{"done 1", "done 2"} {
+		event := <-subscription.Events
+		assert.Equal(t, domain.TodoEventUpdated, event.Type)
+		require.NotNil(t, event.Todo)
+		assert.Equal(t, text, event.Todo.Text)
+		assert.NotNil(t, event.Todo.ArchivedAt)
+	}
+}
+
+func Test_TodoUsecase_AutoArchiveTodos_shouldPublishResetEventToOwner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	createInput, err := domain.NewCreateTodoInput(userID, "completed long ago")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, created.Text, true, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	// 他パッケージのテストが 1991 年より前を期限に自動アーカイブするので、それより後に完了したことにする
+	completedAt := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, dbc.DB.Exec("UPDATE todo SET completed_at = ? WHERE id = ?", completedAt, created.ID).Error)
+	subscription := subscribeTestTodoEvents(t, broker, userID)
+	input, err := domain.NewAutoArchiveTodosInput(completedAt.Add(time.Hour), 1000)
+	require.NoError(t, err)
+
+	// when
+	output, err := uc.AutoArchiveTodos(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, output.UserIDs, userID)
+	require.Len(t, subscription.Events, 1)
+	event := <-subscription.Events
+	assert.Equal(t, domain.TodoEventReset, event.Type)
+	assert.Equal(t, userID, event.UserID)
+}
+
+func Test_ChecklistUsecase_shouldPublishUpdatedEventWithChecklist_whenChecklistChanges(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := usecase.NewChecklistUsecase(gateway.NewTodoChecklistRepository(dbc.DB), broker)
+	createInput, err := domain.NewCreateTodoInput(ownerID, "shared")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	subscription := subscribeTestTodoEvents(t, broker, ownerID)
+	addInput, err := domain.NewAddChecklistItemInput(created.ID, editorID, "step")
+	require.NoError(t, err)
+
+	// when
+	output, err := uc.AddChecklistItem(ctx, addInput)
+	require.NoError(t, err)
+	deleteInput, err := domain.NewDeleteChecklistItemInput(output.Item.ID, created.ID, editorID)
+	require.NoError(t, err)
+	err = uc.DeleteChecklistItem(ctx, deleteInput)
+
+	// then
+	// editor によるチェックリストの変更も owner のリストの購読者に届く
+	require.NoError(t, err)
+	require.Len(t, subscription.Events, 2)
+	added := <-subscription.Events
+	assert.Equal(t, domain.TodoEventUpdated, added.Type)
+	require.NotNil(t, added.Todo)
+	require.Len(t, added.Todo.Checklist, 1)
+	assert.Equal(t, "step", added.Todo.Checklist[0].Text)
+	deleted := <-subscription.Events
+	assert.Equal(t, domain.TodoEventUpdated, deleted.Type)
+	require.NotNil(t, deleted.Todo)
+	assert.Empty(t, deleted.Todo.Checklist)
+	assert.Greater(t, deleted.Todo.Version, added.Todo.Version)
+}
+
+func Test_CommentUsecase_shouldPublishUpdatedEventWithCommentCount_whenCommentIsPostedOrDeleted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := usecase.NewCommentUsecase(gateway.NewTodoCommentRepository(dbc.DB), broker)
+	createInput, err := domain.NewCreateTodoInput(userID, "commented")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	subscription := subscribeTestTodoEvents(t, broker, userID)
+	commentInput, err := domain.NewCreateCommentInput(created.ID, userID, "user1", "looks good")
+	require.NoError(t, err)
+
+	// when
+	output, err := uc.CreateComment(ctx, commentInput)
+	require.NoError(t, err)
+	deleteInput, err := domain.NewDeleteCommentInput(output.Comment.ID, created.ID, userID)
+	require.NoError(t, err)
+	err = uc.DeleteComment(ctx, deleteInput)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, subscription.Events, 2)
+	posted := <-subscription.Events
+	assert.Equal(t, domain.TodoEventUpdated, posted.Type)
+	require.NotNil(t, posted.Todo)
+	assert.Equal(t, 1, posted.Todo.CommentCount)
+	deleted := <-subscription.Events
+	require.NotNil(t, deleted.Todo)
+	assert.Equal(t, 0, deleted.Todo.CommentCount)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ArchivedTodosFinder defines the interface for fetching the archived todos of a user.
+type ArchivedTodosFinder interface {
+	FindArchivedTodos(ctx context.Context, userID int) ([]domain.Todo, error)
+}
+
+// FindArchivedTodosQuery fetches the archived todos of a specific user from the repository.
+type FindArchivedTodosQuery struct {
+	repo ArchivedTodosFinder
+}
+
+// NewFindArchivedTodosQuery returns a new FindArchivedTodosQuery.
+func NewFindArchivedTodosQuery(repo ArchivedTodosFinder) *FindArchivedTodosQuery {
+	return &FindArchivedTodosQuery{
+		repo: repo,
+	}
+}
+
+// Execute retrieves all archived todos for the given userID.
+func (q *FindArchivedTodosQuery) Execute(ctx context.Context, userID int) ([]domain.Todo, error) {
+	todos, err := q.repo.FindArchivedTodos(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find archived todos: %w", err)
+	}
+	return todos, nil
+}
@@ -0,0 +1,50 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindArchivedTodosQuery_Execute_shouldReturnArchivedTodosOfUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	archived := []domain.Todo{*newTestTodo(t, 1, 2, "done"), *newTestTodo(t, 3, 2, "also done")}
+	mockRepo := NewMockArchivedTodosFinder(t)
+	mockRepo.EXPECT().FindArchivedTodos(mock.Anything, 2).Return(archived, nil).Once()
+	query := usecase.NewFindArchivedTodosQuery(mockRepo)
+
+	// when
+	todos, err := query.Execute(ctx, 2)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, archived, todos)
+}
+
+func Test_FindArchivedTodosQuery_Execute_shouldReturnError_whenRepositoryFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	repoErr := errors.New("connection refused")
+	mockRepo := NewMockArchivedTodosFinder(t)
+	mockRepo.EXPECT().FindArchivedTodos(mock.Anything, 2).Return(nil, repoErr).Once()
+	query := usecase.NewFindArchivedTodosQuery(mockRepo)
+
+	// when
+	todos, err := query.Execute(ctx, 2)
+
+	// then
+	require.ErrorIs(t, err, repoErr)
+	assert.Nil(t, todos)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoHistoryFinder defines the interface for listing the revisions of a todo.
+type TodoHistoryFinder interface {
+	FindTodoHistory(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error)
+}
+
+// FindTodoHistoryQuery retrieves the revisions of a todo.
+type FindTodoHistoryQuery struct {
+	repo TodoHistoryFinder
+}
+
+// NewFindTodoHistoryQuery returns a new FindTodoHistoryQuery.
+func NewFindTodoHistoryQuery(repo TodoHistoryFinder) *FindTodoHistoryQuery {
+	return &FindTodoHistoryQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the revisions of the todo, oldest first. Returns ErrTodoNotFound if the user has no such todo outside the trash.
+func (q *FindTodoHistoryQuery) Execute(ctx context.Context, input *domain.FindTodoHistoryInput) ([]domain.TodoRevision, error) {
+	revisions, err := q.repo.FindTodoHistory(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find todo history: %w", err)
+	}
+	return revisions, nil
+}
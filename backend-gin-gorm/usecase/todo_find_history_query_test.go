@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindTodoHistoryQuery_Execute_shouldReturnRevisionsOldestFirst_whenTodoWasChanged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewFindTodoHistoryQuery(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "original")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, "original", true, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindTodoHistoryInput(created.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	revisions, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 1, revisions[0].Revision)
+	assert.Equal(t, domain.TodoRevisionCreated, revisions[0].Action)
+	assert.Equal(t, userID, revisions[0].UserID)
+	assert.Equal(t, 2, revisions[1].Revision)
+	assert.Equal(t, domain.TodoRevisionUpdated, revisions[1].Action)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldIsComplete}, revisions[1].ChangedFields, "only the completion was changed")
+	assert.True(t, revisions[1].Values.IsComplete)
+}
+
+func Test_FindTodoHistoryQuery_Execute_shouldReturnHistory_whenTodoIsSharedWithViewer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, viewerID, domain.TodoListViewer)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewFindTodoHistoryQuery(repo)
+
+	createInput, err := domain.NewCreateTodoInput(ownerID, "shared")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindTodoHistoryInput(created.ID, viewerID)
+	require.NoError(t, err)
+
+	// when
+	revisions, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, ownerID, revisions[0].UserID, "the revision should name the owner who created the todo")
+}
+
+func Test_FindTodoHistoryQuery_Execute_shouldReturnError_whenTodoOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewFindTodoHistoryQuery(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "private")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindTodoHistoryInput(created.ID, userID+1)
+	require.NoError(t, err)
+
+	// when
+	revisions, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, revisions)
+}
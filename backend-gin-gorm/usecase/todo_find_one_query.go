@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoByIDFinder defines the interface for fetching a single todo of a user.
+type TodoByIDFinder interface {
+	FindTodo(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error)
+}
+
+// FindTodoQuery fetches a single todo of a specific user from the repository.
+type FindTodoQuery struct {
+	repo TodoByIDFinder
+}
+
+// NewFindTodoQuery returns a new FindTodoQuery.
+func NewFindTodoQuery(repo TodoByIDFinder) *FindTodoQuery {
+	return &FindTodoQuery{
+		repo: repo,
+	}
+}
+
+// Execute retrieves the todo. Returns ErrTodoNotFound if the user has no such todo outside the trash.
+func (q *FindTodoQuery) Execute(ctx context.Context, input *domain.FindTodoInput) (*domain.Todo, error) {
+	todo, err := q.repo.FindTodo(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find todo: %w", err)
+	}
+	return todo, nil
+}
@@ -9,7 +9,7 @@ import (
 
 // TodoFinder defines the interface for fetching todos from the repository.
 type TodoFinder interface {
-	FindTodos(ctx context.Context, userID int) ([]domain.Todo, error)
+	FindTodos(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error)
 }
 
 // FindTodosQuery fetches todos for a specific user from the repository.
@@ -24,11 +24,11 @@ func NewFindTodosQuery(repo TodoFinder) *FindTodosQuery {
 	}
 }
 
-// Execute retrieves all todos for the given userID.
-func (q *FindTodosQuery) Execute(ctx context.Context, userID int) ([]domain.Todo, error) {
-	todos, err := q.repo.FindTodos(ctx, userID)
+// Execute retrieves one page of todos for the user in input.
+func (q *FindTodosQuery) Execute(ctx context.Context, input *domain.FindTodosInput) (*domain.TodoPage, error) {
+	page, err := q.repo.FindTodos(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("find todos: %w", err)
 	}
-	return todos, nil
+	return page, nil
 }
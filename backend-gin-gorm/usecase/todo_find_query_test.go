@@ -23,12 +23,16 @@ func Test_FindTodosQuery_Execute_shouldReturnEmptyList_whenNoTodosExist(t *testi
 	repo := gateway.NewTodoRepository(dbc.DB)
 	query := usecase.NewFindTodosQuery(repo)
 
+	findInput, err := domain.NewFindTodosInput(userID, domain.TodoFilter{}, domain.DefaultTodoSort, domain.DefaultTodoPageLimit, nil)
+	require.NoError(t, err)
+
 	// when
-	todos, err := query.Execute(ctx, userID)
+	page, err := query.Execute(ctx, findInput)
 
 	// then
 	require.NoError(t, err)
-	assert.Empty(t, todos)
+	assert.Empty(t, page.Todos)
+	assert.Nil(t, page.NextCursor)
 }
 
 func Test_FindTodosQuery_Execute_shouldReturnTodos_whenTodosExist(t *testing.T) {
@@ -48,11 +52,15 @@ func Test_FindTodosQuery_Execute_shouldReturnTodos_whenTodosExist(t *testing.T)
 		require.NoError(t, err)
 	}
 
+	findInput, err := domain.NewFindTodosInput(userID, domain.TodoFilter{}, domain.DefaultTodoSort, domain.DefaultTodoPageLimit, nil)
+	require.NoError(t, err)
+
 	// when
-	todos, err := query.Execute(ctx, userID)
+	page, err := query.Execute(ctx, findInput)
 
 	// then
 	require.NoError(t, err)
+	todos := page.Todos
 	assert.Len(t, todos, 3)
 	assert.Equal(t, "task1", todos[0].Text)
 	assert.Equal(t, "task2", todos[1].Text)
@@ -77,10 +85,59 @@ func Test_FindTodosQuery_Execute_shouldNotReturnOtherUserTodos(t *testing.T) {
 	_, err = repo.CreateTodo(ctx, input)
 	require.NoError(t, err)
 
+	findInput, err := domain.NewFindTodosInput(userID, domain.TodoFilter{}, domain.DefaultTodoSort, domain.DefaultTodoPageLimit, nil)
+	require.NoError(t, err)
+
 	// when
-	todos, err := query.Execute(ctx, userID)
+	page, err := query.Execute(ctx, findInput)
 
 	// then
 	require.NoError(t, err)
-	assert.Empty(t, todos)
+	assert.Empty(t, page.Todos)
+	assert.Nil(t, page.NextCursor)
+}
+
+func Test_FindTodosQuery_Execute_shouldWalkAllPages_whenTodosAreInsertedWhilePaging(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewFindTodosQuery(repo)
+
+	for _, text := range []string{"task1", "task2", "task3"} {
+		input, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		_, err = repo.CreateTodo(ctx, input)
+		require.NoError(t, err)
+	}
+
+	// when
+	firstInput, err := domain.NewFindTodosInput(userID, domain.TodoFilter{}, domain.DefaultTodoSort, 2, nil)
+	require.NoError(t, err)
+	first, err := query.Execute(ctx, firstInput)
+	require.NoError(t, err)
+	require.NotNil(t, first.NextCursor)
+
+	// ページング中に todo が追加されても次のページがずれないことを確認
+	createInput, err := domain.NewCreateTodoInput(userID, "task4")
+	require.NoError(t, err)
+	_, err = repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	secondInput, err := domain.NewFindTodosInput(userID, domain.TodoFilter{}, domain.DefaultTodoSort, 2, first.NextCursor)
+	require.NoError(t, err)
+	second, err := query.Execute(ctx, secondInput)
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, first.Todos, 2)
+	assert.Equal(t, "task1", first.Todos[0].Text)
+	assert.Equal(t, "task2", first.Todos[1].Text)
+	require.Len(t, second.Todos, 2)
+	assert.Equal(t, "task3", second.Todos[0].Text)
+	assert.Equal(t, "task4", second.Todos[1].Text)
+	assert.Nil(t, second.NextCursor, "the last page should not have a next cursor")
 }
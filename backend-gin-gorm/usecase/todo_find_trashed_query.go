@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TrashedTodosFinder defines the interface for fetching the todos in a user's trash.
+type TrashedTodosFinder interface {
+	FindTrashedTodos(ctx context.Context, userID int) ([]domain.Todo, error)
+}
+
+// FindTrashedTodosQuery fetches the trash of a specific user from the repository.
+type FindTrashedTodosQuery struct {
+	repo TrashedTodosFinder
+}
+
+// NewFindTrashedTodosQuery returns a new FindTrashedTodosQuery.
+func NewFindTrashedTodosQuery(repo TrashedTodosFinder) *FindTrashedTodosQuery {
+	return &FindTrashedTodosQuery{
+		repo: repo,
+	}
+}
+
+// Execute retrieves all trashed todos for the given userID.
+func (q *FindTrashedTodosQuery) Execute(ctx context.Context, userID int) ([]domain.Todo, error) {
+	todos, err := q.repo.FindTrashedTodos(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find trashed todos: %w", err)
+	}
+	return todos, nil
+}
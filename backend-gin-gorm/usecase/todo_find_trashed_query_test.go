@@ -0,0 +1,50 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindTrashedTodosQuery_Execute_shouldReturnTrashedTodosOfUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	trashed := []domain.Todo{*newTestTodo(t, 1, 2, "old"), *newTestTodo(t, 3, 2, "older")}
+	mockRepo := NewMockTrashedTodosFinder(t)
+	mockRepo.EXPECT().FindTrashedTodos(mock.Anything, 2).Return(trashed, nil).Once()
+	query := usecase.NewFindTrashedTodosQuery(mockRepo)
+
+	// when
+	todos, err := query.Execute(ctx, 2)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, trashed, todos)
+}
+
+func Test_FindTrashedTodosQuery_Execute_shouldReturnError_whenRepositoryFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	repoErr := errors.New("connection refused")
+	mockRepo := NewMockTrashedTodosFinder(t)
+	mockRepo.EXPECT().FindTrashedTodos(mock.Anything, 2).Return(nil, repoErr).Once()
+	query := usecase.NewFindTrashedTodosQuery(mockRepo)
+
+	// when
+	todos, err := query.Execute(ctx, 2)
+
+	// then
+	require.ErrorIs(t, err, repoErr)
+	assert.Nil(t, todos)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// FindTodoListQuery looks up a todo list a client can follow.
+type FindTodoListQuery struct {
+	viewRepo ViewFinder
+	listRepo TodoListRoleFinder
+}
+
+// NewFindTodoListQuery returns a new FindTodoListQuery.
+func NewFindTodoListQuery(viewRepo ViewFinder, listRepo TodoListRoleFinder) *FindTodoListQuery {
+	return &FindTodoListQuery{
+		viewRepo: viewRepo,
+		listRepo: listRepo,
+	}
+}
+
+// Execute returns the list of all todos of the user, the list of the view input.ViewID, or the todo list of
+// input.ListOwnerID shared with the user.
+// Returns ErrViewNotFound if the view does not exist for the user and ErrTodoListNotFound if the list is not shared with the user.
+func (q *FindTodoListQuery) Execute(ctx context.Context, input *domain.FindTodoListInput) (*domain.TodoList, error) {
+	if input.ListOwnerID != input.UserID {
+		if _, err := q.listRepo.FindTodoListRole(ctx, input.ListOwnerID, input.UserID); err != nil {
+			return nil, fmt.Errorf("find todo list role: %w", err)
+		}
+		return &domain.TodoList{ID: domain.TodoListIDOfSharedList(input.ListOwnerID), OwnerID: input.ListOwnerID, View: nil}, nil
+	}
+
+	if input.ViewID == 0 {
+		return &domain.TodoList{ID: domain.TodoListAll, OwnerID: input.UserID, View: nil}, nil
+	}
+
+	view, err := q.viewRepo.FindView(ctx, input.ViewID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find view: %w", err)
+	}
+	return &domain.TodoList{ID: domain.TodoListIDOfView(view.ID), OwnerID: input.UserID, View: view}, nil
+}
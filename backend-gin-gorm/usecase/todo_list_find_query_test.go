@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindTodoListQuery_Execute_shouldReturnSharedList_whenListIsSharedWithUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewFindTodoListInput("shared:7", 1)
+	require.NoError(t, err)
+	mockListRepo := NewMockTodoListRoleFinder(t)
+	mockListRepo.EXPECT().FindTodoListRole(mock.Anything, 7, 1).Return(domain.TodoListViewer, nil).Once()
+	query := usecase.NewFindTodoListQuery(NewMockViewFinder(t), mockListRepo)
+
+	// when
+	list, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "shared:7", list.ID)
+	assert.Equal(t, 7, list.OwnerID)
+	assert.Nil(t, list.View)
+}
+
+func Test_FindTodoListQuery_Execute_shouldReturnErrTodoListNotFound_whenListIsNotShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewFindTodoListInput("shared:7", 1)
+	require.NoError(t, err)
+	mockListRepo := NewMockTodoListRoleFinder(t)
+	mockListRepo.EXPECT().FindTodoListRole(mock.Anything, 7, 1).Return("", domain.ErrTodoListNotFound).Once()
+	query := usecase.NewFindTodoListQuery(NewMockViewFinder(t), mockListRepo)
+
+	// when
+	list, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoListNotFound)
+	assert.Nil(t, list)
+}
+
+func Test_FindTodoListQuery_Execute_shouldReturnListOfOwnTodos_whenListIsAll(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewFindTodoListInput(domain.TodoListAll, 1)
+	require.NoError(t, err)
+	query := usecase.NewFindTodoListQuery(NewMockViewFinder(t), NewMockTodoListRoleFinder(t))
+
+	// when
+	list, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, domain.TodoListAll, list.ID)
+	assert.Equal(t, 1, list.OwnerID)
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListInvitationAnswerer defines the interface for accepting and declining invitations to todo lists.
+// Implementations must return domain.ErrTodoListInvitationNotFound when the invitation is not addressed to the user
+// and domain.ErrOwnTodoList when the user accepts an invitation to their own list.
+type TodoListInvitationAnswerer interface {
+	AnswerTodoListInvitation(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error)
+}
+
+// AnswerTodoListInvitationCommand accepts or declines an invitation to a todo list.
+type AnswerTodoListInvitationCommand struct {
+	repo TodoListInvitationAnswerer
+}
+
+// NewAnswerTodoListInvitationCommand returns a new AnswerTodoListInvitationCommand.
+func NewAnswerTodoListInvitationCommand(repo TodoListInvitationAnswerer) *AnswerTodoListInvitationCommand {
+	return &AnswerTodoListInvitationCommand{
+		repo: repo,
+	}
+}
+
+// Execute answers the invitation and returns the membership an accept created, or nil for a decline.
+func (u *AnswerTodoListInvitationCommand) Execute(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error) {
+	member, err := u.repo.AnswerTodoListInvitation(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("answer todo list invitation: %w", err)
+	}
+	return member, nil
+}
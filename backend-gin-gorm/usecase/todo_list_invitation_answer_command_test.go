@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+// inviteTestTodoListMember invites the login ID of inviteeID to the todo list of ownerID with the given role.
+func inviteTestTodoListMember(t *testing.T, ctx context.Context, ownerID int, inviteeID int, role domain.TodoListRole) *domain.TodoListInvitation {
+	t.Helper()
+	input, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, "user"+strconv.Itoa(ownerID), "user"+strconv.Itoa(inviteeID), role)
+	require.NoError(t, err)
+	invitation, err := gateway.NewTodoListMemberRepository(dbc.DB).InviteTodoListMember(ctx, input)
+	require.NoError(t, err, "Failed to insert test data")
+	return invitation
+}
+
+func Test_AnswerTodoListInvitationCommand_Execute_shouldCreateMembership_whenInvitationIsAccepted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	inviteeID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(dbc.DB)
+	cmd := usecase.NewAnswerTodoListInvitationCommand(repo)
+	invitation := inviteTestTodoListMember(t, ctx, ownerID, inviteeID, domain.TodoListViewer)
+	input, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, inviteeID, "user"+strconv.Itoa(inviteeID), true)
+	require.NoError(t, err)
+
+	// when
+	member, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, member)
+	assert.Equal(t, ownerID, member.ListOwnerID)
+	assert.Equal(t, inviteeID, member.UserID)
+	assert.Equal(t, domain.TodoListViewer, member.Role)
+
+	// 招待は回答されると消える
+	invitations, err := repo.FindTodoListInvitations(ctx, "user"+strconv.Itoa(inviteeID))
+	require.NoError(t, err)
+	assert.Empty(t, invitations)
+}
+
+func Test_AnswerTodoListInvitationCommand_Execute_shouldNotShareList_whenInvitationIsDeclined(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	inviteeID := ownerID + 1
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	repo := gateway.NewTodoListMemberRepository(dbc.DB)
+	cmd := usecase.NewAnswerTodoListInvitationCommand(repo)
+	invitation := inviteTestTodoListMember(t, ctx, ownerID, inviteeID, domain.TodoListEditor)
+	input, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, inviteeID, "user"+strconv.Itoa(inviteeID), false)
+	require.NoError(t, err)
+
+	// when
+	member, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, member)
+	_, err = repo.FindTodoListRole(ctx, ownerID, inviteeID)
+	require.ErrorIs(t, err, domain.ErrTodoListNotFound)
+}
+
+func Test_AnswerTodoListInvitationCommand_Execute_shouldReturnError_whenInvitationIsNotForUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	inviteeID := ownerID + 1
+	otherUserID := ownerID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	cmd := usecase.NewAnswerTodoListInvitationCommand(gateway.NewTodoListMemberRepository(dbc.DB))
+	invitation := inviteTestTodoListMember(t, ctx, ownerID, inviteeID, domain.TodoListViewer)
+	input, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, otherUserID, "user"+strconv.Itoa(otherUserID), true)
+	require.NoError(t, err)
+
+	// when
+	member, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoListInvitationNotFound)
+	assert.Nil(t, member)
+}
+
+func Test_AnswerTodoListInvitationCommand_Execute_shouldReturnError_whenOwnerAcceptsInvitationToOwnList(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	coOwnerID := ownerID + 1
+
+	// given
+	// 共同オーナーはリストのオーナー本人の login ID も招待できてしまう
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, coOwnerID, domain.TodoListOwner)
+	repo := gateway.NewTodoListMemberRepository(dbc.DB)
+	cmd := usecase.NewAnswerTodoListInvitationCommand(repo)
+	inviteInput, err := domain.NewInviteTodoListMemberInput(ownerID, coOwnerID, "user"+strconv.Itoa(coOwnerID), "user"+strconv.Itoa(ownerID), domain.TodoListViewer)
+	require.NoError(t, err)
+	invitation, err := repo.InviteTodoListMember(ctx, inviteInput)
+	require.NoError(t, err)
+	input, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, ownerID, "user"+strconv.Itoa(ownerID), true)
+	require.NoError(t, err)
+
+	// when
+	member, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrOwnTodoList)
+	assert.Nil(t, member)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListInvitationsFinder defines the interface for listing the invitations addressed to a login ID.
+type TodoListInvitationsFinder interface {
+	FindTodoListInvitations(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error)
+}
+
+// FindTodoListInvitationsQuery retrieves the pending invitations of a user.
+type FindTodoListInvitationsQuery struct {
+	repo TodoListInvitationsFinder
+}
+
+// NewFindTodoListInvitationsQuery returns a new FindTodoListInvitationsQuery.
+func NewFindTodoListInvitationsQuery(repo TodoListInvitationsFinder) *FindTodoListInvitationsQuery {
+	return &FindTodoListInvitationsQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the invitations addressed to the login ID, oldest first.
+func (q *FindTodoListInvitationsQuery) Execute(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error) {
+	invitations, err := q.repo.FindTodoListInvitations(ctx, loginID)
+	if err != nil {
+		return nil, fmt.Errorf("find todo list invitations: %w", err)
+	}
+	return invitations, nil
+}
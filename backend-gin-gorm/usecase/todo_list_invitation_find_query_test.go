@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindTodoListInvitationsQuery_Execute_shouldReturnInvitationsOfLoginIDOldestFirst(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	inviteeID := rand.Intn(1000000) + 1 //nolint:gosec
+	firstOwnerID := inviteeID + 1
+	secondOwnerID := inviteeID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, firstOwnerID)
+	cleanupTodoListMemberTable(t, secondOwnerID)
+	query := usecase.NewFindTodoListInvitationsQuery(gateway.NewTodoListMemberRepository(dbc.DB))
+	first := inviteTestTodoListMember(t, ctx, firstOwnerID, inviteeID, domain.TodoListViewer)
+	second := inviteTestTodoListMember(t, ctx, secondOwnerID, inviteeID, domain.TodoListEditor)
+	inviteTestTodoListMember(t, ctx, firstOwnerID, inviteeID+3, domain.TodoListViewer)
+
+	// when
+	invitations, err := query.Execute(ctx, "user"+strconv.Itoa(inviteeID))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, invitations, 2, "invitations to other login IDs should not be returned")
+	assert.Equal(t, first.ID, invitations[0].ID)
+	assert.Equal(t, firstOwnerID, invitations[0].ListOwnerID)
+	assert.Equal(t, second.ID, invitations[1].ID)
+	assert.Equal(t, domain.TodoListEditor, invitations[1].Role)
+}
+
+func Test_FindTodoListInvitationsQuery_Execute_shouldReturnEmpty_whenNoInvitations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	query := usecase.NewFindTodoListInvitationsQuery(gateway.NewTodoListMemberRepository(dbc.DB))
+
+	// when
+	invitations, err := query.Execute(ctx, "nobody"+strconv.Itoa(userID))
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, invitations)
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListMemberInviter defines the interface for persisting invitations to todo lists.
+// Implementations must return domain.ErrTodoListNotFound when the list is not shared with the user
+// and domain.ErrForbidden when the user is not an owner of it.
+type TodoListMemberInviter interface {
+	InviteTodoListMember(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.TodoListInvitation, error)
+}
+
+// InviteTodoListMemberCommand invites a login ID to a todo list.
+type InviteTodoListMemberCommand struct {
+	repo TodoListMemberInviter
+}
+
+// NewInviteTodoListMemberCommand returns a new InviteTodoListMemberCommand.
+func NewInviteTodoListMemberCommand(repo TodoListMemberInviter) *InviteTodoListMemberCommand {
+	return &InviteTodoListMemberCommand{
+		repo: repo,
+	}
+}
+
+// Execute creates or renews the invitation and returns it.
+func (u *InviteTodoListMemberCommand) Execute(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.InviteTodoListMemberOutput, error) {
+	invitation, err := u.repo.InviteTodoListMember(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("invite todo list member: %w", err)
+	}
+
+	output, err := domain.NewInviteTodoListMemberOutput(invitation)
+	if err != nil {
+		return nil, fmt.Errorf("create invite todo list member output: %w", err)
+	}
+
+	return output, nil
+}
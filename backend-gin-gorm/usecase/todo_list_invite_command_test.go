@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_InviteTodoListMemberCommand_Execute_shouldCreateInvitation_whenUserOwnsList(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	inviteeLoginID := "user" + strconv.Itoa(ownerID+1)
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	cmd := usecase.NewInviteTodoListMemberCommand(gateway.NewTodoListMemberRepository(dbc.DB))
+	input, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, "user"+strconv.Itoa(ownerID), inviteeLoginID, domain.TodoListEditor)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, output.Invitation)
+	assert.Positive(t, output.Invitation.ID)
+	assert.Equal(t, ownerID, output.Invitation.ListOwnerID)
+	assert.Equal(t, inviteeLoginID, output.Invitation.InviteeLoginID)
+	assert.Equal(t, domain.TodoListEditor, output.Invitation.Role)
+	assert.Equal(t, ownerID, output.Invitation.InviterUserID)
+}
+
+func Test_InviteTodoListMemberCommand_Execute_shouldReturnError_whenUserMayNotInvite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+	strangerID := ownerID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	cmd := usecase.NewInviteTodoListMemberCommand(gateway.NewTodoListMemberRepository(dbc.DB))
+
+	tests := []struct {
+		name    string
+		userID  int
+		wantErr error
+	}{
+		{name: "editor", userID: editorID, wantErr: domain.ErrForbidden},
+		{name: "not a member", userID: strangerID, wantErr: domain.ErrTodoListNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			input, err := domain.NewInviteTodoListMemberInput(ownerID, tt.userID, "user"+strconv.Itoa(tt.userID), "user"+strconv.Itoa(ownerID+3), domain.TodoListViewer)
+			require.NoError(t, err)
+
+			// when
+			output, err := cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, output)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListMembersFinder defines the interface for listing the members of a todo list.
+// Implementations must return domain.ErrTodoListNotFound when the list is not shared with the user.
+type TodoListMembersFinder interface {
+	FindTodoListMembers(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error)
+}
+
+// FindTodoListMembersQuery retrieves the members of a todo list.
+type FindTodoListMembersQuery struct {
+	repo TodoListMembersFinder
+}
+
+// NewFindTodoListMembersQuery returns a new FindTodoListMembersQuery.
+func NewFindTodoListMembersQuery(repo TodoListMembersFinder) *FindTodoListMembersQuery {
+	return &FindTodoListMembersQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the members of the list in the order they joined.
+func (q *FindTodoListMembersQuery) Execute(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error) {
+	members, err := q.repo.FindTodoListMembers(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find todo list members: %w", err)
+	}
+	return members, nil
+}
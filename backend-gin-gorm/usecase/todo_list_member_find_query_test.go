@@ -0,0 +1,61 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindTodoListMembersQuery_Execute_shouldReturnMembersInJoinOrder_whenUserIsMember(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+	viewerID := ownerID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	shareTestTodoList(t, ctx, ownerID, viewerID, domain.TodoListViewer)
+	query := usecase.NewFindTodoListMembersQuery(gateway.NewTodoListMemberRepository(dbc.DB))
+	input, err := domain.NewFindTodoListMembersInput(ownerID, viewerID)
+	require.NoError(t, err)
+
+	// when
+	members, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, editorID, members[0].UserID)
+	assert.Equal(t, domain.TodoListEditor, members[0].Role)
+	assert.Equal(t, viewerID, members[1].UserID)
+	assert.Equal(t, domain.TodoListViewer, members[1].Role)
+}
+
+func Test_FindTodoListMembersQuery_Execute_shouldReturnError_whenListIsNotShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, ownerID+1, domain.TodoListViewer)
+	query := usecase.NewFindTodoListMembersQuery(gateway.NewTodoListMemberRepository(dbc.DB))
+	input, err := domain.NewFindTodoListMembersInput(ownerID, ownerID+2)
+	require.NoError(t, err)
+
+	// when
+	members, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoListNotFound)
+	assert.Nil(t, members)
+}
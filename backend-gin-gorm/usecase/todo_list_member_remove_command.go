@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListMemberRemover defines the interface for removing members from todo lists.
+// Implementations must return domain.ErrTodoListNotFound when the list is not shared with the user,
+// domain.ErrForbidden when the user may not remove the member and domain.ErrTodoListMemberNotFound when there is no such member.
+type TodoListMemberRemover interface {
+	RemoveTodoListMember(ctx context.Context, input *domain.RemoveTodoListMemberInput) error
+}
+
+// RemoveTodoListMemberCommand removes a member from a todo list.
+type RemoveTodoListMemberCommand struct {
+	repo TodoListMemberRemover
+}
+
+// NewRemoveTodoListMemberCommand returns a new RemoveTodoListMemberCommand.
+func NewRemoveTodoListMemberCommand(repo TodoListMemberRemover) *RemoveTodoListMemberCommand {
+	return &RemoveTodoListMemberCommand{
+		repo: repo,
+	}
+}
+
+// Execute removes the member. The todos of the list are not affected.
+func (u *RemoveTodoListMemberCommand) Execute(ctx context.Context, input *domain.RemoveTodoListMemberInput) error {
+	if err := u.repo.RemoveTodoListMember(ctx, input); err != nil {
+		return fmt.Errorf("remove todo list member: %w", err)
+	}
+
+	return nil
+}
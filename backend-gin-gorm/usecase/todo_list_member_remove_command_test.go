@@ -0,0 +1,93 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_RemoveTodoListMemberCommand_Execute_shouldRemoveMember_whenAllowed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		byUser func(ownerID int, memberID int) int
+	}{
+		{name: "owner removes member", byUser: func(ownerID int, _ int) int { return ownerID }},
+		{name: "member leaves", byUser: func(_ int, memberID int) int { return memberID }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+			memberID := ownerID + 1
+
+			// given
+			cleanupTodoListMemberTable(t, ownerID)
+			shareTestTodoList(t, ctx, ownerID, memberID, domain.TodoListEditor)
+			repo := gateway.NewTodoListMemberRepository(dbc.DB)
+			cmd := usecase.NewRemoveTodoListMemberCommand(repo)
+			input, err := domain.NewRemoveTodoListMemberInput(ownerID, tt.byUser(ownerID, memberID), memberID)
+			require.NoError(t, err)
+
+			// when
+			err = cmd.Execute(ctx, input)
+
+			// then
+			require.NoError(t, err)
+			_, err = repo.FindTodoListRole(ctx, ownerID, memberID)
+			require.ErrorIs(t, err, domain.ErrTodoListNotFound, "the list should no longer be shared with the member")
+		})
+	}
+}
+
+func Test_RemoveTodoListMemberCommand_Execute_shouldReturnError_whenNotAllowed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+	viewerID := ownerID + 2
+	strangerID := ownerID + 3
+
+	// given
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	shareTestTodoList(t, ctx, ownerID, viewerID, domain.TodoListViewer)
+	repo := gateway.NewTodoListMemberRepository(dbc.DB)
+	cmd := usecase.NewRemoveTodoListMemberCommand(repo)
+
+	tests := []struct {
+		name         string
+		userID       int
+		memberUserID int
+		wantErr      error
+	}{
+		{name: "editor removes other member", userID: editorID, memberUserID: viewerID, wantErr: domain.ErrForbidden},
+		{name: "not a member", userID: strangerID, memberUserID: viewerID, wantErr: domain.ErrTodoListNotFound},
+		{name: "no such member", userID: ownerID, memberUserID: strangerID, wantErr: domain.ErrTodoListMemberNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			input, err := domain.NewRemoveTodoListMemberInput(ownerID, tt.userID, tt.memberUserID)
+			require.NoError(t, err)
+
+			// when
+			err = cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.wantErr)
+			role, err := repo.FindTodoListRole(ctx, ownerID, viewerID)
+			require.NoError(t, err)
+			assert.Equal(t, domain.TodoListViewer, role, "a refused removal should keep the member")
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListMemberRepository composes all todo list sharing persistence interfaces.
+type TodoListMemberRepository interface {
+	TodoListRoleFinder
+	TodoListMemberInviter
+	TodoListInvitationsFinder
+	TodoListInvitationAnswerer
+	TodoListMembersFinder
+	TodoListMemberRemover
+	SharedTodoListsFinder
+}
+
+// TodoListShareUsecase orchestrates the sharing of todo lists with other users via command/query objects.
+type TodoListShareUsecase struct {
+	inviteTodoListMemberCommand     *InviteTodoListMemberCommand
+	findTodoListInvitationsQuery    *FindTodoListInvitationsQuery
+	answerTodoListInvitationCommand *AnswerTodoListInvitationCommand
+	findTodoListMembersQuery        *FindTodoListMembersQuery
+	removeTodoListMemberCommand     *RemoveTodoListMemberCommand
+	findSharedTodoListsQuery        *FindSharedTodoListsQuery
+	findTodoListTodosQuery          *FindTodoListTodosQuery
+	logger                          *slog.Logger
+}
+
+// NewTodoListShareUsecase returns a new TodoListShareUsecase wired with the given member and todo repositories.
+func NewTodoListShareUsecase(repo TodoListMemberRepository, todoRepo TodoFinder) *TodoListShareUsecase {
+	return &TodoListShareUsecase{
+		inviteTodoListMemberCommand:     NewInviteTodoListMemberCommand(repo),
+		findTodoListInvitationsQuery:    NewFindTodoListInvitationsQuery(repo),
+		answerTodoListInvitationCommand: NewAnswerTodoListInvitationCommand(repo),
+		findTodoListMembersQuery:        NewFindTodoListMembersQuery(repo),
+		removeTodoListMemberCommand:     NewRemoveTodoListMemberCommand(repo),
+		findSharedTodoListsQuery:        NewFindSharedTodoListsQuery(repo),
+		findTodoListTodosQuery:          NewFindTodoListTodosQuery(repo, todoRepo),
+		logger:                          slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-TodoListShareUsecase")),
+	}
+}
+
+// InviteTodoListMember invites a login ID to a todo list the user owns or co-owns.
+func (u *TodoListShareUsecase) InviteTodoListMember(ctx context.Context, input *domain.InviteTodoListMemberInput) (*domain.InviteTodoListMemberOutput, error) {
+	output, err := u.inviteTodoListMemberCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute invite todo list member command: %w", err)
+	}
+	return output, nil
+}
+
+// FindTodoListInvitations returns the pending invitations addressed to the login ID of the user.
+func (u *TodoListShareUsecase) FindTodoListInvitations(ctx context.Context, loginID string) ([]domain.TodoListInvitation, error) {
+	invitations, err := u.findTodoListInvitationsQuery.Execute(ctx, loginID)
+	if err != nil {
+		return nil, fmt.Errorf("execute find todo list invitations query: %w", err)
+	}
+	return invitations, nil
+}
+
+// AnswerTodoListInvitation accepts or declines an invitation of the user. Accepting returns the membership, declining nil.
+func (u *TodoListShareUsecase) AnswerTodoListInvitation(ctx context.Context, input *domain.AnswerTodoListInvitationInput) (*domain.TodoListMember, error) {
+	member, err := u.answerTodoListInvitationCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute answer todo list invitation command: %w", err)
+	}
+	return member, nil
+}
+
+// FindTodoListMembers returns the members of a todo list shared with the user.
+func (u *TodoListShareUsecase) FindTodoListMembers(ctx context.Context, input *domain.FindTodoListMembersInput) ([]domain.TodoListMember, error) {
+	members, err := u.findTodoListMembersQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find todo list members query: %w", err)
+	}
+	return members, nil
+}
+
+// RemoveTodoListMember removes a member from a todo list, or the user from a list they leave.
+func (u *TodoListShareUsecase) RemoveTodoListMember(ctx context.Context, input *domain.RemoveTodoListMemberInput) error {
+	if err := u.removeTodoListMemberCommand.Execute(ctx, input); err != nil {
+		return fmt.Errorf("execute remove todo list member command: %w", err)
+	}
+	return nil
+}
+
+// FindSharedTodoLists returns the memberships of the user in the todo lists of others.
+func (u *TodoListShareUsecase) FindSharedTodoLists(ctx context.Context, userID int) ([]domain.TodoListMember, error) {
+	members, err := u.findSharedTodoListsQuery.Execute(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute find shared todo lists query: %w", err)
+	}
+	return members, nil
+}
+
+// FindTodoListTodos returns one page of the unarchived todos of a todo list shared with the user.
+func (u *TodoListShareUsecase) FindTodoListTodos(ctx context.Context, input *domain.FindTodoListTodosInput) (*domain.TodoPage, error) {
+	ctx, span := tracer.Start(ctx, "FindTodoListTodos")
+	defer span.End()
+
+	page, err := u.findTodoListTodosQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find todo list todos query: %w", err)
+	}
+	return page, nil
+}
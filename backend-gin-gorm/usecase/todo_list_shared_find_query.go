@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// SharedTodoListsFinder defines the interface for listing the memberships of a user in the todo lists of others.
+type SharedTodoListsFinder interface {
+	FindSharedTodoLists(ctx context.Context, userID int) ([]domain.TodoListMember, error)
+}
+
+// FindSharedTodoListsQuery retrieves the todo lists shared with a user.
+type FindSharedTodoListsQuery struct {
+	repo SharedTodoListsFinder
+}
+
+// NewFindSharedTodoListsQuery returns a new FindSharedTodoListsQuery.
+func NewFindSharedTodoListsQuery(repo SharedTodoListsFinder) *FindSharedTodoListsQuery {
+	return &FindSharedTodoListsQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns one membership of the user for each todo list shared with them, in the order they joined.
+func (q *FindSharedTodoListsQuery) Execute(ctx context.Context, userID int) ([]domain.TodoListMember, error) {
+	members, err := q.repo.FindSharedTodoLists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find shared todo lists: %w", err)
+	}
+	return members, nil
+}
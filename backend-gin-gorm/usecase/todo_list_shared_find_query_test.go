@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindSharedTodoListsQuery_Execute_shouldReturnMembershipsOfUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+	firstOwnerID := userID + 1
+	secondOwnerID := userID + 2
+
+	// given
+	cleanupTodoListMemberTable(t, userID)
+	cleanupTodoListMemberTable(t, firstOwnerID)
+	cleanupTodoListMemberTable(t, secondOwnerID)
+	shareTestTodoList(t, ctx, firstOwnerID, userID, domain.TodoListViewer)
+	shareTestTodoList(t, ctx, secondOwnerID, userID, domain.TodoListEditor)
+	// 自分のリストを他人に共有していても、自分に共有されたリストには含まれない
+	shareTestTodoList(t, ctx, userID, firstOwnerID, domain.TodoListViewer)
+	query := usecase.NewFindSharedTodoListsQuery(gateway.NewTodoListMemberRepository(dbc.DB))
+
+	// when
+	members, err := query.Execute(ctx, userID)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, firstOwnerID, members[0].ListOwnerID)
+	assert.Equal(t, domain.TodoListViewer, members[0].Role)
+	assert.Equal(t, secondOwnerID, members[1].ListOwnerID)
+	assert.Equal(t, domain.TodoListEditor, members[1].Role)
+	for _, member := range members {
+		assert.Equal(t, userID, member.UserID)
+	}
+}
+
+func Test_FindSharedTodoListsQuery_Execute_shouldReturnEmpty_whenNothingIsShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	query := usecase.NewFindSharedTodoListsQuery(gateway.NewTodoListMemberRepository(dbc.DB))
+
+	// when
+	members, err := query.Execute(ctx, userID+1000000)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, members)
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoListRoleFinder defines the interface for looking up the role of a user in a todo list.
+// Implementations must return domain.ErrTodoListNotFound when the list is not shared with the user.
+type TodoListRoleFinder interface {
+	FindTodoListRole(ctx context.Context, listOwnerID int, userID int) (domain.TodoListRole, error)
+}
+
+// FindTodoListTodosQuery lists the todos of a todo list shared with a user.
+type FindTodoListTodosQuery struct {
+	repo     TodoListRoleFinder
+	todoRepo TodoFinder
+}
+
+// NewFindTodoListTodosQuery returns a new FindTodoListTodosQuery.
+func NewFindTodoListTodosQuery(repo TodoListRoleFinder, todoRepo TodoFinder) *FindTodoListTodosQuery {
+	return &FindTodoListTodosQuery{
+		repo:     repo,
+		todoRepo: todoRepo,
+	}
+}
+
+// Execute checks that the list is shared with the user and returns one page of its unarchived todos in the default order.
+// Returns an error wrapping ErrInvalidCursor if the cursor was issued for a different sort.
+func (q *FindTodoListTodosQuery) Execute(ctx context.Context, input *domain.FindTodoListTodosInput) (*domain.TodoPage, error) {
+	if _, err := q.repo.FindTodoListRole(ctx, input.ListOwnerID, input.UserID); err != nil {
+		return nil, fmt.Errorf("find todo list role: %w", err)
+	}
+
+	findInput, err := domain.NewFindTodosInput(input.ListOwnerID, domain.TodoFilter{}, domain.DefaultTodoSort, input.Limit, input.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("new find todos input: %w", err)
+	}
+
+	page, err := q.todoRepo.FindTodos(ctx, findInput)
+	if err != nil {
+		return nil, fmt.Errorf("find todos: %w", err)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func cleanupTodoListMemberTable(t *testing.T, listOwnerID int) {
+	t.Helper()
+	if err := dbc.DB.Exec("DELETE FROM todo_list_member WHERE list_owner_id = ?", listOwnerID).Error; err != nil {
+		t.Fatalf("Failed to delete from table todo_list_member: %v", err)
+	}
+	if err := dbc.DB.Exec("DELETE FROM todo_list_invitation WHERE list_owner_id = ?", listOwnerID).Error; err != nil {
+		t.Fatalf("Failed to delete from table todo_list_invitation: %v", err)
+	}
+}
+
+func Test_FindTodoListTodosQuery_Execute_shouldReturnOwnerTodos_whenListIsSharedWithUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	memberID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	memberRepo := gateway.NewTodoListMemberRepository(dbc.DB)
+	query := usecase.NewFindTodoListTodosQuery(memberRepo, todoRepo)
+
+	for _, text := range []string{"task 1", "task 2"} {
+		input, err := domain.NewCreateTodoInput(ownerID, text)
+		require.NoError(t, err)
+		_, err = todoRepo.CreateTodo(ctx, input)
+		require.NoError(t, err)
+	}
+	inviteInput, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, "user"+strconv.Itoa(ownerID), "user"+strconv.Itoa(memberID), domain.TodoListViewer)
+	require.NoError(t, err)
+	invitation, err := memberRepo.InviteTodoListMember(ctx, inviteInput)
+	require.NoError(t, err)
+	answerInput, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, memberID, "user"+strconv.Itoa(memberID), true)
+	require.NoError(t, err)
+	_, err = memberRepo.AnswerTodoListInvitation(ctx, answerInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindTodoListTodosInput(ownerID, memberID, domain.MaxTodoPageLimit, nil)
+	require.NoError(t, err)
+
+	// when
+	page, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	texts := make([]string, 0, len(page.Todos))
+	for _, todo := range page.Todos {
+		texts = append(texts, todo.Text)
+	}
+	assert.Equal(t, []string{"task 1", "task 2"}, texts)
+}
+
+func Test_FindTodoListTodosQuery_Execute_shouldReturnTodoListNotFound_whenListIsNotShared(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	query := usecase.NewFindTodoListTodosQuery(gateway.NewTodoListMemberRepository(dbc.DB), gateway.NewTodoRepository(dbc.DB))
+	input, err := domain.NewFindTodoListTodosInput(ownerID, ownerID+1, domain.MaxTodoPageLimit, nil)
+	require.NoError(t, err)
+
+	// when
+	page, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoListNotFound)
+	assert.Nil(t, page)
+}
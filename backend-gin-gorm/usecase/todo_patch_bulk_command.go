@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoBulkCommandTxManager manages database transactions for all-or-nothing bulk todo updates and deletes.
+// todoIDs lists the todos fn writes, so that implementations can prepare for writing all of them before fn runs.
+type TodoBulkCommandTxManager interface {
+	WithTransaction(ctx context.Context, todoIDs []int, fn func(patchTodo domain.PatchTodoFunc, deleteTodo domain.DeleteTodoFunc) error) error
+}
+
+// PatchBulkTodosCommand applies the same partial update to several todos.
+type PatchBulkTodosCommand struct {
+	repo      TodoPatcher
+	txManager TodoBulkCommandTxManager
+}
+
+// NewPatchBulkTodosCommand returns a new PatchBulkTodosCommand.
+func NewPatchBulkTodosCommand(repo TodoPatcher, txManager TodoBulkCommandTxManager) *PatchBulkTodosCommand {
+	return &PatchBulkTodosCommand{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+// Execute patches the todos in input.IDs.
+// In BulkModeAllOrNothing the todos are patched in a single transaction that is rolled back when a todo fails,
+// and a *BulkTodoError naming that todo is returned. In BulkModeBestEffort each todo is patched on its own
+// and failures are reported in the per-todo results.
+func (u *PatchBulkTodosCommand) Execute(ctx context.Context, input *domain.PatchBulkTodosInput) (*domain.BulkTodosOutput, error) {
+	var results []domain.BulkTodoResult
+	if input.Mode == domain.BulkModeAllOrNothing {
+		err := u.txManager.WithTransaction(ctx, input.IDs, func(patchTodo domain.PatchTodoFunc, _ domain.DeleteTodoFunc) error {
+			results = patchTodos(ctx, input, patchTodo)
+			for _, result := range results {
+				if result.Err != nil {
+					return &domain.BulkTodoError{ID: result.ID, Err: result.Err}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("do in transaction: %w", err)
+		}
+	} else {
+		results = patchTodos(ctx, input, u.repo.PatchTodo)
+	}
+
+	output, err := domain.NewBulkTodosOutput(results)
+	if err != nil {
+		return nil, fmt.Errorf("create bulk todos output: %w", err)
+	}
+	return output, nil
+}
+
+// patchTodos patches each todo in input.IDs, stopping at the first failure in BulkModeAllOrNothing.
+func patchTodos(ctx context.Context, input *domain.PatchBulkTodosInput, patchTodo domain.PatchTodoFunc) []domain.BulkTodoResult {
+	results := make([]domain.BulkTodoResult, 0, len(input.IDs))
+	for _, id := range input.IDs {
+		todo, err := patchTodo(ctx, input.PatchTodoInput(id))
+		results = append(results, domain.BulkTodoResult{ID: id, Todo: todo, OwnerID: 0, Err: err})
+		if err != nil && input.Mode == domain.BulkModeAllOrNothing {
+			break
+		}
+	}
+	return results
+}
@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_PatchBulkTodosCommand_Execute_shouldRollbackAll_whenAllOrNothingTodoFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewPatchBulkTodosCommand(repo, gateway.NewTodoBulkCommandTxManager(dbc))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "task1")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	isComplete := true
+	input, err := domain.NewPatchBulkTodosInput(userID, []int{created.ID, 999999999}, nil, &isComplete, domain.BulkModeAllOrNothing)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	var bulkErr *domain.BulkTodoError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Equal(t, 999999999, bulkErr.ID)
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+
+	// 1件目もロールバックされていることを確認
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.False(t, todos[0].IsComplete)
+}
+
+func Test_PatchBulkTodosCommand_Execute_shouldPatchOtherTodos_whenBestEffortTodoFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewPatchBulkTodosCommand(repo, gateway.NewTodoBulkCommandTxManager(dbc))
+
+	createInput, err := domain.NewCreateTodoInput(userID, "task1")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	isComplete := true
+	input, err := domain.NewPatchBulkTodosInput(userID, []int{999999999, created.ID}, nil, &isComplete, domain.BulkModeBestEffort)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Results, 2)
+	require.ErrorIs(t, output.Results[0].Err, domain.ErrTodoNotFound)
+	require.NoError(t, output.Results[1].Err)
+	assert.True(t, output.Results[1].Todo.IsComplete)
+
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.True(t, todos[0].IsComplete)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoPatcher defines the interface for partially updating todos in the repository.
+type TodoPatcher interface {
+	PatchTodo(ctx context.Context, input *domain.PatchTodoInput) (*domain.Todo, error)
+}
+
+// PatchTodoCommand updates the provided fields of an existing todo item in the repository.
+type PatchTodoCommand struct {
+	repo TodoPatcher
+}
+
+// NewPatchTodoCommand returns a new PatchTodoCommand.
+func NewPatchTodoCommand(repo TodoPatcher) *PatchTodoCommand {
+	return &PatchTodoCommand{
+		repo: repo,
+	}
+}
+
+// Execute patches the todo and returns the updated result.
+func (u *PatchTodoCommand) Execute(ctx context.Context, input *domain.PatchTodoInput) (*domain.UpdateTodoOutput, error) {
+	todo, err := u.repo.PatchTodo(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("patch todo: %w", err)
+	}
+
+	output, err := domain.NewUpdateTodoOutput(todo)
+	if err != nil {
+		return nil, fmt.Errorf("create updated todo output: %w", err)
+	}
+
+	return output, nil
+}
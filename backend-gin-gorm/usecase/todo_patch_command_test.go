@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_PatchTodoCommand_Execute_shouldReturnPatchedTodo_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	isComplete := true
+	input, err := domain.NewPatchTodoInput(1, 2, nil, &isComplete, nil)
+	require.NoError(t, err)
+	patched := newTestTodo(t, 1, 2, "unchanged text")
+	patched.IsComplete = true
+	mockRepo := NewMockTodoPatcher(t)
+	mockRepo.EXPECT().PatchTodo(mock.Anything, input).Return(patched, nil).Once()
+	cmd := usecase.NewPatchTodoCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "unchanged text", output.Todo.Text)
+	assert.True(t, output.Todo.IsComplete)
+}
+
+func Test_PatchTodoCommand_Execute_shouldReturnError_whenPatchIsRejected(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	text := "new text"
+	expectedVersion := 3
+	current := newTestTodo(t, 1, 2, "text")
+	current.Version = 4
+	tests := []struct {
+		name    string
+		repoErr error
+	}{
+		{
+			name:    "todo not found",
+			repoErr: domain.ErrTodoNotFound,
+		},
+		{
+			name:    "viewer of a shared list",
+			repoErr: domain.ErrForbidden,
+		},
+		{
+			name:    "stale version",
+			repoErr: &domain.TodoVersionMismatchError{Current: current},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			input, err := domain.NewPatchTodoInput(1, 2, &text, nil, &expectedVersion)
+			require.NoError(t, err)
+			mockRepo := NewMockTodoPatcher(t)
+			mockRepo.EXPECT().PatchTodo(mock.Anything, input).Return(nil, tt.repoErr).Once()
+			cmd := usecase.NewPatchTodoCommand(mockRepo)
+
+			// when
+			output, err := cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.repoErr)
+			assert.Nil(t, output)
+		})
+	}
+}
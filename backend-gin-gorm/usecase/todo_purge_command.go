@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoPurger defines the interface for permanently deleting todos that have been in the trash too long.
+type TodoPurger interface {
+	PurgeTodos(ctx context.Context, input *domain.PurgeTodosInput) (*domain.PurgedTodos, error)
+}
+
+// PurgeTodosCommand permanently deletes trashed todos along with their attachment blobs.
+type PurgeTodosCommand struct {
+	repo      TodoPurger
+	blobStore BlobDeleter
+	logger    *slog.Logger
+}
+
+// NewPurgeTodosCommand returns a new PurgeTodosCommand.
+func NewPurgeTodosCommand(repo TodoPurger, blobStore BlobDeleter) *PurgeTodosCommand {
+	return &PurgeTodosCommand{
+		repo:      repo,
+		blobStore: blobStore,
+		logger:    slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-PurgeTodosCommand")),
+	}
+}
+
+// Execute purges batches of trashed todos until none older than input.DeletedBefore remain or the context is canceled.
+// The blobs are removed after each batch is committed and failures are only logged.
+func (u *PurgeTodosCommand) Execute(ctx context.Context, input *domain.PurgeTodosInput) (*domain.PurgeTodosOutput, error) {
+	purgedCount := 0
+	for ctx.Err() == nil {
+		purged, err := u.repo.PurgeTodos(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("purge todos: %w", err)
+		}
+		purgedCount += len(purged.TodoIDs)
+
+		for _, storageKey := range purged.AttachmentStorageKeys {
+			if err := u.blobStore.Delete(ctx, storageKey); err != nil && !errors.Is(err, domain.ErrBlobNotFound) {
+				u.logger.WarnContext(ctx, "failed to delete attachment blob", slog.String("storageKey", storageKey), slog.Any("error", err))
+			}
+		}
+
+		if len(purged.TodoIDs) < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewPurgeTodosOutput(purgedCount)
+	if err != nil {
+		return nil, fmt.Errorf("create purge todos output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+// purgeCutoff is far enough in the past that only todos backdated by backdateTrashedTodo are purged,
+// so purge tests do not remove todos trashed by tests running in parallel.
+var purgeCutoff = time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func backdateTrashedTodo(t *testing.T, todoID int) {
+	t.Helper()
+	if err := dbc.DB.Exec("UPDATE todo SET deleted_at = ? WHERE id = ?", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), todoID).Error; err != nil {
+		t.Fatalf("Failed to backdate todo: %v", err)
+	}
+}
+
+func Test_PurgeTodosCommand_Execute_shouldDeleteTodoAndAttachmentBlobs_whenRetentionHasPassed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	attachmentRepo := gateway.NewTodoAttachmentRepository(dbc.DB)
+	blobStore := newTestBlobStore(t)
+	cmd := usecase.NewPurgeTodosCommand(repo, blobStore)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "with attachment")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	upload := usecase.NewUploadAttachmentCommand(attachmentRepo, blobStore, newTestAttachmentPolicy(t))
+	uploadInput, err := domain.NewUploadAttachmentInput(created.ID, userID, "note.txt", "text/plain", 4, bytes.NewReader([]byte("note")))
+	require.NoError(t, err)
+	uploaded, err := upload.Execute(ctx, uploadInput)
+	require.NoError(t, err)
+
+	deleteInput, err := domain.NewDeleteTodoInput(created.ID, userID, nil)
+	require.NoError(t, err)
+	_, err = repo.DeleteTodo(ctx, deleteInput)
+	require.NoError(t, err)
+	backdateTrashedTodo(t, created.ID)
+
+	// ゴミ箱に入った直後は blob が残っていることを確認
+	_, err = blobStore.Get(ctx, uploaded.Attachment.StorageKey)
+	require.NoError(t, err)
+
+	input, err := domain.NewPurgeTodosInput(purgeCutoff, 1)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, output.PurgedCount, 1)
+
+	// ゴミ箱からも削除されていることを確認
+	trashed, err := repo.FindTrashedTodos(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, trashed)
+
+	// blob も削除されていることを確認
+	_, err = blobStore.Get(ctx, uploaded.Attachment.StorageKey)
+	require.ErrorIs(t, err, domain.ErrBlobNotFound)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoRestorer defines the interface for moving todos out of the trash.
+type TodoRestorer interface {
+	RestoreTodo(ctx context.Context, input *domain.RestoreTodoInput) (*domain.Todo, error)
+}
+
+// RestoreTodoCommand moves a todo item out of the trash.
+type RestoreTodoCommand struct {
+	repo TodoRestorer
+}
+
+// NewRestoreTodoCommand returns a new RestoreTodoCommand.
+func NewRestoreTodoCommand(repo TodoRestorer) *RestoreTodoCommand {
+	return &RestoreTodoCommand{
+		repo: repo,
+	}
+}
+
+// Execute restores the specified todo item and returns it.
+func (u *RestoreTodoCommand) Execute(ctx context.Context, input *domain.RestoreTodoInput) (*domain.RestoreTodoOutput, error) {
+	todo, err := u.repo.RestoreTodo(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("restore todo: %w", err)
+	}
+
+	output, err := domain.NewRestoreTodoOutput(todo)
+	if err != nil {
+		return nil, fmt.Errorf("create restore todo output: %w", err)
+	}
+
+	return output, nil
+}
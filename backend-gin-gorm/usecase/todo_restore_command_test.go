@@ -0,0 +1,52 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_RestoreTodoCommand_Execute_shouldReturnRestoredTodo_whenTodoIsInTrash(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewRestoreTodoInput(1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockTodoRestorer(t)
+	mockRepo.EXPECT().RestoreTodo(mock.Anything, input).Return(newTestTodo(t, 1, 2, "restored"), nil).Once()
+	cmd := usecase.NewRestoreTodoCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Todo.ID)
+	assert.Nil(t, output.Todo.DeletedAt)
+}
+
+func Test_RestoreTodoCommand_Execute_shouldReturnError_whenTodoIsNotInTrash(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input, err := domain.NewRestoreTodoInput(1, 2)
+	require.NoError(t, err)
+	mockRepo := NewMockTodoRestorer(t)
+	mockRepo.EXPECT().RestoreTodo(mock.Anything, input).Return(nil, domain.ErrTodoNotFound).Once()
+	cmd := usecase.NewRestoreTodoCommand(mockRepo)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoNotFound)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoReverter defines the interface for setting the fields of a todo back to their values at an earlier revision.
+type TodoReverter interface {
+	RevertTodo(ctx context.Context, input *domain.RevertTodoInput) (*domain.Todo, error)
+}
+
+// RevertTodoCommand sets the fields of a todo item back to their values at an earlier revision.
+type RevertTodoCommand struct {
+	repo TodoReverter
+}
+
+// NewRevertTodoCommand returns a new RevertTodoCommand.
+func NewRevertTodoCommand(repo TodoReverter) *RevertTodoCommand {
+	return &RevertTodoCommand{
+		repo: repo,
+	}
+}
+
+// Execute reverts the specified todo item and returns it. The revert is recorded as a new revision.
+func (u *RevertTodoCommand) Execute(ctx context.Context, input *domain.RevertTodoInput) (*domain.RevertTodoOutput, error) {
+	todo, err := u.repo.RevertTodo(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("revert todo: %w", err)
+	}
+
+	output, err := domain.NewRevertTodoOutput(todo)
+	if err != nil {
+		return nil, fmt.Errorf("create revert todo output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_RevertTodoCommand_Execute_shouldReturnRevertedTodo_whenRevisionExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewRevertTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "original")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, "updated", true, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	revertInput, err := domain.NewRevertTodoInput(created.ID, userID, 1, nil)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, revertInput)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, "original", output.Todo.Text)
+	assert.False(t, output.Todo.IsComplete)
+
+	todos, err := findTodos(ctx, repo, userID)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, "original", todos[0].Text)
+}
+
+func Test_RevertTodoCommand_Execute_shouldReturnError_whenRevisionNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewRevertTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "original")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	revertInput, err := domain.NewRevertTodoInput(created.ID, userID, 5, nil)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, revertInput)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrTodoRevisionNotFound)
+	assert.Nil(t, output)
+}
+
+func Test_RevertTodoCommand_Execute_shouldRecordRevertAsRevision_whenRevisionExists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewRevertTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "original")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, "updated", false, nil)
+	require.NoError(t, err)
+	_, err = repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	revertInput, err := domain.NewRevertTodoInput(created.ID, userID, 1, nil)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, revertInput)
+
+	// then
+	require.NoError(t, err)
+	historyInput, err := domain.NewFindTodoHistoryInput(created.ID, userID)
+	require.NoError(t, err)
+	revisions, err := repo.FindTodoHistory(ctx, historyInput)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, domain.TodoRevisionReverted, revisions[2].Action)
+	require.NotNil(t, revisions[2].RevertedFrom)
+	assert.Equal(t, 1, *revisions[2].RevertedFrom)
+	assert.Equal(t, []domain.TodoField{domain.TodoFieldText}, revisions[2].ChangedFields)
+}
+
+func Test_RevertTodoCommand_Execute_shouldReturnError_whenVersionIsStale(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewRevertTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(userID, "original")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, "updated", false, nil)
+	require.NoError(t, err)
+	updated, err := repo.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+
+	staleVersion := created.Version
+	revertInput, err := domain.NewRevertTodoInput(created.ID, userID, 1, &staleVersion)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, revertInput)
+
+	// then
+	var mismatch *domain.TodoVersionMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, updated.Version, mismatch.Current.Version)
+	assert.Nil(t, output)
+}
+
+func Test_RevertTodoCommand_Execute_shouldReturnError_whenUserMayOnlyViewTodo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	viewerID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, viewerID, domain.TodoListViewer)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	cmd := usecase.NewRevertTodoCommand(repo)
+
+	createInput, err := domain.NewCreateTodoInput(ownerID, "original")
+	require.NoError(t, err)
+	created, err := repo.CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+
+	revertInput, err := domain.NewRevertTodoInput(created.ID, viewerID, 1, nil)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, revertInput)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoSearcher defines the interface for searching the todos of a user.
+type TodoSearcher interface {
+	SearchTodos(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error)
+}
+
+// SearchTodosQuery searches the todos of a specific user.
+type SearchTodosQuery struct {
+	searcher TodoSearcher
+}
+
+// NewSearchTodosQuery returns a new SearchTodosQuery.
+func NewSearchTodosQuery(searcher TodoSearcher) *SearchTodosQuery {
+	return &SearchTodosQuery{
+		searcher: searcher,
+	}
+}
+
+// Execute returns the todos matching input, most relevant first.
+func (q *SearchTodosQuery) Execute(ctx context.Context, input *domain.SearchTodosInput) ([]domain.TodoSearchHit, error) {
+	hits, err := q.searcher.SearchTodos(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("search todos: %w", err)
+	}
+	return hits, nil
+}
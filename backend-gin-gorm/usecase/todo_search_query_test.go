@@ -0,0 +1,43 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_SearchTodosQuery_Execute_shouldReturnTodosMatchingEveryTerm(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	repo := gateway.NewTodoRepository(dbc.DB)
+	query := usecase.NewSearchTodosQuery(gateway.NewTodoFullTextSearchRepository(dbc.DB))
+
+	for _, text := range []string{"経費精算の締め切り", "経費の申請", "会議の準備"} {
+		input, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		_, err = repo.CreateTodo(ctx, input)
+		require.NoError(t, err)
+	}
+
+	searchInput, err := domain.NewSearchTodosInput(userID, "経費 締め切り", domain.DefaultTodoSearchLimit)
+	require.NoError(t, err)
+
+	// when
+	hits, err := query.Execute(ctx, searchInput)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "経費精算の締め切り", hits[0].Todo.Text)
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoUndoApplier defines the interface for applying undo tokens in the repository.
+type TodoUndoApplier interface {
+	ApplyTodoUndo(ctx context.Context, input *domain.ApplyTodoUndoInput) ([]domain.Todo, error)
+}
+
+// UndoTodosCommand reverses the write an undo token was issued for.
+type UndoTodosCommand struct {
+	repo TodoUndoApplier
+}
+
+// NewUndoTodosCommand returns a new UndoTodosCommand.
+func NewUndoTodosCommand(repo TodoUndoApplier) *UndoTodosCommand {
+	return &UndoTodosCommand{
+		repo: repo,
+	}
+}
+
+// Execute applies the token in input and returns the todos as the undo left them.
+// Returns ErrTodoUndoNotFound if the token cannot be used and ErrTodoUndoConflict if a todo changed since the write.
+func (u *UndoTodosCommand) Execute(ctx context.Context, input *domain.UndoTodosInput) (*domain.UndoTodosOutput, error) {
+	applyInput, err := domain.NewApplyTodoUndoInput(input, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("new apply todo undo input: %w", err)
+	}
+
+	todos, err := u.repo.ApplyTodoUndo(ctx, applyInput)
+	if err != nil {
+		return nil, fmt.Errorf("apply todo undo: %w", err)
+	}
+
+	output, err := domain.NewUndoTodosOutput(todos)
+	if err != nil {
+		return nil, fmt.Errorf("create undo todos output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func newTestUndoTodosInput(t *testing.T, userID int) *domain.UndoTodosInput {
+	t.Helper()
+	token, err := domain.GenerateTodoUndoToken()
+	require.NoError(t, err)
+	input, err := domain.NewUndoTodosInput(userID, token)
+	require.NoError(t, err)
+	return input
+}
+
+func Test_UndoTodosCommand_Execute_shouldReturnTodos_whenTokenIsApplied(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	input := newTestUndoTodosInput(t, 1)
+	todo := newTestTodo(t, 2, 1, "restored")
+	var applied *domain.ApplyTodoUndoInput
+	mockRepo := NewMockTodoUndoApplier(t)
+	mockRepo.EXPECT().ApplyTodoUndo(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, applyInput *domain.ApplyTodoUndoInput) ([]domain.Todo, error) {
+		applied = applyInput
+		return []domain.Todo{*todo}, nil
+	}).Once()
+	cmd := usecase.NewUndoTodosCommand(mockRepo)
+	started := time.Now()
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, output.Todos, 1)
+	assert.Equal(t, "restored", output.Todos[0].Text)
+	assert.Equal(t, input.Token, applied.Token)
+	assert.Equal(t, input.UserID, applied.UserID)
+	assert.WithinDuration(t, started, applied.Now, time.Second, "the token should be checked against the current time")
+}
+
+func Test_UndoTodosCommand_Execute_shouldReturnError_whenTokenCannotBeApplied(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		repoErr error
+	}{
+		{name: "not found", repoErr: domain.ErrTodoUndoNotFound},
+		{name: "conflict", repoErr: fmt.Errorf("todo 2: %w", domain.ErrTodoUndoConflict)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// given
+			input := newTestUndoTodosInput(t, 1)
+			mockRepo := NewMockTodoUndoApplier(t)
+			mockRepo.EXPECT().ApplyTodoUndo(mock.Anything, mock.Anything).Return(nil, tt.repoErr).Once()
+			cmd := usecase.NewUndoTodosCommand(mockRepo)
+
+			// when
+			output, err := cmd.Execute(ctx, input)
+
+			// then
+			require.ErrorIs(t, err, tt.repoErr)
+			assert.Nil(t, output)
+		})
+	}
+}
+
+func Test_TodoUsecase_UndoTodos_shouldRevertSharedTodo_whenEditorUndoesUpdate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ownerID := rand.Intn(1000000) + 1 //nolint:gosec
+	editorID := ownerID + 1
+
+	// given
+	cleanupTodoTable(t, ownerID)
+	cleanupTodoListMemberTable(t, ownerID)
+	shareTestTodoList(t, ctx, ownerID, editorID, domain.TodoListEditor)
+	broker := gateway.NewTodoEventBroker(10, gateway.NewTodoListMemberRepository(dbc.DB))
+	uc := newTestTodoUsecase(t, broker)
+	createInput, err := domain.NewCreateTodoInput(ownerID, "original")
+	require.NoError(t, err)
+	created, err := gateway.NewTodoRepository(dbc.DB).CreateTodo(ctx, createInput)
+	require.NoError(t, err)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, editorID, "edited", false, nil)
+	require.NoError(t, err)
+	updated, err := uc.UpdateTodo(ctx, updateInput)
+	require.NoError(t, err)
+	undoInput, err := domain.NewCreateTodoUndoInput(editorID, domain.TodoUndoUpdate, []domain.TodoUndoTarget{{TodoID: created.ID, Version: &updated.Todo.Version}})
+	require.NoError(t, err)
+	undo, err := uc.CreateTodoUndo(ctx, undoInput)
+	require.NoError(t, err)
+	require.NotNil(t, undo, "the editor should get a token for a write to a shared todo")
+	subscription := subscribeTestTodoEvents(t, broker, ownerID)
+	input, err := domain.NewUndoTodosInput(editorID, undo.Token)
+	require.NoError(t, err)
+
+	// when
+	output, err := uc.UndoTodos(ctx, input)
+
+	// then
+	// 共有された todo の変更は owner の購読者に届く
+	require.NoError(t, err)
+	require.Len(t, output.Todos, 1)
+	assert.Equal(t, "original", output.Todos[0].Text)
+	require.Len(t, subscription.Events, 1)
+	event := <-subscription.Events
+	assert.Equal(t, domain.TodoEventUpdated, event.Type)
+	assert.Equal(t, created.ID, event.TodoID)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoUndoRecorder defines the interface for recording the undo of a write to todos in the repository.
+type TodoUndoRecorder interface {
+	RecordTodoUndo(ctx context.Context, input *domain.RecordTodoUndoInput) (int, error)
+}
+
+// CreateTodoUndoCommand issues undo tokens that are valid for the undo TTL.
+type CreateTodoUndoCommand struct {
+	repo    TodoUndoRecorder
+	undoTTL time.Duration
+}
+
+// NewCreateTodoUndoCommand returns a new CreateTodoUndoCommand.
+func NewCreateTodoUndoCommand(repo TodoUndoRecorder, undoTTL time.Duration) *CreateTodoUndoCommand {
+	return &CreateTodoUndoCommand{
+		repo:    repo,
+		undoTTL: undoTTL,
+	}
+}
+
+// Execute issues a token for undoing the write to the todos in input.Targets.
+// Returns nil if none of the todos is still as the write left it, so that there is nothing to undo.
+func (u *CreateTodoUndoCommand) Execute(ctx context.Context, input *domain.CreateTodoUndoInput) (*domain.TodoUndo, error) {
+	token, err := domain.GenerateTodoUndoToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate todo undo token: %w", err)
+	}
+	expiresAt := time.Now().Add(u.undoTTL)
+	recordInput, err := domain.NewRecordTodoUndoInput(input, token, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("new record todo undo input: %w", err)
+	}
+
+	recorded, err := u.repo.RecordTodoUndo(ctx, recordInput)
+	if err != nil {
+		return nil, fmt.Errorf("record todo undo: %w", err)
+	}
+	if recorded == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	undo, err := domain.NewTodoUndo(token, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("create todo undo: %w", err)
+	}
+
+	return undo, nil
+}
@@ -0,0 +1,61 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_CreateTodoUndoCommand_Execute_shouldIssueToken_whenTodosRecorded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	var recorded *domain.RecordTodoUndoInput
+	mockRepo := NewMockTodoUndoRecorder(t)
+	mockRepo.EXPECT().RecordTodoUndo(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, input *domain.RecordTodoUndoInput) (int, error) {
+		recorded = input
+		return 1, nil
+	}).Once()
+	cmd := usecase.NewCreateTodoUndoCommand(mockRepo, 30*time.Second)
+	input, err := domain.NewCreateTodoUndoInput(1, domain.TodoUndoDelete, []domain.TodoUndoTarget{{TodoID: 2}})
+	require.NoError(t, err)
+	started := time.Now()
+
+	// when
+	undo, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, undo)
+	assert.Len(t, undo.Token, 32)
+	assert.Equal(t, undo.Token, recorded.Token, "the issued token should be the recorded one")
+	assert.Equal(t, undo.ExpiresAt, recorded.ExpiresAt)
+	assert.WithinDuration(t, started.Add(30*time.Second), undo.ExpiresAt, time.Second)
+}
+
+func Test_CreateTodoUndoCommand_Execute_shouldReturnNil_whenNothingToUndo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	mockRepo := NewMockTodoUndoRecorder(t)
+	mockRepo.EXPECT().RecordTodoUndo(mock.Anything, mock.Anything).Return(0, nil).Once()
+	cmd := usecase.NewCreateTodoUndoCommand(mockRepo, 30*time.Second)
+	input, err := domain.NewCreateTodoUndoInput(1, domain.TodoUndoUpdate, []domain.TodoUndoTarget{{TodoID: 2}})
+	require.NoError(t, err)
+
+	// when
+	undo, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, undo)
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// TodoUndoPurger defines the interface for deleting expired undo tokens from the repository.
+type TodoUndoPurger interface {
+	PurgeTodoUndos(ctx context.Context, input *domain.PurgeTodoUndosInput) (int, error)
+}
+
+// PurgeTodoUndosCommand deletes expired undo tokens.
+type PurgeTodoUndosCommand struct {
+	repo TodoUndoPurger
+}
+
+// NewPurgeTodoUndosCommand returns a new PurgeTodoUndosCommand.
+func NewPurgeTodoUndosCommand(repo TodoUndoPurger) *PurgeTodoUndosCommand {
+	return &PurgeTodoUndosCommand{
+		repo: repo,
+	}
+}
+
+// Execute purges batches of undo records until none that expired before input.ExpiredBefore remain or the context is canceled.
+func (u *PurgeTodoUndosCommand) Execute(ctx context.Context, input *domain.PurgeTodoUndosInput) (*domain.PurgeTodoUndosOutput, error) {
+	purgedCount := 0
+	for ctx.Err() == nil {
+		count, err := u.repo.PurgeTodoUndos(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("purge todo undos: %w", err)
+		}
+		purgedCount += count
+
+		if count < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewPurgeTodoUndosOutput(purgedCount)
+	if err != nil {
+		return nil, fmt.Errorf("create purge todo undos output: %w", err)
+	}
+
+	return output, nil
+}
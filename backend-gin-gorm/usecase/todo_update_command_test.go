@@ -28,7 +28,7 @@ func Test_UpdateTodoCommand_Execute_shouldReturnUpdatedTodo_whenValidInput(t *te
 	created, err := repo.CreateTodo(ctx, createInput)
 	require.NoError(t, err)
 
-	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, "updated", true)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, userID, "updated", true, nil)
 	require.NoError(t, err)
 
 	// when
@@ -41,7 +41,7 @@ func Test_UpdateTodoCommand_Execute_shouldReturnUpdatedTodo_whenValidInput(t *te
 	assert.True(t, output.Todo.IsComplete)
 
 	// DB にも反映されていることを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	require.Len(t, todos, 1)
 	assert.Equal(t, "updated", todos[0].Text)
@@ -58,7 +58,7 @@ func Test_UpdateTodoCommand_Execute_shouldReturnError_whenTodoNotFound(t *testin
 	repo := gateway.NewTodoRepository(dbc.DB)
 	cmd := usecase.NewUpdateTodoCommand(repo)
 
-	updateInput, err := domain.NewUpdateTodoInput(999999999, userID, "updated", true)
+	updateInput, err := domain.NewUpdateTodoInput(999999999, userID, "updated", true, nil)
 	require.NoError(t, err)
 
 	// when
@@ -88,7 +88,7 @@ func Test_UpdateTodoCommand_Execute_shouldReturnError_whenUserIDDoesNotMatch(t *
 	require.NoError(t, err)
 
 	// 別ユーザーの ID で更新を試みる
-	updateInput, err := domain.NewUpdateTodoInput(created.ID, otherUserID, "hacked", true)
+	updateInput, err := domain.NewUpdateTodoInput(created.ID, otherUserID, "hacked", true, nil)
 	require.NoError(t, err)
 
 	// when
@@ -100,7 +100,7 @@ func Test_UpdateTodoCommand_Execute_shouldReturnError_whenUserIDDoesNotMatch(t *
 	require.ErrorIs(t, err, domain.ErrTodoNotFound)
 
 	// 元の todo が変更されていないことを確認
-	todos, err := repo.FindTodos(ctx, userID)
+	todos, err := findTodos(ctx, repo, userID)
 	require.NoError(t, err)
 	require.Len(t, todos, 1)
 	assert.Equal(t, "original", todos[0].Text)
@@ -1,14 +1,17 @@
 package usecase_test
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
 	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
 )
 
@@ -68,3 +71,56 @@ func cleanupTodoTable(t *testing.T, userID int) {
 		t.Fatalf("Failed to delete from table todo: %v", err)
 	}
 }
+
+// findTodos returns the first page of the user's todos, which holds every todo created by a test.
+func findTodos(ctx context.Context, repo *gateway.TodoRepository, userID int) ([]domain.Todo, error) {
+	input, err := domain.NewFindTodosInput(userID, domain.TodoFilter{}, domain.DefaultTodoSort, domain.MaxTodoPageLimit, nil)
+	if err != nil {
+		return nil, err
+	}
+	page, err := repo.FindTodos(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return page.Todos, nil
+}
+
+// newTestTodo returns a todo for tests that stub the repository.
+func newTestTodo(t *testing.T, id int, userID int, text string) *domain.Todo {
+	t.Helper()
+	todo, err := domain.NewTodo(id, userID, text, false, time.Now(), time.Now())
+	if err != nil {
+		t.Fatalf("Failed to create todo: %v", err)
+	}
+	return todo
+}
+
+func newTestBlobStore(t *testing.T) *gateway.LocalBlobStore {
+	t.Helper()
+	blobStore, err := gateway.NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create blob store: %v", err)
+	}
+	return blobStore
+}
+
+// shareTestTodoList makes memberID a member of ownerID's todo list with the given role.
+func shareTestTodoList(t *testing.T, ctx context.Context, ownerID int, memberID int, role domain.TodoListRole) {
+	t.Helper()
+	repo := gateway.NewTodoListMemberRepository(dbc.DB)
+	inviteInput, err := domain.NewInviteTodoListMemberInput(ownerID, ownerID, "user"+strconv.Itoa(ownerID), "user"+strconv.Itoa(memberID), role)
+	if err != nil {
+		t.Fatalf("Failed to create invite input: %v", err)
+	}
+	invitation, err := repo.InviteTodoListMember(ctx, inviteInput)
+	if err != nil {
+		t.Fatalf("Failed to invite member: %v", err)
+	}
+	answerInput, err := domain.NewAnswerTodoListInvitationInput(invitation.ID, memberID, "user"+strconv.Itoa(memberID), true)
+	if err != nil {
+		t.Fatalf("Failed to create answer input: %v", err)
+	}
+	if _, err := repo.AnswerTodoListInvitation(ctx, answerInput); err != nil {
+		t.Fatalf("Failed to accept invitation: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewRepository composes all saved view persistence interfaces.
+type ViewRepository interface {
+	ViewCreator
+	ViewsFinder
+	ViewFinder
+	ViewUpdater
+	ViewDeleter
+}
+
+// ViewTodoRepository composes the todo interfaces views are evaluated with.
+type ViewTodoRepository interface {
+	TodoFinder
+	TodoCounter
+}
+
+// ViewUsecase orchestrates saved views via command/query objects.
+type ViewUsecase struct {
+	createViewCommand  *CreateViewCommand
+	findViewsQuery     *FindViewsQuery
+	updateViewCommand  *UpdateViewCommand
+	deleteViewCommand  *DeleteViewCommand
+	findViewTodosQuery *FindViewTodosQuery
+	logger             *slog.Logger
+}
+
+// NewViewUsecase returns a new ViewUsecase wired with the given view and todo repositories.
+func NewViewUsecase(repo ViewRepository, todoRepo ViewTodoRepository) *ViewUsecase {
+	return &ViewUsecase{
+		createViewCommand:  NewCreateViewCommand(repo),
+		findViewsQuery:     NewFindViewsQuery(repo, todoRepo),
+		updateViewCommand:  NewUpdateViewCommand(repo),
+		deleteViewCommand:  NewDeleteViewCommand(repo),
+		findViewTodosQuery: NewFindViewTodosQuery(repo, todoRepo),
+		logger:             slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-ViewUsecase")),
+	}
+}
+
+// CreateView saves a view for the user.
+func (u *ViewUsecase) CreateView(ctx context.Context, input *domain.CreateViewInput) (*domain.CreateViewOutput, error) {
+	output, err := u.createViewCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute create view command: %w", err)
+	}
+	return output, nil
+}
+
+// FindViews returns the user's views with the number of todos each currently matches.
+func (u *ViewUsecase) FindViews(ctx context.Context, userID int) ([]domain.ViewWithCount, error) {
+	ctx, span := tracer.Start(ctx, "FindViews")
+	defer span.End()
+
+	views, err := u.findViewsQuery.Execute(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute find views query: %w", err)
+	}
+	return views, nil
+}
+
+// UpdateView replaces the name, filter and sort of a view.
+func (u *ViewUsecase) UpdateView(ctx context.Context, input *domain.UpdateViewInput) (*domain.UpdateViewOutput, error) {
+	output, err := u.updateViewCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute update view command: %w", err)
+	}
+	return output, nil
+}
+
+// DeleteView removes a view.
+func (u *ViewUsecase) DeleteView(ctx context.Context, input *domain.DeleteViewInput) error {
+	if err := u.deleteViewCommand.Execute(ctx, input); err != nil {
+		return fmt.Errorf("execute delete view command: %w", err)
+	}
+	return nil
+}
+
+// FindViewTodos returns one page of the todos a view matches, in the order of the view.
+func (u *ViewUsecase) FindViewTodos(ctx context.Context, input *domain.FindViewTodosInput) (*domain.TodoPage, error) {
+	ctx, span := tracer.Start(ctx, "FindViewTodos")
+	defer span.End()
+
+	page, err := u.findViewTodosQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find view todos query: %w", err)
+	}
+	return page, nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewCreator defines the interface for persisting new views.
+// Implementations must return domain.ErrViewLimitExceeded and domain.ErrDuplicateViewName when the view cannot be added.
+type ViewCreator interface {
+	CreateView(ctx context.Context, input *domain.CreateViewInput) (*domain.View, error)
+}
+
+// CreateViewCommand saves a view for a user.
+type CreateViewCommand struct {
+	repo ViewCreator
+}
+
+// NewCreateViewCommand returns a new CreateViewCommand.
+func NewCreateViewCommand(repo ViewCreator) *CreateViewCommand {
+	return &CreateViewCommand{
+		repo: repo,
+	}
+}
+
+// Execute creates the view and returns the created result.
+func (u *CreateViewCommand) Execute(ctx context.Context, input *domain.CreateViewInput) (*domain.CreateViewOutput, error) {
+	view, err := u.repo.CreateView(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("create view: %w", err)
+	}
+
+	output, err := domain.NewCreateViewOutput(view)
+	if err != nil {
+		return nil, fmt.Errorf("create create view output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,85 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_CreateViewCommand_Execute_shouldCreateView_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	cmd := usecase.NewCreateViewCommand(gateway.NewTodoViewRepository(dbc.DB))
+	sort := domain.TodoSort{Field: domain.TodoSortFieldText, Direction: domain.SortDirectionAsc}
+	input, err := domain.NewCreateViewInput(userID, "Invoices", "invoice is:open", sort)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Positive(t, output.View.ID)
+	assert.Equal(t, userID, output.View.UserID)
+	assert.Equal(t, "Invoices", output.View.Name)
+	assert.Equal(t, "invoice is:open", output.View.Filter)
+	assert.Equal(t, sort, output.View.Sort)
+}
+
+func Test_CreateViewCommand_Execute_shouldReturnError_whenNameIsTaken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	cmd := usecase.NewCreateViewCommand(gateway.NewTodoViewRepository(dbc.DB))
+	input, err := domain.NewCreateViewInput(userID, "Invoices", "invoice", domain.DefaultTodoSort)
+	require.NoError(t, err)
+	_, err = cmd.Execute(ctx, input)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrDuplicateViewName)
+	assert.Nil(t, output)
+}
+
+func Test_CreateViewCommand_Execute_shouldReturnError_whenViewLimitIsReached(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	cmd := usecase.NewCreateViewCommand(gateway.NewTodoViewRepository(dbc.DB))
+	for i := range domain.MaxViewsPerUser {
+		input, err := domain.NewCreateViewInput(userID, "view "+strconv.Itoa(i), "is:open", domain.DefaultTodoSort)
+		require.NoError(t, err)
+		_, err = cmd.Execute(ctx, input)
+		require.NoError(t, err)
+	}
+	input, err := domain.NewCreateViewInput(userID, "one too many", "is:open", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewLimitExceeded)
+	assert.Nil(t, output)
+}
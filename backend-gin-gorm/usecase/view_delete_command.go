@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewDeleter defines the interface for deleting views from the repository.
+type ViewDeleter interface {
+	DeleteView(ctx context.Context, input *domain.DeleteViewInput) error
+}
+
+// DeleteViewCommand removes a view.
+type DeleteViewCommand struct {
+	repo ViewDeleter
+}
+
+// NewDeleteViewCommand returns a new DeleteViewCommand.
+func NewDeleteViewCommand(repo ViewDeleter) *DeleteViewCommand {
+	return &DeleteViewCommand{
+		repo: repo,
+	}
+}
+
+// Execute deletes the specified view.
+func (u *DeleteViewCommand) Execute(ctx context.Context, input *domain.DeleteViewInput) error {
+	if err := u.repo.DeleteView(ctx, input); err != nil {
+		return fmt.Errorf("delete view: %w", err)
+	}
+
+	return nil
+}
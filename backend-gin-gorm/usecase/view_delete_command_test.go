@@ -0,0 +1,73 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_DeleteViewCommand_Execute_shouldDeleteView_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	cmd := usecase.NewDeleteViewCommand(viewRepo)
+
+	createInput, err := domain.NewCreateViewInput(userID, "Invoices", "invoice", domain.DefaultTodoSort)
+	require.NoError(t, err)
+	view, err := viewRepo.CreateView(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteViewInput(view.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+
+	// DB からも削除されていることを確認
+	views, err := viewRepo.FindViews(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, views)
+}
+
+func Test_DeleteViewCommand_Execute_shouldReturnError_whenViewOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	cmd := usecase.NewDeleteViewCommand(viewRepo)
+
+	createInput, err := domain.NewCreateViewInput(userID, "Invoices", "invoice", domain.DefaultTodoSort)
+	require.NoError(t, err)
+	view, err := viewRepo.CreateView(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewDeleteViewInput(view.ID, otherUserID)
+	require.NoError(t, err)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewNotFound)
+	views, err := viewRepo.FindViews(ctx, userID)
+	require.NoError(t, err)
+	assert.Len(t, views, 1, "the view should be kept")
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewsFinder defines the interface for listing the views of a user.
+type ViewsFinder interface {
+	FindViews(ctx context.Context, userID int) ([]domain.View, error)
+}
+
+// TodoCounter defines the interface for counting the unarchived todos of a user matching a filter.
+type TodoCounter interface {
+	CountTodos(ctx context.Context, userID int, filter *domain.TodoFilter) (int, error)
+}
+
+// FindViewsQuery retrieves the views of a user with live todo counts.
+type FindViewsQuery struct {
+	repo     ViewsFinder
+	todoRepo TodoCounter
+}
+
+// NewFindViewsQuery returns a new FindViewsQuery.
+func NewFindViewsQuery(repo ViewsFinder, todoRepo TodoCounter) *FindViewsQuery {
+	return &FindViewsQuery{
+		repo:     repo,
+		todoRepo: todoRepo,
+	}
+}
+
+// Execute returns the views of the user, each with the number of todos its filter matches now.
+func (q *FindViewsQuery) Execute(ctx context.Context, userID int) ([]domain.ViewWithCount, error) {
+	views, err := q.repo.FindViews(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find views: %w", err)
+	}
+
+	now := time.Now()
+	counted := make([]domain.ViewWithCount, len(views))
+	for i, view := range views {
+		filter, err := view.TodoFilter(now)
+		if err != nil {
+			return nil, fmt.Errorf("translate filter of view %d: %w", view.ID, err)
+		}
+		count, err := q.todoRepo.CountTodos(ctx, userID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("count todos of view %d: %w", view.ID, err)
+		}
+		counted[i] = domain.ViewWithCount{
+			View:      view,
+			TodoCount: count,
+		}
+	}
+
+	return counted, nil
+}
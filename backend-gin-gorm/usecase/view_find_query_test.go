@@ -0,0 +1,63 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func cleanupTodoViewTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := dbc.DB.Exec("DELETE FROM todo_view WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table todo_view: %v", err)
+	}
+}
+
+func Test_FindViewsQuery_Execute_shouldReturnLiveTodoCountOfEachView(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupTodoViewTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	query := usecase.NewFindViewsQuery(viewRepo, todoRepo)
+
+	for _, text := range []string{"invoice A", "invoice B", "meeting"} {
+		input, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		_, err = todoRepo.CreateTodo(ctx, input)
+		require.NoError(t, err)
+	}
+	for _, def := range []struct{ name, filter string }{
+		{"Invoices", "invoice"},
+		{"Done", "is:done"},
+		{"Today", "created<1d"},
+	} {
+		input, err := domain.NewCreateViewInput(userID, def.name, def.filter, domain.DefaultTodoSort)
+		require.NoError(t, err)
+		_, err = viewRepo.CreateView(ctx, input)
+		require.NoError(t, err)
+	}
+
+	// when
+	views, err := query.Execute(ctx, userID)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, views, 3)
+	counts := map[string]int{}
+	for _, view := range views {
+		counts[view.View.Name] = view.TodoCount
+	}
+	assert.Equal(t, map[string]int{"Invoices": 2, "Done": 0, "Today": 3}, counts)
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewFinder defines the interface for fetching a single view of a user.
+// Implementations must return domain.ErrViewNotFound when the view does not exist for the user.
+type ViewFinder interface {
+	FindView(ctx context.Context, id int, userID int) (*domain.View, error)
+}
+
+// FindViewTodosQuery lists the todos a view matches.
+type FindViewTodosQuery struct {
+	repo     ViewFinder
+	todoRepo TodoFinder
+}
+
+// NewFindViewTodosQuery returns a new FindViewTodosQuery.
+func NewFindViewTodosQuery(repo ViewFinder, todoRepo TodoFinder) *FindViewTodosQuery {
+	return &FindViewTodosQuery{
+		repo:     repo,
+		todoRepo: todoRepo,
+	}
+}
+
+// Execute evaluates the filter of the view now and returns one page of the matching todos in the order of the view.
+// Returns an error wrapping ErrInvalidCursor if the cursor was issued for a different sort.
+func (q *FindViewTodosQuery) Execute(ctx context.Context, input *domain.FindViewTodosInput) (*domain.TodoPage, error) {
+	view, err := q.repo.FindView(ctx, input.ID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find view: %w", err)
+	}
+
+	filter, err := view.TodoFilter(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("translate filter of view %d: %w", view.ID, err)
+	}
+
+	findInput, err := domain.NewFindTodosInput(input.UserID, *filter, view.Sort, input.Limit, input.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("new find todos input: %w", err)
+	}
+
+	page, err := q.todoRepo.FindTodos(ctx, findInput)
+	if err != nil {
+		return nil, fmt.Errorf("find todos: %w", err)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindViewTodosQuery_Execute_shouldReturnMatchingTodosInViewOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoTable(t, userID)
+	cleanupTodoViewTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	query := usecase.NewFindViewTodosQuery(viewRepo, todoRepo)
+
+	for _, text := range []string{"invoice A", "meeting", "invoice B"} {
+		input, err := domain.NewCreateTodoInput(userID, text)
+		require.NoError(t, err)
+		_, err = todoRepo.CreateTodo(ctx, input)
+		require.NoError(t, err)
+	}
+	sort := domain.TodoSort{Field: domain.TodoSortFieldText, Direction: domain.SortDirectionDesc}
+	viewInput, err := domain.NewCreateViewInput(userID, "Invoices", "invoice", sort)
+	require.NoError(t, err)
+	view, err := viewRepo.CreateView(ctx, viewInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindViewTodosInput(view.ID, userID, 1, nil)
+	require.NoError(t, err)
+
+	// when
+	first, err := query.Execute(ctx, input)
+	require.NoError(t, err)
+	input.Cursor = first.NextCursor
+	second, err := query.Execute(ctx, input)
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, first.Todos, 1)
+	assert.Equal(t, "invoice B", first.Todos[0].Text)
+	require.Len(t, second.Todos, 1)
+	assert.Equal(t, "invoice A", second.Todos[0].Text)
+	assert.Nil(t, second.NextCursor, "the last page should not have a next cursor")
+}
+
+func Test_FindViewTodosQuery_Execute_shouldReturnError_whenViewOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	todoRepo := gateway.NewTodoRepository(dbc.DB)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	query := usecase.NewFindViewTodosQuery(viewRepo, todoRepo)
+
+	viewInput, err := domain.NewCreateViewInput(userID, "Open", "is:open", domain.DefaultTodoSort)
+	require.NoError(t, err)
+	view, err := viewRepo.CreateView(ctx, viewInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewFindViewTodosInput(view.ID, otherUserID, domain.DefaultTodoPageLimit, nil)
+	require.NoError(t, err)
+
+	// when
+	page, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewNotFound)
+	assert.Nil(t, page)
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// ViewUpdater defines the interface for updating views in the repository.
+// Implementations must return domain.ErrDuplicateViewName when the new name is taken by another view.
+type ViewUpdater interface {
+	UpdateView(ctx context.Context, input *domain.UpdateViewInput) (*domain.View, error)
+}
+
+// UpdateViewCommand replaces the definition of a view.
+type UpdateViewCommand struct {
+	repo ViewUpdater
+}
+
+// NewUpdateViewCommand returns a new UpdateViewCommand.
+func NewUpdateViewCommand(repo ViewUpdater) *UpdateViewCommand {
+	return &UpdateViewCommand{
+		repo: repo,
+	}
+}
+
+// Execute updates the view and returns the updated result.
+func (u *UpdateViewCommand) Execute(ctx context.Context, input *domain.UpdateViewInput) (*domain.UpdateViewOutput, error) {
+	view, err := u.repo.UpdateView(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("update view: %w", err)
+	}
+
+	output, err := domain.NewUpdateViewOutput(view)
+	if err != nil {
+		return nil, fmt.Errorf("create update view output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,101 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_UpdateViewCommand_Execute_shouldReplaceDefinition_whenValidInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	cmd := usecase.NewUpdateViewCommand(viewRepo)
+
+	createInput, err := domain.NewCreateViewInput(userID, "Invoices", "invoice", domain.DefaultTodoSort)
+	require.NoError(t, err)
+	view, err := viewRepo.CreateView(ctx, createInput)
+	require.NoError(t, err)
+
+	sort := domain.TodoSort{Field: domain.TodoSortFieldText, Direction: domain.SortDirectionDesc}
+	input, err := domain.NewUpdateViewInput(view.ID, userID, "Open invoices", "invoice is:open", sort)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, view.ID, output.View.ID)
+	assert.Equal(t, "Open invoices", output.View.Name)
+	assert.Equal(t, "invoice is:open", output.View.Filter)
+	assert.Equal(t, sort, output.View.Sort)
+}
+
+func Test_UpdateViewCommand_Execute_shouldReturnError_whenNameIsTakenByAnotherView(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	cmd := usecase.NewUpdateViewCommand(viewRepo)
+
+	var views []*domain.View
+	for _, name := range []string{"Invoices", "Meetings"} {
+		createInput, err := domain.NewCreateViewInput(userID, name, "is:open", domain.DefaultTodoSort)
+		require.NoError(t, err)
+		view, err := viewRepo.CreateView(ctx, createInput)
+		require.NoError(t, err)
+		views = append(views, view)
+	}
+
+	input, err := domain.NewUpdateViewInput(views[1].ID, userID, "Invoices", "is:open", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrDuplicateViewName)
+	assert.Nil(t, output)
+}
+
+func Test_UpdateViewCommand_Execute_shouldReturnError_whenViewOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupTodoViewTable(t, userID)
+	viewRepo := gateway.NewTodoViewRepository(dbc.DB)
+	cmd := usecase.NewUpdateViewCommand(viewRepo)
+
+	createInput, err := domain.NewCreateViewInput(userID, "Invoices", "invoice", domain.DefaultTodoSort)
+	require.NoError(t, err)
+	view, err := viewRepo.CreateView(ctx, createInput)
+	require.NoError(t, err)
+
+	input, err := domain.NewUpdateViewInput(view.ID, otherUserID, "Hijacked", "is:done", domain.DefaultTodoSort)
+	require.NoError(t, err)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrViewNotFound)
+	assert.Nil(t, output)
+}
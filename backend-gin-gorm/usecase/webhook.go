@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookRepository composes all webhook persistence interfaces.
+type WebhookRepository interface {
+	WebhookCreator
+	WebhooksFinder
+	WebhookDeleter
+	WebhookDeliveriesFinder
+	WebhookDeliveryEnqueuer
+	WebhookDeliveryRepository
+}
+
+// WebhookUsecase orchestrates webhooks and the delivery of todo events to them via command/query objects.
+type WebhookUsecase struct {
+	createWebhookCommand            *CreateWebhookCommand
+	findWebhooksQuery               *FindWebhooksQuery
+	deleteWebhookCommand            *DeleteWebhookCommand
+	findWebhookDeliveriesQuery      *FindWebhookDeliveriesQuery
+	enqueueWebhookDeliveriesCommand *EnqueueWebhookDeliveriesCommand
+	deliverWebhooksCommand          *DeliverWebhooksCommand
+	logger                          *slog.Logger
+}
+
+// NewWebhookUsecase returns a new WebhookUsecase wired with the given repository and sender.
+// The URL of a new webhook is checked with urlChecker before it is registered.
+// Failed deliveries are retried as the policy allows; a claimed delivery is not attempted again for lease.
+func NewWebhookUsecase(repo WebhookRepository, urlChecker WebhookURLChecker, sender WebhookSender, policy *domain.WebhookRetryPolicy, lease time.Duration) *WebhookUsecase {
+	return &WebhookUsecase{
+		createWebhookCommand:            NewCreateWebhookCommand(repo, urlChecker),
+		findWebhooksQuery:               NewFindWebhooksQuery(repo),
+		deleteWebhookCommand:            NewDeleteWebhookCommand(repo),
+		findWebhookDeliveriesQuery:      NewFindWebhookDeliveriesQuery(repo),
+		enqueueWebhookDeliveriesCommand: NewEnqueueWebhookDeliveriesCommand(repo),
+		deliverWebhooksCommand:          NewDeliverWebhooksCommand(repo, sender, policy, lease),
+		logger:                          slog.Default().With(slog.String(domain.LoggerNameKey, domain.AppName+"-WebhookUsecase")),
+	}
+}
+
+// CreateWebhook registers a webhook for the user.
+func (u *WebhookUsecase) CreateWebhook(ctx context.Context, input *domain.CreateWebhookInput) (*domain.CreateWebhookOutput, error) {
+	output, err := u.createWebhookCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute create webhook command: %w", err)
+	}
+	return output, nil
+}
+
+// FindWebhooks returns the user's webhooks.
+func (u *WebhookUsecase) FindWebhooks(ctx context.Context, userID int) ([]domain.Webhook, error) {
+	webhooks, err := u.findWebhooksQuery.Execute(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("execute find webhooks query: %w", err)
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook.
+func (u *WebhookUsecase) DeleteWebhook(ctx context.Context, input *domain.DeleteWebhookInput) error {
+	if err := u.deleteWebhookCommand.Execute(ctx, input); err != nil {
+		return fmt.Errorf("execute delete webhook command: %w", err)
+	}
+	return nil
+}
+
+// FindWebhookDeliveries returns the most recent deliveries of a webhook.
+func (u *WebhookUsecase) FindWebhookDeliveries(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error) {
+	deliveries, err := u.findWebhookDeliveriesQuery.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute find webhook deliveries query: %w", err)
+	}
+	return deliveries, nil
+}
+
+// PublishEvents queues the delivery of the outbox events to the webhooks subscribed to them.
+// An event that was already queued is not queued again, so the events can be published more than once.
+func (u *WebhookUsecase) PublishEvents(ctx context.Context, events []domain.OutboxEvent) error {
+	ctx, span := tracer.Start(ctx, "PublishEvents")
+	defer span.End()
+
+	if _, err := u.enqueueWebhookDeliveriesCommand.Execute(ctx, events); err != nil {
+		return fmt.Errorf("execute enqueue webhook deliveries command: %w", err)
+	}
+	return nil
+}
+
+// DeliverWebhooks attempts the webhook deliveries that are due.
+func (u *WebhookUsecase) DeliverWebhooks(ctx context.Context, input *domain.DeliverWebhooksInput) (*domain.DeliverWebhooksOutput, error) {
+	ctx, span := tracer.Start(ctx, "DeliverWebhooks")
+	defer span.End()
+
+	output, err := u.deliverWebhooksCommand.Execute(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("execute deliver webhooks command: %w", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookCreator defines the interface for persisting new webhooks.
+// Implementations must return domain.ErrWebhookLimitExceeded when the webhook cannot be added.
+type WebhookCreator interface {
+	CreateWebhook(ctx context.Context, input *domain.CreateWebhookInput, secret string) (*domain.Webhook, error)
+}
+
+// WebhookURLChecker defines the interface for checking where a webhook URL leads before it is registered.
+// Implementations must return domain.ErrInvalidWebhookURL when the host of the URL is not a public address.
+type WebhookURLChecker interface {
+	CheckWebhookURL(ctx context.Context, rawURL string) error
+}
+
+// CreateWebhookCommand registers a webhook for a user.
+type CreateWebhookCommand struct {
+	repo       WebhookCreator
+	urlChecker WebhookURLChecker
+}
+
+// NewCreateWebhookCommand returns a new CreateWebhookCommand.
+func NewCreateWebhookCommand(repo WebhookCreator, urlChecker WebhookURLChecker) *CreateWebhookCommand {
+	return &CreateWebhookCommand{
+		repo:       repo,
+		urlChecker: urlChecker,
+	}
+}
+
+// Execute checks the URL, generates a signing secret, creates the webhook and returns the created result.
+func (u *CreateWebhookCommand) Execute(ctx context.Context, input *domain.CreateWebhookInput) (*domain.CreateWebhookOutput, error) {
+	if err := u.urlChecker.CheckWebhookURL(ctx, input.URL); err != nil {
+		return nil, fmt.Errorf("check webhook URL: %w", err)
+	}
+
+	secret, err := domain.GenerateWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	webhook, err := u.repo.CreateWebhook(ctx, input, secret)
+	if err != nil {
+		return nil, fmt.Errorf("create webhook: %w", err)
+	}
+
+	output, err := domain.NewCreateWebhookOutput(webhook)
+	if err != nil {
+		return nil, fmt.Errorf("create create webhook output: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,97 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+// newAcceptingWebhookURLChecker returns a checker that accepts every URL, so that the tests do not depend on DNS.
+func newAcceptingWebhookURLChecker(t *testing.T) *MockWebhookURLChecker {
+	t.Helper()
+	checker := NewMockWebhookURLChecker(t)
+	checker.EXPECT().CheckWebhookURL(mock.Anything, mock.Anything).Return(nil)
+	return checker
+}
+
+func Test_CreateWebhookCommand_Execute_shouldCreateWebhookWithNewSecret(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	cmd := usecase.NewCreateWebhookCommand(gateway.NewWebhookRepository(dbc.DB), newAcceptingWebhookURLChecker(t))
+	input, err := domain.NewCreateWebhookInput(userID, "https://example.com/hooks/todo", []domain.TodoEventType{domain.TodoEventCreated})
+	require.NoError(t, err)
+
+	// when
+	first, err := cmd.Execute(ctx, input)
+	require.NoError(t, err)
+	second, err := cmd.Execute(ctx, input)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, userID, first.Webhook.UserID)
+	assert.Equal(t, "https://example.com/hooks/todo", first.Webhook.URL)
+	assert.Equal(t, []domain.TodoEventType{domain.TodoEventCreated}, first.Webhook.Events)
+	assert.True(t, strings.HasPrefix(first.Webhook.Secret, "whsec_"))
+	assert.NotEqual(t, first.Webhook.Secret, second.Webhook.Secret, "each webhook should get its own secret")
+}
+
+func Test_CreateWebhookCommand_Execute_shouldReturnError_whenWebhookLimitIsReached(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	cmd := usecase.NewCreateWebhookCommand(gateway.NewWebhookRepository(dbc.DB), newAcceptingWebhookURLChecker(t))
+	input, err := domain.NewCreateWebhookInput(userID, "https://example.com/hooks/todo", []domain.TodoEventType{domain.TodoEventCreated})
+	require.NoError(t, err)
+	for range domain.MaxWebhooksPerUser {
+		_, err := cmd.Execute(ctx, input)
+		require.NoError(t, err)
+	}
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrWebhookLimitExceeded)
+	assert.Nil(t, output)
+}
+
+func Test_CreateWebhookCommand_Execute_shouldNotCreateWebhook_whenURLIsRejected(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	input, err := domain.NewCreateWebhookInput(userID, "https://internal.example.com/hooks/todo", []domain.TodoEventType{domain.TodoEventCreated})
+	require.NoError(t, err)
+	checker := NewMockWebhookURLChecker(t)
+	checker.EXPECT().CheckWebhookURL(mock.Anything, input.URL).Return(domain.ErrInvalidWebhookURL).Once()
+	cmd := usecase.NewCreateWebhookCommand(repo, checker)
+
+	// when
+	output, err := cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+	assert.Nil(t, output)
+	webhooks, err := repo.FindWebhooks(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookDeleter defines the interface for deleting webhooks from the repository.
+type WebhookDeleter interface {
+	DeleteWebhook(ctx context.Context, input *domain.DeleteWebhookInput) error
+}
+
+// DeleteWebhookCommand removes a webhook and its deliveries.
+type DeleteWebhookCommand struct {
+	repo WebhookDeleter
+}
+
+// NewDeleteWebhookCommand returns a new DeleteWebhookCommand.
+func NewDeleteWebhookCommand(repo WebhookDeleter) *DeleteWebhookCommand {
+	return &DeleteWebhookCommand{
+		repo: repo,
+	}
+}
+
+// Execute deletes the specified webhook. Its pending deliveries are dropped.
+func (u *DeleteWebhookCommand) Execute(ctx context.Context, input *domain.DeleteWebhookInput) error {
+	if err := u.repo.DeleteWebhook(ctx, input); err != nil {
+		return fmt.Errorf("delete webhook: %w", err)
+	}
+
+	return nil
+}
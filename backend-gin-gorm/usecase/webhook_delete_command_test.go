@@ -0,0 +1,65 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_DeleteWebhookCommand_Execute_shouldDeleteWebhookAndItsDeliveries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	receiver, _ := newTestWebhookReceiver(t, http.StatusNoContent)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := setupWebhookDelivery(t, ctx, repo, userID, receiver.URL)
+	cmd := usecase.NewDeleteWebhookCommand(repo)
+	input, err := domain.NewDeleteWebhookInput(webhook.ID, userID)
+	require.NoError(t, err)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	webhooks, err := repo.FindWebhooks(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+	var count int64
+	require.NoError(t, dbc.DB.Model(&gateway.WebhookDeliveryEntity{}).Where("webhook_id = ?", webhook.ID).Count(&count).Error) //nolint:exhaustruct
+	assert.Zero(t, count, "pending deliveries of the webhook should be dropped")
+}
+
+func Test_DeleteWebhookCommand_Execute_shouldReturnError_whenWebhookOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	cmd := usecase.NewDeleteWebhookCommand(repo)
+	input, err := domain.NewDeleteWebhookInput(webhook.ID, otherUserID)
+	require.NoError(t, err)
+
+	// when
+	err = cmd.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+	webhooks, err := repo.FindWebhooks(ctx, userID)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1, "the webhook should be kept")
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookDeliveryClaimer defines the interface for claiming due webhook deliveries for an attempt.
+type WebhookDeliveryClaimer interface {
+	ClaimWebhookDeliveries(ctx context.Context, input *domain.ClaimWebhookDeliveriesInput) ([]domain.ClaimedWebhookDelivery, error)
+}
+
+// WebhookAttemptRecorder defines the interface for storing the outcome of an attempt to deliver to a webhook.
+type WebhookAttemptRecorder interface {
+	RecordWebhookAttempt(ctx context.Context, input *domain.RecordWebhookAttemptInput) error
+}
+
+// WebhookSender defines the interface for sending a request to a webhook.
+// Implementations return the status code of the response, or an error if no response was received.
+type WebhookSender interface {
+	SendWebhook(ctx context.Context, request *domain.WebhookRequest) (int, error)
+}
+
+// WebhookDeliveryRepository composes the webhook delivery interfaces used by the delivery worker.
+type WebhookDeliveryRepository interface {
+	WebhookDeliveryClaimer
+	WebhookAttemptRecorder
+}
+
+// DeliverWebhooksCommand attempts the webhook deliveries that are due.
+type DeliverWebhooksCommand struct {
+	repo   WebhookDeliveryRepository
+	sender WebhookSender
+	policy *domain.WebhookRetryPolicy
+	lease  time.Duration
+}
+
+// NewDeliverWebhooksCommand returns a new DeliverWebhooksCommand.
+// A claimed delivery is not attempted again for lease, which must outlast a request to a webhook.
+func NewDeliverWebhooksCommand(repo WebhookDeliveryRepository, sender WebhookSender, policy *domain.WebhookRetryPolicy, lease time.Duration) *DeliverWebhooksCommand {
+	return &DeliverWebhooksCommand{
+		repo:   repo,
+		sender: sender,
+		policy: policy,
+		lease:  lease,
+	}
+}
+
+// Execute claims batches of due deliveries and attempts each batch concurrently until none are due or the context is canceled.
+// Failed attempts are retried later as the retry policy allows; deliveries that ran out of attempts are dead.
+// Deliveries are at least once: one whose outcome could not be recorded is attempted again when its lease ends.
+func (u *DeliverWebhooksCommand) Execute(ctx context.Context, input *domain.DeliverWebhooksInput) (*domain.DeliverWebhooksOutput, error) {
+	counts := make(map[domain.WebhookDeliveryStatus]int)
+	for ctx.Err() == nil {
+		now := time.Now()
+		claimInput, err := domain.NewClaimWebhookDeliveriesInput(now, now.Add(u.lease), input.BatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("new claim webhook deliveries input: %w", err)
+		}
+		claimed, err := u.repo.ClaimWebhookDeliveries(ctx, claimInput)
+		if err != nil {
+			return nil, fmt.Errorf("claim webhook deliveries: %w", err)
+		}
+
+		statuses, err := u.attempt(ctx, claimed)
+		for _, status := range statuses {
+			counts[status]++
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(claimed) < input.BatchSize {
+			break
+		}
+	}
+
+	output, err := domain.NewDeliverWebhooksOutput(counts[domain.WebhookDeliverySucceeded], counts[domain.WebhookDeliveryPending], counts[domain.WebhookDeliveryDead])
+	if err != nil {
+		return nil, fmt.Errorf("create deliver webhooks output: %w", err)
+	}
+
+	return output, nil
+}
+
+// attempt sends the claimed deliveries concurrently and records their outcomes.
+// Returns the statuses that were recorded and the first error.
+func (u *DeliverWebhooksCommand) attempt(ctx context.Context, claimed []domain.ClaimedWebhookDelivery) ([]domain.WebhookDeliveryStatus, error) {
+	statuses := make([]domain.WebhookDeliveryStatus, len(claimed))
+	errs := make([]error, len(claimed))
+
+	var wg sync.WaitGroup
+	for i := range claimed {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i], errs[i] = u.attemptOne(ctx, &claimed[i])
+		}()
+	}
+	wg.Wait()
+
+	recorded := make([]domain.WebhookDeliveryStatus, 0, len(claimed))
+	var firstErr error
+	for i := range claimed {
+		if errs[i] != nil {
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		recorded = append(recorded, statuses[i])
+	}
+
+	return recorded, firstErr
+}
+
+func (u *DeliverWebhooksCommand) attemptOne(ctx context.Context, claimed *domain.ClaimedWebhookDelivery) (domain.WebhookDeliveryStatus, error) {
+	request := domain.NewWebhookRequest(claimed, time.Now())
+	var statusCode *int
+	code, sendErr := u.sender.SendWebhook(ctx, request)
+	if sendErr != nil && ctx.Err() != nil {
+		// The worker is stopping; the delivery is attempted again when its lease ends
+		return "", fmt.Errorf("send webhook: %w", ctx.Err())
+	}
+	if sendErr == nil {
+		statusCode = &code
+	}
+
+	input, err := domain.NewRecordWebhookAttemptInput(&claimed.Delivery, u.policy, time.Now(), statusCode, sendErr)
+	if err != nil {
+		return "", fmt.Errorf("new record webhook attempt input: %w", err)
+	}
+	// Record the outcome even if the worker is stopping meanwhile, so that a delivered event is not sent again
+	if err := u.repo.RecordWebhookAttempt(context.WithoutCancel(ctx), input); err != nil {
+		return "", fmt.Errorf("record webhook attempt: %w", err)
+	}
+
+	return input.Status, nil
+}
@@ -0,0 +1,173 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+type receivedWebhook struct {
+	signature string
+	body      []byte
+}
+
+// newTestWebhookReceiver starts a receiver that answers every request with the status code and records what it received.
+func newTestWebhookReceiver(t *testing.T, statusCode int) (*httptest.Server, func() []receivedWebhook) {
+	t.Helper()
+	var mu sync.Mutex
+	var received []receivedWebhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, receivedWebhook{signature: r.Header.Get("X-Webhook-Signature"), body: body})
+		mu.Unlock()
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []receivedWebhook {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedWebhook(nil), received...)
+	}
+}
+
+// allowsLoopbackWebhookAddr lets the sender deliver to the local receivers, which listen on the loopback address.
+func allowsLoopbackWebhookAddr(addr netip.Addr) bool {
+	return addr.IsLoopback() || domain.IsPublicWebhookAddr(addr)
+}
+
+func setupWebhookDelivery(t *testing.T, ctx context.Context, repo *gateway.WebhookRepository, userID int, url string) *domain.Webhook {
+	t.Helper()
+	if err := dbc.DB.Exec("DELETE FROM webhook WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table webhook: %v", err)
+	}
+	webhook, err := repo.CreateWebhook(ctx, newLocalCreateWebhookInput(userID, url, []domain.TodoEventType{domain.TodoEventDeleted}), "whsec_test")
+	require.NoError(t, err)
+
+	event := domain.NewTodoDeletedEvent(userID, 1)
+	outboxEvent, err := domain.NewOutboxEvent(&event)
+	require.NoError(t, err)
+	_, err = usecase.NewEnqueueWebhookDeliveriesCommand(repo).Execute(ctx, []domain.OutboxEvent{*outboxEvent})
+	require.NoError(t, err)
+	return webhook
+}
+
+func findTestWebhookDelivery(t *testing.T, ctx context.Context, repo *gateway.WebhookRepository, webhook *domain.Webhook) domain.WebhookDelivery {
+	t.Helper()
+	input, err := domain.NewFindWebhookDeliveriesInput(webhook.ID, webhook.UserID, 10)
+	require.NoError(t, err)
+	deliveries, err := repo.FindWebhookDeliveries(ctx, input)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	return deliveries[0]
+}
+
+func Test_DeliverWebhooksCommand_Execute_shouldSendSignedPayload_whenReceiverAccepts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	receiver, received := newTestWebhookReceiver(t, http.StatusNoContent)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := setupWebhookDelivery(t, ctx, repo, userID, receiver.URL)
+	policy, err := domain.NewWebhookRetryPolicy(3, time.Minute, time.Hour)
+	require.NoError(t, err)
+	cmd := usecase.NewDeliverWebhooksCommand(repo, gateway.NewWebhookSender(time.Second, allowsLoopbackWebhookAddr), policy, time.Minute)
+	input, err := domain.NewDeliverWebhooksInput(100)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	delivery := findTestWebhookDelivery(t, ctx, repo, webhook)
+	assert.Equal(t, domain.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	require.NotNil(t, delivery.LastStatusCode)
+	assert.Equal(t, http.StatusNoContent, *delivery.LastStatusCode)
+
+	// - the receiver can verify the signature with the secret
+	requests := received()
+	require.Len(t, requests, 1)
+	ts, _, ok := strings.Cut(strings.TrimPrefix(requests[0].signature, "t="), ",")
+	require.True(t, ok)
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SignWebhookPayload("whsec_test", time.Unix(unix, 0), requests[0].body), requests[0].signature)
+	assert.JSONEq(t, string(delivery.Payload), string(requests[0].body))
+}
+
+func Test_DeliverWebhooksCommand_Execute_shouldRetryLater_whenReceiverFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	receiver, received := newTestWebhookReceiver(t, http.StatusInternalServerError)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := setupWebhookDelivery(t, ctx, repo, userID, receiver.URL)
+	policy, err := domain.NewWebhookRetryPolicy(3, time.Minute, time.Hour)
+	require.NoError(t, err)
+	cmd := usecase.NewDeliverWebhooksCommand(repo, gateway.NewWebhookSender(time.Second, allowsLoopbackWebhookAddr), policy, time.Minute)
+	input, err := domain.NewDeliverWebhooksInput(100)
+	require.NoError(t, err)
+	started := time.Now()
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	delivery := findTestWebhookDelivery(t, ctx, repo, webhook)
+	assert.Equal(t, domain.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, "unexpected status code 500", delivery.LastError)
+	assert.WithinDuration(t, started.Add(time.Minute), delivery.NextAttemptAt, 5*time.Second)
+	assert.Len(t, received(), 1)
+
+	// - the delivery is not attempted again before it is due
+	_, err = cmd.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Len(t, received(), 1)
+}
+
+func Test_DeliverWebhooksCommand_Execute_shouldDeadLetter_whenAttemptsRunOut(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) //nolint:gosec
+
+	// given
+	receiver, _ := newTestWebhookReceiver(t, http.StatusBadGateway)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := setupWebhookDelivery(t, ctx, repo, userID, receiver.URL)
+	policy, err := domain.NewWebhookRetryPolicy(1, time.Minute, time.Hour)
+	require.NoError(t, err)
+	cmd := usecase.NewDeliverWebhooksCommand(repo, gateway.NewWebhookSender(time.Second, allowsLoopbackWebhookAddr), policy, time.Minute)
+	input, err := domain.NewDeliverWebhooksInput(100)
+	require.NoError(t, err)
+
+	// when
+	_, err = cmd.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	delivery := findTestWebhookDelivery(t, ctx, repo, webhook)
+	assert.Equal(t, domain.WebhookDeliveryDead, delivery.Status)
+	assert.Equal(t, "unexpected status code 502", delivery.LastError)
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookDeliveryEnqueuer defines the interface for queueing deliveries of events to the webhooks subscribed to them.
+type WebhookDeliveryEnqueuer interface {
+	EnqueueWebhookDeliveries(ctx context.Context, events []domain.WebhookEvent) (int, error)
+}
+
+// EnqueueWebhookDeliveriesCommand queues the deliveries of outbox events to webhooks.
+type EnqueueWebhookDeliveriesCommand struct {
+	repo WebhookDeliveryEnqueuer
+}
+
+// NewEnqueueWebhookDeliveriesCommand returns a new EnqueueWebhookDeliveriesCommand.
+func NewEnqueueWebhookDeliveriesCommand(repo WebhookDeliveryEnqueuer) *EnqueueWebhookDeliveriesCommand {
+	return &EnqueueWebhookDeliveriesCommand{
+		repo: repo,
+	}
+}
+
+// Execute queues a delivery of each outbox event to every webhook of its user that subscribes to its type.
+// Returns the number of deliveries queued.
+func (u *EnqueueWebhookDeliveriesCommand) Execute(ctx context.Context, events []domain.OutboxEvent) (int, error) {
+	webhookEvents := make([]domain.WebhookEvent, 0, len(events))
+	for i := range events {
+		webhookEvent, err := domain.NewWebhookEvent(&events[i])
+		if err != nil {
+			return 0, fmt.Errorf("new webhook event: %w", err)
+		}
+		webhookEvents = append(webhookEvents, *webhookEvent)
+	}
+	if len(webhookEvents) == 0 {
+		return 0, nil
+	}
+
+	count, err := u.repo.EnqueueWebhookDeliveries(ctx, webhookEvents)
+	if err != nil {
+		return 0, fmt.Errorf("enqueue webhook deliveries: %w", err)
+	}
+
+	return count, nil
+}
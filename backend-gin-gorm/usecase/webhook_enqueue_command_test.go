@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func newTestOutboxEvent(t *testing.T, event domain.TodoEvent) domain.OutboxEvent {
+	t.Helper()
+	outboxEvent, err := domain.NewOutboxEvent(&event)
+	require.NoError(t, err)
+	return *outboxEvent
+}
+
+// findTestWebhookDeliveryEventIDs returns the IDs of the events queued for the webhook, most recent first.
+func findTestWebhookDeliveryEventIDs(t *testing.T, ctx context.Context, repo *gateway.WebhookRepository, webhook *domain.Webhook) []string {
+	t.Helper()
+	input, err := domain.NewFindWebhookDeliveriesInput(webhook.ID, webhook.UserID, domain.MaxWebhookDeliveryLimit)
+	require.NoError(t, err)
+	deliveries, err := repo.FindWebhookDeliveries(ctx, input)
+	require.NoError(t, err)
+	eventIDs := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		eventIDs = append(eventIDs, delivery.EventID)
+	}
+	return eventIDs
+}
+
+func Test_EnqueueWebhookDeliveriesCommand_Execute_shouldQueueEventsForSubscribedWebhooksOfUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupWebhookTable(t, userID)
+	cleanupWebhookTable(t, otherUserID)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	cmd := usecase.NewEnqueueWebhookDeliveriesCommand(repo)
+	onCreate := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	onChange := createTestWebhook(t, ctx, repo, userID, domain.TodoEventUpdated, domain.TodoEventDeleted)
+	othersOnDelete := createTestWebhook(t, ctx, repo, otherUserID, domain.TodoEventDeleted)
+
+	todo := newTestTodo(t, 1, userID, "task")
+	created := newTestOutboxEvent(t, domain.NewTodoChangedEvent(domain.TodoEventCreated, todo))
+	updated := newTestOutboxEvent(t, domain.NewTodoChangedEvent(domain.TodoEventUpdated, todo))
+	deleted := newTestOutboxEvent(t, domain.NewTodoDeletedEvent(userID, todo.ID))
+
+	// when
+	count, err := cmd.Execute(ctx, []domain.OutboxEvent{created, updated, deleted})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{created.EventID}, findTestWebhookDeliveryEventIDs(t, ctx, repo, onCreate))
+	assert.Equal(t, []string{deleted.EventID, updated.EventID}, findTestWebhookDeliveryEventIDs(t, ctx, repo, onChange))
+	assert.Empty(t, findTestWebhookDeliveryEventIDs(t, ctx, repo, othersOnDelete), "events should only go to the webhooks of their user")
+}
+
+func Test_EnqueueWebhookDeliveriesCommand_Execute_shouldQueueEventOnce_whenEventIsRelayedAgain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	cmd := usecase.NewEnqueueWebhookDeliveriesCommand(repo)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventDeleted)
+	deleted := newTestOutboxEvent(t, domain.NewTodoDeletedEvent(userID, 1))
+	_, err := cmd.Execute(ctx, []domain.OutboxEvent{deleted})
+	require.NoError(t, err)
+
+	// when
+	count, err := cmd.Execute(ctx, []domain.OutboxEvent{deleted})
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Equal(t, []string{deleted.EventID}, findTestWebhookDeliveryEventIDs(t, ctx, repo, webhook))
+}
+
+func Test_EnqueueWebhookDeliveriesCommand_Execute_shouldNotCallRepository_whenThereAreNoEvents(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// given
+	// EnqueueWebhookDeliveries が呼ばれた場合は mock が失敗させる
+	mockRepo := NewMockWebhookDeliveryEnqueuer(t)
+	cmd := usecase.NewEnqueueWebhookDeliveriesCommand(mockRepo)
+
+	// when
+	count, err := cmd.Execute(ctx, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhookDeliveriesFinder defines the interface for listing the deliveries of a webhook.
+// Implementations must return domain.ErrWebhookNotFound when the webhook does not exist for the user.
+type WebhookDeliveriesFinder interface {
+	FindWebhookDeliveries(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error)
+}
+
+// FindWebhookDeliveriesQuery lists the delivery log of a webhook.
+type FindWebhookDeliveriesQuery struct {
+	repo WebhookDeliveriesFinder
+}
+
+// NewFindWebhookDeliveriesQuery returns a new FindWebhookDeliveriesQuery.
+func NewFindWebhookDeliveriesQuery(repo WebhookDeliveriesFinder) *FindWebhookDeliveriesQuery {
+	return &FindWebhookDeliveriesQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the most recent deliveries of the webhook, most recent first.
+func (u *FindWebhookDeliveriesQuery) Execute(ctx context.Context, input *domain.FindWebhookDeliveriesInput) ([]domain.WebhookDelivery, error) {
+	deliveries, err := u.repo.FindWebhookDeliveries(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("find webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func Test_FindWebhookDeliveriesQuery_Execute_shouldReturnMostRecentDeliveriesFirst(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+
+	// given
+	cleanupWebhookTable(t, userID)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := createTestWebhook(t, ctx, repo, userID, domain.TodoEventDeleted)
+	enqueue := usecase.NewEnqueueWebhookDeliveriesCommand(repo)
+	eventIDs := make([]string, 0, 3)
+	for todoID := 1; todoID <= 3; todoID++ {
+		event := domain.NewTodoDeletedEvent(userID, todoID)
+		outboxEvent, err := domain.NewOutboxEvent(&event)
+		require.NoError(t, err)
+		_, err = enqueue.Execute(ctx, []domain.OutboxEvent{*outboxEvent})
+		require.NoError(t, err)
+		eventIDs = append(eventIDs, outboxEvent.EventID)
+	}
+	query := usecase.NewFindWebhookDeliveriesQuery(repo)
+	input, err := domain.NewFindWebhookDeliveriesInput(webhook.ID, userID, 2)
+	require.NoError(t, err)
+
+	// when
+	deliveries, err := query.Execute(ctx, input)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2, "no more deliveries than the limit should be returned")
+	assert.Equal(t, eventIDs[2], deliveries[0].EventID)
+	assert.Equal(t, eventIDs[1], deliveries[1].EventID)
+}
+
+func Test_FindWebhookDeliveriesQuery_Execute_shouldReturnError_whenWebhookOwnedByOtherUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	receiver, _ := newTestWebhookReceiver(t, http.StatusNoContent)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	webhook := setupWebhookDelivery(t, ctx, repo, userID, receiver.URL)
+	query := usecase.NewFindWebhookDeliveriesQuery(repo)
+	input, err := domain.NewFindWebhookDeliveriesInput(webhook.ID, otherUserID, domain.MaxWebhookDeliveryLimit)
+	require.NoError(t, err)
+
+	// when
+	deliveries, err := query.Execute(ctx, input)
+
+	// then
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+	assert.Nil(t, deliveries)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+)
+
+// WebhooksFinder defines the interface for listing the webhooks of a user.
+type WebhooksFinder interface {
+	FindWebhooks(ctx context.Context, userID int) ([]domain.Webhook, error)
+}
+
+// FindWebhooksQuery lists the webhooks of a user.
+type FindWebhooksQuery struct {
+	repo WebhooksFinder
+}
+
+// NewFindWebhooksQuery returns a new FindWebhooksQuery.
+func NewFindWebhooksQuery(repo WebhooksFinder) *FindWebhooksQuery {
+	return &FindWebhooksQuery{
+		repo: repo,
+	}
+}
+
+// Execute returns the webhooks of the user in creation order.
+func (u *FindWebhooksQuery) Execute(ctx context.Context, userID int) ([]domain.Webhook, error) {
+	webhooks, err := u.repo.FindWebhooks(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,67 @@
+package usecase_test
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/domain"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/gateway"
+	"github.com/mocoarow/todo-apps/backend-gin-gorm/usecase"
+)
+
+func cleanupWebhookTable(t *testing.T, userID int) {
+	t.Helper()
+	if err := dbc.DB.Exec("DELETE FROM webhook WHERE user_id = ?", userID).Error; err != nil {
+		t.Fatalf("Failed to delete from table webhook: %v", err)
+	}
+}
+
+// newLocalCreateWebhookInput returns the input for registering a webhook at a local receiver.
+// The input is built directly because NewCreateWebhookInput rejects the loopback address the receiver listens on.
+func newLocalCreateWebhookInput(userID int, url string, events []domain.TodoEventType) *domain.CreateWebhookInput {
+	return &domain.CreateWebhookInput{
+		UserID: userID,
+		URL:    url,
+		Events: events,
+	}
+}
+
+// createTestWebhook registers a webhook that points at a local receiver, so that deliveries queued for it never leave the host.
+func createTestWebhook(t *testing.T, ctx context.Context, repo *gateway.WebhookRepository, userID int, events ...domain.TodoEventType) *domain.Webhook {
+	t.Helper()
+	receiver, _ := newTestWebhookReceiver(t, http.StatusNoContent)
+	webhook, err := repo.CreateWebhook(ctx, newLocalCreateWebhookInput(userID, receiver.URL, events), "whsec_test")
+	require.NoError(t, err)
+	return webhook
+}
+
+func Test_FindWebhooksQuery_Execute_shouldReturnWebhooksOfUserInCreationOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	userID := rand.Intn(1000000) + 1 //nolint:gosec
+	otherUserID := userID + 1
+
+	// given
+	cleanupWebhookTable(t, userID)
+	cleanupWebhookTable(t, otherUserID)
+	repo := gateway.NewWebhookRepository(dbc.DB)
+	query := usecase.NewFindWebhooksQuery(repo)
+	first := createTestWebhook(t, ctx, repo, userID, domain.TodoEventCreated)
+	second := createTestWebhook(t, ctx, repo, userID, domain.TodoEventUpdated, domain.TodoEventDeleted)
+	createTestWebhook(t, ctx, repo, otherUserID, domain.TodoEventCreated)
+
+	// when
+	webhooks, err := query.Execute(ctx, userID)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2, "webhooks of other users should not be returned")
+	assert.Equal(t, first.ID, webhooks[0].ID)
+	assert.Equal(t, second.ID, webhooks[1].ID)
+	assert.Equal(t, []domain.TodoEventType{domain.TodoEventUpdated, domain.TodoEventDeleted}, webhooks[1].Events)
+}
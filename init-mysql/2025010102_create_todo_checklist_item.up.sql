@@ -0,0 +1,12 @@
+CREATE TABLE `todo_checklist_item` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`todo_id` INT NOT NULL
+,`text` VARCHAR(255) NOT NULL
+,`is_checked` BOOLEAN NOT NULL DEFAULT FALSE
+,`position` INT NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,KEY `idx_todo_checklist_item_todo_id_position` (`todo_id`, `position`)
+,CONSTRAINT `fk_todo_checklist_item_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE
+);
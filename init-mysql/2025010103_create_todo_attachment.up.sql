@@ -0,0 +1,13 @@
+CREATE TABLE `todo_attachment` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`todo_id` INT NOT NULL
+,`file_name` VARCHAR(255) NOT NULL
+,`content_type` VARCHAR(255) NOT NULL
+,`size` BIGINT NOT NULL
+,`storage_key` VARCHAR(255) NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,UNIQUE KEY `uk_todo_attachment_storage_key` (`storage_key`)
+,KEY `idx_todo_attachment_todo_id` (`todo_id`)
+,CONSTRAINT `fk_todo_attachment_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE `todo_comment` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`todo_id` INT NOT NULL
+,`author_user_id` INT NOT NULL
+,`author_login_id` VARCHAR(255) NOT NULL
+,`text` VARCHAR(2000) NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+,`edited_at` DATETIME(6) NULL
+,PRIMARY KEY (`id`)
+,KEY `idx_todo_comment_todo_id` (`todo_id`)
+,CONSTRAINT `fk_todo_comment_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE
+);
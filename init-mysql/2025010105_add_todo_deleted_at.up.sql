@@ -0,0 +1,4 @@
+ALTER TABLE `todo`
+ ADD COLUMN `deleted_at` DATETIME(6) NULL
+,ADD KEY `idx_todo_deleted_at` (`deleted_at`)
+;
@@ -0,0 +1,7 @@
+ALTER TABLE `todo`
+ ADD COLUMN `completed_at` DATETIME(6) NULL
+,ADD COLUMN `archived_at` DATETIME(6) NULL
+,ADD KEY `idx_todo_archived_at_completed_at` (`archived_at`, `completed_at`)
+;
+
+UPDATE `todo` SET `completed_at` = `updated_at`, `updated_at` = `updated_at` WHERE `is_complete` = TRUE;
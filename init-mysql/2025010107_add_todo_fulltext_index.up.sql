@@ -0,0 +1,10 @@
+-- The ngram parser drops every token containing a stopword, and single letters such as "a" and "i" are default stopwords
+SET SESSION innodb_ft_enable_stopword = OFF;
+
+ALTER TABLE `todo`
+ ADD FULLTEXT KEY `ftx_todo_text` (`text`) WITH PARSER ngram
+;
+
+ALTER TABLE `todo_comment`
+ ADD FULLTEXT KEY `ftx_todo_comment_text` (`text`) WITH PARSER ngram
+;
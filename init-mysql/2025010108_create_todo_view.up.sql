@@ -0,0 +1,12 @@
+CREATE TABLE `todo_view` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`user_id` INT NOT NULL
+,`name` VARCHAR(100) NOT NULL
+,`filter` VARCHAR(500) NOT NULL DEFAULT ''
+,`sort_field` VARCHAR(20) NOT NULL
+,`sort_direction` VARCHAR(4) NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,UNIQUE KEY `uk_todo_view_user_id_name` (`user_id`, `name`)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE `idempotency_key` (
+ `user_id` INT NOT NULL
+,`idempotency_key` VARCHAR(255) NOT NULL
+,`fingerprint` CHAR(64) NOT NULL
+,`status_code` INT NOT NULL DEFAULT 0
+,`response_header` TEXT NULL
+,`response_body` MEDIUMBLOB NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`expires_at` DATETIME(6) NOT NULL
+,PRIMARY KEY (`user_id`, `idempotency_key`)
+,KEY `idx_idempotency_key_expires_at` (`expires_at`)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE `todo_change_sequence` (
+ `user_id` INT NOT NULL
+,`last_seq` BIGINT NOT NULL
+,PRIMARY KEY (`user_id`)
+);
+ALTER TABLE `todo`
+ ADD COLUMN `created_change_seq` BIGINT NOT NULL DEFAULT 0
+,ADD COLUMN `change_seq` BIGINT NOT NULL DEFAULT 0
+,ADD KEY `idx_todo_user_id_change_seq` (`user_id`, `change_seq`, `id`)
+;
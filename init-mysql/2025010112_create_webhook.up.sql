@@ -0,0 +1,30 @@
+CREATE TABLE `webhook` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`user_id` INT NOT NULL
+,`url` VARCHAR(2048) NOT NULL
+,`events` VARCHAR(100) NOT NULL
+,`secret` VARCHAR(100) NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,KEY `idx_webhook_user_id` (`user_id`)
+);
+CREATE TABLE `webhook_delivery` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`webhook_id` INT NOT NULL
+,`event_id` CHAR(32) NOT NULL
+,`event_type` VARCHAR(20) NOT NULL
+,`payload` MEDIUMTEXT NOT NULL
+,`status` VARCHAR(20) NOT NULL
+,`attempts` INT NOT NULL DEFAULT 0
+,`next_attempt_at` DATETIME(6) NOT NULL
+,`last_attempt_at` DATETIME(6) NULL
+,`last_status_code` INT NULL
+,`last_error` VARCHAR(1000) NOT NULL DEFAULT ''
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,KEY `idx_webhook_delivery_status_next_attempt_at` (`status`, `next_attempt_at`)
+,KEY `idx_webhook_delivery_webhook_id` (`webhook_id`, `id`)
+,CONSTRAINT `fk_webhook_delivery_webhook_id` FOREIGN KEY (`webhook_id`) REFERENCES `webhook` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+CREATE TABLE `outbox` (
+ `id` BIGINT NOT NULL AUTO_INCREMENT
+,`event_id` CHAR(32) NOT NULL
+,`event_type` VARCHAR(20) NOT NULL
+,`user_id` INT NOT NULL
+,`todo_id` INT NOT NULL
+,`payload` MEDIUMTEXT NOT NULL
+,`occurred_at` DATETIME(6) NOT NULL
+,`attempts` INT NOT NULL DEFAULT 0
+,`available_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`dispatched_at` DATETIME(6) NULL
+,`last_error` VARCHAR(1000) NOT NULL DEFAULT ''
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,UNIQUE KEY `uk_outbox_event_id` (`event_id`)
+,KEY `idx_outbox_dispatched_at_available_at` (`dispatched_at`, `available_at`)
+);
+ALTER TABLE `webhook_delivery`
+ ADD UNIQUE KEY `uk_webhook_delivery_webhook_id_event_id` (`webhook_id`, `event_id`)
+;
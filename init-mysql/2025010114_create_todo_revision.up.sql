@@ -0,0 +1,15 @@
+CREATE TABLE `todo_revision` (
+ `id` BIGINT NOT NULL AUTO_INCREMENT
+,`todo_id` INT NOT NULL
+,`revision` INT NOT NULL
+,`action` VARCHAR(20) NOT NULL
+,`user_id` INT NOT NULL
+,`text` VARCHAR(255) NOT NULL
+,`is_complete` BOOLEAN NOT NULL
+,`changed_fields` VARCHAR(100) NOT NULL DEFAULT ''
+,`reverted_from` INT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,UNIQUE KEY `uk_todo_revision_todo_id_revision` (`todo_id`, `revision`)
+,CONSTRAINT `fk_todo_revision_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE `todo_undo` (
+ `token` CHAR(32) NOT NULL
+,`todo_id` INT NOT NULL
+,`user_id` INT NOT NULL
+,`action` VARCHAR(20) NOT NULL
+,`version` INT NOT NULL
+,`revision` INT NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`expires_at` DATETIME(6) NOT NULL
+,PRIMARY KEY (`token`, `todo_id`)
+,KEY `idx_todo_undo_expires_at` (`expires_at`)
+,CONSTRAINT `fk_todo_undo_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE
+);
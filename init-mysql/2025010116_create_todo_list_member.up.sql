@@ -0,0 +1,23 @@
+CREATE TABLE `todo_list_member` (
+ `list_owner_id` INT NOT NULL
+,`user_id` INT NOT NULL
+,`login_id` VARCHAR(255) NOT NULL
+,`role` VARCHAR(10) NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,`updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`list_owner_id`, `user_id`)
+,KEY `idx_todo_list_member_user_id` (`user_id`)
+);
+
+CREATE TABLE `todo_list_invitation` (
+ `id` INT NOT NULL AUTO_INCREMENT
+,`list_owner_id` INT NOT NULL
+,`invitee_login_id` VARCHAR(255) NOT NULL
+,`role` VARCHAR(10) NOT NULL
+,`inviter_user_id` INT NOT NULL
+,`inviter_login_id` VARCHAR(255) NOT NULL
+,`created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+,PRIMARY KEY (`id`)
+,UNIQUE KEY `uk_todo_list_invitation_list_owner_id_invitee_login_id` (`list_owner_id`, `invitee_login_id`)
+,KEY `idx_todo_list_invitation_invitee_login_id` (`invitee_login_id`)
+);